@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/karthickk/k8s-manager/pkg/utils"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newApplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply -f <file|dir|->",
+		Short: "Apply resources from manifests",
+		Long: `Create or update resources from YAML or JSON manifests using server-side apply.
+
+Files may contain multiple documents separated by '---'. When a directory is
+given, every .yaml, .yml and .json file in it is applied. Use '-' to read the
+manifests from standard input.
+
+Fields set by another field manager are not overwritten: the object fails
+with a conflict naming the manager, and --force-conflicts takes ownership.`,
+		Args: cobra.NoArgs,
+		RunE: runApply,
+	}
+
+	cmd.Flags().StringP("filename", "f", "", "File, directory or '-' for stdin containing the manifests")
+	cmd.Flags().StringP("namespace", "n", "", "Namespace for objects that do not set one (overrides config)")
+	cmd.Flags().BoolP("dry-run", "", false, "Preview the result without persisting any changes")
+	cmd.Flags().Bool("force-conflicts", false, "Take ownership of fields managed by another field manager")
+	_ = cmd.MarkFlagRequired("filename")
+
+	return cmd
+}
+
+func runApply(cmd *cobra.Command, args []string) error {
+	filename, _ := cmd.Flags().GetString("filename")
+	namespace, _ := cmd.Flags().GetString("namespace")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	forceConflicts, _ := cmd.Flags().GetBool("force-conflicts")
+
+	objects, err := readManifests(cmd.InOrStdin(), filename)
+	if err != nil {
+		return err
+	}
+
+	if len(objects) == 0 {
+		fmt.Println("No objects found in the manifests")
+		return nil
+	}
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	applier, err := client.NewApplier(k8s.ApplyOptions{
+		Namespace:        namespace,
+		EnforceNamespace: cmd.Flags().Changed("namespace"),
+		DryRun:           dryRun,
+		ForceConflicts:   forceConflicts,
+	})
+	if err != nil {
+		return err
+	}
+
+	suffix := ""
+	if dryRun {
+		suffix = " (dry run)"
+	}
+
+	ctx := cmd.Context()
+	failed := 0
+	w := utils.NewTableWriter(os.Stdout, false)
+	w.Header("RESOURCE", "RESULT")
+	for _, obj := range objects {
+		result, err := applier.Apply(ctx, obj)
+		if err != nil {
+			failed++
+			fmt.Fprintf(w, "%s\t❌ %v\n", k8s.ResourceName(obj), err)
+			continue
+		}
+		fmt.Fprintf(w, "%s\t✅ %s%s\n", k8s.ResourceName(obj), result, suffix)
+	}
+	w.Flush()
+
+	if failed > 0 {
+		return fmt.Errorf("failed to apply %d of %d objects", failed, len(objects))
+	}
+
+	return nil
+}
+
+// Helper functions
+
+// manifestExtensions lists the file extensions picked up when applying a directory
+var manifestExtensions = map[string]bool{
+	".yaml": true,
+	".yml":  true,
+	".json": true,
+}
+
+// readManifests decodes the objects from a file, a directory of manifests or
+// stdin when path is "-"
+func readManifests(stdin io.Reader, path string) ([]*unstructured.Unstructured, error) {
+	if path == "-" {
+		return k8s.DecodeManifests(stdin)
+	}
+
+	files, err := manifestFiles(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var objects []*unstructured.Unstructured
+	for _, file := range files {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open %s: %w", file, err)
+		}
+		decoded, err := k8s.DecodeManifests(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		objects = append(objects, decoded...)
+	}
+
+	return objects, nil
+}
+
+// manifestFiles returns the manifest files to read for a path, in name order
+// when the path is a directory
+func manifestFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory %s: %w", path, err)
+	}
+
+	var files []string
+	for _, entry := range entries {
+		if entry.IsDir() || !manifestExtensions[strings.ToLower(filepath.Ext(entry.Name()))] {
+			continue
+		}
+		files = append(files, filepath.Join(path, entry.Name()))
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no manifest files found in %s", path)
+	}
+
+	return files, nil
+}
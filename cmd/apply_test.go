@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyCommand(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []string
+		wantErr  bool
+		contains []string
+	}{
+		{
+			name:    "apply help",
+			args:    []string{"apply", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Apply resources from manifests",
+				"server-side apply",
+				"--filename",
+				"--namespace",
+				"--dry-run",
+				"--force-conflicts",
+				"--field-manager",
+			},
+		},
+		{
+			name:    "apply missing filename",
+			args:    []string{"apply"},
+			wantErr: true,
+		},
+		{
+			name:    "apply unexpected argument",
+			args:    []string{"apply", "-f", "deploy.yaml", "extra"},
+			wantErr: true,
+		},
+		{
+			name:    "apply missing file",
+			args:    []string{"apply", "-f", filepath.Join(t.TempDir(), "missing.yaml")},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := newRootCmd("test")
+			buf := new(bytes.Buffer)
+			cmd.SetOut(buf)
+			cmd.SetErr(buf)
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			output := buf.String()
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			for _, expected := range tc.contains {
+				assert.Contains(t, output, expected)
+			}
+		})
+	}
+}
+
+func TestReadManifests(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"01-namespace.yaml": "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: demo\n",
+		"02-app.yml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: app-config
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+`,
+		"03-service.json": `{"apiVersion": "v1", "kind": "Service", "metadata": {"name": "web"}}`,
+		"README.md":       "not a manifest",
+	}
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+
+	t.Run("directory", func(t *testing.T) {
+		objects, err := readManifests(nil, dir)
+		require.NoError(t, err)
+
+		names := make([]string, 0, len(objects))
+		for _, obj := range objects {
+			names = append(names, k8s.ResourceName(obj))
+		}
+		assert.Equal(t, []string{
+			"namespace/demo",
+			"configmap/app-config",
+			"deployment.apps/web",
+			"service/web",
+		}, names)
+	})
+
+	t.Run("single file", func(t *testing.T) {
+		objects, err := readManifests(nil, filepath.Join(dir, "02-app.yml"))
+		require.NoError(t, err)
+		assert.Len(t, objects, 2)
+	})
+
+	t.Run("stdin", func(t *testing.T) {
+		objects, err := readManifests(strings.NewReader(files["01-namespace.yaml"]), "-")
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		assert.Equal(t, "demo", objects[0].GetName())
+	})
+
+	t.Run("directory without manifests", func(t *testing.T) {
+		_, err := readManifests(nil, t.TempDir())
+		assert.Error(t, err)
+	})
+}
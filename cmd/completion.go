@@ -0,0 +1,223 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// completionTimeout bounds the API calls made while completing a command
+// line, so a slow cluster never hangs the shell.
+const completionTimeout = 5 * time.Second
+
+func newCompletionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "completion [bash|zsh|fish]",
+		Short: "Generate shell completion scripts",
+		Long: `Generate a completion script for bash, zsh, or fish.
+
+Pod, secret, and namespace names are completed live from the cluster.
+
+  Bash:  source <(k8s-manager completion bash)
+  Zsh:   k8s-manager completion zsh > "${fpath[1]}/_k8s-manager"
+  Fish:  k8s-manager completion fish | source`,
+		Args:                  cobra.ExactValidArgs(1),
+		ValidArgs:             []string{"bash", "zsh", "fish"},
+		DisableFlagsInUseLine: true,
+		RunE:                  runCompletion,
+	}
+
+	return cmd
+}
+
+func runCompletion(cmd *cobra.Command, args []string) error {
+	out := cmd.OutOrStdout()
+	root := cmd.Root()
+
+	switch args[0] {
+	case "bash":
+		return root.GenBashCompletionV2(out, true)
+	case "zsh":
+		return root.GenZshCompletion(out)
+	case "fish":
+		return root.GenFishCompletion(out, true)
+	default:
+		return fmt.Errorf("unsupported shell %q (supported: bash, zsh, fish)", args[0])
+	}
+}
+
+// registerNamespaceCompletion completes the --namespace flag of every command
+// in the tree with the namespaces in the cluster
+func registerNamespaceCompletion(cmd *cobra.Command) {
+	if cmd.Flags().Lookup("namespace") != nil {
+		_ = cmd.RegisterFlagCompletionFunc("namespace", completeNamespaces)
+	}
+	for _, sub := range cmd.Commands() {
+		registerNamespaceCompletion(sub)
+	}
+}
+
+func completeNamespaces(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	client, ctx, cancel, ok := newCompletionClient(cmd)
+	if !ok {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	defer cancel()
+
+	namespaces, err := client.Clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	names := make([]string, 0, len(namespaces.Items))
+	for _, ns := range namespaces.Items {
+		names = append(names, ns.Name)
+	}
+	return filterCompletions(names, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeNamespaceArg completes the first argument with namespace names
+func completeNamespaceArg(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return completeNamespaces(cmd, args, toComplete)
+}
+
+// completePodNames completes the first argument with pod names
+func completePodNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	client, ctx, cancel, ok := newCompletionClient(cmd)
+	if !ok {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	defer cancel()
+
+	pods, err := client.Clientset.CoreV1().Pods(completionNamespace(cmd, client)).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	names := make([]string, 0, len(pods.Items))
+	for _, pod := range pods.Items {
+		names = append(names, pod.Name)
+	}
+	return filterCompletions(names, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeSecretNames completes the first argument with secret names
+func completeSecretNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	client, ctx, cancel, ok := newCompletionClient(cmd)
+	if !ok {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	defer cancel()
+
+	secrets, err := client.Clientset.CoreV1().Secrets(completionNamespace(cmd, client)).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	names := make([]string, 0, len(secrets.Items))
+	for _, secret := range secrets.Items {
+		names = append(names, secret.Name)
+	}
+	return filterCompletions(names, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeConfigMapNames completes the names of config maps in the target namespace
+func completeConfigMapNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	client, ctx, cancel, ok := newCompletionClient(cmd)
+	if !ok {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	defer cancel()
+
+	configMaps, err := client.Clientset.CoreV1().ConfigMaps(completionNamespace(cmd, client)).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	names := make([]string, 0, len(configMaps.Items))
+	for _, configMap := range configMaps.Items {
+		names = append(names, configMap.Name)
+	}
+	return filterCompletions(names, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeSecretNameAndKey completes a secret name followed by one of its keys
+func completeSecretNameAndKey(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) == 0 {
+		return completeSecretNames(cmd, args, toComplete)
+	}
+	if len(args) > 1 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	client, ctx, cancel, ok := newCompletionClient(cmd)
+	if !ok {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	defer cancel()
+
+	secret, err := client.Clientset.CoreV1().Secrets(completionNamespace(cmd, client)).Get(ctx, args[0], metav1.GetOptions{})
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	keys := make([]string, 0, len(secret.Data))
+	for key := range secret.Data {
+		keys = append(keys, key)
+	}
+	return filterCompletions(keys, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// Helper functions
+
+func newCompletionClient(cmd *cobra.Command) (*k8s.Client, context.Context, context.CancelFunc, bool) {
+	client, err := k8s.NewClient()
+	if err != nil {
+		cobra.CompDebugln(fmt.Sprintf("failed to create Kubernetes client: %v", err), false)
+		return nil, nil, nil, false
+	}
+
+	parent := cmd.Context()
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(parent, completionTimeout)
+	return client, ctx, cancel, true
+}
+
+func completionNamespace(cmd *cobra.Command, client *k8s.Client) string {
+	if namespace, _ := cmd.Flags().GetString("namespace"); namespace != "" {
+		return namespace
+	}
+	return client.GetNamespace()
+}
+
+func filterCompletions(candidates []string, toComplete string) []string {
+	matches := []string{}
+	for _, candidate := range candidates {
+		if strings.HasPrefix(candidate, toComplete) {
+			matches = append(matches, candidate)
+		}
+	}
+	return matches
+}
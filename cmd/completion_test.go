@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompletionCommand(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []string
+		wantErr  bool
+		contains []string
+	}{
+		{
+			name:     "bash script",
+			args:     []string{"completion", "bash"},
+			contains: []string{"bash completion V2 for k8s-manager"},
+		},
+		{
+			name:     "zsh script",
+			args:     []string{"completion", "zsh"},
+			contains: []string{"#compdef k8s-manager"},
+		},
+		{
+			name:     "fish script",
+			args:     []string{"completion", "fish"},
+			contains: []string{"fish completion for k8s-manager"},
+		},
+		{
+			name:    "unsupported shell",
+			args:    []string{"completion", "tcsh"},
+			wantErr: true,
+		},
+		{
+			name:    "missing shell",
+			args:    []string{"completion"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := newRootCmd("test")
+			buf := new(bytes.Buffer)
+			cmd.SetOut(buf)
+			cmd.SetErr(buf)
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			for _, expected := range tc.contains {
+				assert.Contains(t, buf.String(), expected)
+			}
+		})
+	}
+}
+
+func TestCompletionRegistration(t *testing.T) {
+	cmd := newRootCmd("test")
+
+	for _, path := range [][]string{
+		{"pods", "get"},
+		{"pods", "delete"},
+		{"pods", "restart"},
+		{"pods", "ssh"},
+		{"secrets", "get"},
+		{"secrets", "delete"},
+		{"secrets", "decode"},
+	} {
+		sub, _, err := cmd.Find(path)
+		if assert.NoError(t, err) {
+			assert.NotNil(t, sub.ValidArgsFunction, "%v should complete resource names", path)
+			_, ok := sub.GetFlagCompletionFunc("namespace")
+			assert.True(t, ok, "%v should complete --namespace", path)
+		}
+	}
+}
+
+func TestFilterCompletions(t *testing.T) {
+	candidates := []string{"api-7d9f", "api-8c2a", "worker-1"}
+
+	assert.Equal(t, []string{"api-7d9f", "api-8c2a"}, filterCompletions(candidates, "api"))
+	assert.Equal(t, candidates, filterCompletions(candidates, ""))
+	assert.Empty(t, filterCompletions(candidates, "db"))
+}
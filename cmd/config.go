@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"bufio"
 	"fmt"
 	"os"
 	"os/exec"
@@ -11,6 +12,7 @@ import (
 	"github.com/karthickk/k8s-manager/pkg/config"
 	"github.com/karthickk/k8s-manager/pkg/k8s"
 	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
 )
 
 func newConfigCmd() *cobra.Command {
@@ -22,8 +24,13 @@ func newConfigCmd() *cobra.Command {
 
 	cmd.AddCommand(newConfigInitCmd())
 	cmd.AddCommand(newConfigShowCmd())
+	cmd.AddCommand(newConfigViewCmd())
+	cmd.AddCommand(newConfigPathCmd())
 	cmd.AddCommand(newConfigSetCmd())
 	cmd.AddCommand(newConfigValidateCmd())
+	cmd.AddCommand(newConfigPinCmd())
+	cmd.AddCommand(newConfigUnpinCmd())
+	cmd.AddCommand(newConfigSetContextCmd())
 
 	return cmd
 }
@@ -50,6 +57,34 @@ func newConfigShowCmd() *cobra.Command {
 	return cmd
 }
 
+func newConfigViewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "view",
+		Short: "Print the effective configuration as YAML",
+		Long: `Print the effective configuration as YAML: defaults merged with the
+configuration file and K8S_MANAGER_* environment variables.`,
+		Args: cobra.NoArgs,
+		RunE: runConfigView,
+	}
+
+	return cmd
+}
+
+func newConfigPathCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "path",
+		Short: "Print the configuration file location",
+		Long: `Print the location of the configuration file that is read and written.
+
+The file is looked up in the current directory, ~/.config/k8s-manager and
+/etc/k8s-manager, in that order. Set K8S_MANAGER_CONFIG to use another file.`,
+		Args: cobra.NoArgs,
+		RunE: runConfigPath,
+	}
+
+	return cmd
+}
+
 func newConfigSetCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "set <key> <value>",
@@ -73,6 +108,37 @@ func newConfigValidateCmd() *cobra.Command {
 	return cmd
 }
 
+func newConfigPinCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pin [kind/namespace/name]",
+		Short: "Pin a resource to the favorites",
+		Long: `Pin a pod, secret or namespace so it is listed under Favorites at the top of
+the interactive main menu. Without an argument, list the pinned resources.
+
+Examples:
+  k8s-manager config pin pod/shop/web-0
+  k8s-manager config pin secret/shop/db-credentials
+  k8s-manager config pin namespace/payments
+  k8s-manager config pin`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: runConfigPin,
+	}
+
+	return cmd
+}
+
+func newConfigUnpinCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unpin <kind/namespace/name>",
+		Short: "Remove a resource from the favorites",
+		Long:  `Remove a pinned pod, secret or namespace from the favorites.`,
+		Args:  cobra.ExactArgs(1),
+		RunE:  runConfigUnpin,
+	}
+
+	return cmd
+}
+
 func runConfigInit(cmd *cobra.Command, args []string) error {
 	fmt.Println("🚀 Initializing K8s Manager configuration...")
 	fmt.Println()
@@ -167,7 +233,7 @@ func runConfigInit(cmd *cobra.Command, args []string) error {
 	if err := config.Update("k8s.cluster_name", cfg.K8s.ClusterName); err != nil {
 		return fmt.Errorf("failed to save cluster name: %w", err)
 	}
-	if err := config.Update("k8s.namespace", cfg.K8s.Namespace); err != nil {
+	if err := config.SetNamespace(cfg.K8s.Namespace); err != nil {
 		return fmt.Errorf("failed to save namespace: %w", err)
 	}
 	if cfg.GCP.Zone != "" {
@@ -194,32 +260,90 @@ func runConfigShow(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to load config: %w", err)
 	}
 
-	fmt.Println("📋 Current K8s Manager Configuration:")
-	fmt.Println()
+	out := cmd.OutOrStdout()
 
-	fmt.Println("🌐 GCP Settings:")
-	fmt.Printf("  Project ID: %s\n", cfg.GCP.ProjectID)
-	fmt.Printf("  Zone:       %s\n", cfg.GCP.Zone)
-	fmt.Printf("  Region:     %s\n", cfg.GCP.Region)
-	fmt.Println()
+	fmt.Fprintln(out, "📋 Current K8s Manager Configuration:")
+	fmt.Fprintln(out)
 
-	fmt.Println("☸️  Kubernetes Settings:")
-	fmt.Printf("  Cluster:    %s\n", cfg.K8s.ClusterName)
-	fmt.Printf("  Namespace:  %s\n", cfg.K8s.Namespace)
-	fmt.Printf("  Config:     %s\n", filepath.Join(os.Getenv("HOME"), ".kube", "config"))
-	fmt.Println()
+	fmt.Fprintln(out, "🌐 GCP Settings:")
+	fmt.Fprintf(out, "  Project ID: %s\n", cfg.GCP.ProjectID)
+	fmt.Fprintf(out, "  Zone:       %s\n", cfg.GCP.Zone)
+	fmt.Fprintf(out, "  Region:     %s\n", cfg.GCP.Region)
+	fmt.Fprintln(out)
 
-	fmt.Println("🔐 SSH Settings:")
-	fmt.Printf("  Username:   %s\n", cfg.SSH.Username)
-	fmt.Printf("  Port:       %d\n", cfg.SSH.Port)
-	fmt.Printf("  Key Path:   %s\n", cfg.SSH.KeyPath)
-	fmt.Println()
+	fmt.Fprintln(out, "☸️  Kubernetes Settings:")
+	fmt.Fprintf(out, "  Cluster:    %s\n", cfg.K8s.ClusterName)
+	fmt.Fprintf(out, "  Namespace:  %s\n", cfg.Namespace())
+	fmt.Fprintf(out, "  Config:     %s\n", filepath.Join(os.Getenv("HOME"), ".kube", "config"))
+	fmt.Fprintln(out)
+
+	fmt.Fprintln(out, "🔐 SSH Settings:")
+	fmt.Fprintf(out, "  Username:   %s\n", cfg.SSH.Username)
+	fmt.Fprintf(out, "  Port:       %d\n", cfg.SSH.Port)
+	fmt.Fprintf(out, "  Key Path:   %s\n", cfg.SSH.KeyPath)
+	fmt.Fprintln(out)
+
+	fmt.Fprintf(out, "📊 Log Level:   %s\n", cfg.LogLevel)
+
+	return nil
+}
+
+func runConfigView(cmd *cobra.Command, args []string) error {
+	if _, err := config.Load(); err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	data, err := yaml.Marshal(config.Settings())
+	if err != nil {
+		return fmt.Errorf("failed to encode config: %w", err)
+	}
+
+	fmt.Fprint(cmd.OutOrStdout(), string(data))
+	return nil
+}
+
+func runConfigPath(cmd *cobra.Command, args []string) error {
+	if _, err := config.Load(); err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
 
-	fmt.Printf("📊 Log Level:   %s\n", cfg.LogLevel)
+	path := config.Path()
+	fmt.Fprintln(cmd.OutOrStdout(), path)
 
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		fmt.Fprintln(cmd.ErrOrStderr(), "⚠️  The file does not exist yet; 'config init' or 'config set' creates it")
+	}
 	return nil
 }
 
+func newConfigSetContextCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-context <context>",
+		Short: "Switch the kubeconfig context",
+		Long: `Switch the kubeconfig context that K8s Manager connects to. The choice is
+saved as k8s.context in the configuration; the kubeconfig file and kubectl's
+current context are left unchanged.`,
+		Args: cobra.ExactArgs(1),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			contexts, _, err := k8s.ListContexts()
+			if err != nil {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			names := make([]string, 0, len(contexts))
+			for _, context := range contexts {
+				names = append(names, context.Name)
+			}
+			return names, cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: runConfigSetContext,
+	}
+
+	return cmd
+}
+
 func runConfigSet(cmd *cobra.Command, args []string) error {
 	key := args[0]
 	value := args[1]
@@ -232,6 +356,59 @@ func runConfigSet(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func runConfigPin(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		var favorites []config.Favorite
+		if cfg := config.Get(); cfg != nil {
+			favorites = cfg.PinnedFavorites()
+		}
+		if len(favorites) == 0 {
+			fmt.Fprintln(cmd.OutOrStdout(), "No pinned resources")
+			return nil
+		}
+		for _, favorite := range favorites {
+			fmt.Fprintln(cmd.OutOrStdout(), favorite)
+		}
+		return nil
+	}
+
+	favorite, err := config.ParseFavorite(args[0])
+	if err != nil {
+		return err
+	}
+	if err := config.PinFavorite(favorite); err != nil {
+		return fmt.Errorf("failed to pin %s: %w", favorite, err)
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "✅ Pinned %s\n", favorite)
+	return nil
+}
+
+func runConfigUnpin(cmd *cobra.Command, args []string) error {
+	favorite, err := config.ParseFavorite(args[0])
+	if err != nil {
+		return err
+	}
+	if cfg := config.Get(); cfg == nil || !cfg.IsFavorite(favorite) {
+		return fmt.Errorf("%s is not pinned", favorite)
+	}
+	if err := config.UnpinFavorites(favorite); err != nil {
+		return fmt.Errorf("failed to unpin %s: %w", favorite, err)
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "✅ Unpinned %s\n", favorite)
+	return nil
+}
+
+func runConfigSetContext(cmd *cobra.Command, args []string) error {
+	if err := k8s.SetContext(args[0]); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "✅ Switched to context %s\n", args[0])
+	return nil
+}
+
 func runConfigValidate(cmd *cobra.Command, args []string) error {
 	fmt.Println("🔍 Validating K8s Manager configuration...")
 	fmt.Println()
@@ -289,3 +466,24 @@ func runConfigValidate(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// promptInput prompts for a value on stdin, returning defaultValue on empty input
+func promptInput(prompt, defaultValue string) (string, error) {
+	if defaultValue != "" {
+		fmt.Printf("%s [%s]: ", prompt, defaultValue)
+	} else {
+		fmt.Printf("%s: ", prompt)
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+	input, err := reader.ReadString('\n')
+	if err != nil && input == "" {
+		return defaultValue, nil
+	}
+
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return defaultValue, nil
+	}
+
+	return input, nil
+}
@@ -3,9 +3,12 @@ package cmd
 import (
 	"bytes"
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/karthickk/k8s-manager/pkg/config"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestConfigCommand(t *testing.T) {
@@ -23,6 +26,8 @@ func TestConfigCommand(t *testing.T) {
 				"Configure GCP project, Kubernetes cluster",
 				"init",
 				"show",
+				"view",
+				"path",
 				"set",
 				"validate",
 			},
@@ -154,6 +159,29 @@ func TestConfigShow(t *testing.T) {
 	// The actual output format testing is better done with integration tests
 }
 
+func TestConfigViewAndPath(t *testing.T) {
+	tempDir := t.TempDir()
+	t.Setenv("HOME", tempDir)
+	path := filepath.Join(tempDir, "k8s-manager.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("k8s:\n  namespace: staging\n"), 0644))
+	t.Setenv(config.PathEnvVar, path)
+
+	cmd := newRootCmd("test")
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"config", "path"})
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, path+"\n", buf.String())
+
+	cmd = newRootCmd("test")
+	buf.Reset()
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"config", "view"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, buf.String(), "namespace: staging")
+	assert.Contains(t, buf.String(), "log_level: info", "defaults are merged in")
+}
+
 func TestPromptInput(t *testing.T) {
 	testCases := []struct {
 		name         string
@@ -183,3 +211,77 @@ func TestPromptInput(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigPinAndUnpin(t *testing.T) {
+	tempDir := t.TempDir()
+	t.Setenv("HOME", tempDir)
+	t.Setenv(config.PathEnvVar, filepath.Join(tempDir, "k8s-manager.yaml"))
+	_, err := config.Load()
+	require.NoError(t, err)
+
+	run := func(args ...string) (string, error) {
+		cmd := newRootCmd("test")
+		buf := new(bytes.Buffer)
+		cmd.SetOut(buf)
+		cmd.SetErr(buf)
+		cmd.SetArgs(append([]string{"config"}, args...))
+		err := cmd.Execute()
+		return buf.String(), err
+	}
+
+	out, err := run("pin", "pod/shop/web-0")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Pinned pod/shop/web-0")
+
+	_, err = run("pin", "namespace/payments")
+	require.NoError(t, err)
+
+	out, err = run("pin")
+	require.NoError(t, err)
+	assert.Equal(t, "pod/shop/web-0\nnamespace/payments\n", out)
+
+	_, err = run("pin", "deployment/shop/web")
+	assert.ErrorContains(t, err, "invalid favorite")
+
+	_, err = run("unpin", "pod/shop/web-0")
+	require.NoError(t, err)
+	_, err = run("unpin", "pod/shop/web-0")
+	assert.ErrorContains(t, err, "is not pinned")
+
+	out, err = run("pin")
+	require.NoError(t, err)
+	assert.Equal(t, "namespace/payments\n", out)
+}
+
+func TestConfigSetContext(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv(config.PathEnvVar, filepath.Join(home, "k8s-manager.yaml"))
+	require.NoError(t, os.MkdirAll(filepath.Join(home, ".kube"), 0755))
+	kubeConfig := `apiVersion: v1
+kind: Config
+contexts:
+- name: staging
+  context:
+    cluster: staging
+current-context: staging
+`
+	require.NoError(t, os.WriteFile(filepath.Join(home, ".kube", "config"), []byte(kubeConfig), 0600))
+	_, err := config.Load()
+	require.NoError(t, err)
+	t.Cleanup(func() { config.Update("k8s.context", "") })
+
+	cmd := newRootCmd("test")
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"config", "set-context", "staging"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, buf.String(), "Switched to context staging")
+	assert.Equal(t, "staging", config.Get().K8s.Context)
+
+	cmd = newRootCmd("test")
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetErr(new(bytes.Buffer))
+	cmd.SetArgs([]string{"config", "set-context", "prod"})
+	assert.ErrorContains(t, cmd.Execute(), `context "prod" not found`)
+}
@@ -0,0 +1,502 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/karthickk/k8s-manager/pkg/ui"
+	"github.com/karthickk/k8s-manager/pkg/utils"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+// configMapValueLimit caps how much of each value get prints without --full
+const configMapValueLimit = 512
+
+// NewConfigMapsCmd returns the configmaps command group, for registering on
+// the root the binary runs
+func NewConfigMapsCmd() *cobra.Command {
+	return newConfigMapsCmd()
+}
+
+func newConfigMapsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "configmaps",
+		Short: "Manage Kubernetes config maps",
+		Long:  `Create, view, update, and delete Kubernetes config maps in your cluster.`,
+	}
+
+	cmd.AddCommand(newConfigMapsListCmd())
+	cmd.AddCommand(newConfigMapsGetCmd())
+	cmd.AddCommand(newConfigMapsCreateCmd())
+	cmd.AddCommand(newConfigMapsUpdateCmd())
+	cmd.AddCommand(newConfigMapsDeleteCmd())
+
+	return cmd
+}
+
+func newConfigMapsListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List all config maps in the namespace",
+		Long:  `List all Kubernetes config maps in the current namespace.`,
+		RunE:  runConfigMapsList,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace to list config maps from (overrides config)")
+	cmd.Flags().BoolP("all-namespaces", "A", false, "List config maps from all namespaces")
+	addListOutputFlag(cmd)
+
+	return cmd
+}
+
+func newConfigMapsGetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get <configmap-name>",
+		Short: "Get details of a specific config map",
+		Long: fmt.Sprintf(`Get detailed information about a specific Kubernetes config map, including
+its values. Values longer than %d bytes are truncated unless --full is given;
+binary values are only summarized.`, configMapValueLimit),
+		Args:              cobra.ExactArgs(1),
+		RunE:              runConfigMapsGet,
+		ValidArgsFunction: completeConfigMapNames,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace of the config map (overrides config)")
+	cmd.Flags().Bool("full", false, "Print values in full instead of truncating long ones")
+
+	return cmd
+}
+
+func newConfigMapsCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create <configmap-name>",
+		Short: "Create a new config map",
+		Long: `Create a new Kubernetes config map from literal values, files, or env files.
+
+Files that are not valid UTF-8 are stored as binary data.
+
+Examples:
+  k8s-manager configmaps create app-config --from-literal LOG_LEVEL=debug
+  k8s-manager configmaps create nginx-conf --from-file nginx.conf
+  k8s-manager configmaps create app-env --from-env-file .env`,
+		Args: cobra.ExactArgs(1),
+		RunE: runConfigMapsCreate,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace to create the config map in (overrides config)")
+	cmd.Flags().StringSliceP("from-literal", "l", []string{}, "Key-value pairs (key=value)")
+	cmd.Flags().StringSliceP("from-file", "f", []string{}, "Files to include in config map ([key=]path)")
+	cmd.Flags().StringSlice("from-env-file", []string{}, "Env files whose KEY=VALUE lines become keys")
+
+	return cmd
+}
+
+func newConfigMapsUpdateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "update <configmap-name>",
+		Short:             "Update an existing config map",
+		Long:              `Update an existing Kubernetes config map with new key-value pairs.`,
+		Args:              cobra.ExactArgs(1),
+		RunE:              runConfigMapsUpdate,
+		ValidArgsFunction: completeConfigMapNames,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace of the config map (overrides config)")
+	cmd.Flags().StringSliceP("from-literal", "l", []string{}, "Key-value pairs to add/update (key=value)")
+	cmd.Flags().StringSliceP("from-file", "f", []string{}, "Files to add/update in config map ([key=]path)")
+	cmd.Flags().StringSlice("from-env-file", []string{}, "Env files whose KEY=VALUE lines are added/updated")
+	cmd.Flags().StringSliceP("remove-key", "r", []string{}, "Keys to remove from config map")
+
+	return cmd
+}
+
+func newConfigMapsDeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "delete <configmap-name>",
+		Short:             "Delete a config map",
+		Long:              `Delete a Kubernetes config map from the cluster.`,
+		Args:              cobra.ExactArgs(1),
+		RunE:              runConfigMapsDelete,
+		ValidArgsFunction: completeConfigMapNames,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace of the config map (overrides config)")
+	cmd.Flags().BoolP("force", "", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func runConfigMapsList(cmd *cobra.Command, args []string) error {
+	output, err := parseListFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	namespace, _ := cmd.Flags().GetString("namespace")
+	allNamespaces, _ := cmd.Flags().GetBool("all-namespaces")
+
+	if namespace == "" && !allNamespaces {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	var configMaps *corev1.ConfigMapList
+
+	if allNamespaces {
+		configMaps, err = k8s.ListAll(ctx, metav1.ListOptions{}, output.limit, client.Clientset.CoreV1().ConfigMaps("").List)
+		if err != nil {
+			return fmt.Errorf("failed to list config maps: %w", err)
+		}
+	} else {
+		configMaps, err = k8s.ListAll(ctx, metav1.ListOptions{}, output.limit, client.Clientset.CoreV1().ConfigMaps(namespace).List)
+		if err != nil {
+			return fmt.Errorf("failed to list config maps in namespace %s: %w", namespace, err)
+		}
+	}
+
+	if output.structured() {
+		return output.print(os.Stdout, "configmaps", configMaps)
+	}
+
+	if len(configMaps.Items) == 0 {
+		if allNamespaces {
+			fmt.Println("No config maps found in any namespace")
+		} else {
+			fmt.Printf("No config maps found in namespace '%s'\n", namespace)
+		}
+		return nil
+	}
+
+	w := newListTableWriter(cmd)
+	if allNamespaces {
+		w.Header("NAMESPACE", "NAME", "DATA", "AGE")
+	} else {
+		w.Header("NAME", "DATA", "AGE")
+	}
+
+	for _, configMap := range configMaps.Items {
+		age := utils.FormatAge(configMap.CreationTimestamp.Time)
+		keys := len(configMap.Data) + len(configMap.BinaryData)
+		if allNamespaces {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", configMap.Namespace, configMap.Name, keys, age)
+		} else {
+			fmt.Fprintf(w, "%s\t%d\t%s\n", configMap.Name, keys, age)
+		}
+	}
+	w.Flush()
+	output.warnTruncated(configMaps)
+
+	return nil
+}
+
+func runConfigMapsGet(cmd *cobra.Command, args []string) error {
+	configMapName := args[0]
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	namespace, _ := cmd.Flags().GetString("namespace")
+	full, _ := cmd.Flags().GetBool("full")
+
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	configMap, err := client.Clientset.CoreV1().ConfigMaps(namespace).Get(ctx, configMapName, metav1.GetOptions{})
+	if err != nil {
+		return resourceError(ctx, client, "get", "config map", namespace, configMapName, err)
+	}
+
+	fmt.Printf("Name:         %s\n", configMap.Name)
+	fmt.Printf("Namespace:    %s\n", configMap.Namespace)
+	fmt.Printf("Created:      %s\n", configMap.CreationTimestamp.Format("2006-01-02 15:04:05"))
+	fmt.Printf("Data Keys:    %d\n", len(configMap.Data)+len(configMap.BinaryData))
+	fmt.Println()
+
+	limit := configMapValueLimit
+	if full {
+		limit = 0
+	}
+
+	truncated := false
+	if len(configMap.Data) > 0 {
+		fmt.Println("Data:")
+		for _, key := range sortedKeys(configMap.Data) {
+			value, cut := truncateValue(configMap.Data[key], limit)
+			truncated = truncated || cut
+			fmt.Printf("  %s: %s\n", key, indentValue(value))
+		}
+	}
+
+	if len(configMap.BinaryData) > 0 {
+		fmt.Println("Binary Data:")
+		keys := make([]string, 0, len(configMap.BinaryData))
+		for key := range configMap.BinaryData {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fmt.Printf("  %s: <binary, %d bytes>\n", key, len(configMap.BinaryData[key]))
+		}
+	}
+
+	if truncated {
+		fmt.Println()
+		fmt.Printf("Some values were truncated to %d bytes; use --full to print them in full\n", configMapValueLimit)
+	}
+
+	return nil
+}
+
+func runConfigMapsCreate(cmd *cobra.Command, args []string) error {
+	configMapName := args[0]
+	if err := utils.ValidateResourceName(configMapName); err != nil {
+		return err
+	}
+
+	namespace, _ := cmd.Flags().GetString("namespace")
+	fromLiteral, _ := cmd.Flags().GetStringSlice("from-literal")
+	fromFile, _ := cmd.Flags().GetStringSlice("from-file")
+	fromEnvFile, _ := cmd.Flags().GetStringSlice("from-env-file")
+
+	if len(fromLiteral) == 0 && len(fromFile) == 0 && len(fromEnvFile) == 0 {
+		return fmt.Errorf("must specify --from-literal, --from-file, or --from-env-file")
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: configMapName,
+		},
+		Data:       map[string]string{},
+		BinaryData: map[string][]byte{},
+	}
+	if err := addConfigMapSources(configMap, fromLiteral, fromFile, fromEnvFile); err != nil {
+		return err
+	}
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	configMap.Namespace = namespace
+	ctx := cmd.Context()
+	_, err = client.Clientset.CoreV1().ConfigMaps(namespace).Create(ctx, configMap, metav1.CreateOptions{FieldManager: k8s.FieldManager()})
+	if err != nil {
+		return fmt.Errorf("failed to create config map %s: %w", configMapName, err)
+	}
+
+	fmt.Printf("✅ Config map '%s' created successfully in namespace '%s'\n", configMapName, namespace)
+	return nil
+}
+
+func runConfigMapsUpdate(cmd *cobra.Command, args []string) error {
+	configMapName := args[0]
+	namespace, _ := cmd.Flags().GetString("namespace")
+	fromLiteral, _ := cmd.Flags().GetStringSlice("from-literal")
+	fromFile, _ := cmd.Flags().GetStringSlice("from-file")
+	fromEnvFile, _ := cmd.Flags().GetStringSlice("from-env-file")
+	removeKeys, _ := cmd.Flags().GetStringSlice("remove-key")
+
+	if len(fromLiteral) == 0 && len(fromFile) == 0 && len(fromEnvFile) == 0 && len(removeKeys) == 0 {
+		return fmt.Errorf("must specify --from-literal, --from-file, --from-env-file, or --remove-key")
+	}
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	// The config map is read again and the changes reapplied if someone
+	// else updated it in the meantime, so their changes are not lost
+	ctx := cmd.Context()
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		configMap, err := client.Clientset.CoreV1().ConfigMaps(namespace).Get(ctx, configMapName, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get config map %s: %w", configMapName, err)
+		}
+
+		if configMap.Data == nil {
+			configMap.Data = map[string]string{}
+		}
+		if configMap.BinaryData == nil {
+			configMap.BinaryData = map[string][]byte{}
+		}
+		if err := addConfigMapSources(configMap, fromLiteral, fromFile, fromEnvFile); err != nil {
+			return err
+		}
+
+		// Remove keys
+		for _, key := range removeKeys {
+			delete(configMap.Data, key)
+			delete(configMap.BinaryData, key)
+		}
+
+		_, err = client.Clientset.CoreV1().ConfigMaps(namespace).Update(ctx, configMap, metav1.UpdateOptions{FieldManager: k8s.FieldManager()})
+		if err != nil {
+			return fmt.Errorf("failed to update config map %s: %w", configMapName, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ Config map '%s' updated successfully in namespace '%s'\n", configMapName, namespace)
+	return nil
+}
+
+func runConfigMapsDelete(cmd *cobra.Command, args []string) error {
+	configMapName := args[0]
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	namespace, _ := cmd.Flags().GetString("namespace")
+	force, _ := cmd.Flags().GetBool("force")
+
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	confirmed, err := ui.ConfirmDestructive("delete", "config map", namespace, configMapName, force)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		fmt.Println("Deletion cancelled")
+		return nil
+	}
+
+	ctx := cmd.Context()
+	err = client.Clientset.CoreV1().ConfigMaps(namespace).Delete(ctx, configMapName, metav1.DeleteOptions{})
+	if err != nil {
+		return resourceError(ctx, client, "delete", "config map", namespace, configMapName, err)
+	}
+
+	fmt.Printf("✅ Config map '%s' deleted successfully from namespace '%s'\n", configMapName, namespace)
+	return nil
+}
+
+// Helper functions
+
+// addConfigMapSources adds the values of --from-literal, --from-file and
+// --from-env-file to a config map. A key set as text replaces a binary value
+// of the same name and the other way round.
+func addConfigMapSources(configMap *corev1.ConfigMap, literals, files, envFiles []string) error {
+	setText := func(key, value string) {
+		delete(configMap.BinaryData, key)
+		configMap.Data[key] = value
+	}
+
+	for _, envFile := range envFiles {
+		data, err := os.ReadFile(envFile)
+		if err != nil {
+			return fmt.Errorf("failed to read env file %s: %w", envFile, err)
+		}
+		values, err := utils.ParseEnvFile(data)
+		if err != nil {
+			return fmt.Errorf("invalid env file %s: %w", envFile, err)
+		}
+		for key, value := range values {
+			setText(key, value)
+		}
+	}
+
+	for _, literal := range literals {
+		key, value, err := parseLiteral(literal)
+		if err != nil {
+			return err
+		}
+		setText(key, string(value))
+	}
+
+	for _, source := range files {
+		key, data, err := readFileSource(source)
+		if err != nil {
+			return err
+		}
+		// Config map data only holds UTF-8 strings
+		if utf8.Valid(data) {
+			setText(key, string(data))
+		} else {
+			delete(configMap.Data, key)
+			configMap.BinaryData[key] = data
+		}
+	}
+
+	return nil
+}
+
+// truncateValue cuts value to at most limit bytes, without splitting a
+// UTF-8 sequence, and reports whether it did. A limit of 0 disables the cap.
+func truncateValue(value string, limit int) (string, bool) {
+	if limit <= 0 || len(value) <= limit {
+		return value, false
+	}
+	cut := limit
+	for cut > 0 && !utf8.RuneStart(value[cut]) {
+		cut--
+	}
+	return fmt.Sprintf("%s... (%d more bytes)", value[:cut], len(value)-cut), true
+}
+
+// indentValue prints multi-line values as an indented block below their key
+func indentValue(value string) string {
+	if !strings.Contains(value, "\n") {
+		return value
+	}
+	return "|\n    " + strings.ReplaceAll(strings.TrimRight(value, "\n"), "\n", "\n    ")
+}
+
+func sortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
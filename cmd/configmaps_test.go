@@ -0,0 +1,265 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestConfigMapsCommand(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []string
+		wantErr  bool
+		contains []string
+	}{
+		{
+			name:    "configmaps help",
+			args:    []string{"configmaps", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Manage Kubernetes config maps",
+				"list",
+				"get",
+				"create",
+				"update",
+				"delete",
+			},
+		},
+		{
+			name:    "configmaps list help",
+			args:    []string{"configmaps", "list", "--help"},
+			wantErr: false,
+			contains: []string{
+				"List all config maps in the namespace",
+				"--namespace",
+				"--all-namespaces",
+				"--output",
+				"--no-headers",
+			},
+		},
+		{
+			name:    "configmaps get help",
+			args:    []string{"configmaps", "get", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Get details of a specific config map",
+				"--namespace",
+				"--full",
+			},
+		},
+		{
+			name:    "configmaps create help",
+			args:    []string{"configmaps", "create", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Create a new config map",
+				"--from-literal",
+				"--from-file",
+				"--from-env-file",
+			},
+		},
+		{
+			name:    "configmaps update help",
+			args:    []string{"configmaps", "update", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Update an existing config map",
+				"--from-literal",
+				"--from-file",
+				"--from-env-file",
+				"--remove-key",
+			},
+		},
+		{
+			name:    "configmaps delete help",
+			args:    []string{"configmaps", "delete", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Delete a config map",
+				"--namespace",
+				"--force",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := newRootCmd("test")
+			buf := new(bytes.Buffer)
+			cmd.SetOut(buf)
+			cmd.SetErr(buf)
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			output := buf.String()
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			for _, expected := range tc.contains {
+				assert.Contains(t, output, expected)
+			}
+		})
+	}
+}
+
+func TestConfigMapsCommandArguments(t *testing.T) {
+	testCases := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "configmaps get missing argument",
+			args:    []string{"configmaps", "get"},
+			wantErr: "accepts 1 arg(s)",
+		},
+		{
+			name:    "configmaps create missing argument",
+			args:    []string{"configmaps", "create"},
+			wantErr: "accepts 1 arg(s)",
+		},
+		{
+			name:    "configmaps update missing argument",
+			args:    []string{"configmaps", "update"},
+			wantErr: "accepts 1 arg(s)",
+		},
+		{
+			name:    "configmaps delete missing argument",
+			args:    []string{"configmaps", "delete"},
+			wantErr: "accepts 1 arg(s)",
+		},
+		{
+			name:    "configmaps list unsupported output",
+			args:    []string{"configmaps", "list", "-o", "yaml"},
+			wantErr: "unsupported output",
+		},
+		{
+			name:    "configmaps create invalid name",
+			args:    []string{"configmaps", "create", "My_Config", "--from-literal", "key=value"},
+			wantErr: "My_Config",
+		},
+		{
+			name:    "configmaps create without sources",
+			args:    []string{"configmaps", "create", "app-config"},
+			wantErr: "must specify --from-literal, --from-file, or --from-env-file",
+		},
+		{
+			name:    "configmaps create invalid literal",
+			args:    []string{"configmaps", "create", "app-config", "--from-literal", "LOG_LEVEL"},
+			wantErr: "expected key=value",
+		},
+		{
+			name:    "configmaps update without changes",
+			args:    []string{"configmaps", "update", "app-config"},
+			wantErr: "must specify --from-literal, --from-file, --from-env-file, or --remove-key",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := newRootCmd("test")
+			buf := new(bytes.Buffer)
+			cmd.SetOut(buf)
+			cmd.SetErr(buf)
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestConfigMapsCommandStructure(t *testing.T) {
+	cmd := newConfigMapsCmd()
+
+	// Verify basic properties
+	assert.Equal(t, "configmaps", cmd.Use)
+	assert.Contains(t, cmd.Short, "Manage Kubernetes config maps")
+	assert.Contains(t, cmd.Long, "Create, view, update, and delete Kubernetes config maps")
+
+	// Verify subcommands are registered
+	subcommands := cmd.Commands()
+	expectedCommands := []string{"list", "get", "create", "update", "delete"}
+
+	for _, expected := range expectedCommands {
+		found := false
+		for _, subcmd := range subcommands {
+			if subcmd.Name() == expected {
+				found = true
+				break
+			}
+		}
+		assert.True(t, found, "Expected subcommand %s not found", expected)
+	}
+}
+
+func TestAddConfigMapSources(t *testing.T) {
+	dir := t.TempDir()
+	envFile := filepath.Join(dir, "app.env")
+	textFile := filepath.Join(dir, "nginx.conf")
+	binaryFile := filepath.Join(dir, "keystore.p12")
+	require.NoError(t, os.WriteFile(envFile, []byte("# defaults\nLOG_LEVEL=info\nPORT=8080\n"), 0600))
+	require.NoError(t, os.WriteFile(textFile, []byte("server {}\n"), 0600))
+	require.NoError(t, os.WriteFile(binaryFile, []byte{0x30, 0x82, 0xff, 0xfe}, 0600))
+
+	configMap := &corev1.ConfigMap{
+		Data:       map[string]string{"keystore": "old"},
+		BinaryData: map[string][]byte{"PORT": {0x00}},
+	}
+	err := addConfigMapSources(configMap,
+		[]string{"LOG_LEVEL=debug"},
+		[]string{textFile, "keystore=" + binaryFile},
+		[]string{envFile},
+	)
+	require.NoError(t, err)
+
+	// Literals win over env files, and a key moves between data and
+	// binaryData when its kind changes
+	assert.Equal(t, map[string]string{
+		"LOG_LEVEL":  "debug",
+		"PORT":       "8080",
+		"nginx.conf": "server {}\n",
+	}, configMap.Data)
+	assert.Equal(t, map[string][]byte{"keystore": {0x30, 0x82, 0xff, 0xfe}}, configMap.BinaryData)
+
+	err = addConfigMapSources(configMap, nil, []string{"bad key=" + textFile}, nil)
+	assert.ErrorContains(t, err, `invalid key "bad key"`)
+
+	err = addConfigMapSources(configMap, nil, nil, []string{filepath.Join(dir, "missing.env")})
+	assert.ErrorContains(t, err, "failed to read env file")
+}
+
+func TestTruncateValue(t *testing.T) {
+	value, truncated := truncateValue("short", 10)
+	assert.Equal(t, "short", value)
+	assert.False(t, truncated)
+
+	value, truncated = truncateValue(strings.Repeat("a", 20), 10)
+	assert.Equal(t, strings.Repeat("a", 10)+"... (10 more bytes)", value)
+	assert.True(t, truncated)
+
+	// Multi-byte characters are not split
+	value, truncated = truncateValue("ééé", 3)
+	assert.Equal(t, "é... (4 more bytes)", value)
+	assert.True(t, truncated)
+
+	value, truncated = truncateValue(strings.Repeat("a", 20), 0)
+	assert.Equal(t, strings.Repeat("a", 20), value)
+	assert.False(t, truncated)
+}
+
+func TestIndentValue(t *testing.T) {
+	assert.Equal(t, "debug", indentValue("debug"))
+	assert.Equal(t, "|\n    server {\n    }", indentValue("server {\n}\n"))
+}
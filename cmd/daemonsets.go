@@ -0,0 +1,214 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/karthickk/k8s-manager/pkg/utils"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newDaemonSetsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "daemonsets",
+		Aliases: []string{"daemonset", "ds"},
+		Short:   "Manage Kubernetes daemonsets",
+		Long:    `List and inspect Kubernetes daemonsets and their pods on each node.`,
+	}
+
+	cmd.AddCommand(newDaemonSetsListCmd())
+	cmd.AddCommand(newDaemonSetsGetCmd())
+
+	return cmd
+}
+
+func newDaemonSetsListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List daemonsets in the namespace",
+		Long:  `List Kubernetes daemonsets with their desired, current, ready, updated and available pods, and age.`,
+		RunE:  runDaemonSetsList,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace to list daemonsets from (overrides config)")
+	cmd.Flags().BoolP("all-namespaces", "A", false, "List daemonsets from all namespaces")
+	addListOutputFlag(cmd)
+
+	return cmd
+}
+
+func newDaemonSetsGetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get <daemonset-name>",
+		Short: "Get details of a specific daemonset",
+		Long:  `Get detailed information about a Kubernetes daemonset and its pod on each node.`,
+		Args:  cobra.ExactArgs(1),
+		RunE:  runDaemonSetsGet,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace of the daemonset (overrides config)")
+
+	return cmd
+}
+
+func runDaemonSetsList(cmd *cobra.Command, args []string) error {
+	output, err := parseListFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	namespace, _ := cmd.Flags().GetString("namespace")
+	allNamespaces, _ := cmd.Flags().GetBool("all-namespaces")
+
+	if allNamespaces {
+		namespace = ""
+	} else if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	daemonSets, err := k8s.ListAll(ctx, metav1.ListOptions{}, output.limit, client.Clientset.AppsV1().DaemonSets(namespace).List)
+	if err != nil {
+		return fmt.Errorf("failed to list daemonsets: %w", err)
+	}
+
+	if output.structured() {
+		return output.print(os.Stdout, "daemonsets", daemonSets)
+	}
+
+	if len(daemonSets.Items) == 0 {
+		if allNamespaces {
+			fmt.Println("No daemonsets found in any namespace")
+		} else {
+			fmt.Printf("No daemonsets found in namespace '%s'\n", namespace)
+		}
+		return nil
+	}
+
+	w := newListTableWriter(cmd)
+	if allNamespaces {
+		w.Header("NAMESPACE", "NAME", "DESIRED", "CURRENT", "READY", "UP-TO-DATE", "AVAILABLE", "AGE")
+	} else {
+		w.Header("NAME", "DESIRED", "CURRENT", "READY", "UP-TO-DATE", "AVAILABLE", "AGE")
+	}
+
+	for _, ds := range daemonSets.Items {
+		row := fmt.Sprintf("%s\t%d\t%d\t%d\t%d\t%d\t%s",
+			ds.Name,
+			ds.Status.DesiredNumberScheduled,
+			ds.Status.CurrentNumberScheduled,
+			ds.Status.NumberReady,
+			ds.Status.UpdatedNumberScheduled,
+			ds.Status.NumberAvailable,
+			utils.FormatAge(ds.CreationTimestamp.Time),
+		)
+		if allNamespaces {
+			fmt.Fprintf(w, "%s\t%s\n", ds.Namespace, row)
+		} else {
+			fmt.Fprintln(w, row)
+		}
+	}
+	w.Flush()
+	output.warnTruncated(daemonSets)
+
+	return nil
+}
+
+func runDaemonSetsGet(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	namespace, _ := cmd.Flags().GetString("namespace")
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	ds, err := client.Clientset.AppsV1().DaemonSets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get daemonset %s: %w", name, err)
+	}
+
+	fmt.Printf("Name:             %s\n", ds.Name)
+	fmt.Printf("Namespace:        %s\n", ds.Namespace)
+	fmt.Printf("Desired:          %d\n", ds.Status.DesiredNumberScheduled)
+	fmt.Printf("Current:          %d\n", ds.Status.CurrentNumberScheduled)
+	fmt.Printf("Ready:            %d\n", ds.Status.NumberReady)
+	fmt.Printf("Up-to-date:       %d\n", ds.Status.UpdatedNumberScheduled)
+	fmt.Printf("Available:        %d\n", ds.Status.NumberAvailable)
+	fmt.Printf("Misscheduled:     %d\n", ds.Status.NumberMisscheduled)
+	fmt.Printf("Node Selector:    %s\n", formatKeyValues(ds.Spec.Template.Spec.NodeSelector))
+	fmt.Printf("Update Strategy:  %s\n", ds.Spec.UpdateStrategy.Type)
+	fmt.Printf("Created:          %s\n", ds.CreationTimestamp.Format("2006-01-02 15:04:05"))
+	fmt.Println()
+
+	if len(ds.Spec.Template.Spec.Containers) > 0 {
+		fmt.Println("Containers:")
+		for _, container := range ds.Spec.Template.Spec.Containers {
+			fmt.Printf("  - Name:   %s\n", container.Name)
+			fmt.Printf("    Image:  %s\n", container.Image)
+		}
+		fmt.Println()
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(ds.Spec.Selector)
+	if err != nil {
+		return fmt.Errorf("invalid selector on daemonset %s: %w", ds.Name, err)
+	}
+	pods, err := client.ListPodsForSelector(ctx, namespace, selector.String())
+	if err != nil {
+		return err
+	}
+
+	if len(pods) == 0 {
+		fmt.Println("No pods found for this daemonset")
+		return nil
+	}
+
+	sortPodsByNode(pods)
+
+	fmt.Println("Pods by node:")
+	w := utils.NewTableWriter(os.Stdout, false)
+	w.Header("  NODE", "POD", "READY", "STATUS", "RESTARTS", "AGE")
+	for i := range pods {
+		pod := &pods[i]
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%d\t%s\n",
+			valueOrNone(pod.Spec.NodeName), pod.Name, getPodReadyStatus(pod), k8s.PodStatus(pod),
+			getPodRestartCount(pod), utils.FormatAge(pod.CreationTimestamp.Time))
+	}
+	w.Flush()
+
+	return nil
+}
+
+// Helper functions
+
+// sortPodsByNode orders pods by the node they run on, then by name
+func sortPodsByNode(pods []corev1.Pod) {
+	sort.Slice(pods, func(i, j int) bool {
+		if pods[i].Spec.NodeName != pods[j].Spec.NodeName {
+			return pods[i].Spec.NodeName < pods[j].Spec.NodeName
+		}
+		return pods[i].Name < pods[j].Name
+	})
+}
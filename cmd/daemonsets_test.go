@@ -0,0 +1,23 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSortPodsByNode(t *testing.T) {
+	pods := []corev1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Name: "agent-b"}, Spec: corev1.PodSpec{NodeName: "node-2"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "agent-c"}, Spec: corev1.PodSpec{NodeName: "node-1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "agent-a"}, Spec: corev1.PodSpec{NodeName: "node-1"}},
+	}
+
+	sortPodsByNode(pods)
+
+	assert.Equal(t, "agent-a", pods[0].Name)
+	assert.Equal(t, "agent-c", pods[1].Name)
+	assert.Equal(t, "agent-b", pods[2].Name)
+}
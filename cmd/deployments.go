@@ -0,0 +1,791 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/karthickk/k8s-manager/pkg/ui"
+	"github.com/karthickk/k8s-manager/pkg/utils"
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newDeploymentsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "deployments",
+		Aliases: []string{"deployment", "deploy"},
+		Short:   "Manage Kubernetes deployments",
+		Long:    `List, inspect, scale, restart and delete Kubernetes deployments, and follow their rollouts and history.`,
+	}
+
+	cmd.AddCommand(newDeploymentsListCmd())
+	cmd.AddCommand(newDeploymentsGetCmd())
+	cmd.AddCommand(newDeploymentsScaleCmd())
+	cmd.AddCommand(newDeploymentsRestartCmd())
+	cmd.AddCommand(newDeploymentsDeleteCmd())
+	cmd.AddCommand(newDeploymentsHistoryCmd())
+	cmd.AddCommand(newDeploymentsRollbackCmd())
+	cmd.AddCommand(newDeploymentsRecommendCmd())
+	cmd.AddCommand(newDeploymentsRolloutStatusCmd())
+
+	return cmd
+}
+
+func newDeploymentsListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List deployments in the namespace",
+		Long:  `List Kubernetes deployments with their ready, up-to-date and available replicas, and age.`,
+		RunE:  runDeploymentsList,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace to list deployments from (overrides config)")
+	cmd.Flags().BoolP("all-namespaces", "A", false, "List deployments from all namespaces")
+	cmd.Flags().Bool("wide", false, "Show the container images and selector of each deployment")
+	addListOutputFlag(cmd)
+
+	return cmd
+}
+
+func newDeploymentsGetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get <deployment-name>",
+		Short: "Get details of a specific deployment",
+		Long: `Get detailed information about a Kubernetes deployment: its replicas,
+rollout strategy, containers, conditions and pods.
+
+With -o yaml or -o json the deployment manifest is printed instead, without
+managed fields or the last-applied-configuration annotation.`,
+		Args: cobra.ExactArgs(1),
+		RunE: runDeploymentsGet,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace of the deployment (overrides config)")
+	cmd.Flags().StringP("output", "o", "", "Print the deployment manifest instead: yaml or json")
+
+	return cmd
+}
+
+func newDeploymentsRestartCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restart <deployment-name>",
+		Short: "Restart the pods of a deployment",
+		Long: `Roll every pod of a deployment by stamping the restart time on its pod
+template, as kubectl rollout restart does. Pods are replaced following the
+rollout strategy of the deployment.`,
+		Args: cobra.ExactArgs(1),
+		RunE: runDeploymentsRestart,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace of the deployment (overrides config)")
+	cmd.Flags().BoolP("wait", "w", false, "Wait until the rollout finishes")
+	cmd.Flags().Duration("timeout", 5*time.Minute, "How long to wait for the rollout with --wait")
+	cmd.Flags().BoolP("force", "", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func newDeploymentsDeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <deployment-name>",
+		Short: "Delete a deployment",
+		Long:  `Delete a Kubernetes deployment together with its replica sets and pods.`,
+		Args:  cobra.ExactArgs(1),
+		RunE:  runDeploymentsDelete,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace of the deployment (overrides config)")
+	cmd.Flags().BoolP("force", "", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func newDeploymentsScaleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scale <deployment-name>",
+		Short: "Scale a deployment",
+		Long: `Set the number of replicas of a deployment.
+
+Without --replicas an interactive prompt shows the current replicas, asks for
+the new count and follows the pods until they are ready.`,
+		Args: cobra.ExactArgs(1),
+		RunE: runDeploymentsScale,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace of the deployment (overrides config)")
+	cmd.Flags().Int64P("replicas", "r", 0, "New number of replicas")
+	cmd.Flags().BoolP("wait", "w", false, "Wait until the replicas are ready")
+	cmd.Flags().Duration("timeout", 5*time.Minute, "How long to wait for the replicas with --wait")
+	cmd.Flags().BoolP("force", "", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func newDeploymentsHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history <deployment-name>",
+		Short: "Show the rollout history of a deployment",
+		Long: `List the revisions of a deployment, read from the ReplicaSets it owns,
+with their images and change-cause.`,
+		Args: cobra.ExactArgs(1),
+		RunE: runDeploymentsHistory,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace of the deployment (overrides config)")
+
+	return cmd
+}
+
+func newDeploymentsRollbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollback <deployment-name>",
+		Short: "Roll a deployment back to an earlier revision",
+		Long: `Roll a deployment back by restoring the pod template of an earlier revision.
+
+Without --to-revision the deployment is rolled back to the revision before
+the current one. Use 'deployments history' to list the revisions.`,
+		Args: cobra.ExactArgs(1),
+		RunE: runDeploymentsRollback,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace of the deployment (overrides config)")
+	cmd.Flags().Int64("to-revision", 0, "Revision to roll back to (default: the previous revision)")
+	cmd.Flags().BoolP("force", "", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func newDeploymentsRecommendCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recommend <deployment-name>",
+		Short: "Recommend CPU and memory requests for a deployment",
+		Long: `Compare the CPU and memory requests of a deployment's containers with the
+current usage of its running pods, read from metrics-server, and suggest
+requests that leave 20% headroom above the busiest pod.
+
+The recommendations are advisory: nothing is changed in the cluster.`,
+		Args: cobra.ExactArgs(1),
+		RunE: runDeploymentsRecommend,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace of the deployment (overrides config)")
+
+	return cmd
+}
+
+func newDeploymentsRolloutStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollout-status <deployment-name>",
+		Short: "Wait for the rollout of a deployment to finish",
+		Long: `Follow the rollout of a deployment until all its replicas are updated and
+available, showing the ready replicas as they come up.
+
+The command fails with the last observed status when --timeout elapses, and
+right away when the deployment exceeds its progress deadline, so a stuck
+rollout fails fast in CI.`,
+		Args: cobra.ExactArgs(1),
+		RunE: runDeploymentsRolloutStatus,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace of the deployment (overrides config)")
+	cmd.Flags().Duration("timeout", 5*time.Minute, "How long to wait before giving up; 0 waits forever")
+
+	return cmd
+}
+
+func runDeploymentsList(cmd *cobra.Command, args []string) error {
+	output, err := parseListFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	namespace, _ := cmd.Flags().GetString("namespace")
+	allNamespaces, _ := cmd.Flags().GetBool("all-namespaces")
+	wide, _ := cmd.Flags().GetBool("wide")
+
+	if allNamespaces {
+		namespace = ""
+	} else if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	deployments, err := k8s.ListAll(ctx, metav1.ListOptions{}, output.limit, client.Clientset.AppsV1().Deployments(namespace).List)
+	if err != nil {
+		return fmt.Errorf("failed to list deployments: %w", err)
+	}
+
+	if output.structured() {
+		return output.print(os.Stdout, "deployments", deployments)
+	}
+
+	if len(deployments.Items) == 0 {
+		if allNamespaces {
+			fmt.Println("No deployments found in any namespace")
+		} else {
+			fmt.Printf("No deployments found in namespace '%s'\n", namespace)
+		}
+		return nil
+	}
+
+	headers := []string{"NAME", "READY", "UP-TO-DATE", "AVAILABLE", "AGE"}
+	if allNamespaces {
+		headers = append([]string{"NAMESPACE"}, headers...)
+	}
+	if wide {
+		headers = append(headers, "IMAGES", "SELECTOR")
+	}
+
+	w := newListTableWriter(cmd)
+	w.Header(headers...)
+	for i := range deployments.Items {
+		fmt.Fprintln(w, strings.Join(deploymentListRow(&deployments.Items[i], allNamespaces, wide), "\t"))
+	}
+	w.Flush()
+	output.warnTruncated(deployments)
+
+	return nil
+}
+
+func runDeploymentsGet(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	namespace, _ := cmd.Flags().GetString("namespace")
+	outputFlag, _ := cmd.Flags().GetString("output")
+
+	output, err := parseObjectOutput(outputFlag)
+	if err != nil {
+		return err
+	}
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	deployment, err := client.Clientset.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return resourceError(ctx, client, "get", "deployment", namespace, name, err)
+	}
+
+	if output != "" {
+		return printObject(cmd.OutOrStdout(), output, deploymentManifest(deployment))
+	}
+
+	out := cmd.OutOrStdout()
+	printDeploymentDetails(out, deployment)
+
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return fmt.Errorf("invalid selector on deployment %s: %w", name, err)
+	}
+	pods, err := client.ListPodsForSelector(ctx, namespace, selector.String())
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(out)
+	if len(pods) == 0 {
+		fmt.Fprintln(out, "No pods found for this deployment")
+		return nil
+	}
+
+	fmt.Fprintln(out, "Pods:")
+	w := utils.NewTableWriter(out, false)
+	w.Header("  NAME", "READY", "STATUS", "RESTARTS", "AGE")
+	for i := range pods {
+		pod := &pods[i]
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%d\t%s\n", pod.Name, getPodReadyStatus(pod), k8s.PodStatus(pod),
+			getPodRestartCount(pod), utils.FormatAge(pod.CreationTimestamp.Time))
+	}
+	return w.Flush()
+}
+
+func runDeploymentsRestart(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	namespace, _ := cmd.Flags().GetString("namespace")
+	wait, _ := cmd.Flags().GetBool("wait")
+	timeout, _ := cmd.Flags().GetDuration("timeout")
+	force, _ := cmd.Flags().GetBool("force")
+
+	if timeout <= 0 {
+		return fmt.Errorf("--timeout must be greater than zero, got %s", timeout)
+	}
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	current, err := k8s.GetDeploymentProgress(ctx, client.Clientset, namespace, name)
+	if err != nil {
+		return err
+	}
+
+	if !force {
+		fmt.Printf("All %d pods of deployment '%s' will be replaced.\n", current.Desired, name)
+	}
+	confirmed, err := ui.ConfirmDestructive("restart", "deployment", namespace, name, force)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		fmt.Println("Restart cancelled")
+		return nil
+	}
+
+	if err := k8s.RestartWorkload(ctx, client.Clientset, k8s.KindDeployment, namespace, name); err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ Deployment '%s' restarted in namespace '%s'\n", name, namespace)
+
+	if !wait {
+		return nil
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	progress, err := waitForDeployment(waitCtx, client, namespace, name)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ Deployment '%s' successfully rolled out (%s)\n", name, progress)
+	return nil
+}
+
+func runDeploymentsDelete(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	namespace, _ := cmd.Flags().GetString("namespace")
+	force, _ := cmd.Flags().GetBool("force")
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	current, err := k8s.GetDeploymentProgress(ctx, client.Clientset, namespace, name)
+	if err != nil {
+		return err
+	}
+
+	if !force && current.Desired > 0 {
+		fmt.Printf("⚠️  Deleting deployment '%s' also stops its %d pods.\n", name, current.Desired)
+	}
+	confirmed, err := ui.ConfirmDestructive("delete", "deployment", namespace, name, force)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		fmt.Println("Deletion cancelled")
+		return nil
+	}
+
+	if err := client.Clientset.AppsV1().Deployments(namespace).Delete(ctx, name, metav1.DeleteOptions{}); err != nil {
+		return resourceError(ctx, client, "delete", "deployment", namespace, name, err)
+	}
+
+	fmt.Printf("✅ Deployment '%s' deleted successfully from namespace '%s'\n", name, namespace)
+	return nil
+}
+
+func runDeploymentsScale(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	namespace, _ := cmd.Flags().GetString("namespace")
+	replicasFlag, _ := cmd.Flags().GetInt64("replicas")
+	wait, _ := cmd.Flags().GetBool("wait")
+	timeout, _ := cmd.Flags().GetDuration("timeout")
+	force, _ := cmd.Flags().GetBool("force")
+
+	interactive := !cmd.Flags().Changed("replicas")
+	replicas, err := k8s.ValidateReplicas(replicasFlag)
+	if err != nil {
+		return err
+	}
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	if interactive {
+		return ui.ShowDeploymentScale(client, namespace, name)
+	}
+
+	ctx := cmd.Context()
+	current, err := k8s.GetDeploymentProgress(ctx, client.Clientset, namespace, name)
+	if err != nil {
+		return err
+	}
+
+	if replicas == 0 && current.Desired > 0 {
+		if !force {
+			fmt.Printf("⚠️  Scaling to 0 will stop all %d pods of deployment '%s'.\n", current.Desired, name)
+		}
+		confirmed, err := ui.ConfirmDestructive("scale down", "deployment", namespace, name, force)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Println("Scale cancelled")
+			return nil
+		}
+	}
+
+	if err := k8s.ScaleDeployment(ctx, client.Clientset, namespace, name, replicas); err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ Deployment '%s' scaled from %d to %d replicas in namespace '%s'\n", name, current.Desired, replicas, namespace)
+
+	if !wait {
+		return nil
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if _, err := waitForDeployment(waitCtx, client, namespace, name); err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ All %d replicas are ready\n", replicas)
+	return nil
+}
+
+func runDeploymentsRolloutStatus(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	namespace, _ := cmd.Flags().GetString("namespace")
+	timeout, _ := cmd.Flags().GetDuration("timeout")
+
+	if timeout < 0 {
+		return fmt.Errorf("--timeout must not be negative, got %s", timeout)
+	}
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	progress, err := waitForDeployment(ctx, client, namespace, name)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ Deployment '%s' successfully rolled out (%s)\n", name, progress)
+	return nil
+}
+
+func runDeploymentsHistory(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	namespace, _ := cmd.Flags().GetString("namespace")
+
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	deployment, revisions, err := k8s.DeploymentHistory(cmd.Context(), client.Clientset, namespace, name)
+	if err != nil {
+		return err
+	}
+
+	if len(revisions) == 0 {
+		fmt.Printf("No rollout history found for deployment '%s' in namespace '%s'\n", name, namespace)
+		return nil
+	}
+
+	printDeploymentHistory(revisions, k8s.CurrentRevision(deployment))
+	return nil
+}
+
+func runDeploymentsRollback(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	namespace, _ := cmd.Flags().GetString("namespace")
+	toRevision, _ := cmd.Flags().GetInt64("to-revision")
+	force, _ := cmd.Flags().GetBool("force")
+
+	if toRevision < 0 {
+		return fmt.Errorf("--to-revision must be a positive integer, got %d", toRevision)
+	}
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	deployment, revisions, err := k8s.DeploymentHistory(ctx, client.Clientset, namespace, name)
+	if err != nil {
+		return err
+	}
+
+	current := k8s.CurrentRevision(deployment)
+	target, err := k8s.SelectRollbackRevision(revisions, current, toRevision)
+	if err != nil {
+		return fmt.Errorf("cannot roll back deployment %s: %w", name, err)
+	}
+
+	if !force {
+		printDeploymentHistory(revisions, current)
+		fmt.Println()
+		fmt.Printf("⚠️  Deployment '%s' will be rolled back from revision %d to revision %d.\n", name, current, target.Revision)
+	}
+	confirmed, err := ui.ConfirmDestructive("roll back", "deployment", namespace, name, force)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		fmt.Println("Rollback cancelled")
+		return nil
+	}
+
+	revision, changed, err := k8s.RollbackDeployment(ctx, client.Clientset, namespace, name, target.Revision)
+	if err != nil {
+		return err
+	}
+
+	if !changed {
+		fmt.Printf("⚠️  Deployment '%s' already runs the template of revision %d, nothing to roll back\n", name, revision)
+		return nil
+	}
+
+	fmt.Printf("✅ Deployment '%s' rolled back to revision %d in namespace '%s'\n", name, revision, namespace)
+	return nil
+}
+
+func runDeploymentsRecommend(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	namespace, _ := cmd.Flags().GetString("namespace")
+
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	recommendations, pods, err := client.RecommendDeploymentRequests(cmd.Context(), namespace, name)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	fmt.Fprintf(out, "Sampled the current usage of %d pods of deployment '%s' in namespace '%s'\n\n", pods, name, namespace)
+	printRequestRecommendations(out, recommendations)
+	fmt.Fprintln(out, "\nThese recommendations are advisory; no requests were changed.")
+	return nil
+}
+
+// Helper functions
+
+// deploymentListRow returns the columns of a deployment in deployments list
+func deploymentListRow(deployment *appsv1.Deployment, allNamespaces, wide bool) []string {
+	progress := k8s.NewDeploymentProgress(deployment)
+	row := []string{
+		deployment.Name,
+		fmt.Sprintf("%d/%d", progress.Ready, progress.Desired),
+		fmt.Sprintf("%d", progress.Updated),
+		fmt.Sprintf("%d", progress.Available),
+		utils.FormatAge(deployment.CreationTimestamp.Time),
+	}
+	if allNamespaces {
+		row = append([]string{deployment.Namespace}, row...)
+	}
+	if wide {
+		images := make([]string, 0, len(deployment.Spec.Template.Spec.Containers))
+		for _, container := range deployment.Spec.Template.Spec.Containers {
+			images = append(images, container.Image)
+		}
+		selector := "<none>"
+		if deployment.Spec.Selector != nil {
+			selector = metav1.FormatLabelSelector(deployment.Spec.Selector)
+		}
+		row = append(row, strings.Join(images, ","), selector)
+	}
+	return row
+}
+
+// deploymentManifest returns a copy of a deployment as printed by
+// deployments get -o, in the same way podManifest does for pods
+func deploymentManifest(deployment *appsv1.Deployment) *appsv1.Deployment {
+	manifest := deployment.DeepCopy()
+	manifest.APIVersion, manifest.Kind = "apps/v1", "Deployment"
+	trimManifestMeta(&manifest.ObjectMeta)
+	return manifest
+}
+
+// printDeploymentDetails prints the status block shown by deployments get
+func printDeploymentDetails(out io.Writer, deployment *appsv1.Deployment) {
+	progress := k8s.NewDeploymentProgress(deployment)
+	selector := "<none>"
+	if deployment.Spec.Selector != nil {
+		selector = metav1.FormatLabelSelector(deployment.Spec.Selector)
+	}
+
+	fmt.Fprintf(out, "Name:          %s\n", deployment.Name)
+	fmt.Fprintf(out, "Namespace:     %s\n", deployment.Namespace)
+	fmt.Fprintf(out, "Created:       %s (%s ago)\n", deployment.CreationTimestamp.Format("2006-01-02 15:04:05"), utils.FormatAge(deployment.CreationTimestamp.Time))
+	fmt.Fprintf(out, "Selector:      %s\n", selector)
+	fmt.Fprintf(out, "Replicas:      %d desired | %d updated | %d ready | %d available\n",
+		progress.Desired, progress.Updated, progress.Ready, progress.Available)
+	strategy := string(deployment.Spec.Strategy.Type)
+	if rolling := deployment.Spec.Strategy.RollingUpdate; rolling != nil && rolling.MaxSurge != nil && rolling.MaxUnavailable != nil {
+		strategy += fmt.Sprintf(" (max surge %s, max unavailable %s)", rolling.MaxSurge.String(), rolling.MaxUnavailable.String())
+	}
+	fmt.Fprintf(out, "Strategy:      %s\n", valueOrNone(strategy))
+	fmt.Fprintf(out, "Revision:      %d\n", k8s.CurrentRevision(deployment))
+
+	fmt.Fprintln(out, "Containers:")
+	for _, container := range deployment.Spec.Template.Spec.Containers {
+		fmt.Fprintf(out, "  - Name:   %s\n", container.Name)
+		fmt.Fprintf(out, "    Image:  %s\n", container.Image)
+	}
+
+	if len(deployment.Status.Conditions) > 0 {
+		fmt.Fprintln(out, "Conditions:")
+		for _, condition := range deployment.Status.Conditions {
+			fmt.Fprintf(out, "  %-14s %-6s %s\n", condition.Type, condition.Status, condition.Reason)
+		}
+	}
+}
+
+// waitForDeployment waits for a deployment to settle, showing its progress,
+// and returns the last progress observed
+func waitForDeployment(ctx context.Context, client *k8s.Client, namespace, name string) (k8s.DeploymentProgress, error) {
+	var last k8s.DeploymentProgress
+	progress := startWaitProgress(os.Stdout, fmt.Sprintf("Waiting for deployment '%s' to roll out", name))
+	err := k8s.WaitForDeploymentReady(ctx, client.Clientset, namespace, name, func(p k8s.DeploymentProgress) {
+		last = p
+		progress.update(int64(p.Ready), int64(p.Desired), p.String())
+	})
+	progress.stop()
+	return last, err
+}
+
+// printRequestRecommendations prints the requests of each container next to
+// the sampled usage and the advice
+func printRequestRecommendations(out io.Writer, recommendations []k8s.RequestRecommendation) {
+	w := utils.NewTableWriter(out, false)
+	w.Header("CONTAINER", "RESOURCE", "REQUEST", "AVERAGE", "PEAK", "SUGGESTED", "ADVICE")
+	for _, r := range recommendations {
+		request, suggested := "<none>", "-"
+		if r.Request != nil {
+			request = r.Request.String()
+		}
+		if r.Suggested != nil {
+			suggested = r.Suggested.String()
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			r.Container, r.Resource, request, r.Average.String(), r.Peak.String(), suggested, r.Advice)
+	}
+	w.Flush()
+}
+
+// printDeploymentHistory prints the revisions of a deployment as a table,
+// marking the revision currently rolled out
+func printDeploymentHistory(revisions []k8s.DeploymentRevision, current int64) {
+	w := utils.NewTableWriter(os.Stdout, false)
+	w.Header("REVISION", "REPLICASET", "IMAGES", "AGE", "CHANGE-CAUSE")
+	for _, revision := range revisions {
+		number := fmt.Sprintf("%d", revision.Revision)
+		if revision.Revision == current {
+			number += " (current)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			number,
+			revision.ReplicaSet,
+			strings.Join(revision.Images, ","),
+			utils.FormatAge(revision.Created),
+			valueOrNone(revision.ChangeCause))
+	}
+	w.Flush()
+}
@@ -0,0 +1,292 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDeploymentsCommand(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []string
+		wantErr  bool
+		contains []string
+	}{
+		{
+			name:    "deployments help",
+			args:    []string{"deployments", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Manage Kubernetes deployments",
+				"list",
+				"get",
+				"restart",
+				"delete",
+				"scale",
+				"history",
+				"rollback",
+				"recommend",
+				"rollout-status",
+			},
+		},
+		{
+			name:    "deployments list help",
+			args:    []string{"deployments", "list", "--help"},
+			wantErr: false,
+			contains: []string{
+				"List deployments in the namespace",
+				"--namespace",
+				"--all-namespaces",
+				"--wide",
+				"--output",
+				"--no-headers",
+			},
+		},
+		{
+			name:    "deployments get help",
+			args:    []string{"deployments", "get", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Get details of a specific deployment",
+				"--namespace",
+				"--output",
+			},
+		},
+		{
+			name:    "deployments restart help",
+			args:    []string{"deployments", "restart", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Restart the pods of a deployment",
+				"--wait",
+				"--timeout",
+				"--force",
+			},
+		},
+		{
+			name:    "deployments delete help",
+			args:    []string{"deployments", "delete", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Delete a deployment",
+				"--namespace",
+				"--force",
+			},
+		},
+		{
+			name:    "deployments scale help",
+			args:    []string{"deployments", "scale", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Scale a deployment",
+				"--namespace",
+				"--replicas",
+				"--wait",
+				"--timeout",
+				"--force",
+			},
+		},
+		{
+			name:    "deployments history help",
+			args:    []string{"deployments", "history", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Show the rollout history of a deployment",
+				"--namespace",
+			},
+		},
+		{
+			name:    "deployments recommend help",
+			args:    []string{"deployments", "recommend", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Recommend CPU and memory requests for a deployment",
+				"advisory",
+				"--namespace",
+			},
+		},
+		{
+			name:    "deployments rollout-status help",
+			args:    []string{"deployments", "rollout-status", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Wait for the rollout of a deployment to finish",
+				"--namespace",
+				"--timeout",
+			},
+		},
+		{
+			name:    "deployments rollback help",
+			args:    []string{"deployments", "rollback", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Roll a deployment back to an earlier revision",
+				"--namespace",
+				"--to-revision",
+				"--force",
+			},
+		},
+		{
+			name:    "deploy alias",
+			args:    []string{"deploy", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Manage Kubernetes deployments",
+			},
+		},
+		{
+			name:    "deployments get missing argument",
+			args:    []string{"deployments", "get"},
+			wantErr: true,
+		},
+		{
+			name:    "deployments get unsupported output",
+			args:    []string{"deployments", "get", "web", "-o", "xml"},
+			wantErr: true,
+		},
+		{
+			name:    "deployments restart missing argument",
+			args:    []string{"deployments", "restart"},
+			wantErr: true,
+		},
+		{
+			name:    "deployments restart zero timeout",
+			args:    []string{"deployments", "restart", "web", "--timeout", "0"},
+			wantErr: true,
+		},
+		{
+			name:    "deployments delete missing argument",
+			args:    []string{"deployments", "delete"},
+			wantErr: true,
+		},
+		{
+			name:    "deployments list unsupported output",
+			args:    []string{"deployments", "list", "-o", "xml"},
+			wantErr: true,
+		},
+		{
+			name:    "deployments scale missing argument",
+			args:    []string{"deployments", "scale"},
+			wantErr: true,
+		},
+		{
+			name:    "deployments history missing argument",
+			args:    []string{"deployments", "history"},
+			wantErr: true,
+		},
+		{
+			name:    "deployments recommend missing argument",
+			args:    []string{"deployments", "recommend"},
+			wantErr: true,
+		},
+		{
+			name:    "deployments rollout-status missing argument",
+			args:    []string{"deployments", "rollout-status"},
+			wantErr: true,
+		},
+		{
+			name:    "deployments rollout-status negative timeout",
+			args:    []string{"deployments", "rollout-status", "web", "--timeout", "-1s"},
+			wantErr: true,
+		},
+		{
+			name:    "deployments rollback missing argument",
+			args:    []string{"deployments", "rollback"},
+			wantErr: true,
+		},
+		{
+			name:    "deployments rollback negative revision",
+			args:    []string{"deployments", "rollback", "web", "--to-revision", "-2"},
+			wantErr: true,
+		},
+		{
+			name:    "deployments scale negative replicas",
+			args:    []string{"deployments", "scale", "web", "--replicas", "-1"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := newRootCmd("test")
+			buf := new(bytes.Buffer)
+			cmd.SetOut(buf)
+			cmd.SetErr(buf)
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			output := buf.String()
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			for _, expected := range tc.contains {
+				assert.Contains(t, output, expected)
+			}
+		})
+	}
+}
+
+func TestDeploymentListRow(t *testing.T) {
+	replicas := int32(3)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "shop"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+			Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{
+				{Name: "web", Image: "nginx:1.25"},
+				{Name: "proxy", Image: "envoy:1.29"},
+			}}},
+		},
+		Status: appsv1.DeploymentStatus{UpdatedReplicas: 3, ReadyReplicas: 2, AvailableReplicas: 2},
+	}
+
+	row := deploymentListRow(deployment, false, false)
+	assert.Equal(t, []string{"web", "2/3", "3", "2"}, row[:4])
+
+	row = deploymentListRow(deployment, true, true)
+	assert.Equal(t, "shop", row[0])
+	assert.Equal(t, []string{"nginx:1.25,envoy:1.29", "app=web"}, row[len(row)-2:])
+}
+
+func TestPrintDeploymentDetails(t *testing.T) {
+	replicas := int32(2)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "shop", Annotations: map[string]string{"deployment.kubernetes.io/revision": "4"}},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+			Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+			Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "web", Image: "nginx:1.25"}}}},
+		},
+		Status: appsv1.DeploymentStatus{
+			UpdatedReplicas: 2, ReadyReplicas: 1, AvailableReplicas: 1,
+			Conditions: []appsv1.DeploymentCondition{
+				{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionFalse, Reason: "MinimumReplicasUnavailable"},
+			},
+		},
+	}
+
+	out := new(bytes.Buffer)
+	printDeploymentDetails(out, deployment)
+
+	for _, want := range []string{
+		"Selector:      app=web\n",
+		"Replicas:      2 desired | 2 updated | 1 ready | 1 available\n",
+		"Strategy:      Recreate\n",
+		"Revision:      4\n",
+		"  - Name:   web\n    Image:  nginx:1.25\n",
+		"  Available      False  MinimumReplicasUnavailable\n",
+	} {
+		assert.Contains(t, out.String(), want)
+	}
+}
@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/karthickk/k8s-manager/pkg/utils"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func newEventsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "events",
+		Short: "View Kubernetes events",
+		Long:  `List and filter the events recorded in the cluster.`,
+	}
+
+	cmd.AddCommand(newEventsListCmd())
+
+	return cmd
+}
+
+func newEventsListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List events in the namespace",
+		Long: `List events oldest first with how often and when they were first and last seen.
+
+Use --type to show only Normal or Warning events and --reason to keep events
+whose reason contains the given text, for example --reason BackOff.`,
+		RunE: runEventsList,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace to list events from (overrides config)")
+	cmd.Flags().BoolP("all-namespaces", "A", false, "List events from all namespaces")
+	cmd.Flags().String("type", "", "Only show events of this type (Normal or Warning)")
+	cmd.Flags().String("reason", "", "Only show events whose reason contains this text")
+	addListOutputFlag(cmd)
+
+	return cmd
+}
+
+func runEventsList(cmd *cobra.Command, args []string) error {
+	output, err := parseListFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	eventType, _ := cmd.Flags().GetString("type")
+	if eventType != "" && !strings.EqualFold(eventType, corev1.EventTypeNormal) && !strings.EqualFold(eventType, corev1.EventTypeWarning) {
+		return fmt.Errorf("invalid event type %q (supported: Normal, Warning)", eventType)
+	}
+	reason, _ := cmd.Flags().GetString("reason")
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	namespace, _ := cmd.Flags().GetString("namespace")
+	allNamespaces, _ := cmd.Flags().GetBool("all-namespaces")
+
+	if allNamespaces {
+		namespace = ""
+	} else if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	events, err := client.ListEvents(cmd.Context(), namespace, k8s.EventFilter{Type: eventType, Reason: reason})
+	if err != nil {
+		return err
+	}
+
+	// Events are filtered on the client, so the limit keeps the most recent
+	// matching ones
+	if output.limit > 0 && int64(len(events)) > output.limit {
+		events = events[int64(len(events))-output.limit:]
+	}
+
+	if output.structured() {
+		return output.print(os.Stdout, "events", &corev1.EventList{Items: events})
+	}
+
+	if len(events) == 0 {
+		if allNamespaces {
+			fmt.Println("No events found in any namespace")
+		} else {
+			fmt.Printf("No events found in namespace '%s'\n", namespace)
+		}
+		return nil
+	}
+
+	w := newListTableWriter(cmd)
+	if allNamespaces {
+		w.Header("NAMESPACE", "LAST SEEN", "FIRST SEEN", "COUNT", "TYPE", "REASON", "OBJECT", "MESSAGE")
+	} else {
+		w.Header("LAST SEEN", "FIRST SEEN", "COUNT", "TYPE", "REASON", "OBJECT", "MESSAGE")
+	}
+
+	for _, event := range events {
+		row := fmt.Sprintf("%s\t%s\t%d\t%s\t%s\t%s\t%s",
+			utils.FormatAge(k8s.EventTime(event)),
+			utils.FormatAge(k8s.EventFirstSeen(event)),
+			k8s.EventCount(event),
+			event.Type,
+			event.Reason,
+			eventObject(event),
+			strings.TrimSpace(event.Message),
+		)
+		if allNamespaces {
+			fmt.Fprintf(w, "%s\t%s\n", event.Namespace, row)
+		} else {
+			fmt.Fprintln(w, row)
+		}
+	}
+	w.Flush()
+
+	return nil
+}
+
+// eventObject names the object an event is about as kind/name
+func eventObject(event corev1.Event) string {
+	return strings.ToLower(event.InvolvedObject.Kind) + "/" + event.InvolvedObject.Name
+}
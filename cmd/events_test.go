@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestEventsCommand(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []string
+		wantErr  string
+		contains []string
+	}{
+		{
+			name: "events list help",
+			args: []string{"events", "list", "--help"},
+			contains: []string{
+				"List events oldest first",
+				"--type",
+				"--reason",
+				"--all-namespaces",
+				"--output",
+			},
+		},
+		{
+			name:    "invalid type",
+			args:    []string{"events", "list", "--type", "Error"},
+			wantErr: `invalid event type "Error"`,
+		},
+		{
+			name:    "invalid output",
+			args:    []string{"events", "list", "-o", "yaml"},
+			wantErr: `unsupported output format "yaml"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := newRootCmd("test")
+			buf := new(bytes.Buffer)
+			cmd.SetOut(buf)
+			cmd.SetErr(buf)
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			for _, s := range tc.contains {
+				assert.Contains(t, buf.String(), s)
+			}
+		})
+	}
+}
+
+func TestEventsJSONOutputIncludesCountAndTimestamps(t *testing.T) {
+	output, err := parseListOutput("json")
+	assert.NoError(t, err)
+
+	event := corev1.Event{Reason: "BackOff", Type: corev1.EventTypeWarning, Count: 3}
+	buf := new(bytes.Buffer)
+	assert.NoError(t, output.print(buf, "events", &corev1.EventList{Items: []corev1.Event{event}}))
+	assert.Contains(t, buf.String(), `"reason": "BackOff"`)
+	assert.Contains(t, buf.String(), `"count": 3`)
+	assert.Contains(t, buf.String(), `"firstTimestamp": null`)
+	assert.Contains(t, buf.String(), `"lastTimestamp": null`)
+}
+
+func TestEventObject(t *testing.T) {
+	event := corev1.Event{InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "web-0"}}
+	assert.Equal(t, "pod/web-0", eventObject(event))
+}
@@ -1,13 +1,19 @@
 package cmd
 
 import (
+	"bufio"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
+	"strconv"
+	"strings"
 	"syscall"
 
 	"github.com/karthickk/k8s-manager/pkg/k8s"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -145,28 +151,10 @@ func runExecShell(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get pod %s: %w", podName, err)
 	}
 
-	// If container not specified and pod has multiple containers, list them
-	if container == "" && len(pod.Spec.Containers) > 1 {
-		fmt.Println("Pod has multiple containers:")
-		for i, c := range pod.Spec.Containers {
-			fmt.Printf("  %d. %s\n", i+1, c.Name)
+	if container == "" {
+		if container, err = selectContainer(cmd.InOrStdin(), cmd.OutOrStdout(), pod); err != nil {
+			return err
 		}
-		fmt.Printf("Select container (1-%d) or press Enter for %s: ", len(pod.Spec.Containers), pod.Spec.Containers[0].Name)
-
-		var input string
-		fmt.Scanln(&input)
-
-		if input != "" {
-			var choice int
-			if _, err := fmt.Sscanf(input, "%d", &choice); err != nil || choice < 1 || choice > len(pod.Spec.Containers) {
-				return fmt.Errorf("invalid container selection")
-			}
-			container = pod.Spec.Containers[choice-1].Name
-		} else {
-			container = pod.Spec.Containers[0].Name
-		}
-	} else if container == "" {
-		container = pod.Spec.Containers[0].Name
 	}
 
 	fmt.Printf("🔗 Starting interactive shell in pod '%s', container '%s'...\n", podName, container)
@@ -175,3 +163,34 @@ func runExecShell(cmd *cobra.Command, args []string) error {
 	// Use the ExecIntoPod function
 	return k8s.ExecIntoPod(namespace, podName, container, shell)
 }
+
+// selectContainer asks which container of a multi-container pod to use,
+// offering the primary container as the default on Enter. A pod with a
+// single container needs no prompt.
+func selectContainer(in io.Reader, out io.Writer, pod *corev1.Pod) (string, error) {
+	primary := k8s.DefaultContainer(pod)
+	if len(pod.Spec.Containers) < 2 {
+		return primary, nil
+	}
+
+	fmt.Fprintln(out, "Pod has multiple containers:")
+	for i, c := range pod.Spec.Containers {
+		fmt.Fprintf(out, "  %d. %s\n", i+1, c.Name)
+	}
+	fmt.Fprintf(out, "Select container (1-%d) or press Enter for %s: ", len(pod.Spec.Containers), primary)
+
+	input, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("failed to read container selection: %w", err)
+	}
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return primary, nil
+	}
+
+	choice, err := strconv.Atoi(input)
+	if err != nil || choice < 1 || choice > len(pod.Spec.Containers) {
+		return "", fmt.Errorf("invalid container selection")
+	}
+	return pod.Spec.Containers[choice-1].Name, nil
+}
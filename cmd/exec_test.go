@@ -2,9 +2,12 @@ package cmd
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 )
 
 func TestExecCommand(t *testing.T) {
@@ -199,3 +202,38 @@ func TestExecShellCommandStructure(t *testing.T) {
 	assert.NotNil(t, flags.ShorthandLookup("c"), "container flag should have shorthand 'c'")
 	assert.NotNil(t, flags.ShorthandLookup("s"), "shell flag should have shorthand 's'")
 }
+
+func TestSelectContainer(t *testing.T) {
+	pod := &corev1.Pod{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "istio-proxy"}, {Name: "web"}}}}
+
+	testCases := []struct {
+		name     string
+		input    string
+		expected string
+		wantErr  bool
+	}{
+		{name: "enter picks the primary container", input: "\n", expected: "web"},
+		{name: "no input picks the primary container", input: "", expected: "web"},
+		{name: "explicit choice", input: "1\n", expected: "istio-proxy"},
+		{name: "out of range", input: "3\n", wantErr: true},
+		{name: "not a number", input: "web\n", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out := new(bytes.Buffer)
+			container, err := selectContainer(strings.NewReader(tc.input), out, pod)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, container)
+			assert.Contains(t, out.String(), "press Enter for web")
+		})
+	}
+
+	container, err := selectContainer(strings.NewReader(""), new(bytes.Buffer), &corev1.Pod{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "only"}}}})
+	require.NoError(t, err)
+	assert.Equal(t, "only", container, "a single container needs no prompt")
+}
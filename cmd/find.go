@@ -0,0 +1,158 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/spf13/cobra"
+)
+
+// findKindAliases maps the singular and short names accepted by --kind to
+// the kinds searched by find
+var findKindAliases = map[string]string{
+	"pod":        "pods",
+	"po":         "pods",
+	"deployment": "deployments",
+	"deploy":     "deployments",
+	"service":    "services",
+	"svc":        "services",
+	"secret":     "secrets",
+	"configmap":  "configmaps",
+	"cm":         "configmaps",
+}
+
+func newFindCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "find <term>",
+		Short: "Find resources of any kind by name",
+		Long: `Search the names of pods, deployments, services, secrets and config maps for
+a term and print the matches grouped by kind. The match is a case-insensitive
+substring match, so you do not need to remember what kind something is.
+
+All kinds are listed concurrently and must answer within --timeout. A kind you
+are not allowed to list is reported and the others are still searched.
+
+Examples:
+  k8s-manager find redis
+  k8s-manager find api -A
+  k8s-manager find web --kind deploy,svc`,
+		Args: cobra.ExactArgs(1),
+		RunE: runFind,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace to search (overrides config)")
+	cmd.Flags().BoolP("all-namespaces", "A", false, "Search all namespaces")
+	cmd.Flags().StringSlice("kind", nil, "Only search these kinds (pods, deployments, services, secrets, configmaps)")
+	cmd.Flags().Duration("timeout", 30*time.Second, "Time allowed for all the searches together")
+
+	return cmd
+}
+
+func runFind(cmd *cobra.Command, args []string) error {
+	term := args[0]
+	kindNames, _ := cmd.Flags().GetStringSlice("kind")
+	timeout, _ := cmd.Flags().GetDuration("timeout")
+
+	kinds, err := parseFindKinds(kindNames)
+	if err != nil {
+		return err
+	}
+	if timeout <= 0 {
+		return fmt.Errorf("--timeout must be greater than zero")
+	}
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	namespace, _ := cmd.Flags().GetString("namespace")
+	allNamespaces, _ := cmd.Flags().GetBool("all-namespaces")
+
+	if allNamespaces {
+		namespace = ""
+	} else {
+		if namespace == "" {
+			namespace = client.GetNamespace()
+		}
+		if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+	defer cancel()
+
+	results := k8s.FindResources(ctx, client.Clientset, namespace, term, kinds)
+	if !printFindResults(cmd.OutOrStdout(), cmd.ErrOrStderr(), results, allNamespaces) {
+		if allNamespaces {
+			fmt.Fprintf(cmd.OutOrStdout(), "No resources matching '%s' found in any namespace\n", term)
+		} else {
+			fmt.Fprintf(cmd.OutOrStdout(), "No resources matching '%s' found in namespace '%s'\n", term, namespace)
+		}
+	}
+	return nil
+}
+
+// parseFindKinds resolves the --kind values to the kinds searched by find,
+// keeping the order of k8s.FindKinds. No values means every kind.
+func parseFindKinds(names []string) ([]string, error) {
+	if len(names) == 0 {
+		return k8s.FindKinds, nil
+	}
+
+	wanted := map[string]bool{}
+	for _, name := range names {
+		kind := strings.ToLower(strings.TrimSpace(name))
+		if alias, ok := findKindAliases[kind]; ok {
+			kind = alias
+		}
+		if !slices.Contains(k8s.FindKinds, kind) {
+			return nil, fmt.Errorf("unsupported kind %q (supported: %s)", name, strings.Join(k8s.FindKinds, ", "))
+		}
+		wanted[kind] = true
+	}
+
+	kinds := make([]string, 0, len(wanted))
+	for _, kind := range k8s.FindKinds {
+		if wanted[kind] {
+			kinds = append(kinds, kind)
+		}
+	}
+	return kinds, nil
+}
+
+// printFindResults prints the matches grouped by kind and reports the kinds
+// that could not be searched to errOut. It returns whether anything matched.
+func printFindResults(out, errOut io.Writer, results []k8s.FindResult, allNamespaces bool) bool {
+	found := false
+	for _, result := range results {
+		if result.Err != nil {
+			fmt.Fprintf(errOut, "⚠️  Could not search %s: %v\n", result.Kind, result.Err)
+			continue
+		}
+		if len(result.Matches) == 0 {
+			continue
+		}
+
+		if found {
+			fmt.Fprintln(out)
+		}
+		found = true
+
+		fmt.Fprintf(out, "%s (%d)\n", result.Kind, len(result.Matches))
+		for _, match := range result.Matches {
+			if allNamespaces {
+				fmt.Fprintf(out, "  %s/%s\n", match.Namespace, match.Name)
+			} else {
+				fmt.Fprintf(out, "  %s\n", match.Name)
+			}
+		}
+	}
+	return found
+}
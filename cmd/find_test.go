@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindCommand(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []string
+		wantErr  string
+		contains []string
+	}{
+		{
+			name: "find help",
+			args: []string{"find", "--help"},
+			contains: []string{
+				"Find resources of any kind by name",
+				"--kind",
+				"--all-namespaces",
+				"--timeout",
+			},
+		},
+		{
+			name:    "missing term",
+			args:    []string{"find"},
+			wantErr: "accepts 1 arg(s)",
+		},
+		{
+			name:    "unknown kind",
+			args:    []string{"find", "web", "--kind", "ingress"},
+			wantErr: `unsupported kind "ingress"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := newRootCmd("test")
+			buf := new(bytes.Buffer)
+			cmd.SetOut(buf)
+			cmd.SetErr(buf)
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			for _, s := range tc.contains {
+				assert.Contains(t, buf.String(), s)
+			}
+		})
+	}
+}
+
+func TestParseFindKinds(t *testing.T) {
+	kinds, err := parseFindKinds(nil)
+	require.NoError(t, err)
+	assert.Equal(t, k8s.FindKinds, kinds)
+
+	kinds, err = parseFindKinds([]string{"svc", "Pod", "services"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"pods", "services"}, kinds)
+}
+
+func TestPrintFindResults(t *testing.T) {
+	results := []k8s.FindResult{
+		{Kind: "pods", Matches: []k8s.FindMatch{{Namespace: "prod", Name: "redis-0"}}},
+		{Kind: "deployments"},
+		{Kind: "secrets", Err: errors.New("forbidden")},
+		{Kind: "services", Matches: []k8s.FindMatch{{Namespace: "prod", Name: "redis"}}},
+	}
+
+	out, errOut := new(bytes.Buffer), new(bytes.Buffer)
+	assert.True(t, printFindResults(out, errOut, results, false))
+	assert.Equal(t, "pods (1)\n  redis-0\n\nservices (1)\n  redis\n", out.String())
+	assert.Contains(t, errOut.String(), "Could not search secrets: forbidden")
+
+	out.Reset()
+	printFindResults(out, errOut, results[:1], true)
+	assert.Equal(t, "pods (1)\n  prod/redis-0\n", out.String())
+
+	assert.False(t, printFindResults(out, errOut, results[1:2], false))
+}
@@ -0,0 +1,250 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/karthickk/k8s-manager/pkg/utils"
+	"github.com/spf13/cobra"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newIngressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ingress",
+		Short: "Manage Kubernetes ingresses",
+		Long:  `List and inspect Kubernetes ingresses, their routing rules, and TLS configuration.`,
+	}
+
+	cmd.AddCommand(newIngressListCmd())
+	cmd.AddCommand(newIngressGetCmd())
+
+	return cmd
+}
+
+func newIngressListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List ingresses in the namespace",
+		Long:  `List Kubernetes ingresses with their ingress class, hosts, and address.`,
+		RunE:  runIngressList,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace to list ingresses from (overrides config)")
+	cmd.Flags().BoolP("all-namespaces", "A", false, "List ingresses from all namespaces")
+	addListOutputFlag(cmd)
+
+	return cmd
+}
+
+func newIngressGetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get <ingress-name>",
+		Short: "Get details of a specific ingress",
+		Long:  `Get the routing rules and TLS configuration of a Kubernetes ingress.`,
+		Args:  cobra.ExactArgs(1),
+		RunE:  runIngressGet,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace of the ingress (overrides config)")
+
+	return cmd
+}
+
+func runIngressList(cmd *cobra.Command, args []string) error {
+	output, err := parseListFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	namespace, _ := cmd.Flags().GetString("namespace")
+	allNamespaces, _ := cmd.Flags().GetBool("all-namespaces")
+
+	if allNamespaces {
+		namespace = ""
+	} else if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	ctx := cmd.Context()
+	ingresses, err := k8s.ListAll(ctx, metav1.ListOptions{}, output.limit, client.Clientset.NetworkingV1().Ingresses(namespace).List)
+	if err != nil {
+		return fmt.Errorf("failed to list ingresses: %w", err)
+	}
+
+	if output.structured() {
+		return output.print(os.Stdout, "ingresses", ingresses)
+	}
+
+	if len(ingresses.Items) == 0 {
+		if allNamespaces {
+			fmt.Println("No ingresses found in any namespace")
+		} else {
+			fmt.Printf("No ingresses found in namespace '%s'\n", namespace)
+		}
+		return nil
+	}
+
+	w := newListTableWriter(cmd)
+	if allNamespaces {
+		w.Header("NAMESPACE", "NAME", "CLASS", "HOSTS", "ADDRESS", "AGE")
+	} else {
+		w.Header("NAME", "CLASS", "HOSTS", "ADDRESS", "AGE")
+	}
+
+	for _, ingress := range ingresses.Items {
+		row := fmt.Sprintf("%s\t%s\t%s\t%s\t%s",
+			ingress.Name,
+			valueOrNone(getIngressClass(&ingress)),
+			getIngressHosts(&ingress),
+			valueOrNone(getIngressAddress(&ingress)),
+			utils.FormatAge(ingress.CreationTimestamp.Time),
+		)
+		if allNamespaces {
+			fmt.Fprintf(w, "%s\t%s\n", ingress.Namespace, row)
+		} else {
+			fmt.Fprintln(w, row)
+		}
+	}
+	w.Flush()
+	output.warnTruncated(ingresses)
+
+	return nil
+}
+
+func runIngressGet(cmd *cobra.Command, args []string) error {
+	ingressName := args[0]
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	namespace, _ := cmd.Flags().GetString("namespace")
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	ctx := cmd.Context()
+	ingress, err := client.Clientset.NetworkingV1().Ingresses(namespace).Get(ctx, ingressName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get ingress %s: %w", ingressName, err)
+	}
+
+	fmt.Printf("Name:       %s\n", ingress.Name)
+	fmt.Printf("Namespace:  %s\n", ingress.Namespace)
+	fmt.Printf("Class:      %s\n", valueOrNone(getIngressClass(ingress)))
+	fmt.Printf("Address:    %s\n", valueOrNone(getIngressAddress(ingress)))
+	fmt.Printf("Created:    %s\n", ingress.CreationTimestamp.Format("2006-01-02 15:04:05"))
+	if backend := ingress.Spec.DefaultBackend; backend != nil {
+		fmt.Printf("Default:    %s\n", formatIngressBackend(backend))
+	}
+	fmt.Println()
+
+	fmt.Println("Rules:")
+	w := utils.NewTableWriter(os.Stdout, false)
+	w.Header("  HOST", "PATH", "BACKEND")
+	for _, rule := range ingress.Spec.Rules {
+		host := rule.Host
+		if host == "" {
+			host = "*"
+		}
+		if rule.HTTP == nil || len(rule.HTTP.Paths) == 0 {
+			fmt.Fprintf(w, "  %s\t%s\t%s\n", host, "<none>", "<none>")
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			p := path.Path
+			if p == "" {
+				p = "/"
+			}
+			fmt.Fprintf(w, "  %s\t%s\t%s\n", host, p, formatIngressBackend(&path.Backend))
+		}
+	}
+	w.Flush()
+	fmt.Println()
+
+	if len(ingress.Spec.TLS) == 0 {
+		fmt.Println("TLS: <none>")
+		return nil
+	}
+
+	fmt.Println("TLS:")
+	missing := []string{}
+	for _, tls := range ingress.Spec.TLS {
+		secretName := valueOrNone(tls.SecretName)
+		fmt.Printf("  - %s terminates %s\n", secretName, strings.Join(tls.Hosts, ", "))
+
+		if tls.SecretName == "" {
+			continue
+		}
+		_, err := client.Clientset.CoreV1().Secrets(namespace).Get(ctx, tls.SecretName, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			missing = append(missing, tls.SecretName)
+		} else if err != nil {
+			fmt.Printf("    ⚠️  Could not verify secret %s: %v\n", tls.SecretName, err)
+		}
+	}
+
+	for _, name := range missing {
+		fmt.Printf("⚠️  TLS secret '%s' does not exist in namespace '%s'\n", name, namespace)
+	}
+
+	return nil
+}
+
+// Helper functions
+
+func getIngressClass(ingress *networkingv1.Ingress) string {
+	if ingress.Spec.IngressClassName != nil {
+		return *ingress.Spec.IngressClassName
+	}
+	return ingress.Annotations["kubernetes.io/ingress.class"]
+}
+
+func getIngressHosts(ingress *networkingv1.Ingress) string {
+	hosts := []string{}
+	for _, rule := range ingress.Spec.Rules {
+		if rule.Host != "" {
+			hosts = append(hosts, rule.Host)
+		}
+	}
+	if len(hosts) == 0 {
+		return "*"
+	}
+	return strings.Join(hosts, ",")
+}
+
+func getIngressAddress(ingress *networkingv1.Ingress) string {
+	addresses := []string{}
+	for _, lb := range ingress.Status.LoadBalancer.Ingress {
+		if lb.IP != "" {
+			addresses = append(addresses, lb.IP)
+		} else if lb.Hostname != "" {
+			addresses = append(addresses, lb.Hostname)
+		}
+	}
+	return strings.Join(addresses, ",")
+}
+
+func formatIngressBackend(backend *networkingv1.IngressBackend) string {
+	if backend.Service != nil {
+		port := backend.Service.Port.Name
+		if port == "" {
+			port = fmt.Sprintf("%d", backend.Service.Port.Number)
+		}
+		return fmt.Sprintf("%s:%s", backend.Service.Name, port)
+	}
+	if backend.Resource != nil {
+		return fmt.Sprintf("%s/%s", backend.Resource.Kind, backend.Resource.Name)
+	}
+	return "<none>"
+}
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+func TestIngressCommand(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []string
+		wantErr  bool
+		contains []string
+	}{
+		{
+			name:    "ingress help",
+			args:    []string{"ingress", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Manage Kubernetes ingresses",
+				"list",
+				"get",
+			},
+		},
+		{
+			name:    "ingress list help",
+			args:    []string{"ingress", "list", "--help"},
+			wantErr: false,
+			contains: []string{
+				"List ingresses in the namespace",
+				"--namespace",
+				"--all-namespaces",
+			},
+		},
+		{
+			name:    "ingress get missing argument",
+			args:    []string{"ingress", "get"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := newRootCmd("test")
+			buf := new(bytes.Buffer)
+			cmd.SetOut(buf)
+			cmd.SetErr(buf)
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			output := buf.String()
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			for _, expected := range tc.contains {
+				assert.Contains(t, output, expected)
+			}
+		})
+	}
+}
+
+func TestFormatIngressBackend(t *testing.T) {
+	testCases := []struct {
+		name     string
+		backend  *networkingv1.IngressBackend
+		expected string
+	}{
+		{
+			name: "service with port number",
+			backend: &networkingv1.IngressBackend{
+				Service: &networkingv1.IngressServiceBackend{
+					Name: "web",
+					Port: networkingv1.ServiceBackendPort{Number: 8080},
+				},
+			},
+			expected: "web:8080",
+		},
+		{
+			name: "service with named port",
+			backend: &networkingv1.IngressBackend{
+				Service: &networkingv1.IngressServiceBackend{
+					Name: "web",
+					Port: networkingv1.ServiceBackendPort{Name: "http"},
+				},
+			},
+			expected: "web:http",
+		},
+		{
+			name:     "empty backend",
+			backend:  &networkingv1.IngressBackend{},
+			expected: "<none>",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, formatIngressBackend(tc.backend))
+		})
+	}
+}
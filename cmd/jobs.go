@@ -0,0 +1,589 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/karthickk/k8s-manager/pkg/utils"
+	"github.com/spf13/cobra"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newJobsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "jobs",
+		Short: "Manage Kubernetes jobs",
+		Long:  `List, inspect, and view logs of Kubernetes batch jobs.`,
+	}
+
+	cmd.AddCommand(newJobsListCmd())
+	cmd.AddCommand(newJobsGetCmd())
+	cmd.AddCommand(newJobsLogsCmd())
+
+	return cmd
+}
+
+func newJobsListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List jobs in the namespace",
+		Long:  `List Kubernetes jobs with their completions, duration, and age.`,
+		RunE:  runJobsList,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace to list jobs from (overrides config)")
+	cmd.Flags().BoolP("all-namespaces", "A", false, "List jobs from all namespaces")
+	addListOutputFlag(cmd)
+
+	return cmd
+}
+
+func newJobsGetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get <job-name>",
+		Short: "Get details of a specific job",
+		Long:  `Get detailed information about a Kubernetes job and the pods it created.`,
+		Args:  cobra.ExactArgs(1),
+		RunE:  runJobsGet,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace of the job (overrides config)")
+
+	return cmd
+}
+
+func newJobsLogsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logs <job-name>",
+		Short: "View logs of a job's pods",
+		Long:  `View logs from the pods created by a Kubernetes job.`,
+		Args:  cobra.ExactArgs(1),
+		RunE:  runJobsLogs,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace of the job (overrides config)")
+	cmd.Flags().StringP("container", "c", "", "Container name (if pod has multiple containers)")
+	cmd.Flags().BoolP("follow", "f", false, "Follow log output of the most recent pod")
+	cmd.Flags().BoolP("previous", "p", false, "Show logs from previous container instance")
+	cmd.Flags().StringP("since-time", "", "", "Show logs since timestamp (RFC3339)")
+	cmd.Flags().Int64P("tail", "", -1, "Number of lines to show from the end of the logs")
+	cmd.Flags().BoolP("timestamps", "", false, "Include timestamps in log output")
+	cmd.Flags().Int64P("limit-bytes", "", 0, "Maximum number of bytes of logs to return per pod")
+
+	return cmd
+}
+
+func newCronJobsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cronjobs",
+		Short: "Manage Kubernetes cronjobs",
+		Long:  `List, inspect, and manually trigger Kubernetes cronjobs.`,
+	}
+
+	cmd.AddCommand(newCronJobsListCmd())
+	cmd.AddCommand(newCronJobsGetCmd())
+	cmd.AddCommand(newCronJobsTriggerCmd())
+
+	return cmd
+}
+
+func newCronJobsListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List cronjobs in the namespace",
+		Long:  `List Kubernetes cronjobs with their schedule, last run, and active jobs.`,
+		RunE:  runCronJobsList,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace to list cronjobs from (overrides config)")
+	cmd.Flags().BoolP("all-namespaces", "A", false, "List cronjobs from all namespaces")
+	addListOutputFlag(cmd)
+
+	return cmd
+}
+
+func newCronJobsGetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get <cronjob-name>",
+		Short: "Get details of a specific cronjob",
+		Long:  `Get detailed information about a Kubernetes cronjob and its active jobs.`,
+		Args:  cobra.ExactArgs(1),
+		RunE:  runCronJobsGet,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace of the cronjob (overrides config)")
+
+	return cmd
+}
+
+func newCronJobsTriggerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trigger <cronjob-name>",
+		Short: "Run a cronjob immediately",
+		Long:  `Create a manual job from the cronjob's job template, like 'kubectl create job --from=cronjob/<name>'.`,
+		Args:  cobra.ExactArgs(1),
+		RunE:  runCronJobsTrigger,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace of the cronjob (overrides config)")
+
+	return cmd
+}
+
+func runJobsList(cmd *cobra.Command, args []string) error {
+	output, err := parseListFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	namespace, _ := cmd.Flags().GetString("namespace")
+	allNamespaces, _ := cmd.Flags().GetBool("all-namespaces")
+
+	if allNamespaces {
+		namespace = ""
+	} else if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	ctx := cmd.Context()
+	jobs, err := k8s.ListAll(ctx, metav1.ListOptions{}, output.limit, client.Clientset.BatchV1().Jobs(namespace).List)
+	if err != nil {
+		return fmt.Errorf("failed to list jobs: %w", err)
+	}
+
+	if output.structured() {
+		return output.print(os.Stdout, "jobs", jobs)
+	}
+
+	if len(jobs.Items) == 0 {
+		if allNamespaces {
+			fmt.Println("No jobs found in any namespace")
+		} else {
+			fmt.Printf("No jobs found in namespace '%s'\n", namespace)
+		}
+		return nil
+	}
+
+	w := newListTableWriter(cmd)
+	if allNamespaces {
+		w.Header("NAMESPACE", "NAME", "COMPLETIONS", "DURATION", "STATUS", "AGE")
+	} else {
+		w.Header("NAME", "COMPLETIONS", "DURATION", "STATUS", "AGE")
+	}
+
+	for _, job := range jobs.Items {
+		row := fmt.Sprintf("%s\t%s\t%s\t%s\t%s",
+			job.Name,
+			getJobCompletions(&job),
+			getJobDuration(&job),
+			getJobStatus(&job),
+			utils.FormatAge(job.CreationTimestamp.Time),
+		)
+		if allNamespaces {
+			fmt.Fprintf(w, "%s\t%s\n", job.Namespace, row)
+		} else {
+			fmt.Fprintln(w, row)
+		}
+	}
+	w.Flush()
+	output.warnTruncated(jobs)
+
+	return nil
+}
+
+func runJobsGet(cmd *cobra.Command, args []string) error {
+	jobName := args[0]
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	namespace, _ := cmd.Flags().GetString("namespace")
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	ctx := cmd.Context()
+	job, err := client.Clientset.BatchV1().Jobs(namespace).Get(ctx, jobName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get job %s: %w", jobName, err)
+	}
+
+	fmt.Printf("Name:         %s\n", job.Name)
+	fmt.Printf("Namespace:    %s\n", job.Namespace)
+	fmt.Printf("Status:       %s\n", getJobStatus(job))
+	fmt.Printf("Completions:  %s\n", getJobCompletions(job))
+	fmt.Printf("Active:       %d\n", job.Status.Active)
+	fmt.Printf("Succeeded:    %d\n", job.Status.Succeeded)
+	fmt.Printf("Failed:       %d\n", job.Status.Failed)
+	fmt.Printf("Duration:     %s\n", getJobDuration(job))
+	fmt.Printf("Created:      %s\n", job.CreationTimestamp.Format("2006-01-02 15:04:05"))
+	for _, owner := range job.OwnerReferences {
+		fmt.Printf("Owner:        %s/%s\n", owner.Kind, owner.Name)
+	}
+	fmt.Println()
+
+	if len(job.Spec.Template.Spec.Containers) > 0 {
+		fmt.Println("Containers:")
+		for _, container := range job.Spec.Template.Spec.Containers {
+			fmt.Printf("  - Name:   %s\n", container.Name)
+			fmt.Printf("    Image:  %s\n", container.Image)
+		}
+		fmt.Println()
+	}
+
+	pods, err := client.ListPodsForSelector(ctx, namespace, "job-name="+job.Name)
+	if err != nil {
+		return err
+	}
+
+	if len(pods) == 0 {
+		fmt.Println("No pods found for this job")
+		return nil
+	}
+
+	fmt.Println("Pods:")
+	w := utils.NewTableWriter(os.Stdout, false)
+	w.Header("  NAME", "READY", "STATUS", "RESTARTS", "AGE")
+	for _, pod := range pods {
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%d\t%s\n",
+			pod.Name, getPodReadyStatus(&pod), pod.Status.Phase,
+			getPodRestartCount(&pod), utils.FormatAge(pod.CreationTimestamp.Time))
+	}
+	w.Flush()
+
+	return nil
+}
+
+func runJobsLogs(cmd *cobra.Command, args []string) error {
+	jobName := args[0]
+	namespace, _ := cmd.Flags().GetString("namespace")
+	container, _ := cmd.Flags().GetString("container")
+	follow, _ := cmd.Flags().GetBool("follow")
+	previous, _ := cmd.Flags().GetBool("previous")
+	sinceTime, _ := cmd.Flags().GetString("since-time")
+	tail, _ := cmd.Flags().GetInt64("tail")
+	timestamps, _ := cmd.Flags().GetBool("timestamps")
+	limitBytes, _ := cmd.Flags().GetInt64("limit-bytes")
+
+	if err := validateLogWindowFlags("", sinceTime, limitBytes); err != nil {
+		return err
+	}
+
+	opts := k8s.LogOptions{
+		Container:  container,
+		Follow:     follow,
+		Previous:   previous,
+		Timestamps: timestamps,
+		TailLines:  tail,
+		LimitBytes: limitBytes,
+	}
+	if sinceTime != "" {
+		opts.SinceTime, _ = k8s.ParseSinceTime(sinceTime)
+	}
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	ctx := cmd.Context()
+	pods, err := client.ListPodsForSelector(ctx, namespace, "job-name="+jobName)
+	if err != nil {
+		return err
+	}
+
+	if len(pods) == 0 {
+		return fmt.Errorf("no pods found for job %s in namespace %s", jobName, namespace)
+	}
+
+	// Following several pods at once would interleave their output, so only
+	// the most recent attempt is followed.
+	if follow {
+		pod := k8s.NewestPod(pods)
+		if len(pods) > 1 {
+			fmt.Printf("📜 Following logs of most recent pod '%s'\n", pod.Name)
+		}
+		return client.StreamLogs(ctx, namespace, pod.Name, opts, os.Stdout)
+	}
+
+	for i, pod := range pods {
+		if len(pods) > 1 {
+			if i > 0 {
+				fmt.Println()
+			}
+			fmt.Printf("==> %s <==\n", pod.Name)
+		}
+		if err := client.StreamLogs(ctx, namespace, pod.Name, opts, os.Stdout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func runCronJobsList(cmd *cobra.Command, args []string) error {
+	output, err := parseListFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	namespace, _ := cmd.Flags().GetString("namespace")
+	allNamespaces, _ := cmd.Flags().GetBool("all-namespaces")
+
+	if allNamespaces {
+		namespace = ""
+	} else if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	ctx := cmd.Context()
+	cronJobs, err := k8s.ListAll(ctx, metav1.ListOptions{}, output.limit, client.Clientset.BatchV1().CronJobs(namespace).List)
+	if err != nil {
+		return fmt.Errorf("failed to list cronjobs: %w", err)
+	}
+
+	if output.structured() {
+		return output.print(os.Stdout, "cronjobs", cronJobs)
+	}
+
+	if len(cronJobs.Items) == 0 {
+		if allNamespaces {
+			fmt.Println("No cronjobs found in any namespace")
+		} else {
+			fmt.Printf("No cronjobs found in namespace '%s'\n", namespace)
+		}
+		return nil
+	}
+
+	w := newListTableWriter(cmd)
+	if allNamespaces {
+		w.Header("NAMESPACE", "NAME", "SCHEDULE", "SUSPEND", "ACTIVE", "LAST RUN", "AGE")
+	} else {
+		w.Header("NAME", "SCHEDULE", "SUSPEND", "ACTIVE", "LAST RUN", "AGE")
+	}
+
+	for _, cronJob := range cronJobs.Items {
+		row := fmt.Sprintf("%s\t%s\t%t\t%d\t%s\t%s",
+			cronJob.Name,
+			cronJob.Spec.Schedule,
+			isCronJobSuspended(&cronJob),
+			len(cronJob.Status.Active),
+			getCronJobLastRun(&cronJob),
+			utils.FormatAge(cronJob.CreationTimestamp.Time),
+		)
+		if allNamespaces {
+			fmt.Fprintf(w, "%s\t%s\n", cronJob.Namespace, row)
+		} else {
+			fmt.Fprintln(w, row)
+		}
+	}
+	w.Flush()
+	output.warnTruncated(cronJobs)
+
+	return nil
+}
+
+func runCronJobsGet(cmd *cobra.Command, args []string) error {
+	cronJobName := args[0]
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	namespace, _ := cmd.Flags().GetString("namespace")
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	ctx := cmd.Context()
+	cronJob, err := client.Clientset.BatchV1().CronJobs(namespace).Get(ctx, cronJobName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get cronjob %s: %w", cronJobName, err)
+	}
+
+	fmt.Printf("Name:               %s\n", cronJob.Name)
+	fmt.Printf("Namespace:          %s\n", cronJob.Namespace)
+	fmt.Printf("Schedule:           %s\n", cronJob.Spec.Schedule)
+	if cronJob.Spec.TimeZone != nil {
+		fmt.Printf("Time Zone:          %s\n", *cronJob.Spec.TimeZone)
+	}
+	fmt.Printf("Suspend:            %t\n", isCronJobSuspended(cronJob))
+	fmt.Printf("Concurrency Policy: %s\n", cronJob.Spec.ConcurrencyPolicy)
+	fmt.Printf("Last Schedule:      %s\n", getCronJobLastRun(cronJob))
+	if cronJob.Status.LastSuccessfulTime != nil {
+		fmt.Printf("Last Successful:    %s ago\n", utils.FormatAge(cronJob.Status.LastSuccessfulTime.Time))
+	}
+	fmt.Printf("Created:            %s\n", cronJob.CreationTimestamp.Format("2006-01-02 15:04:05"))
+	fmt.Println()
+
+	if len(cronJob.Spec.JobTemplate.Spec.Template.Spec.Containers) > 0 {
+		fmt.Println("Containers:")
+		for _, container := range cronJob.Spec.JobTemplate.Spec.Template.Spec.Containers {
+			fmt.Printf("  - Name:   %s\n", container.Name)
+			fmt.Printf("    Image:  %s\n", container.Image)
+		}
+		fmt.Println()
+	}
+
+	if len(cronJob.Status.Active) == 0 {
+		fmt.Println("No active jobs")
+		return nil
+	}
+
+	fmt.Println("Active Jobs:")
+	for _, ref := range cronJob.Status.Active {
+		fmt.Printf("  - %s\n", ref.Name)
+	}
+
+	return nil
+}
+
+func runCronJobsTrigger(cmd *cobra.Command, args []string) error {
+	cronJobName := args[0]
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	namespace, _ := cmd.Flags().GetString("namespace")
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	ctx := cmd.Context()
+	cronJob, err := client.Clientset.BatchV1().CronJobs(namespace).Get(ctx, cronJobName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get cronjob %s: %w", cronJobName, err)
+	}
+
+	job := newJobFromCronJob(cronJob, time.Now())
+	created, err := client.Clientset.BatchV1().Jobs(namespace).Create(ctx, job, metav1.CreateOptions{FieldManager: k8s.FieldManager()})
+	if err != nil {
+		return fmt.Errorf("failed to create job from cronjob %s: %w", cronJobName, err)
+	}
+
+	fmt.Printf("✅ Job '%s' created from cronjob '%s' in namespace '%s'\n", created.Name, cronJobName, namespace)
+	return nil
+}
+
+// newJobFromCronJob builds a manual job from a cronjob's template, mirroring
+// what 'kubectl create job --from=cronjob/<name>' produces
+func newJobFromCronJob(cronJob *batchv1.CronJob, now time.Time) *batchv1.Job {
+	name := fmt.Sprintf("%s-manual-%d", cronJob.Name, now.Unix())
+	if len(name) > 63 {
+		name = strings.TrimRight(name[:63], "-")
+	}
+
+	annotations := map[string]string{"cronjob.kubernetes.io/instantiate": "manual"}
+	for k, v := range cronJob.Spec.JobTemplate.Annotations {
+		annotations[k] = v
+	}
+
+	labels := map[string]string{}
+	for k, v := range cronJob.Spec.JobTemplate.Labels {
+		labels[k] = v
+	}
+
+	controller := true
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   cronJob.Namespace,
+			Labels:      labels,
+			Annotations: annotations,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: "batch/v1",
+					Kind:       "CronJob",
+					Name:       cronJob.Name,
+					UID:        cronJob.UID,
+					Controller: &controller,
+				},
+			},
+		},
+		Spec: *cronJob.Spec.JobTemplate.Spec.DeepCopy(),
+	}
+}
+
+// Helper functions
+
+func getJobCompletions(job *batchv1.Job) string {
+	completions := int32(1)
+	if job.Spec.Completions != nil {
+		completions = *job.Spec.Completions
+	}
+	return fmt.Sprintf("%d/%d", job.Status.Succeeded, completions)
+}
+
+func getJobDuration(job *batchv1.Job) string {
+	if job.Status.StartTime == nil {
+		return "<none>"
+	}
+
+	end := time.Now()
+	if job.Status.CompletionTime != nil {
+		end = job.Status.CompletionTime.Time
+	}
+
+	return formatDuration(end.Sub(job.Status.StartTime.Time))
+}
+
+func getJobStatus(job *batchv1.Job) string {
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != "True" {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			return "Complete"
+		case batchv1.JobFailed:
+			return "Failed"
+		case batchv1.JobSuspended:
+			return "Suspended"
+		}
+	}
+	if job.Status.Active > 0 {
+		return "Running"
+	}
+	return "Pending"
+}
+
+func getCronJobLastRun(cronJob *batchv1.CronJob) string {
+	if cronJob.Status.LastScheduleTime == nil {
+		return "<none>"
+	}
+	return utils.FormatAge(cronJob.Status.LastScheduleTime.Time) + " ago"
+}
+
+func isCronJobSuspended(cronJob *batchv1.CronJob) bool {
+	return cronJob.Spec.Suspend != nil && *cronJob.Spec.Suspend
+}
+
+func formatDuration(d time.Duration) string {
+	if d < time.Minute {
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	}
+	if d < time.Hour {
+		return fmt.Sprintf("%dm%ds", int(d.Minutes()), int(d.Seconds())%60)
+	}
+	return fmt.Sprintf("%dh%dm", int(d.Hours()), int(d.Minutes())%60)
+}
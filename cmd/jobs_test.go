@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestJobsCommand(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []string
+		wantErr  bool
+		contains []string
+	}{
+		{
+			name:    "jobs help",
+			args:    []string{"jobs", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Manage Kubernetes jobs",
+				"list",
+				"get",
+				"logs",
+			},
+		},
+		{
+			name:    "jobs logs help",
+			args:    []string{"jobs", "logs", "--help"},
+			wantErr: false,
+			contains: []string{
+				"View logs of a job's pods",
+				"--container",
+				"--follow",
+				"--previous",
+				"--since-time",
+				"--tail",
+				"--limit-bytes",
+			},
+		},
+		{
+			name:    "cronjobs help",
+			args:    []string{"cronjobs", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Manage Kubernetes cronjobs",
+				"list",
+				"get",
+				"trigger",
+			},
+		},
+		{
+			name:    "jobs get missing argument",
+			args:    []string{"jobs", "get"},
+			wantErr: true,
+		},
+		{
+			name:    "cronjobs trigger missing argument",
+			args:    []string{"cronjobs", "trigger"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := newRootCmd("test")
+			buf := new(bytes.Buffer)
+			cmd.SetOut(buf)
+			cmd.SetErr(buf)
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			output := buf.String()
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			for _, expected := range tc.contains {
+				assert.Contains(t, output, expected)
+			}
+		})
+	}
+}
+
+func TestNewJobFromCronJob(t *testing.T) {
+	cronJob := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "nightly-backup",
+			Namespace: "ops",
+			UID:       "1234",
+		},
+		Spec: batchv1.CronJobSpec{
+			JobTemplate: batchv1.JobTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"app": "backup"},
+				},
+			},
+		},
+	}
+
+	job := newJobFromCronJob(cronJob, time.Unix(1700000000, 0))
+
+	assert.Equal(t, "nightly-backup-manual-1700000000", job.Name)
+	assert.Equal(t, "ops", job.Namespace)
+	assert.Equal(t, "backup", job.Labels["app"])
+	assert.Equal(t, "manual", job.Annotations["cronjob.kubernetes.io/instantiate"])
+	if assert.Len(t, job.OwnerReferences, 1) {
+		assert.Equal(t, "CronJob", job.OwnerReferences[0].Kind)
+		assert.Equal(t, "nightly-backup", job.OwnerReferences[0].Name)
+	}
+}
+
+func TestGetJobCompletions(t *testing.T) {
+	completions := int32(3)
+	job := &batchv1.Job{
+		Spec:   batchv1.JobSpec{Completions: &completions},
+		Status: batchv1.JobStatus{Succeeded: 2},
+	}
+	assert.Equal(t, "2/3", getJobCompletions(job))
+
+	assert.Equal(t, "0/1", getJobCompletions(&batchv1.Job{}))
+}
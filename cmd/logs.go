@@ -1,44 +1,67 @@
 package cmd
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"os"
-	"os/exec"
 	"os/signal"
+	"strings"
+	"sync"
 	"syscall"
+	"time"
 
 	"github.com/karthickk/k8s-manager/pkg/k8s"
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// containerColors are the ANSI colors cycled through for container prefixes
+var containerColors = []string{"36", "32", "33", "35", "34", "31"}
+
 func newLogsCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "logs <pod-name>",
+		Use:   "logs [pod-name]",
 		Short: "View pod logs",
-		Long:  `View and follow logs from Kubernetes pods.`,
-		Args:  cobra.ExactArgs(1),
-		RunE:  runLogs,
+		Long: `View and follow logs from Kubernetes pods.
+
+With --all-containers the logs of every container, including init and
+ephemeral containers, are shown together, each line prefixed with the name of
+its container. When following, containers that start or restart later are
+picked up as well.
+
+With --selector the logs of every pod matching a label selector are shown,
+each line prefixed with its pod and container. All containers are shown
+unless --container names one. When following, pods created later that match
+the selector are picked up too.
+
+Examples:
+  k8s-manager logs web-7d4b9
+  k8s-manager logs web-7d4b9 -c app -f
+  k8s-manager logs web-7d4b9 --all-containers -f
+  k8s-manager logs -l app=web -c app -f`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: runLogs,
 	}
 
 	cmd.Flags().StringP("namespace", "n", "", "Namespace of the pod (overrides config)")
 	cmd.Flags().StringP("container", "c", "", "Container name (if pod has multiple containers)")
+	cmd.Flags().Bool("all-containers", false, "Show logs of all containers, prefixed with the container name")
+	cmd.Flags().StringP("selector", "l", "", "Show logs of all pods matching this label selector")
+	cmd.Flags().Int("max-log-requests", 5, "Maximum number of log streams open at once with --selector")
 	cmd.Flags().BoolP("follow", "f", false, "Follow log output")
 	cmd.Flags().BoolP("previous", "p", false, "Show logs from previous container instance")
 	cmd.Flags().StringP("since", "", "", "Show logs since duration (e.g., 5s, 2m, 3h)")
 	cmd.Flags().StringP("since-time", "", "", "Show logs since timestamp (RFC3339)")
 	cmd.Flags().Int64P("tail", "", -1, "Number of lines to show from the end of the logs")
 	cmd.Flags().BoolP("timestamps", "", false, "Include timestamps in log output")
+	cmd.Flags().Int64P("limit-bytes", "", 0, "Maximum number of bytes of logs to return")
 
 	return cmd
 }
 
 func runLogs(cmd *cobra.Command, args []string) error {
-	podName := args[0]
-	client, err := k8s.NewClient()
-	if err != nil {
-		return fmt.Errorf("failed to create Kubernetes client: %w", err)
-	}
-
 	namespace, _ := cmd.Flags().GetString("namespace")
 	container, _ := cmd.Flags().GetString("container")
 	follow, _ := cmd.Flags().GetBool("follow")
@@ -47,70 +70,210 @@ func runLogs(cmd *cobra.Command, args []string) error {
 	sinceTime, _ := cmd.Flags().GetString("since-time")
 	tail, _ := cmd.Flags().GetInt64("tail")
 	timestamps, _ := cmd.Flags().GetBool("timestamps")
+	limitBytes, _ := cmd.Flags().GetInt64("limit-bytes")
+	allContainers, _ := cmd.Flags().GetBool("all-containers")
+	selector, _ := cmd.Flags().GetString("selector")
+	maxLogRequests, _ := cmd.Flags().GetInt("max-log-requests")
 
-	if namespace == "" {
-		namespace = client.GetNamespace()
+	var podName string
+	switch {
+	case len(args) == 1 && selector != "":
+		return fmt.Errorf("a pod name cannot be used with --selector")
+	case len(args) == 1:
+		podName = args[0]
+	case selector == "":
+		return fmt.Errorf("a pod name or --selector is required")
 	}
 
-	// Build kubectl logs command
-	kubectlArgs := []string{"logs"}
-
-	if namespace != "" {
-		kubectlArgs = append(kubectlArgs, "-n", namespace)
+	if err := validateLogWindowFlags(since, sinceTime, limitBytes); err != nil {
+		return err
 	}
-
-	kubectlArgs = append(kubectlArgs, podName)
-
-	if container != "" {
-		kubectlArgs = append(kubectlArgs, "-c", container)
+	if allContainers && container != "" {
+		return fmt.Errorf("--all-containers cannot be used with --container")
+	}
+	if maxLogRequests < 1 {
+		return fmt.Errorf("--max-log-requests must be at least 1")
 	}
 
-	if follow {
-		kubectlArgs = append(kubectlArgs, "-f")
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
 	}
 
-	if previous {
-		kubectlArgs = append(kubectlArgs, "-p")
+	if namespace == "" {
+		namespace = client.GetNamespace()
 	}
 
+	opts := k8s.LogOptions{
+		Container:  container,
+		Follow:     follow,
+		Previous:   previous,
+		Timestamps: timestamps,
+		TailLines:  tail,
+		LimitBytes: limitBytes,
+	}
 	if since != "" {
-		kubectlArgs = append(kubectlArgs, "--since", since)
+		opts.Since, _ = time.ParseDuration(since)
 	}
-
 	if sinceTime != "" {
-		kubectlArgs = append(kubectlArgs, "--since-time", sinceTime)
+		opts.SinceTime, _ = k8s.ParseSinceTime(sinceTime)
 	}
 
-	if tail >= 0 {
-		kubectlArgs = append(kubectlArgs, "--tail", fmt.Sprintf("%d", tail))
+	switch {
+	case selector != "":
+		return streamSelectorLogs(cmd, client, namespace, selector, opts, maxLogRequests)
+	case allContainers:
+		return streamAllContainerLogs(cmd, client, namespace, podName, opts)
 	}
+	return streamPodLogs(cmd, client, namespace, podName, opts)
+}
 
-	if timestamps {
-		kubectlArgs = append(kubectlArgs, "--timestamps")
-	}
+// Helper functions
 
-	// Execute kubectl logs command
-	kubectlCmd := exec.Command("kubectl", kubectlArgs...)
-	kubectlCmd.Stdout = os.Stdout
-	kubectlCmd.Stderr = os.Stderr
-	kubectlCmd.Stdin = os.Stdin
+// validateLogWindowFlags rejects log window flags the API would refuse, before
+// any connection to the cluster is made
+func validateLogWindowFlags(since, sinceTime string, limitBytes int64) error {
+	if since != "" && sinceTime != "" {
+		return fmt.Errorf("only one of --since and --since-time may be specified")
+	}
+	if since != "" {
+		if _, err := time.ParseDuration(since); err != nil {
+			return fmt.Errorf("invalid --since duration %q: expected a duration such as 5s, 2m or 3h", since)
+		}
+	}
+	if sinceTime != "" {
+		if _, err := k8s.ParseSinceTime(sinceTime); err != nil {
+			return err
+		}
+	}
+	if limitBytes < 0 {
+		return fmt.Errorf("--limit-bytes must not be negative")
+	}
+	return nil
+}
 
-	// Handle interrupt signals for graceful shutdown when following logs
-	if follow {
-		sigChan := make(chan os.Signal, 1)
-		signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+// streamPodLogs copies the logs of one container of a pod to the output of
+// the command until the logs end or the command is interrupted. Without a
+// container the default container of the pod is used, as kubectl does.
+func streamPodLogs(cmd *cobra.Command, client *k8s.Client, namespace, podName string, opts k8s.LogOptions) error {
+	ctx, stop := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
 
-		go func() {
-			<-sigChan
-			if kubectlCmd.Process != nil {
-				kubectlCmd.Process.Kill()
+	if opts.Container == "" {
+		pod, err := client.Clientset.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+		if err != nil {
+			return resourceError(ctx, client, "get logs of", "pod", namespace, podName, err)
+		}
+		if len(pod.Spec.Containers) > 1 {
+			names := make([]string, 0, len(pod.Spec.Containers))
+			for _, container := range pod.Spec.Containers {
+				names = append(names, container.Name)
 			}
-		}()
+			opts.Container = k8s.DefaultContainer(pod)
+			fmt.Fprintf(cmd.ErrOrStderr(), "Defaulted container %q out of: %s\n", opts.Container, strings.Join(names, ", "))
+		}
 	}
 
-	if err := kubectlCmd.Run(); err != nil {
+	if err := client.StreamLogs(ctx, namespace, podName, opts, cmd.OutOrStdout()); err != nil {
 		return fmt.Errorf("failed to get logs for pod %s: %w", podName, err)
 	}
+	return nil
+}
 
+// streamAllContainerLogs prints the logs of every container of a pod, each
+// line prefixed with its container name, until the logs end or the command
+// is interrupted
+func streamAllContainerLogs(cmd *cobra.Command, client *k8s.Client, namespace, podName string, opts k8s.LogOptions) error {
+	ctx, stop := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	mux := newLogMux(os.Stdout, term.IsTerminal(int(os.Stdout.Fd())))
+	err := client.StreamAllContainerLogs(ctx, namespace, podName, opts, mux.writer)
+	mux.flush()
+	if err != nil {
+		return fmt.Errorf("failed to get logs for pod %s: %w", podName, err)
+	}
 	return nil
 }
+
+// streamSelectorLogs prints the logs of every pod matching a selector, each
+// line prefixed with its pod and container, until the logs end or the
+// command is interrupted
+func streamSelectorLogs(cmd *cobra.Command, client *k8s.Client, namespace, selector string, opts k8s.LogOptions, maxLogRequests int) error {
+	ctx, stop := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	mux := newLogMux(os.Stdout, term.IsTerminal(int(os.Stdout.Fd())))
+	err := client.StreamSelectorLogs(ctx, namespace, selector, opts, maxLogRequests, func(pod, container string) io.Writer {
+		return mux.writer(pod + "/" + container)
+	})
+	mux.flush()
+	return err
+}
+
+// logMux merges the logs of several containers into one writer. Output is
+// written a whole line at a time, so lines of different containers never mix,
+// and in the order the lines complete.
+type logMux struct {
+	mu      sync.Mutex
+	out     io.Writer
+	color   bool
+	writers []*prefixedLineWriter
+}
+
+func newLogMux(out io.Writer, color bool) *logMux {
+	return &logMux{out: out, color: color}
+}
+
+// writer returns the writer for the logs of a container, prefixed with name
+func (m *logMux) writer(name string) io.Writer {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	prefix := "[" + name + "] "
+	if m.color {
+		color := containerColors[len(m.writers)%len(containerColors)]
+		prefix = "\033[" + color + "m[" + name + "]\033[0m "
+	}
+	w := &prefixedLineWriter{mux: m, prefix: prefix}
+	m.writers = append(m.writers, w)
+	return w
+}
+
+// flush writes out lines left unterminated when their streams ended
+func (m *logMux) flush() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, w := range m.writers {
+		if len(w.partial) > 0 {
+			fmt.Fprintf(m.out, "%s%s\n", w.prefix, w.partial)
+			w.partial = nil
+		}
+	}
+}
+
+type prefixedLineWriter struct {
+	mux     *logMux
+	prefix  string
+	partial []byte
+}
+
+func (w *prefixedLineWriter) Write(p []byte) (int, error) {
+	w.mux.mu.Lock()
+	defer w.mux.mu.Unlock()
+
+	data := append(w.partial, p...)
+	for {
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 {
+			break
+		}
+		if _, err := fmt.Fprintf(w.mux.out, "%s%s", w.prefix, data[:i+1]); err != nil {
+			return 0, err
+		}
+		data = data[i+1:]
+	}
+	w.partial = append([]byte(nil), data...)
+	return len(p), nil
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"bytes"
+	"io"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -28,6 +29,7 @@ func TestLogsCommand(t *testing.T) {
 				"--since",
 				"--tail",
 				"--timestamps",
+				"--limit-bytes",
 			},
 		},
 	}
@@ -72,6 +74,26 @@ func TestLogsCommandArguments(t *testing.T) {
 			args:    []string{"logs", "pod1", "pod2"},
 			wantErr: true,
 		},
+		{
+			name:    "logs invalid since time",
+			args:    []string{"logs", "pod1", "--since-time", "yesterday"},
+			wantErr: true,
+		},
+		{
+			name:    "logs all containers with container",
+			args:    []string{"logs", "pod1", "--all-containers", "-c", "app"},
+			wantErr: true,
+		},
+		{
+			name:    "logs pod name with selector",
+			args:    []string{"logs", "pod1", "-l", "app=web"},
+			wantErr: true,
+		},
+		{
+			name:    "logs selector with zero max log requests",
+			args:    []string{"logs", "-l", "app=web", "--max-log-requests", "0"},
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -97,7 +119,7 @@ func TestLogsCommandStructure(t *testing.T) {
 	cmd := newLogsCmd()
 	
 	// Verify basic properties
-	assert.Equal(t, "logs <pod-name>", cmd.Use)
+	assert.Equal(t, "logs [pod-name]", cmd.Use)
 	assert.Contains(t, cmd.Short, "View pod logs")
 	assert.Contains(t, cmd.Long, "View and follow logs from Kubernetes pods")
 
@@ -113,6 +135,10 @@ func TestLogsCommandStructure(t *testing.T) {
 		"since-time",
 		"tail",
 		"timestamps",
+		"limit-bytes",
+		"all-containers",
+		"selector",
+		"max-log-requests",
 	}
 
 	for _, flagName := range expectedFlags {
@@ -125,4 +151,61 @@ func TestLogsCommandStructure(t *testing.T) {
 	assert.NotNil(t, flags.ShorthandLookup("c"), "container flag should have shorthand 'c'")
 	assert.NotNil(t, flags.ShorthandLookup("f"), "follow flag should have shorthand 'f'")
 	assert.NotNil(t, flags.ShorthandLookup("p"), "previous flag should have shorthand 'p'")
+	assert.NotNil(t, flags.ShorthandLookup("l"), "selector flag should have shorthand 'l'")
+}
+
+func TestValidateLogWindowFlags(t *testing.T) {
+	testCases := []struct {
+		name       string
+		since      string
+		sinceTime  string
+		limitBytes int64
+		wantErr    string
+	}{
+		{name: "no window"},
+		{name: "since time", sinceTime: "2024-05-01T12:30:00Z", limitBytes: 1024},
+		{name: "since time with offset", sinceTime: "2024-05-01T12:30:00+02:00"},
+		{name: "invalid since time", sinceTime: "2024-05-01", wantErr: "RFC3339"},
+		{name: "since and since time", since: "5m", sinceTime: "2024-05-01T12:30:00Z", wantErr: "only one of"},
+		{name: "negative limit bytes", limitBytes: -1, wantErr: "--limit-bytes"},
+		{name: "since duration", since: "2m"},
+		{name: "invalid since duration", since: "2 minutes", wantErr: "invalid --since duration"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateLogWindowFlags(tc.since, tc.sinceTime, tc.limitBytes)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
+
+func TestLogMux(t *testing.T) {
+	var out bytes.Buffer
+	mux := newLogMux(&out, false)
+	app := mux.writer("app")
+	sidecar := mux.writer("sidecar")
+
+	// Partial lines are held back until complete, so lines never mix
+	io.WriteString(app, "starting ")
+	io.WriteString(sidecar, "proxy ready\nlistening")
+	io.WriteString(app, "server\nready\n")
+	io.WriteString(sidecar, " on 15001")
+	mux.flush()
+
+	assert.Equal(t, "[sidecar] proxy ready\n[app] starting server\n[app] ready\n[sidecar] listening on 15001\n", out.String())
+}
+
+func TestLogMuxColorsContainers(t *testing.T) {
+	var out bytes.Buffer
+	mux := newLogMux(&out, true)
+	io.WriteString(mux.writer("app"), "a\n")
+	io.WriteString(mux.writer("sidecar"), "b\n")
+
+	assert.Equal(t, "\033[36m[app]\033[0m a\n\033[32m[sidecar]\033[0m b\n", out.String())
 }
@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/spf13/cobra"
+)
+
+// validateNamespaceFlag returns an error when --namespace was given explicitly
+// and names a namespace that does not exist, so a typo is not reported as an
+// empty result. The check is skipped for --all-namespaces.
+func validateNamespaceFlag(cmd *cobra.Command, client *k8s.Client, namespace string) error {
+	if namespace == "" || !cmd.Flags().Changed("namespace") {
+		return nil
+	}
+
+	if allNamespaces, err := cmd.Flags().GetBool("all-namespaces"); err == nil && allNamespaces {
+		return nil
+	}
+
+	exists, err := client.NamespaceExists(cmd.Context(), namespace)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("namespace '%s' not found", namespace)
+	}
+
+	return nil
+}
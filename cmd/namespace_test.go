@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateNamespaceFlagSkipsWithoutLookup(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{
+			name: "namespace not set",
+			args: []string{},
+		},
+		{
+			name: "all namespaces",
+			args: []string{"-n", "typo", "-A"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			cmd.Flags().StringP("namespace", "n", "", "")
+			cmd.Flags().BoolP("all-namespaces", "A", false, "")
+			assert.NoError(t, cmd.Flags().Parse(tt.args))
+
+			namespace, _ := cmd.Flags().GetString("namespace")
+			if namespace == "" {
+				namespace = "default"
+			}
+
+			// A nil client would panic if a lookup were attempted
+			assert.NoError(t, validateNamespaceFlag(cmd, nil, namespace))
+		})
+	}
+}
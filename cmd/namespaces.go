@@ -0,0 +1,457 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/karthickk/k8s-manager/pkg/ui"
+	"github.com/karthickk/k8s-manager/pkg/utils"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// quotaBarWidth is the number of cells in a quota usage bar
+const quotaBarWidth = 20
+
+// quotaWarnFraction is the share of a quota from which its usage is flagged
+const quotaWarnFraction = 0.8
+
+func newNamespacesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "namespaces",
+		Aliases: []string{"namespace", "ns"},
+		Short:   "Manage Kubernetes namespaces",
+		Long:    `List, create and inspect Kubernetes namespaces and the limits placed on them, and delete them.`,
+	}
+
+	cmd.AddCommand(newNamespacesListCmd())
+	cmd.AddCommand(newNamespacesCreateCmd())
+	cmd.AddCommand(newNamespacesDescribeCmd())
+	cmd.AddCommand(newNamespacesDeleteCmd())
+
+	return cmd
+}
+
+func newNamespacesListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List namespaces",
+		Long: `List Kubernetes namespaces with their status (Active or Terminating) and
+age. The current namespace is marked with *.`,
+		Args: cobra.NoArgs,
+		RunE: runNamespacesList,
+	}
+
+	cmd.Flags().StringP("selector", "l", "", "Only list namespaces matching this label selector")
+	cmd.Flags().Bool("show-labels", false, "Show the labels of each namespace")
+	addListOutputFlag(cmd)
+
+	return cmd
+}
+
+func newNamespacesCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create <namespace>",
+		Short: "Create a namespace",
+		Long: `Create a Kubernetes namespace, optionally with labels.
+
+Examples:
+  k8s-manager namespaces create feature-123
+  k8s-manager namespaces create feature-123 --labels team=payments,env=dev`,
+		Args: cobra.ExactArgs(1),
+		RunE: runNamespacesCreate,
+	}
+
+	cmd.Flags().StringSlice("labels", []string{}, "Labels of the namespace (key=value)")
+
+	return cmd
+}
+
+func newNamespacesDescribeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe [namespace]",
+		Short: "Show the quotas, limit ranges and objects of a namespace",
+		Long: `Show the resource quotas of a namespace with how much of each is used, its
+limit ranges, and how many pods, secrets and config maps it holds. Quotas
+close to their limit are flagged, which explains why new pods or objects are
+refused.
+
+The namespace defaults to the current one.
+
+Examples:
+  k8s-manager namespaces describe
+  k8s-manager namespaces describe production`,
+		Args:              cobra.MaximumNArgs(1),
+		RunE:              runNamespacesDescribe,
+		ValidArgsFunction: completeNamespaceArg,
+	}
+
+	return cmd
+}
+
+func newNamespacesDeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <namespace>",
+		Short: "Delete a namespace and everything in it",
+		Long: `Delete a namespace after previewing how many pods, services, secrets,
+persistent volume claims and other objects it holds. The namespace name must
+be typed out to confirm. System namespaces such as kube-system are refused.
+
+The namespace is deleted with foreground propagation. With --wait the command
+follows the deletion until the namespace is gone and, if --timeout elapses
+first, reports what blocks it, such as objects stuck on finalizers.
+
+Examples:
+  k8s-manager namespaces delete feature-123
+  k8s-manager namespaces delete feature-123 --wait --timeout 10m`,
+		Args:              cobra.ExactArgs(1),
+		RunE:              runNamespacesDelete,
+		ValidArgsFunction: completeNamespaceArg,
+	}
+
+	cmd.Flags().BoolP("wait", "w", false, "Wait until the namespace is fully deleted")
+	cmd.Flags().Duration("timeout", 5*time.Minute, "How long to wait for the deletion with --wait")
+	cmd.Flags().BoolP("force", "", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func runNamespacesList(cmd *cobra.Command, args []string) error {
+	output, err := parseListFlags(cmd)
+	if err != nil {
+		return err
+	}
+	selector, _ := cmd.Flags().GetString("selector")
+	showLabels, _ := cmd.Flags().GetBool("show-labels")
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	ctx := cmd.Context()
+	namespaces, err := k8s.ListAll(ctx, metav1.ListOptions{LabelSelector: selector}, output.limit, client.Clientset.CoreV1().Namespaces().List)
+	if err != nil {
+		return fmt.Errorf("failed to list namespaces: %w", err)
+	}
+
+	if output.structured() {
+		return output.print(os.Stdout, "namespaces", namespaces)
+	}
+
+	if len(namespaces.Items) == 0 {
+		fmt.Println("No namespaces found")
+		return nil
+	}
+
+	headers := []string{"NAME", "STATUS", "AGE"}
+	if showLabels {
+		headers = append(headers, "LABELS")
+	}
+
+	current := client.GetNamespace()
+	w := newListTableWriter(cmd)
+	w.Header(headers...)
+	for i := range namespaces.Items {
+		fmt.Fprintln(w, strings.Join(namespaceListRow(&namespaces.Items[i], current, showLabels), "\t"))
+	}
+	w.Flush()
+	output.warnTruncated(namespaces)
+
+	return nil
+}
+
+func runNamespacesCreate(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	labelFlags, _ := cmd.Flags().GetStringSlice("labels")
+
+	if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+		return fmt.Errorf("invalid namespace name '%s': %s", name, strings.Join(errs, "; "))
+	}
+	labels, err := parseLabels(labelFlags)
+	if err != nil {
+		return err
+	}
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+	}
+	if _, err := client.Clientset.CoreV1().Namespaces().Create(cmd.Context(), namespace, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("failed to create namespace %s: %w", name, err)
+	}
+
+	fmt.Printf("✅ Namespace '%s' created successfully\n", name)
+	return nil
+}
+
+func runNamespacesDescribe(cmd *cobra.Command, args []string) error {
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	namespace := client.GetNamespace()
+	if len(args) == 1 {
+		namespace = args[0]
+	}
+
+	summary, err := client.DescribeNamespace(cmd.Context(), namespace)
+	if err != nil {
+		return err
+	}
+
+	ns := summary.Namespace
+	fmt.Printf("Name:         %s\n", ns.Name)
+	fmt.Printf("Status:       %s\n", ns.Status.Phase)
+	fmt.Printf("Created:      %s\n", ns.CreationTimestamp.Format("2006-01-02 15:04:05"))
+	fmt.Printf("Labels:       %s\n", strings.ReplaceAll(formatKeyValues(ns.Labels), "\n", "\n              "))
+	fmt.Println()
+
+	fmt.Println("Resource Quotas:")
+	if len(summary.Quotas) == 0 {
+		fmt.Println("  <none>")
+	} else {
+		printQuotaUsage(summary.Quotas)
+	}
+	fmt.Println()
+
+	fmt.Println("Limit Ranges:")
+	if len(summary.LimitRanges) == 0 {
+		fmt.Println("  <none>")
+	} else {
+		printLimitRanges(summary.LimitRanges)
+	}
+	fmt.Println()
+
+	fmt.Println("Objects:")
+	fmt.Printf("  Pods:         %d\n", summary.Pods)
+	fmt.Printf("  Secrets:      %d\n", summary.Secrets)
+	fmt.Printf("  Config Maps:  %d\n", summary.ConfigMaps)
+
+	return nil
+}
+
+func runNamespacesDelete(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	wait, _ := cmd.Flags().GetBool("wait")
+	timeout, _ := cmd.Flags().GetDuration("timeout")
+	force, _ := cmd.Flags().GetBool("force")
+
+	if k8s.IsSystemNamespace(name) {
+		return fmt.Errorf("namespace '%s' is a system namespace and cannot be deleted", name)
+	}
+	if timeout <= 0 {
+		return fmt.Errorf("--timeout must be positive, got %s", timeout)
+	}
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	ctx := cmd.Context()
+	contents, err := client.ListNamespaceContents(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if !force {
+		printNamespaceContents(os.Stdout, contents)
+		fmt.Println()
+	}
+	confirmed, err := ui.ConfirmByName("delete", "namespace", name, force)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		fmt.Println("Delete cancelled")
+		return nil
+	}
+
+	if contents.Namespace.Status.Phase == corev1.NamespaceTerminating {
+		fmt.Printf("⚠️  Namespace '%s' is already being deleted\n", name)
+	} else {
+		if err := client.DeleteNamespace(ctx, name); err != nil {
+			return err
+		}
+		fmt.Printf("✅ Namespace '%s' is being deleted\n", name)
+	}
+
+	if !wait {
+		return nil
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	progress := startWaitProgress(os.Stdout, fmt.Sprintf("Waiting for namespace '%s' to be deleted", name))
+	err = client.WaitForNamespaceDeleted(waitCtx, name, func(contents *k8s.NamespaceContents) {
+		progress.update(0, 0, fmt.Sprintf("%d objects remaining", contents.Total()))
+	})
+	progress.stop()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ Namespace '%s' deleted\n", name)
+	return nil
+}
+
+// Helper functions
+
+func namespaceListRow(namespace *corev1.Namespace, current string, showLabels bool) []string {
+	name := namespace.Name
+	if name == current {
+		name += " *"
+	}
+	status := string(namespace.Status.Phase)
+	if namespace.DeletionTimestamp != nil {
+		status = string(corev1.NamespaceTerminating)
+	}
+	row := []string{name, valueOrNone(status), utils.FormatAge(namespace.CreationTimestamp.Time)}
+	if showLabels {
+		row = append(row, strings.ReplaceAll(formatKeyValues(namespace.Labels), "\n", ","))
+	}
+	return row
+}
+
+// parseLabels parses key=value labels, checking that keys and values are
+// valid label names and values
+func parseLabels(pairs []string) (map[string]string, error) {
+	if len(pairs) == 0 {
+		return nil, nil
+	}
+	labels := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid label '%s': expected key=value", pair)
+		}
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid label key '%s': %s", key, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid value for label '%s': %s", key, strings.Join(errs, "; "))
+		}
+		labels[key] = value
+	}
+	return labels, nil
+}
+
+// printNamespaceContents previews what deleting a namespace removes
+func printNamespaceContents(out io.Writer, contents *k8s.NamespaceContents) {
+	name := contents.Namespace.Name
+	if len(contents.Counts) == 0 {
+		fmt.Fprintf(out, "Namespace '%s' holds no objects.\n", name)
+		return
+	}
+
+	fmt.Fprintf(out, "Namespace '%s' holds %d objects that will be deleted with it:\n", name, contents.Total())
+	for _, count := range contents.Counts {
+		fmt.Fprintf(out, "  %-24s %d\n", count.Kind, count.Count)
+	}
+	if len(contents.Stuck) > 0 {
+		fmt.Fprintln(out, "\nAlready waiting on finalizers:")
+		for _, stuck := range contents.Stuck {
+			fmt.Fprintf(out, "  %s\n", stuck)
+		}
+	}
+}
+
+func printQuotaUsage(quotas []k8s.QuotaUsage) {
+	w := utils.NewTableWriter(os.Stdout, false)
+	w.Header("  QUOTA", "RESOURCE", "USED", "HARD", "USAGE")
+	for _, usage := range quotas {
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\n",
+			usage.Quota,
+			usage.Resource,
+			usage.Used.String(),
+			usage.Hard.String(),
+			quotaUsageBar(usage.Fraction()),
+		)
+	}
+	w.Flush()
+}
+
+// quotaUsageBar draws the share of a quota in use as a bar with a
+// percentage, flagged once it nears or reaches the limit
+func quotaUsageBar(fraction float64) string {
+	filled := int(fraction*quotaBarWidth + 0.5)
+	if filled > quotaBarWidth {
+		filled = quotaBarWidth
+	}
+	if filled < 0 {
+		filled = 0
+	}
+
+	bar := fmt.Sprintf("[%s%s] %3.0f%%",
+		strings.Repeat("█", filled), strings.Repeat("░", quotaBarWidth-filled), fraction*100)
+	switch {
+	case fraction >= 1:
+		bar += " ❌ at limit"
+	case fraction >= quotaWarnFraction:
+		bar += " ⚠️  near limit"
+	}
+	return bar
+}
+
+func printLimitRanges(limitRanges []corev1.LimitRange) {
+	w := utils.NewTableWriter(os.Stdout, false)
+	w.Header("  NAME", "TYPE", "RESOURCE", "MIN", "MAX", "DEFAULT REQUEST", "DEFAULT LIMIT", "MAX RATIO")
+	for _, limitRange := range limitRanges {
+		for _, item := range limitRange.Spec.Limits {
+			for _, name := range limitRangeResources(item) {
+				fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+					limitRange.Name,
+					item.Type,
+					name,
+					quantityOrNone(item.Min, name),
+					quantityOrNone(item.Max, name),
+					quantityOrNone(item.DefaultRequest, name),
+					quantityOrNone(item.Default, name),
+					quantityOrNone(item.MaxLimitRequestRatio, name),
+				)
+			}
+		}
+	}
+	w.Flush()
+}
+
+// limitRangeResources returns the resources a limit range item constrains,
+// sorted by name
+func limitRangeResources(item corev1.LimitRangeItem) []corev1.ResourceName {
+	seen := map[corev1.ResourceName]bool{}
+	for _, list := range []corev1.ResourceList{item.Min, item.Max, item.DefaultRequest, item.Default, item.MaxLimitRequestRatio} {
+		for name := range list {
+			seen[name] = true
+		}
+	}
+
+	names := make([]corev1.ResourceName, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	return names
+}
+
+func quantityOrNone(list corev1.ResourceList, name corev1.ResourceName) string {
+	quantity, ok := list[name]
+	if !ok {
+		return "<none>"
+	}
+	return quantity.String()
+}
@@ -0,0 +1,220 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNamespacesCommand(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []string
+		wantErr  bool
+		contains []string
+	}{
+		{
+			name:    "namespaces help",
+			args:    []string{"namespaces", "--help"},
+			wantErr: false,
+			contains: []string{
+				"List, create and inspect Kubernetes namespaces",
+				"list",
+				"create",
+				"describe",
+				"delete",
+			},
+		},
+		{
+			name:    "namespaces list help",
+			args:    []string{"ns", "list", "--help"},
+			wantErr: false,
+			contains: []string{
+				"status (Active or Terminating)",
+				"--selector",
+				"--show-labels",
+				"--output",
+			},
+		},
+		{
+			name:    "namespaces list unexpected argument",
+			args:    []string{"namespaces", "list", "default"},
+			wantErr: true,
+		},
+		{
+			name:    "namespaces create help",
+			args:    []string{"namespaces", "create", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Create a namespace",
+				"--labels",
+			},
+		},
+		{
+			name:    "namespaces create missing argument",
+			args:    []string{"namespaces", "create"},
+			wantErr: true,
+		},
+		{
+			name:    "namespaces create invalid name",
+			args:    []string{"namespaces", "create", "Feature_123"},
+			wantErr: true,
+		},
+		{
+			name:    "namespaces create invalid label",
+			args:    []string{"namespaces", "create", "feature-123", "--labels", "team"},
+			wantErr: true,
+		},
+		{
+			name:    "namespaces delete help",
+			args:    []string{"namespaces", "delete", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Delete a namespace and everything in it",
+				"--wait",
+				"--timeout",
+				"--force",
+			},
+		},
+		{
+			name:    "namespaces delete missing argument",
+			args:    []string{"namespaces", "delete"},
+			wantErr: true,
+		},
+		{
+			name:    "namespaces delete system namespace",
+			args:    []string{"namespaces", "delete", "kube-system", "--force"},
+			wantErr: true,
+		},
+		{
+			name:    "namespaces delete zero timeout",
+			args:    []string{"namespaces", "delete", "feature-123", "--timeout", "0s"},
+			wantErr: true,
+		},
+		{
+			name:    "namespaces describe help",
+			args:    []string{"namespaces", "describe", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Show the resource quotas of a namespace",
+				"limit ranges",
+			},
+		},
+		{
+			name:    "namespaces describe too many arguments",
+			args:    []string{"namespaces", "describe", "a", "b"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := newRootCmd("test")
+			buf := new(bytes.Buffer)
+			cmd.SetOut(buf)
+			cmd.SetErr(buf)
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			output := buf.String()
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			for _, expected := range tc.contains {
+				assert.Contains(t, output, expected)
+			}
+		})
+	}
+}
+
+func TestQuotaUsageBar(t *testing.T) {
+	testCases := []struct {
+		name     string
+		fraction float64
+		want     string
+	}{
+		{name: "empty", fraction: 0, want: "[" + strings.Repeat("░", 20) + "]   0%"},
+		{name: "half", fraction: 0.5, want: "[" + strings.Repeat("█", 10) + strings.Repeat("░", 10) + "]  50%"},
+		{name: "near limit", fraction: 0.85, want: "[" + strings.Repeat("█", 17) + strings.Repeat("░", 3) + "]  85% ⚠️  near limit"},
+		{name: "over limit", fraction: 1.2, want: "[" + strings.Repeat("█", 20) + "] 120% ❌ at limit"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, quotaUsageBar(tc.fraction))
+		})
+	}
+}
+
+func TestLimitRangeResources(t *testing.T) {
+	item := corev1.LimitRangeItem{
+		Type:    corev1.LimitTypeContainer,
+		Max:     corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+		Default: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m"), corev1.ResourceMemory: resource.MustParse("512Mi")},
+	}
+
+	assert.Equal(t, []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory}, limitRangeResources(item))
+	assert.Equal(t, "1Gi", quantityOrNone(item.Max, corev1.ResourceMemory))
+	assert.Equal(t, "<none>", quantityOrNone(item.Max, corev1.ResourceCPU))
+}
+
+func TestPrintNamespaceContents(t *testing.T) {
+	var out bytes.Buffer
+	printNamespaceContents(&out, &k8s.NamespaceContents{
+		Namespace: &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}},
+		Counts:    []k8s.ResourceCount{{Kind: "pods", Count: 2}, {Kind: "persistentvolumeclaims", Count: 1}},
+		Stuck:     []string{"persistentvolumeclaims/data (finalizers: kubernetes.io/pvc-protection)"},
+	})
+	output := out.String()
+	assert.Contains(t, output, "Namespace 'team-a' holds 3 objects")
+	assert.Contains(t, output, "pods                     2")
+	assert.Contains(t, output, "Already waiting on finalizers:\n  persistentvolumeclaims/data")
+
+	out.Reset()
+	printNamespaceContents(&out, &k8s.NamespaceContents{Namespace: &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "empty"}}})
+	assert.Equal(t, "Namespace 'empty' holds no objects.\n", out.String())
+}
+
+func TestNamespaceListRow(t *testing.T) {
+	now := metav1.Now()
+	active := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "shop", Labels: map[string]string{"team": "payments", "env": "dev"}},
+		Status:     corev1.NamespaceStatus{Phase: corev1.NamespaceActive},
+	}
+	assert.Equal(t, []string{"shop *", "Active"}, namespaceListRow(active, "shop", false)[:2])
+
+	row := namespaceListRow(active, "default", true)
+	assert.Equal(t, "shop", row[0])
+	assert.Equal(t, "env=dev,team=payments", row[len(row)-1])
+
+	deleting := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "old", DeletionTimestamp: &now},
+		Status:     corev1.NamespaceStatus{Phase: corev1.NamespaceActive},
+	}
+	assert.Equal(t, "Terminating", namespaceListRow(deleting, "default", false)[1])
+}
+
+func TestParseLabels(t *testing.T) {
+	labels, err := parseLabels([]string{"team=payments", "example.com/tier=", "env=dev"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "payments", "example.com/tier": "", "env": "dev"}, labels)
+
+	labels, err = parseLabels(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, labels)
+
+	for _, invalid := range []string{"team", "-team=x", "team=has space"} {
+		_, err := parseLabels([]string{invalid})
+		assert.Error(t, err, invalid)
+	}
+}
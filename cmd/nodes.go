@@ -0,0 +1,192 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/karthickk/k8s-manager/pkg/utils"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newNodesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "nodes",
+		Aliases: []string{"node", "no"},
+		Short:   "Inspect the nodes of the cluster",
+		Long:    `List the nodes of the cluster and show their capacity, allocatable resources and taints.`,
+	}
+
+	cmd.AddCommand(newNodesListCmd())
+	cmd.AddCommand(newNodesDescribeCmd())
+
+	return cmd
+}
+
+func newNodesListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the nodes of the cluster",
+		Long: `List the nodes of the cluster with their roles, status, Kubernetes version,
+internal IP, OS image and age. The status comes from the Ready condition;
+cordoned nodes are marked SchedulingDisabled.`,
+		Args: cobra.NoArgs,
+		RunE: runNodesList,
+	}
+
+	cmd.Flags().StringP("selector", "l", "", "Only list nodes matching this label selector")
+	cmd.Flags().Bool("wide", false, "Show the external IP, kernel version and container runtime of each node")
+	addListOutputFlag(cmd)
+
+	return cmd
+}
+
+func newNodesDescribeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe <node-name>",
+		Short: "Show the resources, taints and conditions of a node",
+		Long: `Show the capacity and allocatable CPU, memory and pods of a node, its taints,
+conditions, addresses and system information. Allocatable is what remains for
+pods once the system and kubelet reservations are taken from the capacity.
+
+Examples:
+  k8s-manager nodes describe worker-1`,
+		Args: cobra.ExactArgs(1),
+		RunE: runNodesDescribe,
+	}
+
+	return cmd
+}
+
+func runNodesList(cmd *cobra.Command, args []string) error {
+	output, err := parseListFlags(cmd)
+	if err != nil {
+		return err
+	}
+	selector, _ := cmd.Flags().GetString("selector")
+	wide, _ := cmd.Flags().GetBool("wide")
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	ctx := cmd.Context()
+	nodes, err := k8s.ListAll(ctx, metav1.ListOptions{LabelSelector: selector}, output.limit, client.Clientset.CoreV1().Nodes().List)
+	if err != nil {
+		return fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	if output.structured() {
+		return output.print(os.Stdout, "nodes", nodes)
+	}
+
+	if len(nodes.Items) == 0 {
+		fmt.Println("No nodes found")
+		return nil
+	}
+
+	headers := []string{"NAME", "STATUS", "ROLES", "VERSION", "INTERNAL-IP", "OS-IMAGE", "AGE"}
+	if wide {
+		headers = append(headers, "EXTERNAL-IP", "KERNEL-VERSION", "CONTAINER-RUNTIME")
+	}
+
+	w := newListTableWriter(cmd)
+	w.Header(headers...)
+	for i := range nodes.Items {
+		fmt.Fprintln(w, strings.Join(nodeListRow(&nodes.Items[i], wide), "\t"))
+	}
+	w.Flush()
+	output.warnTruncated(nodes)
+
+	return nil
+}
+
+func runNodesDescribe(cmd *cobra.Command, args []string) error {
+	name := args[0]
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	ctx := cmd.Context()
+	node, err := client.Clientset.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return resourceError(ctx, client, "get", "node", "", name, err)
+	}
+
+	printNodeDetails(cmd.OutOrStdout(), node)
+	return nil
+}
+
+// Helper functions
+
+func nodeListRow(node *corev1.Node, wide bool) []string {
+	info := node.Status.NodeInfo
+	row := []string{
+		node.Name,
+		k8s.NodeStatus(node),
+		k8s.NodeRoles(node),
+		valueOrNone(info.KubeletVersion),
+		valueOrNone(k8s.NodeAddress(node, corev1.NodeInternalIP)),
+		valueOrNone(info.OSImage),
+		utils.FormatAge(node.CreationTimestamp.Time),
+	}
+	if wide {
+		row = append(row,
+			valueOrNone(k8s.NodeAddress(node, corev1.NodeExternalIP)),
+			valueOrNone(info.KernelVersion),
+			valueOrNone(info.ContainerRuntimeVersion),
+		)
+	}
+	return row
+}
+
+// printNodeDetails prints the block shown by nodes describe
+func printNodeDetails(out io.Writer, node *corev1.Node) {
+	info := node.Status.NodeInfo
+	fmt.Fprintf(out, "Name:          %s\n", node.Name)
+	fmt.Fprintf(out, "Roles:         %s\n", k8s.NodeRoles(node))
+	fmt.Fprintf(out, "Status:        %s\n", k8s.NodeStatus(node))
+	fmt.Fprintf(out, "Created:       %s (%s ago)\n", node.CreationTimestamp.Format("2006-01-02 15:04:05"), utils.FormatAge(node.CreationTimestamp.Time))
+	fmt.Fprintf(out, "Version:       %s\n", valueOrNone(info.KubeletVersion))
+	fmt.Fprintf(out, "OS Image:      %s (%s/%s)\n", valueOrNone(info.OSImage), info.OperatingSystem, info.Architecture)
+	fmt.Fprintf(out, "Kernel:        %s\n", valueOrNone(info.KernelVersion))
+	fmt.Fprintf(out, "Runtime:       %s\n", valueOrNone(info.ContainerRuntimeVersion))
+
+	fmt.Fprintln(out, "Addresses:")
+	if len(node.Status.Addresses) == 0 {
+		fmt.Fprintln(out, "  <none>")
+	}
+	for _, address := range node.Status.Addresses {
+		fmt.Fprintf(out, "  %-12s %s\n", address.Type+":", address.Address)
+	}
+
+	fmt.Fprintln(out, "Resources:")
+	w := utils.NewTableWriter(out, false)
+	w.Header("  RESOURCE", "CAPACITY", "ALLOCATABLE")
+	for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory, corev1.ResourcePods, corev1.ResourceEphemeralStorage} {
+		fmt.Fprintf(w, "  %s\t%s\t%s\n", name, quantityOrNone(node.Status.Capacity, name), quantityOrNone(node.Status.Allocatable, name))
+	}
+	w.Flush()
+
+	fmt.Fprintln(out, "Taints:")
+	if len(node.Spec.Taints) == 0 {
+		fmt.Fprintln(out, "  <none>")
+	}
+	for _, taint := range node.Spec.Taints {
+		fmt.Fprintf(out, "  %s\n", taint.ToString())
+	}
+
+	if len(node.Status.Conditions) > 0 {
+		fmt.Fprintln(out, "Conditions:")
+		for _, condition := range node.Status.Conditions {
+			fmt.Fprintf(out, "  %-18s %-7s %s\n", condition.Type, condition.Status, condition.Reason)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNodesCommand(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []string
+		wantErr  bool
+		contains []string
+	}{
+		{
+			name:    "nodes help",
+			args:    []string{"nodes", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Inspect the nodes of the cluster",
+				"list",
+				"describe",
+			},
+		},
+		{
+			name:    "nodes list help",
+			args:    []string{"nodes", "list", "--help"},
+			wantErr: false,
+			contains: []string{
+				"roles, status, Kubernetes version",
+				"--selector",
+				"--wide",
+				"--output",
+			},
+		},
+		{
+			name:    "nodes list unexpected argument",
+			args:    []string{"nodes", "list", "worker-1"},
+			wantErr: true,
+		},
+		{
+			name:    "nodes describe help",
+			args:    []string{"no", "describe", "--help"},
+			wantErr: false,
+			contains: []string{
+				"capacity and allocatable",
+			},
+		},
+		{
+			name:    "nodes describe missing argument",
+			args:    []string{"nodes", "describe"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := newRootCmd("test")
+			buf := new(bytes.Buffer)
+			cmd.SetOut(buf)
+			cmd.SetErr(buf)
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			output := buf.String()
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			for _, expected := range tc.contains {
+				assert.Contains(t, output, expected)
+			}
+		})
+	}
+}
+
+func testNode() *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "worker-1", Labels: map[string]string{"node-role.kubernetes.io/worker": ""}},
+		Spec: corev1.NodeSpec{Taints: []corev1.Taint{
+			{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule},
+		}},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue, Reason: "KubeletReady"}},
+			Addresses:  []corev1.NodeAddress{{Type: corev1.NodeInternalIP, Address: "10.0.0.5"}},
+			NodeInfo:   corev1.NodeSystemInfo{KubeletVersion: "v1.28.4", OSImage: "Ubuntu 22.04.3 LTS", ContainerRuntimeVersion: "containerd://1.7.2"},
+			Capacity: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("4"),
+				corev1.ResourceMemory: resource.MustParse("16Gi"),
+			},
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("3800m"),
+				corev1.ResourceMemory: resource.MustParse("15Gi"),
+			},
+		},
+	}
+}
+
+func TestNodeListRow(t *testing.T) {
+	node := testNode()
+
+	row := nodeListRow(node, false)
+	assert.Equal(t, []string{"worker-1", "Ready", "worker", "v1.28.4", "10.0.0.5", "Ubuntu 22.04.3 LTS"}, row[:6])
+
+	row = nodeListRow(node, true)
+	assert.Equal(t, []string{"<none>", "<none>", "containerd://1.7.2"}, row[len(row)-3:])
+}
+
+func TestPrintNodeDetails(t *testing.T) {
+	out := new(bytes.Buffer)
+	printNodeDetails(out, testNode())
+
+	for _, want := range []string{
+		"Roles:         worker\n",
+		"Status:        Ready\n",
+		"  InternalIP:  10.0.0.5\n",
+		"  dedicated=gpu:NoSchedule\n",
+		"  KubeletReady",
+	} {
+		assert.Contains(t, out.String(), want)
+	}
+	assert.Regexp(t, `cpu\s+4\s+3800m`, out.String())
+	assert.Regexp(t, `memory\s+16Gi\s+15Gi`, out.String())
+	assert.Regexp(t, `pods\s+<none>\s+<none>`, out.String())
+}
@@ -0,0 +1,21 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+)
+
+// resourceError explains a failed get or delete of a named resource. A
+// missing resource is reported with the closest name that does exist.
+func resourceError(ctx context.Context, client *k8s.Client, verb, kind, namespace, name string, err error) error {
+	err = k8s.WithNameSuggestion(ctx, client.Clientset, kind, namespace, name, err)
+
+	var notFound *k8s.NotFoundError
+	if errors.As(err, &notFound) {
+		return err
+	}
+	return fmt.Errorf("failed to %s %s %s: %w", verb, kind, name, err)
+}
@@ -0,0 +1,185 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/karthickk/k8s-manager/pkg/utils"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/jsonpath"
+	"sigs.k8s.io/yaml"
+)
+
+// listOutput is the output format of a list command selected with -o. The
+// zero value prints the usual human-readable table.
+type listOutput struct {
+	format   string // "", "name", "json" or "jsonpath"
+	jsonPath *jsonpath.JSONPath
+	limit    int64 // Maximum number of items to list, 0 for all
+}
+
+func addListOutputFlag(cmd *cobra.Command) {
+	cmd.Flags().StringP("output", "o", "", "Output format for scripting: name, json or jsonpath=<template>")
+	cmd.Flags().Bool("no-headers", false, "Don't print the header row of the table")
+	cmd.Flags().Int64("limit", 0, "Maximum number of items to list (0 lists all)")
+}
+
+// parseListFlags parses the -o and --limit flags of a list command
+func parseListFlags(cmd *cobra.Command) (listOutput, error) {
+	outputFlag, _ := cmd.Flags().GetString("output")
+	output, err := parseListOutput(outputFlag)
+	if err != nil {
+		return listOutput{}, err
+	}
+
+	limit, _ := cmd.Flags().GetInt64("limit")
+	if limit < 0 {
+		return listOutput{}, fmt.Errorf("--limit cannot be negative")
+	}
+	output.limit = limit
+	return output, nil
+}
+
+// newListTableWriter returns the table writer of a list command, leaving out
+// the header row when --no-headers is set
+func newListTableWriter(cmd *cobra.Command) *utils.TableWriter {
+	noHeaders, _ := cmd.Flags().GetBool("no-headers")
+	return utils.NewTableWriter(os.Stdout, noHeaders)
+}
+
+// parseListOutput parses the value of the -o flag of a list command
+func parseListOutput(value string) (listOutput, error) {
+	switch {
+	case value == "":
+		return listOutput{}, nil
+
+	case value == "name":
+		return listOutput{format: "name"}, nil
+
+	case value == "json":
+		return listOutput{format: "json"}, nil
+
+	case strings.HasPrefix(value, "jsonpath="):
+		template := strings.TrimPrefix(value, "jsonpath=")
+		if template == "" {
+			return listOutput{}, fmt.Errorf("jsonpath template cannot be empty")
+		}
+
+		jp := jsonpath.New("output").AllowMissingKeys(true)
+		if err := jp.Parse(relaxedJSONPath(template)); err != nil {
+			return listOutput{}, fmt.Errorf("invalid jsonpath template %q: %w", template, err)
+		}
+		return listOutput{format: "jsonpath", jsonPath: jp}, nil
+	}
+
+	return listOutput{}, fmt.Errorf("unsupported output format %q (supported: name, json, jsonpath=<template>)", value)
+}
+
+// structured reports whether the table and any decoration are replaced by
+// machine-readable output
+func (o listOutput) structured() bool {
+	return o.format != ""
+}
+
+// print writes list in the selected format. With "name" every item is
+// printed as <resource>/<name>, one per line, ready to be piped into xargs.
+func (o listOutput) print(w io.Writer, resource string, list runtime.Object) error {
+	switch o.format {
+	case "name":
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", resource, err)
+		}
+		for _, item := range items {
+			accessor, err := meta.Accessor(item)
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", resource, err)
+			}
+			fmt.Fprintf(w, "%s/%s\n", resource, accessor.GetName())
+		}
+
+	case "json":
+		data, err := json.MarshalIndent(list, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode %s: %w", resource, err)
+		}
+		fmt.Fprintln(w, string(data))
+
+	case "jsonpath":
+		data, err := runtime.DefaultUnstructuredConverter.ToUnstructured(list)
+		if err != nil {
+			return fmt.Errorf("failed to convert %s: %w", resource, err)
+		}
+		if err := o.jsonPath.Execute(w, data); err != nil {
+			return fmt.Errorf("failed to evaluate jsonpath template: %w", err)
+		}
+		fmt.Fprintln(w)
+	}
+
+	return nil
+}
+
+// warnTruncated tells the user on stderr when --limit left items out of a
+// table, so the table can still be piped
+func (o listOutput) warnTruncated(list metav1.ListInterface) {
+	if list.GetContinue() != "" {
+		fmt.Fprintf(os.Stderr, "Showing the first %d items; raise --limit to see more\n", o.limit)
+	}
+}
+
+// parseObjectOutput parses the -o flag of a command that prints a single
+// object: "" for the human-readable description, "yaml" or "json"
+func parseObjectOutput(value string) (string, error) {
+	switch value {
+	case "", "yaml", "json":
+		return value, nil
+	}
+	return "", fmt.Errorf("unsupported output format %q (supported: yaml, json)", value)
+}
+
+// printObject writes an object as YAML or JSON
+func printObject(w io.Writer, format string, obj runtime.Object) error {
+	var data []byte
+	var err error
+	if format == "yaml" {
+		data, err = yaml.Marshal(obj)
+	} else {
+		data, err = json.MarshalIndent(obj, "", "  ")
+		data = append(data, '\n')
+	}
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", obj.GetObjectKind().GroupVersionKind().Kind, err)
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// trimManifestMeta drops the managed fields and last applied configuration
+// of an object printed with -o, which only repeat the rest of the manifest
+func trimManifestMeta(meta *metav1.ObjectMeta) {
+	meta.ManagedFields = nil
+	delete(meta.Annotations, k8s.LastAppliedAnnotation)
+	if len(meta.Annotations) == 0 {
+		meta.Annotations = nil
+	}
+}
+
+// relaxedJSONPath accepts templates written without braces or the leading
+// dot, as kubectl does, so ".items[*].metadata.name" and "{.items[*]...}"
+// are equivalent
+func relaxedJSONPath(template string) string {
+	if strings.Contains(template, "{") {
+		return template
+	}
+	if !strings.HasPrefix(template, ".") {
+		template = "." + template
+	}
+	return "{" + template + "}"
+}
@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestParseListOutput(t *testing.T) {
+	testCases := []struct {
+		name       string
+		value      string
+		format     string
+		structured bool
+		wantErr    string
+	}{
+		{name: "default table", value: "", format: "", structured: false},
+		{name: "name", value: "name", format: "name", structured: true},
+		{name: "json", value: "json", format: "json", structured: true},
+		{name: "jsonpath", value: "jsonpath={.items[*].metadata.name}", format: "jsonpath", structured: true},
+		{name: "relaxed jsonpath", value: "jsonpath=.items[0].metadata.name", format: "jsonpath", structured: true},
+		{name: "empty jsonpath", value: "jsonpath=", wantErr: "jsonpath template cannot be empty"},
+		{name: "invalid jsonpath", value: "jsonpath={.items[", wantErr: "invalid jsonpath template"},
+		{name: "unsupported format", value: "yaml", wantErr: `unsupported output format "yaml"`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			output, err := parseListOutput(tc.value)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.format, output.format)
+			assert.Equal(t, tc.structured, output.structured())
+		})
+	}
+}
+
+func TestListOutputPrint(t *testing.T) {
+	pods := &corev1.PodList{Items: []corev1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "default"}, Status: corev1.PodStatus{Phase: corev1.PodRunning}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "web-2", Namespace: "default"}, Status: corev1.PodStatus{Phase: corev1.PodPending}},
+	}}
+
+	testCases := []struct {
+		name     string
+		value    string
+		list     *corev1.PodList
+		expected string
+	}{
+		{
+			name:     "name",
+			value:    "name",
+			list:     pods,
+			expected: "pods/web-1\npods/web-2\n",
+		},
+		{
+			name:     "jsonpath names",
+			value:    "jsonpath={.items[*].metadata.name}",
+			list:     pods,
+			expected: "web-1 web-2\n",
+		},
+		{
+			name:     "jsonpath range",
+			value:    `jsonpath={range .items[*]}{.metadata.name}={.status.phase}{"\n"}{end}`,
+			list:     pods,
+			expected: "web-1=Running\nweb-2=Pending\n\n",
+		},
+		{
+			name:     "name with empty list",
+			value:    "name",
+			list:     &corev1.PodList{},
+			expected: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			output, err := parseListOutput(tc.value)
+			require.NoError(t, err)
+
+			buf := new(bytes.Buffer)
+			require.NoError(t, output.print(buf, "pods", tc.list))
+			assert.Equal(t, tc.expected, buf.String())
+		})
+	}
+}
+
+func TestRelaxedJSONPath(t *testing.T) {
+	assert.Equal(t, "{.items[*].metadata.name}", relaxedJSONPath(".items[*].metadata.name"))
+	assert.Equal(t, "{.items[*].metadata.name}", relaxedJSONPath("items[*].metadata.name"))
+	assert.Equal(t, "{.items[0].metadata.name}", relaxedJSONPath("{.items[0].metadata.name}"))
+}
+
+func TestParseListFlagsLimit(t *testing.T) {
+	newListCmd := func(args ...string) *cobra.Command {
+		cmd := &cobra.Command{Use: "list"}
+		addListOutputFlag(cmd)
+		require.NoError(t, cmd.ParseFlags(args))
+		return cmd
+	}
+
+	output, err := parseListFlags(newListCmd())
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), output.limit)
+
+	output, err = parseListFlags(newListCmd("--limit", "50", "-o", "name"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(50), output.limit)
+	assert.Equal(t, "name", output.format)
+
+	_, err = parseListFlags(newListCmd("--limit", "-1"))
+	assert.EqualError(t, err, "--limit cannot be negative")
+}
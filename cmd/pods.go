@@ -1,17 +1,26 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"os/signal"
+	"sort"
 	"strings"
-	"text/tabwriter"
+	"sync"
+	"time"
 
 	"github.com/karthickk/k8s-manager/pkg/k8s"
 	"github.com/karthickk/k8s-manager/pkg/ui"
 	"github.com/karthickk/k8s-manager/pkg/utils"
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
 )
 
 func newPodsCmd() *cobra.Command {
@@ -23,19 +32,44 @@ func newPodsCmd() *cobra.Command {
 
 	cmd.AddCommand(newPodsListCmd())
 	cmd.AddCommand(newPodsGetCmd())
+	cmd.AddCommand(newPodsLogsCmd())
+	cmd.AddCommand(newPodsDescribeCmd())
 	cmd.AddCommand(newPodsRestartCmd())
 	cmd.AddCommand(newPodsDeleteCmd())
 	cmd.AddCommand(newPodsSSHCmd())
+	cmd.AddCommand(newPodsCpCmd())
+	cmd.AddCommand(newPodsDiagnosticsCmd())
+	cmd.AddCommand(newPodsDebugCmd())
+	cmd.AddCommand(newPodsAnalyzeCmd())
+	cmd.AddCommand(newPodsPortForwardCmd())
+	cmd.AddCommand(newPodsImagesCmd())
+	cmd.AddCommand(newPodsRestartHistoryCmd())
 
 	return cmd
 }
 
 func newPodsListCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "list",
+		Use:   "list [pod-name]",
 		Short: "List pods in the namespace",
-		Long:  `List all Kubernetes pods in the current namespace.`,
-		RunE:  runPodsList,
+		Long: `List all Kubernetes pods in the current namespace.
+
+With --interactive the pods open in the interactive view. When --selector or
+a pod name given as the argument matches exactly one pod, its actions menu
+opens straight away; otherwise the matching pods are listed.
+
+With --watch the table stays open and is redrawn whenever a pod is added,
+changes or is deleted, until Ctrl+C is pressed. When the output is not a
+terminal each change is printed as a line instead.
+
+Examples:
+  k8s-manager pods list -l app=web
+  k8s-manager pods list -w
+  k8s-manager pods list -i -l app=web
+  k8s-manager pods list -i web-7d9f8c6b5-x2k4p`,
+		Args:              cobra.MaximumNArgs(1),
+		RunE:              runPodsList,
+		ValidArgsFunction: completePodNames,
 	}
 
 	cmd.Flags().StringP("namespace", "n", "", "Namespace to list pods from (overrides config)")
@@ -43,6 +77,11 @@ func newPodsListCmd() *cobra.Command {
 	cmd.Flags().StringP("selector", "l", "", "Selector (label query) to filter on")
 	cmd.Flags().StringP("field-selector", "", "", "Field selector to filter on")
 	cmd.Flags().BoolP("show-labels", "", false, "Show pod labels")
+	cmd.Flags().Bool("wide", false, "Show the container images of each pod")
+	cmd.Flags().BoolP("watch", "w", false, "Keep the table open and update it as pods change")
+	cmd.Flags().Duration("refresh-interval", ui.DefaultPodsRefreshInterval, "How often the interactive view reloads while auto-refresh (w) is on")
+	cmd.Flags().Int64("chunk-size", k8s.DefaultListPageSize, "Pods fetched per request; the table is printed a chunk at a time as they arrive")
+	addListOutputFlag(cmd)
 
 	return cmd
 }
@@ -51,28 +90,86 @@ func newPodsGetCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "get <pod-name>",
 		Short: "Get details of a specific pod",
-		Long:  `Get detailed information about a specific Kubernetes pod.`,
-		Args:  cobra.ExactArgs(1),
-		RunE:  runPodsGet,
+		Long: `Get detailed information about a specific Kubernetes pod. Each container is
+listed with its image, current state (running since, waiting reason or exit
+code), the state it last terminated in, readiness, restarts and the probes it
+has configured.
+
+With --watch the status is printed again every time the pod changes, along
+with container state transitions such as ContainerCreating → Running, until
+the pod is ready or deleted. Press Ctrl+C to stop watching.
+
+With -o yaml or -o json the pod manifest is printed instead, without managed
+fields or the last-applied-configuration annotation, ready to be piped.`,
+		Args:              cobra.ExactArgs(1),
+		RunE:              runPodsGet,
+		ValidArgsFunction: completePodNames,
 	}
 
 	cmd.Flags().StringP("namespace", "n", "", "Namespace of the pod (overrides config)")
-	cmd.Flags().BoolP("yaml", "y", false, "Output in YAML format")
+	cmd.Flags().StringP("output", "o", "", "Print the pod manifest instead: yaml or json")
+	cmd.Flags().BoolP("yaml", "y", false, "Print the pod manifest as YAML (same as -o yaml)")
+	cmd.Flags().BoolP("watch", "w", false, "Watch the pod and print its status on every change until it is ready or deleted")
+
+	return cmd
+}
+
+func newPodsLogsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logs <pod-name>",
+		Short: "Print the logs of a pod",
+		Long: `Print the logs of a container of a pod, read straight from the Kubernetes
+API so kubectl does not need to be installed.
+
+Without --container the container named by the default-container annotation
+is used, or else the main container of the pod. With --follow new lines are
+printed as they are written until the pod stops or Ctrl+C is pressed.
+
+Examples:
+  k8s-manager pods logs web-7d4b9
+  k8s-manager pods logs web-7d4b9 -c app -f --tail 100
+  k8s-manager pods logs web-7d4b9 --previous --since 10m`,
+		Args:              cobra.ExactArgs(1),
+		RunE:              runPodsLogs,
+		ValidArgsFunction: completePodNames,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace of the pod (overrides config)")
+	cmd.Flags().StringP("container", "c", "", "Container name (if pod has multiple containers)")
+	cmd.Flags().BoolP("follow", "f", false, "Follow log output")
+	cmd.Flags().Int64("tail", -1, "Number of lines to show from the end of the logs")
+	cmd.Flags().BoolP("previous", "p", false, "Show logs from previous container instance")
+	cmd.Flags().String("since", "", "Show logs since duration (e.g., 5s, 2m, 3h)")
 
 	return cmd
 }
 
 func newPodsRestartCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "restart <pod-name-or-deployment>",
+		Use:   "restart <pod-or-workload-name>",
 		Short: "Restart pods",
-		Long:  `Restart a specific pod or all pods in a deployment.`,
-		Args:  cobra.ExactArgs(1),
-		RunE:  runPodsRestart,
+		Long: `Restart a specific pod, or all pods of a deployment, statefulset or daemonset.
+
+A pod is restarted by deleting it so its controller recreates it. A workload
+is restarted by rolling its pods, as kubectl rollout restart does.
+
+When no pod has the given name, the workload of that name is restarted. If
+a deployment, statefulset and daemonset share the name, choose one with
+--deployment, --statefulset or --daemonset.
+
+Examples:
+  k8s-manager pods restart web-7d9f8c6b5-x2k4p
+  k8s-manager pods restart web
+  k8s-manager pods restart db --statefulset`,
+		Args:              cobra.ExactArgs(1),
+		RunE:              runPodsRestart,
+		ValidArgsFunction: completePodNames,
 	}
 
-	cmd.Flags().StringP("namespace", "n", "", "Namespace of the pod/deployment (overrides config)")
-	cmd.Flags().BoolP("deployment", "d", false, "Restart deployment instead of individual pod")
+	cmd.Flags().StringP("namespace", "n", "", "Namespace of the pod or workload (overrides config)")
+	cmd.Flags().BoolP("deployment", "d", false, "Restart the deployment of this name")
+	cmd.Flags().BoolP("statefulset", "", false, "Restart the statefulset of this name")
+	cmd.Flags().BoolP("daemonset", "", false, "Restart the daemonset of this name")
 	cmd.Flags().BoolP("force", "", false, "Skip confirmation prompt")
 
 	return cmd
@@ -80,27 +177,37 @@ func newPodsRestartCmd() *cobra.Command {
 
 func newPodsDeleteCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "delete <pod-name>",
+		Use:   "delete [pod-name]",
 		Short: "Delete a pod",
-		Long:  `Delete a Kubernetes pod from the cluster.`,
-		Args:  cobra.ExactArgs(1),
-		RunE:  runPodsDelete,
+		Long: `Delete a Kubernetes pod from the cluster.
+
+Use --selector or --field-selector instead of a pod name to delete every
+matching pod at once.
+
+Use --force together with --grace-period=0 to remove a pod that is stuck in
+Terminating from the API immediately, without waiting for the kubelet.`,
+		Args:              podsDeleteArgs,
+		RunE:              runPodsDelete,
+		ValidArgsFunction: completePodNames,
 	}
 
 	cmd.Flags().StringP("namespace", "n", "", "Namespace of the pod (overrides config)")
-	cmd.Flags().BoolP("force", "", false, "Skip confirmation prompt")
-	cmd.Flags().Int64P("grace-period", "", 30, "Grace period in seconds")
+	cmd.Flags().BoolP("force", "", false, "Skip confirmation prompt; with --grace-period=0, force delete immediately")
+	cmd.Flags().Int64P("grace-period", "", 30, "Grace period in seconds (0 requires --force)")
+	cmd.Flags().StringP("selector", "l", "", "Delete all pods matching this label selector")
+	cmd.Flags().StringP("field-selector", "", "", "Delete all pods matching this field selector")
 
 	return cmd
 }
 
 func newPodsSSHCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "ssh <pod-name>",
-		Short: "SSH into a pod",
-		Long:  `Execute an interactive shell session in a Kubernetes pod.`,
-		Args:  cobra.ExactArgs(1),
-		RunE:  runPodsSSH,
+		Use:               "ssh <pod-name>",
+		Short:             "SSH into a pod",
+		Long:              `Execute an interactive shell session in a Kubernetes pod.`,
+		Args:              cobra.ExactArgs(1),
+		RunE:              runPodsSSH,
+		ValidArgsFunction: completePodNames,
 	}
 
 	cmd.Flags().StringP("namespace", "n", "", "Namespace of the pod (overrides config)")
@@ -115,9 +222,41 @@ func runPodsList(cmd *cobra.Command, args []string) error {
 	if interactiveMode {
 		namespace, _ := cmd.Flags().GetString("namespace")
 		allNamespaces, _ := cmd.Flags().GetBool("all-namespaces")
+		refreshInterval, _ := cmd.Flags().GetDuration("refresh-interval")
+		if refreshInterval <= 0 {
+			return fmt.Errorf("--refresh-interval must be greater than zero")
+		}
+
+		target := ui.PodTarget{}
+		target.Selector, _ = cmd.Flags().GetString("selector")
+		if len(args) == 1 {
+			target.Name = args[0]
+		}
 
 		// Use the enhanced UI for interactive mode
-		return ui.ShowEnhancedPodsInterface(namespace, allNamespaces)
+		return ui.ShowEnhancedPodsInterface(namespace, allNamespaces, refreshInterval, target)
+	}
+
+	if len(args) == 1 {
+		return fmt.Errorf("a pod name can only be given with --interactive; use 'pods get %s' to show one pod", args[0])
+	}
+
+	output, err := parseListFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	chunkSize, _ := cmd.Flags().GetInt64("chunk-size")
+	if chunkSize <= 0 {
+		return fmt.Errorf("--chunk-size must be greater than zero")
+	}
+
+	watchChanges, _ := cmd.Flags().GetBool("watch")
+	if watchChanges && output.structured() {
+		return fmt.Errorf("--watch can only be used with the table output")
+	}
+	if watchChanges && output.limit > 0 {
+		return fmt.Errorf("--watch cannot be combined with --limit")
 	}
 
 	client, err := k8s.NewClient()
@@ -130,12 +269,16 @@ func runPodsList(cmd *cobra.Command, args []string) error {
 	selector, _ := cmd.Flags().GetString("selector")
 	fieldSelector, _ := cmd.Flags().GetString("field-selector")
 	showLabels, _ := cmd.Flags().GetBool("show-labels")
-
+	wide, _ := cmd.Flags().GetBool("wide")
 	if namespace == "" && !allNamespaces {
 		namespace = client.GetNamespace()
 	}
 
-	listOptions := metav1.ListOptions{}
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	listOptions := metav1.ListOptions{Limit: chunkSize}
 	if selector != "" {
 		listOptions.LabelSelector = selector
 	}
@@ -144,212 +287,301 @@ func runPodsList(cmd *cobra.Command, args []string) error {
 	}
 
 	ctx := cmd.Context()
-	var pods *corev1.PodList
-
+	listNamespace := namespace
 	if allNamespaces {
-		pods, err = client.Clientset.CoreV1().Pods("").List(ctx, listOptions)
-		if err != nil {
+		listNamespace = ""
+	}
+	listPods := client.Clientset.CoreV1().Pods(listNamespace).List
+
+	listError := func(err error) error {
+		if allNamespaces {
 			return fmt.Errorf("failed to list pods: %w", err)
 		}
-	} else {
-		pods, err = client.Clientset.CoreV1().Pods(namespace).List(ctx, listOptions)
-		if err != nil {
-			return fmt.Errorf("failed to list pods in namespace %s: %w", namespace, err)
-		}
+		return fmt.Errorf("failed to list pods in namespace %s: %w", namespace, err)
 	}
 
-	if len(pods.Items) == 0 {
-		if allNamespaces {
-			fmt.Println("No pods found in any namespace")
-		} else {
-			fmt.Printf("No pods found in namespace '%s'\n", namespace)
+	if output.structured() {
+		pods, err := k8s.ListAll(ctx, listOptions, output.limit, listPods)
+		if err != nil {
+			return listError(err)
 		}
-		return nil
+		return output.print(os.Stdout, "pods", pods)
 	}
 
-	// Display pods in table format
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	// Display pods in table format, printing each page as soon as it arrives
+	// so large clusters show the first rows without waiting for the rest
+	headers := []string{"NAME", "READY", "STATUS", "RESTARTS", "AGE"}
 	if allNamespaces {
-		if showLabels {
-			fmt.Fprintln(w, "NAMESPACE\tNAME\tREADY\tSTATUS\tRESTARTS\tAGE\tLABELS")
-		} else {
-			fmt.Fprintln(w, "NAMESPACE\tNAME\tREADY\tSTATUS\tRESTARTS\tAGE")
-		}
-	} else {
-		if showLabels {
-			fmt.Fprintln(w, "NAME\tREADY\tSTATUS\tRESTARTS\tAGE\tLABELS")
-		} else {
-			fmt.Fprintln(w, "NAME\tREADY\tSTATUS\tRESTARTS\tAGE")
-		}
+		headers = append([]string{"NAMESPACE"}, headers...)
+	}
+	if wide {
+		headers = append(headers, "IMAGES")
+	}
+	if showLabels {
+		headers = append(headers, "LABELS")
 	}
 
-	for _, pod := range pods.Items {
-		ready := getPodReadyStatus(&pod)
-		status := string(pod.Status.Phase)
-		restarts := getPodRestartCount(&pod)
-		age := utils.FormatAge(pod.CreationTimestamp.Time)
-
-		var labels string
-		if showLabels {
-			labelPairs := []string{}
-			for k, v := range pod.Labels {
-				labelPairs = append(labelPairs, fmt.Sprintf("%s=%s", k, v))
-			}
-			labels = strings.Join(labelPairs, ",")
+	if watchChanges {
+		return watchPodList(cmd, client, listNamespace, listOptions, headers, func(pod *corev1.Pod) []string {
+			return podListRow(pod, allNamespaces, wide, showLabels)
+		})
+	}
+
+	w := newListTableWriter(cmd)
+	count := 0
+	remaining, err := k8s.ListPages(ctx, listOptions, output.limit, listPods, func(page *corev1.PodList) error {
+		if count == 0 && len(page.Items) > 0 {
+			w.Header(headers...)
+		}
+		for i := range page.Items {
+			fmt.Fprintln(w, strings.Join(podListRow(&page.Items[i], allNamespaces, wide, showLabels), "\t"))
 		}
+		count += len(page.Items)
+		return w.Flush()
+	})
+	if err != nil {
+		return listError(err)
+	}
 
+	if count == 0 {
 		if allNamespaces {
-			if showLabels {
-				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\t%s\n",
-					pod.Namespace, pod.Name, ready, status, restarts, age, labels)
-			} else {
-				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\n",
-					pod.Namespace, pod.Name, ready, status, restarts, age)
-			}
+			fmt.Println("No pods found in any namespace")
 		} else {
-			if showLabels {
-				fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%s\n",
-					pod.Name, ready, status, restarts, age, labels)
-			} else {
-				fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\n",
-					pod.Name, ready, status, restarts, age)
-			}
+			fmt.Printf("No pods found in namespace '%s'\n", namespace)
 		}
+		return nil
 	}
-	w.Flush()
+	output.warnTruncated(&metav1.ListMeta{Continue: remaining})
 
 	return nil
 }
 
+// podListRow returns the table columns of a pod for pods list
+func podListRow(pod *corev1.Pod, allNamespaces, wide, showLabels bool) []string {
+	row := []string{
+		pod.Name,
+		getPodReadyStatus(pod),
+		k8s.PodStatus(pod),
+		fmt.Sprintf("%d", getPodRestartCount(pod)),
+		utils.FormatAge(pod.CreationTimestamp.Time),
+	}
+	if allNamespaces {
+		row = append([]string{pod.Namespace}, row...)
+	}
+	if wide {
+		row = append(row, strings.Join(k8s.PodImages(pod), ","))
+	}
+	if showLabels {
+		labelPairs := []string{}
+		for k, v := range pod.Labels {
+			labelPairs = append(labelPairs, fmt.Sprintf("%s=%s", k, v))
+		}
+		row = append(row, strings.Join(labelPairs, ","))
+	}
+	return row
+}
+
 func runPodsGet(cmd *cobra.Command, args []string) error {
 	podName := args[0]
+	namespace, _ := cmd.Flags().GetString("namespace")
+	outputFlag, _ := cmd.Flags().GetString("output")
+	outputYAML, _ := cmd.Flags().GetBool("yaml")
+	watchChanges, _ := cmd.Flags().GetBool("watch")
+
+	output, err := parseObjectOutput(outputFlag)
+	if err != nil {
+		return err
+	}
+	if outputYAML {
+		if output == "json" {
+			return fmt.Errorf("--yaml cannot be combined with -o json")
+		}
+		output = "yaml"
+	}
+	if output != "" && watchChanges {
+		return fmt.Errorf("--watch cannot be combined with -o %s", output)
+	}
+
 	client, err := k8s.NewClient()
 	if err != nil {
 		return fmt.Errorf("failed to create Kubernetes client: %w", err)
 	}
 
-	namespace, _ := cmd.Flags().GetString("namespace")
-	outputYAML, _ := cmd.Flags().GetBool("yaml")
-
 	if namespace == "" {
 		namespace = client.GetNamespace()
 	}
 
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
 	ctx := cmd.Context()
 	pod, err := client.Clientset.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
 	if err != nil {
-		return fmt.Errorf("failed to get pod %s: %w", podName, err)
+		return resourceError(ctx, client, "get", "pod", namespace, podName, err)
 	}
 
-	if outputYAML {
-		// TODO: Implement YAML output
-		fmt.Println("YAML output not yet implemented")
-		return nil
+	if output != "" {
+		return printObject(cmd.OutOrStdout(), output, podManifest(pod))
 	}
 
-	fmt.Printf("Name:         %s\n", pod.Name)
-	fmt.Printf("Namespace:    %s\n", pod.Namespace)
-	fmt.Printf("Status:       %s\n", pod.Status.Phase)
-	fmt.Printf("Node:         %s\n", pod.Spec.NodeName)
-	fmt.Printf("Created:      %s\n", pod.CreationTimestamp.Format("2006-01-02 15:04:05"))
-	fmt.Printf("Ready:        %s\n", getPodReadyStatus(pod))
-	fmt.Printf("Restarts:     %d\n", getPodRestartCount(pod))
-	fmt.Println()
-
-	if len(pod.Spec.Containers) > 0 {
-		fmt.Println("Containers:")
-		for _, container := range pod.Spec.Containers {
-			fmt.Printf("  - Name:   %s\n", container.Name)
-			fmt.Printf("    Image:  %s\n", container.Image)
-			if len(container.Ports) > 0 {
-				fmt.Printf("    Ports:  ")
-				for i, port := range container.Ports {
-					if i > 0 {
-						fmt.Print(", ")
-					}
-					fmt.Printf("%d/%s", port.ContainerPort, port.Protocol)
-				}
-				fmt.Println()
-			}
-		}
-		fmt.Println()
-	}
+	printPodDetails(cmd.OutOrStdout(), pod)
 
-	if len(pod.Status.ContainerStatuses) > 0 {
-		fmt.Println("Container Status:")
-		for _, status := range pod.Status.ContainerStatuses {
-			fmt.Printf("  - %s: Ready=%t, RestartCount=%d\n",
-				status.Name, status.Ready, status.RestartCount)
-		}
+	if watchChanges {
+		return watchPod(ctx, client, pod)
 	}
 
 	return nil
 }
 
+func runPodsLogs(cmd *cobra.Command, args []string) error {
+	podName := args[0]
+	namespace, _ := cmd.Flags().GetString("namespace")
+	container, _ := cmd.Flags().GetString("container")
+	follow, _ := cmd.Flags().GetBool("follow")
+	tail, _ := cmd.Flags().GetInt64("tail")
+	previous, _ := cmd.Flags().GetBool("previous")
+	since, _ := cmd.Flags().GetString("since")
+
+	if err := validateLogWindowFlags(since, "", 0); err != nil {
+		return err
+	}
+	if tail < -1 {
+		return fmt.Errorf("--tail must be -1 (all lines) or more")
+	}
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	opts := k8s.LogOptions{Container: container, Follow: follow, Previous: previous, TailLines: tail}
+	if since != "" {
+		opts.Since, _ = time.ParseDuration(since)
+	}
+	return streamPodLogs(cmd, client, namespace, podName, opts)
+}
+
 func runPodsRestart(cmd *cobra.Command, args []string) error {
 	name := args[0]
+	kind, err := restartKindFlag(cmd)
+	if err != nil {
+		return err
+	}
+
 	client, err := k8s.NewClient()
 	if err != nil {
 		return fmt.Errorf("failed to create Kubernetes client: %w", err)
 	}
 
 	namespace, _ := cmd.Flags().GetString("namespace")
-	isDeployment, _ := cmd.Flags().GetBool("deployment")
 	force, _ := cmd.Flags().GetBool("force")
 
 	if namespace == "" {
 		namespace = client.GetNamespace()
 	}
 
-	if !force {
-		if isDeployment {
-			fmt.Printf("Are you sure you want to restart deployment '%s' in namespace '%s'? (y/N): ", name, namespace)
-		} else {
-			fmt.Printf("Are you sure you want to restart pod '%s' in namespace '%s'? (y/N): ", name, namespace)
-		}
-		var response string
-		fmt.Scanln(&response)
-		if strings.ToLower(response) != "y" && strings.ToLower(response) != "yes" {
-			fmt.Println("Restart cancelled")
-			return nil
-		}
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
 	}
 
 	ctx := cmd.Context()
 
-	if isDeployment {
-		// Restart deployment by adding/updating restart annotation
-		deployment, err := client.Clientset.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
-		if err != nil {
-			return fmt.Errorf("failed to get deployment %s: %w", name, err)
+	// Without a kind flag the name is a pod, or else the workload of that name
+	if kind == "" {
+		_, err := client.Clientset.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+		switch {
+		case err == nil:
+		case apierrors.IsNotFound(err):
+			kind, err = k8s.DetectWorkloadKind(ctx, client.Clientset, namespace, name)
+			if errors.Is(err, k8s.ErrWorkloadNotFound) {
+				return fmt.Errorf("no pod, deployment, statefulset or daemonset named %s in namespace %s", name, namespace)
+			}
+			if err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("failed to get pod %s: %w", name, err)
 		}
+	}
 
-		if deployment.Spec.Template.Annotations == nil {
-			deployment.Spec.Template.Annotations = make(map[string]string)
-		}
-		deployment.Spec.Template.Annotations["kubectl.kubernetes.io/restartedAt"] = metav1.Now().Format("2006-01-02T15:04:05Z")
+	what := "pod"
+	if kind != "" {
+		what = strings.ToLower(string(kind))
+	}
 
-		_, err = client.Clientset.AppsV1().Deployments(namespace).Update(ctx, deployment, metav1.UpdateOptions{})
-		if err != nil {
-			return fmt.Errorf("failed to restart deployment %s: %w", name, err)
-		}
+	confirmed, err := ui.ConfirmDestructive("restart", what, namespace, name, force)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		fmt.Println("Restart cancelled")
+		return nil
+	}
 
-		fmt.Printf("✅ Deployment '%s' restart initiated in namespace '%s'\n", name, namespace)
-	} else {
-		// Delete pod to restart it (if managed by a controller)
-		err = client.Clientset.CoreV1().Pods(namespace).Delete(ctx, name, metav1.DeleteOptions{})
-		if err != nil {
-			return fmt.Errorf("failed to restart pod %s: %w", name, err)
+	if kind != "" {
+		if err := k8s.RestartWorkload(ctx, client.Clientset, kind, namespace, name); err != nil {
+			return err
 		}
+		fmt.Printf("✅ %s '%s' restart initiated in namespace '%s'\n", kind, name, namespace)
+		return nil
+	}
 
-		fmt.Printf("✅ Pod '%s' restart initiated in namespace '%s'\n", name, namespace)
+	// Delete pod to restart it (if managed by a controller)
+	err = client.Clientset.CoreV1().Pods(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to restart pod %s: %w", name, err)
 	}
 
+	fmt.Printf("✅ Pod '%s' restart initiated in namespace '%s'\n", name, namespace)
 	return nil
 }
 
+// restartKindFlag returns the workload kind chosen with --deployment,
+// --statefulset or --daemonset, or "" when none is set
+func restartKindFlag(cmd *cobra.Command) (k8s.WorkloadKind, error) {
+	var kinds []k8s.WorkloadKind
+	for _, kind := range k8s.WorkloadKinds {
+		if set, _ := cmd.Flags().GetBool(strings.ToLower(string(kind))); set {
+			kinds = append(kinds, kind)
+		}
+	}
+
+	if len(kinds) > 1 {
+		return "", fmt.Errorf("only one of --deployment, --statefulset and --daemonset can be set")
+	}
+	if len(kinds) == 0 {
+		return "", nil
+	}
+	return kinds[0], nil
+}
+
+// podsDeleteArgs requires either a pod name or a selector, but not both
+func podsDeleteArgs(cmd *cobra.Command, args []string) error {
+	selector, _ := cmd.Flags().GetString("selector")
+	fieldSelector, _ := cmd.Flags().GetString("field-selector")
+	hasSelector := selector != "" || fieldSelector != ""
+
+	switch {
+	case hasSelector && len(args) > 0:
+		return fmt.Errorf("a pod name cannot be combined with --selector or --field-selector")
+	case hasSelector:
+		return nil
+	case len(args) != 1:
+		return fmt.Errorf("requires a pod name or --selector/--field-selector")
+	default:
+		return nil
+	}
+}
+
 func runPodsDelete(cmd *cobra.Command, args []string) error {
-	podName := args[0]
 	client, err := k8s.NewClient()
 	if err != nil {
 		return fmt.Errorf("failed to create Kubernetes client: %w", err)
@@ -363,27 +595,107 @@ func runPodsDelete(cmd *cobra.Command, args []string) error {
 		namespace = client.GetNamespace()
 	}
 
-	if !force {
-		fmt.Printf("Are you sure you want to delete pod '%s' in namespace '%s'? (y/N): ", podName, namespace)
-		var response string
-		fmt.Scanln(&response)
-		if strings.ToLower(response) != "y" && strings.ToLower(response) != "yes" {
-			fmt.Println("Deletion cancelled")
-			return nil
-		}
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	if len(args) == 0 {
+		return runPodsDeleteBySelector(cmd, client, namespace, force, gracePeriod)
+	}
+	podName := args[0]
+
+	confirmed, err := ui.ConfirmDestructive("delete", "pod", namespace, podName, force)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		fmt.Println("Deletion cancelled")
+		return nil
 	}
 
 	ctx := cmd.Context()
-	deleteOptions := metav1.DeleteOptions{
-		GracePeriodSeconds: &gracePeriod,
+	deleteOptions := buildPodDeleteOptions(gracePeriod, force)
+	if isForceDelete(gracePeriod, force) {
+		fmt.Println("⚠️  Immediate deletion does not wait for confirmation that the running resource has been terminated.")
+		fmt.Println("   The container may continue to run on the node indefinitely.")
+	} else if gracePeriod == 0 {
+		fmt.Println("⚠️  --grace-period=0 requires --force; using a grace period of 1 second instead")
 	}
 
 	err = client.Clientset.CoreV1().Pods(namespace).Delete(ctx, podName, deleteOptions)
 	if err != nil {
-		return fmt.Errorf("failed to delete pod %s: %w", podName, err)
+		return resourceError(ctx, client, "delete", "pod", namespace, podName, err)
 	}
 
-	fmt.Printf("✅ Pod '%s' deleted successfully from namespace '%s'\n", podName, namespace)
+	if isForceDelete(gracePeriod, force) {
+		fmt.Printf("✅ Pod '%s' force deleted from namespace '%s'\n", podName, namespace)
+	} else {
+		fmt.Printf("✅ Pod '%s' deleted successfully from namespace '%s'\n", podName, namespace)
+	}
+	return nil
+}
+
+func runPodsDeleteBySelector(cmd *cobra.Command, client *k8s.Client, namespace string, force bool, gracePeriod int64) error {
+	selector, _ := cmd.Flags().GetString("selector")
+	fieldSelector, _ := cmd.Flags().GetString("field-selector")
+
+	ctx := cmd.Context()
+	pods, err := client.Clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: selector,
+		FieldSelector: fieldSelector,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	if len(pods.Items) == 0 {
+		fmt.Printf("No pods matching the selector found in namespace '%s'\n", namespace)
+		return nil
+	}
+
+	names := make([]string, 0, len(pods.Items))
+	for _, pod := range pods.Items {
+		names = append(names, pod.Name)
+	}
+
+	if !force {
+		fmt.Printf("The following %d pods in namespace '%s' will be deleted:\n", len(names), namespace)
+		for _, name := range names {
+			fmt.Printf("  - %s\n", name)
+		}
+	}
+	confirmed, err := ui.ConfirmDestructive("delete", fmt.Sprintf("%d pods", len(names)), namespace, "", force)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		fmt.Println("Deletion cancelled")
+		return nil
+	}
+
+	deleteOptions := buildPodDeleteOptions(gracePeriod, force)
+	results := deletePodsConcurrently(ctx, names, podDeleteParallelism, func(ctx context.Context, name string) error {
+		return client.Clientset.CoreV1().Pods(namespace).Delete(ctx, name, deleteOptions)
+	})
+
+	failed := 0
+	w := utils.NewTableWriter(os.Stdout, false)
+	w.Header("NAME", "RESULT")
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+			fmt.Fprintf(w, "%s\t❌ %v\n", result.Name, result.Err)
+		} else {
+			fmt.Fprintf(w, "%s\t✅ deleted\n", result.Name)
+		}
+	}
+	w.Flush()
+
+	if failed > 0 {
+		return fmt.Errorf("failed to delete %d of %d pods", failed, len(results))
+	}
+
+	fmt.Printf("✅ %d pods deleted from namespace '%s'\n", len(results), namespace)
 	return nil
 }
 
@@ -402,6 +714,10 @@ func runPodsSSH(cmd *cobra.Command, args []string) error {
 		namespace = client.GetNamespace()
 	}
 
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
 	// Check if pod exists and get container info
 	ctx := cmd.Context()
 	pod, err := client.Clientset.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
@@ -409,31 +725,288 @@ func runPodsSSH(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get pod %s: %w", podName, err)
 	}
 
-	// If container not specified and pod has multiple containers, ask user to choose
-	if container == "" && len(pod.Spec.Containers) > 1 {
-		fmt.Println("Pod has multiple containers:")
-		for i, c := range pod.Spec.Containers {
-			fmt.Printf("  %d. %s\n", i+1, c.Name)
+	if container == "" {
+		if container, err = selectContainer(cmd.InOrStdin(), cmd.OutOrStdout(), pod); err != nil {
+			return err
 		}
-		fmt.Printf("Select container (1-%d): ", len(pod.Spec.Containers))
-
-		var choice int
-		if _, err := fmt.Scanln(&choice); err != nil || choice < 1 || choice > len(pod.Spec.Containers) {
-			return fmt.Errorf("invalid container selection")
-		}
-		container = pod.Spec.Containers[choice-1].Name
-	} else if container == "" {
-		container = pod.Spec.Containers[0].Name
 	}
 
 	fmt.Printf("🔗 Connecting to pod '%s', container '%s'...\n", podName, container)
 
-	// Use kubectl exec for interactive session
+	// Open an interactive session through the API server
 	return k8s.ExecIntoPod(namespace, podName, container, shell)
 }
 
 // Helper functions
 
+// podDeleteParallelism bounds the number of concurrent pod deletions
+const podDeleteParallelism = 5
+
+type podDeleteResult struct {
+	Name string
+	Err  error
+}
+
+// deletePodsConcurrently calls deleteFn for every pod with at most
+// parallelism calls in flight, returning results in the order of names
+func deletePodsConcurrently(ctx context.Context, names []string, parallelism int, deleteFn func(context.Context, string) error) []podDeleteResult {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	results := make([]podDeleteResult, len(names))
+	sem := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+
+	for i, name := range names {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, name string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i] = podDeleteResult{Name: name, Err: deleteFn(ctx, name)}
+		}(i, name)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// isForceDelete reports whether a delete should bypass graceful termination
+func isForceDelete(gracePeriod int64, force bool) bool {
+	return force && gracePeriod == 0
+}
+
+// buildPodDeleteOptions mirrors kubectl: a zero grace period is only honoured
+// with --force, otherwise it is bumped to 1 second so the kubelet still gets
+// a chance to stop the containers.
+func buildPodDeleteOptions(gracePeriod int64, force bool) metav1.DeleteOptions {
+	if isForceDelete(gracePeriod, force) {
+		zero := int64(0)
+		propagation := metav1.DeletePropagationBackground
+		return metav1.DeleteOptions{
+			GracePeriodSeconds: &zero,
+			PropagationPolicy:  &propagation,
+		}
+	}
+
+	if gracePeriod == 0 {
+		gracePeriod = 1
+	}
+	return metav1.DeleteOptions{
+		GracePeriodSeconds: &gracePeriod,
+	}
+}
+
+// podManifest returns a copy of a pod as printed by pods get -o, with its
+// kind set and its metadata trimmed
+func podManifest(pod *corev1.Pod) *corev1.Pod {
+	manifest := pod.DeepCopy()
+	manifest.APIVersion, manifest.Kind = "v1", "Pod"
+	trimManifestMeta(&manifest.ObjectMeta)
+	return manifest
+}
+
+// printPodDetails prints the status block shown by pods get
+func printPodDetails(out io.Writer, pod *corev1.Pod) {
+	fmt.Fprintf(out, "Name:         %s\n", pod.Name)
+	fmt.Fprintf(out, "Namespace:    %s\n", pod.Namespace)
+	fmt.Fprintf(out, "Status:       %s\n", k8s.PodStatus(pod))
+	fmt.Fprintf(out, "Node:         %s\n", pod.Spec.NodeName)
+	fmt.Fprintf(out, "Created:      %s\n", pod.CreationTimestamp.Format("2006-01-02 15:04:05"))
+	fmt.Fprintf(out, "Ready:        %s\n", getPodReadyStatus(pod))
+	fmt.Fprintf(out, "Restarts:     %d\n", getPodRestartCount(pod))
+	fmt.Fprintln(out)
+
+	if len(pod.Spec.Containers) == 0 {
+		return
+	}
+
+	statuses := make(map[string]corev1.ContainerStatus, len(pod.Status.ContainerStatuses))
+	for _, status := range pod.Status.ContainerStatuses {
+		statuses[status.Name] = status
+	}
+
+	fmt.Fprintln(out, "Containers:")
+	for _, container := range pod.Spec.Containers {
+		fmt.Fprintf(out, "  - Name:       %s\n", container.Name)
+		fmt.Fprintf(out, "    Image:      %s\n", container.Image)
+		if len(container.Ports) > 0 {
+			fmt.Fprintf(out, "    Ports:      %s\n", formatContainerPorts(container.Ports))
+		}
+		if status, ok := statuses[container.Name]; ok {
+			fmt.Fprintf(out, "    State:      %s\n", formatContainerState(status.State))
+			if status.LastTerminationState.Terminated != nil {
+				fmt.Fprintf(out, "    Last State: %s\n", formatContainerState(status.LastTerminationState))
+			}
+			fmt.Fprintf(out, "    Ready:      %t\n", status.Ready)
+			fmt.Fprintf(out, "    Restarts:   %d\n", status.RestartCount)
+		} else {
+			fmt.Fprintf(out, "    State:      <no status yet>\n")
+		}
+		fmt.Fprintf(out, "    Probes:     %s\n", formatProbes(container))
+	}
+}
+
+// formatProbes lists the probes configured on a container and what they
+// check, e.g. "readiness http-get :8080/healthz, liveness tcp :8080"
+func formatProbes(container corev1.Container) string {
+	probes := []struct {
+		name  string
+		probe *corev1.Probe
+	}{
+		{"startup", container.StartupProbe},
+		{"readiness", container.ReadinessProbe},
+		{"liveness", container.LivenessProbe},
+	}
+
+	var parts []string
+	for _, p := range probes {
+		if p.probe != nil {
+			parts = append(parts, p.name+" "+probeHandler(p.probe.ProbeHandler))
+		}
+	}
+	if len(parts) == 0 {
+		return "<none>"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// probeHandler describes what a probe checks
+func probeHandler(handler corev1.ProbeHandler) string {
+	switch {
+	case handler.HTTPGet != nil:
+		return fmt.Sprintf("http-get :%s%s", handler.HTTPGet.Port.String(), handler.HTTPGet.Path)
+	case handler.TCPSocket != nil:
+		return fmt.Sprintf("tcp :%s", handler.TCPSocket.Port.String())
+	case handler.GRPC != nil:
+		return fmt.Sprintf("grpc :%d", handler.GRPC.Port)
+	case handler.Exec != nil:
+		return fmt.Sprintf("exec [%s]", strings.Join(handler.Exec.Command, " "))
+	}
+	return "<unknown>"
+}
+
+// watchPodList prints the pods matching listOptions and keeps the table up
+// to date until the user presses Ctrl+C. On a terminal the table is redrawn
+// in place on every change; otherwise each change is printed as a line.
+// When the watch falls too far behind, the pods are listed again.
+func watchPodList(cmd *cobra.Command, client *k8s.Client, namespace string, listOptions metav1.ListOptions, headers []string, row func(*corev1.Pod) []string) error {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+	defer stop()
+
+	out := cmd.OutOrStdout()
+	f, isFile := out.(*os.File)
+	redraw := isFile && term.IsTerminal(int(f.Fd()))
+	listPods := client.Clientset.CoreV1().Pods(namespace).List
+
+	for {
+		list, err := k8s.ListAll(ctx, listOptions, 0, listPods)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("failed to list pods: %w", err)
+		}
+
+		pods := map[string]*corev1.Pod{}
+		for i := range list.Items {
+			pods[podKey(&list.Items[i])] = &list.Items[i]
+		}
+		if redraw {
+			drawPodTable(cmd, out, headers, pods, row)
+		} else {
+			printPodTable(cmd, out, headers, pods, row)
+		}
+
+		err = client.WatchPods(ctx, namespace, list.ResourceVersion, listOptions, func(event watch.EventType, pod *corev1.Pod) bool {
+			if event == watch.Deleted {
+				delete(pods, podKey(pod))
+			} else {
+				pods[podKey(pod)] = pod
+			}
+
+			if redraw {
+				drawPodTable(cmd, out, headers, pods, row)
+			} else {
+				fmt.Fprintf(out, "[%s] %-8s %s\n", time.Now().Format("15:04:05"), event, strings.Join(row(pod), "   "))
+			}
+			return false
+		})
+		if apierrors.IsResourceExpired(err) || apierrors.IsGone(err) {
+			continue
+		}
+		return err
+	}
+}
+
+// drawPodTable clears the terminal and prints the pods watched by
+// pods list --watch
+func drawPodTable(cmd *cobra.Command, out io.Writer, headers []string, pods map[string]*corev1.Pod, row func(*corev1.Pod) []string) {
+	fmt.Fprint(out, "\033[H\033[2J")
+	fmt.Fprintf(out, "Watching %d pods, updated %s (Ctrl+C to stop)\n\n", len(pods), time.Now().Format("15:04:05"))
+	printPodTable(cmd, out, headers, pods, row)
+}
+
+// printPodTable prints pods sorted by namespace and name
+func printPodTable(cmd *cobra.Command, out io.Writer, headers []string, pods map[string]*corev1.Pod, row func(*corev1.Pod) []string) {
+	keys := make([]string, 0, len(pods))
+	for key := range pods {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	noHeaders, _ := cmd.Flags().GetBool("no-headers")
+	w := utils.NewTableWriter(out, noHeaders)
+	w.Header(headers...)
+	for _, key := range keys {
+		fmt.Fprintln(w, strings.Join(row(pods[key]), "\t"))
+	}
+	w.Flush()
+}
+
+// podKey identifies a pod across namespaces
+func podKey(pod *corev1.Pod) string {
+	return pod.Namespace + "/" + pod.Name
+}
+
+// watchPod re-prints the status of a pod on every change until it becomes
+// ready, is deleted or the user presses Ctrl+C
+func watchPod(ctx context.Context, client *k8s.Client, pod *corev1.Pod) error {
+	if podReady(pod) {
+		fmt.Printf("\n✅ Pod '%s' is ready\n", pod.Name)
+		return nil
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+
+	fmt.Printf("\nWatching pod '%s' for changes (Ctrl+C to stop)...\n", pod.Name)
+
+	last := pod
+	return client.WatchPod(ctx, pod.Namespace, pod.Name, pod.ResourceVersion, func(event watch.EventType, updated *corev1.Pod) bool {
+		switch event {
+		case watch.Deleted:
+			fmt.Printf("\n[%s] Pod '%s' was deleted\n", time.Now().Format("15:04:05"), updated.Name)
+			return true
+		case watch.Modified:
+			fmt.Printf("\n[%s] ─────────────────────────────────────\n", time.Now().Format("15:04:05"))
+			for _, transition := range containerTransitions(last, updated) {
+				fmt.Printf("  %s\n", transition)
+			}
+			fmt.Println()
+			printPodDetails(os.Stdout, updated)
+			last = updated
+		}
+
+		if podReady(updated) {
+			fmt.Printf("\n✅ Pod '%s' is ready\n", updated.Name)
+			return true
+		}
+		return false
+	})
+}
+
 func getPodReadyStatus(pod *corev1.Pod) string {
 	readyContainers := 0
 	totalContainers := len(pod.Spec.Containers)
@@ -454,3 +1027,54 @@ func getPodRestartCount(pod *corev1.Pod) int32 {
 	}
 	return totalRestarts
 }
+
+// podReady reports whether the pod's Ready condition is true
+func podReady(pod *corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// containerStateSummary describes a container state the way kubectl shows
+// it, using the waiting or terminated reason when there is one
+func containerStateSummary(state corev1.ContainerState) string {
+	switch {
+	case state.Waiting != nil:
+		if state.Waiting.Reason != "" {
+			return state.Waiting.Reason
+		}
+		return "Waiting"
+	case state.Running != nil:
+		return "Running"
+	case state.Terminated != nil:
+		if state.Terminated.Reason != "" {
+			return state.Terminated.Reason
+		}
+		return "Terminated"
+	}
+	return "Unknown"
+}
+
+// containerTransitions lists the containers whose state changed between two
+// versions of a pod, e.g. "app: ContainerCreating → Running"
+func containerTransitions(old, updated *corev1.Pod) []string {
+	previous := make(map[string]string)
+	for _, status := range append(old.Status.InitContainerStatuses, old.Status.ContainerStatuses...) {
+		previous[status.Name] = containerStateSummary(status.State)
+	}
+
+	var transitions []string
+	for _, status := range append(updated.Status.InitContainerStatuses, updated.Status.ContainerStatuses...) {
+		current := containerStateSummary(status.State)
+		before, ok := previous[status.Name]
+		if !ok {
+			transitions = append(transitions, fmt.Sprintf("%s: %s", status.Name, current))
+		} else if before != current {
+			transitions = append(transitions, fmt.Sprintf("%s: %s → %s", status.Name, before, current))
+		}
+	}
+	return transitions
+}
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/karthickk/k8s-manager/pkg/utils"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newPodsAnalyzeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "analyze <pod-name>",
+		Short: "Explain why a pod is pending",
+		Long: `Explain why a pending pod is not running. The scheduler's FailedScheduling
+events, the pod's persistent volume claims and what each node has left to
+allocate are combined into a list of likely causes, such as insufficient cpu
+on all nodes, no node matching the nodeSelector, or an unbound PVC.
+
+Examples:
+  k8s-manager pods analyze web-7d4b9
+  k8s-manager pods analyze db-0 -n production`,
+		Args:              cobra.ExactArgs(1),
+		RunE:              runPodsAnalyze,
+		ValidArgsFunction: completePodNames,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace of the pod (overrides config)")
+
+	return cmd
+}
+
+func runPodsAnalyze(cmd *cobra.Command, args []string) error {
+	podName := args[0]
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	namespace, _ := cmd.Flags().GetString("namespace")
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	pod, err := client.Clientset.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return resourceError(ctx, client, "get", "pod", namespace, podName, err)
+	}
+
+	if pod.Status.Phase != corev1.PodPending {
+		fmt.Printf("✅ Pod '%s' is %s, not pending\n", pod.Name, k8s.PodStatus(pod))
+		return nil
+	}
+
+	analysis, err := client.AnalyzePendingPod(ctx, pod)
+	if err != nil {
+		return err
+	}
+
+	printPendingAnalysis(pod, analysis)
+	return nil
+}
+
+// Helper functions
+
+func printPendingAnalysis(pod *corev1.Pod, analysis *k8s.PendingAnalysis) {
+	fmt.Printf("Pod '%s' is pending (%s)\n", pod.Name, k8s.PodStatus(pod))
+	if analysis.SchedulerMessage != "" {
+		fmt.Printf("Scheduler: %s\n", analysis.SchedulerMessage)
+	}
+	fmt.Println()
+
+	if len(analysis.Causes) == 0 {
+		fmt.Println("No likely cause found; at least one node has room for the pod")
+	} else {
+		fmt.Println("⚠️  Likely causes:")
+		for _, cause := range analysis.Causes {
+			fmt.Printf("  - %s\n", cause)
+		}
+	}
+
+	if len(analysis.Nodes) == 0 {
+		return
+	}
+
+	fmt.Println()
+	fmt.Println("Nodes:")
+	w := utils.NewTableWriter(os.Stdout, false)
+	w.Header("  NODE", "FITS", "REASONS")
+	for _, node := range analysis.Nodes {
+		fits := "yes"
+		if !node.Fits() {
+			fits = "no"
+		}
+		fmt.Fprintf(w, "  %s\t%s\t%s\n", node.Name, fits, valueOrNone(strings.Join(node.Reasons, ", ")))
+	}
+	w.Flush()
+}
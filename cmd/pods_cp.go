@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/karthickk/k8s-manager/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+func newPodsCpCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cp <src> <dest>",
+		Short: "Copy files to and from pods",
+		Long: `Copy files and directories between a pod and the local machine.
+
+One of <src> and <dest> must be a pod path written as <pod-name>:<path>, the
+other a local path. Files are streamed through tar, so the container image
+must include tar.
+
+Examples:
+  k8s-manager pods cp web-7d4b9:/var/log/app.log ./app.log
+  k8s-manager pods cp ./config web-7d4b9:/etc/app/config
+  k8s-manager pods cp ./dump.sql db-0:/tmp/ -c postgres`,
+		Args: cobra.ExactArgs(2),
+		RunE: runPodsCp,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace of the pod (overrides config)")
+	cmd.Flags().StringP("container", "c", "", "Container name (if pod has multiple containers)")
+
+	return cmd
+}
+
+func runPodsCp(cmd *cobra.Command, args []string) error {
+	namespace, _ := cmd.Flags().GetString("namespace")
+	container, _ := cmd.Flags().GetString("container")
+
+	srcPod, srcPath := parseCopyPath(args[0])
+	destPod, destPath := parseCopyPath(args[1])
+
+	switch {
+	case srcPod != "" && destPod != "":
+		return fmt.Errorf("copying between pods is not supported; one of <src> and <dest> must be a local path")
+	case srcPod == "" && destPod == "":
+		return fmt.Errorf("one of <src> and <dest> must be a pod path (<pod-name>:<path>)")
+	case srcPath == "" || destPath == "":
+		return fmt.Errorf("source and destination paths cannot be empty")
+	}
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	var files int
+	if srcPod != "" {
+		files, err = client.CopyFromPod(ctx, namespace, srcPod, container, srcPath, destPath)
+	} else {
+		files, err = client.CopyToPod(ctx, namespace, destPod, container, srcPath, destPath)
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ Copied %s to %s (%d %s)\n", args[0], args[1], files, pluralize(files, "file", "files"))
+	return nil
+}
+
+// Helper functions
+
+// parseCopyPath splits a cp argument into a pod name and a path. The part
+// before the first colon is only taken as a pod name if it is a valid one,
+// so local paths such as "./backup:old" are left alone.
+func parseCopyPath(arg string) (pod, path string) {
+	i := strings.Index(arg, ":")
+	if i > 0 && utils.ValidateResourceName(arg[:i]) == nil {
+		return arg[:i], arg[i+1:]
+	}
+	return "", arg
+}
+
+func pluralize(n int, singular, plural string) string {
+	if n == 1 {
+		return singular
+	}
+	return plural
+}
@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// debugStartTimeout bounds how long to wait for the debug image to be
+// pulled and the ephemeral container to start
+const debugStartTimeout = 2 * time.Minute
+
+func newPodsDebugCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "debug <pod-name> [-- <command> [args...]]",
+		Short: "Debug a pod with an ephemeral container",
+		Long: `Add an ephemeral debug container to a running pod and open a shell in it.
+
+The debug container brings its own tools, which makes it possible to inspect
+containers built from distroless or scratch images that have no shell. By
+default it shares the process namespace of the pod's main container, so its
+processes can be listed and their files reached under /proc/<pid>/root.
+
+Ephemeral containers need Kubernetes 1.23 or later and cannot be removed; the
+debug container stays in the pod until the pod is deleted.
+
+Examples:
+  k8s-manager pods debug web-7d4b9
+  k8s-manager pods debug web-7d4b9 --image nicolaka/netshoot --target app
+  k8s-manager pods debug web-7d4b9 -- ps aux`,
+		Args:              cobra.MinimumNArgs(1),
+		RunE:              runPodsDebug,
+		ValidArgsFunction: completePodNames,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace of the pod (overrides config)")
+	cmd.Flags().String("image", "busybox", "Image of the debug container")
+	cmd.Flags().String("target", "", "Container whose processes to share (defaults to the pod's main container)")
+	cmd.Flags().Bool("share-processes", true, "Share the process namespace of the target container")
+
+	return cmd
+}
+
+func runPodsDebug(cmd *cobra.Command, args []string) error {
+	podName := args[0]
+	command := args[1:]
+	if len(command) == 0 {
+		command = []string{"sh"}
+	}
+
+	namespace, _ := cmd.Flags().GetString("namespace")
+	image, _ := cmd.Flags().GetString("image")
+	target, _ := cmd.Flags().GetString("target")
+	shareProcesses, _ := cmd.Flags().GetBool("share-processes")
+
+	if image == "" {
+		return fmt.Errorf("--image cannot be empty")
+	}
+	if target != "" && !shareProcesses {
+		return fmt.Errorf("--target cannot be used with --share-processes=false")
+	}
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	if shareProcesses && target == "" {
+		pod, err := client.Clientset.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get pod %s: %w", podName, err)
+		}
+		target = k8s.DefaultContainer(pod)
+	}
+
+	container, err := client.AddDebugContainer(ctx, namespace, podName, k8s.DebugOptions{
+		Image:  image,
+		Target: target,
+	})
+	if err != nil {
+		return err
+	}
+
+	if target != "" {
+		fmt.Printf("Added debug container %s (%s) to pod %s, sharing processes with container %s\n", container, image, podName, target)
+	} else {
+		fmt.Printf("Added debug container %s (%s) to pod %s\n", container, image, podName)
+	}
+	fmt.Println("Waiting for it to start...")
+
+	waitCtx, cancel := context.WithTimeout(ctx, debugStartTimeout)
+	err = client.WaitForEphemeralContainer(waitCtx, namespace, podName, container)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	err = client.ExecInTerminal(ctx, namespace, podName, container, command)
+	fmt.Printf("\nDebug container %s stays in pod %s until the pod is deleted\n", container, podName)
+	if err != nil {
+		return fmt.Errorf("debug session in pod %s failed: %w", podName, err)
+	}
+	return nil
+}
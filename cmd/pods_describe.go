@@ -0,0 +1,312 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/karthickk/k8s-manager/pkg/utils"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newPodsDescribeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "describe <pod-name>",
+		Short:             "Describe a pod",
+		Long:              `Show a detailed report of a pod including the controllers that manage it, containers, conditions, volumes, tolerations, and recent events.`,
+		Args:              cobra.ExactArgs(1),
+		RunE:              runPodsDescribe,
+		ValidArgsFunction: completePodNames,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace of the pod (overrides config)")
+	cmd.Flags().StringP("output", "o", "", "Output format (json)")
+
+	return cmd
+}
+
+func runPodsDescribe(cmd *cobra.Command, args []string) error {
+	podName := args[0]
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	namespace, _ := cmd.Flags().GetString("namespace")
+	output, _ := cmd.Flags().GetString("output")
+
+	if output != "" && output != "json" {
+		return fmt.Errorf("unsupported output format %q (supported: json)", output)
+	}
+
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	pod, err := client.Clientset.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get pod %s: %w", podName, err)
+	}
+
+	if output == "json" {
+		data, err := json.MarshalIndent(pod, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode pod %s: %w", podName, err)
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), string(data))
+		return nil
+	}
+
+	events, err := client.GetEventsForObject(ctx, namespace, "Pod", pod.Name)
+	if err != nil {
+		pterm.Warning.Printf("Could not load events: %v\n", err)
+	}
+
+	owners, err := k8s.OwnerChain(ctx, client.Clientset, namespace, pod.OwnerReferences)
+	if err != nil {
+		pterm.Warning.Printf("Could not resolve owners: %v\n", err)
+	}
+
+	describePod(pod, owners, events)
+	return nil
+}
+
+// describePod prints a kubectl-describe-like report of a pod, with the chain
+// of controllers that manage it
+func describePod(pod *corev1.Pod, owners []k8s.Owner, events []corev1.Event) {
+	pterm.DefaultSection.Println("Pod")
+	overview := [][]string{
+		{"Name", pod.Name},
+		{"Namespace", pod.Namespace},
+		{"Node", valueOrNone(pod.Spec.NodeName)},
+		{"Status", k8s.PodStatus(pod)},
+		{"IP", valueOrNone(pod.Status.PodIP)},
+		{"QoS Class", string(pod.Status.QOSClass)},
+		{"Service Account", pod.Spec.ServiceAccountName},
+		{"Created", pod.CreationTimestamp.Format(time.RFC3339)},
+		{"Labels", formatKeyValues(pod.Labels)},
+		{"Annotations", formatKeyValues(pod.Annotations)},
+		{"Controlled By", k8s.FormatOwnerChain(owners)},
+	}
+	if pod.Status.Reason != "" {
+		overview = append(overview, []string{"Reason", pod.Status.Reason})
+	}
+	if pod.Status.Message != "" {
+		overview = append(overview, []string{"Message", pod.Status.Message})
+	}
+	pterm.DefaultTable.WithData(overview).Render()
+
+	statuses := map[string]corev1.ContainerStatus{}
+	for _, status := range pod.Status.InitContainerStatuses {
+		statuses[status.Name] = status
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		statuses[status.Name] = status
+	}
+
+	if len(pod.Spec.InitContainers) > 0 {
+		pterm.DefaultSection.Println("Init Containers")
+		for _, container := range pod.Spec.InitContainers {
+			renderContainer(container, statuses)
+		}
+	}
+
+	pterm.DefaultSection.Println("Containers")
+	for _, container := range pod.Spec.Containers {
+		renderContainer(container, statuses)
+	}
+
+	if len(pod.Status.Conditions) > 0 {
+		pterm.DefaultSection.Println("Conditions")
+		conditions := [][]string{{"Type", "Status", "Reason"}}
+		for _, condition := range pod.Status.Conditions {
+			conditions = append(conditions, []string{
+				string(condition.Type), string(condition.Status), condition.Reason,
+			})
+		}
+		pterm.DefaultTable.WithHasHeader().WithData(conditions).Render()
+	}
+
+	if len(pod.Spec.Volumes) > 0 {
+		pterm.DefaultSection.Println("Volumes")
+		volumes := [][]string{{"Name", "Type", "Source"}}
+		for _, volume := range pod.Spec.Volumes {
+			volumeType, source := describeVolumeSource(volume)
+			volumes = append(volumes, []string{volume.Name, volumeType, source})
+		}
+		pterm.DefaultTable.WithHasHeader().WithData(volumes).Render()
+	}
+
+	if len(pod.Spec.Tolerations) > 0 {
+		pterm.DefaultSection.Println("Tolerations")
+		for _, toleration := range pod.Spec.Tolerations {
+			fmt.Printf("  %s\n", formatToleration(toleration))
+		}
+	}
+
+	pterm.DefaultSection.Println("Events")
+	if len(events) == 0 {
+		fmt.Println("  <none>")
+		return
+	}
+	rows := [][]string{{"Type", "Reason", "Age", "From", "Message"}}
+	for _, event := range events {
+		rows = append(rows, []string{
+			event.Type,
+			event.Reason,
+			utils.FormatAge(k8s.EventTime(event)),
+			event.Source.Component,
+			strings.TrimSpace(event.Message),
+		})
+	}
+	pterm.DefaultTable.WithHasHeader().WithData(rows).Render()
+}
+
+func renderContainer(container corev1.Container, statuses map[string]corev1.ContainerStatus) {
+	data := [][]string{
+		{"Name", container.Name},
+		{"Image", container.Image},
+		{"Ports", formatContainerPorts(container.Ports)},
+	}
+
+	if status, ok := statuses[container.Name]; ok {
+		data = append(data,
+			[]string{"State", formatContainerState(status.State)},
+			[]string{"Ready", fmt.Sprintf("%t", status.Ready)},
+			[]string{"Restart Count", fmt.Sprintf("%d", status.RestartCount)},
+		)
+		if status.LastTerminationState.Terminated != nil {
+			data = append(data, []string{"Last State", formatContainerState(status.LastTerminationState)})
+		}
+		if digest := k8s.ImageDigest(status.ImageID); digest != "" {
+			data = append(data, []string{"Image Digest", digest})
+		}
+	}
+
+	data = append(data, []string{"Pull Policy", valueOrNone(string(container.ImagePullPolicy))})
+	for _, warning := range k8s.ImageWarnings(container) {
+		data = append(data, []string{"Warning", "⚠️  " + warning})
+	}
+
+	if len(container.Resources.Requests) > 0 {
+		data = append(data, []string{"Requests", formatResourceList(container.Resources.Requests)})
+	}
+	if len(container.Resources.Limits) > 0 {
+		data = append(data, []string{"Limits", formatResourceList(container.Resources.Limits)})
+	}
+
+	pterm.DefaultTable.WithData(data).Render()
+	fmt.Println()
+}
+
+// Helper functions
+
+func formatContainerState(state corev1.ContainerState) string {
+	switch {
+	case state.Running != nil:
+		return fmt.Sprintf("Running (since %s)", state.Running.StartedAt.Format(time.RFC3339))
+	case state.Waiting != nil:
+		if state.Waiting.Message != "" {
+			return fmt.Sprintf("Waiting: %s (%s)", state.Waiting.Reason, state.Waiting.Message)
+		}
+		return fmt.Sprintf("Waiting: %s", state.Waiting.Reason)
+	case state.Terminated != nil:
+		return fmt.Sprintf("Terminated: %s (exit code %d)", state.Terminated.Reason, state.Terminated.ExitCode)
+	default:
+		return "<unknown>"
+	}
+}
+
+func formatContainerPorts(ports []corev1.ContainerPort) string {
+	if len(ports) == 0 {
+		return "<none>"
+	}
+	parts := make([]string, 0, len(ports))
+	for _, port := range ports {
+		parts = append(parts, fmt.Sprintf("%d/%s", port.ContainerPort, port.Protocol))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func formatResourceList(resources corev1.ResourceList) string {
+	names := make([]string, 0, len(resources))
+	for name := range resources {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		quantity := resources[corev1.ResourceName(name)]
+		parts = append(parts, fmt.Sprintf("%s=%s", name, quantity.String()))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func formatKeyValues(values map[string]string) string {
+	if len(values) == 0 {
+		return "<none>"
+	}
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", k, values[k]))
+	}
+	return strings.Join(pairs, "\n")
+}
+
+func formatToleration(toleration corev1.Toleration) string {
+	s := toleration.Key
+	if toleration.Operator == corev1.TolerationOpEqual || toleration.Value != "" {
+		s += "=" + toleration.Value
+	}
+	if toleration.Effect != "" {
+		s += ":" + string(toleration.Effect)
+	}
+	if toleration.TolerationSeconds != nil {
+		s += fmt.Sprintf(" for %ds", *toleration.TolerationSeconds)
+	}
+	if s == "" {
+		return "<all taints>"
+	}
+	return s
+}
+
+func describeVolumeSource(volume corev1.Volume) (string, string) {
+	switch {
+	case volume.ConfigMap != nil:
+		return "ConfigMap", volume.ConfigMap.Name
+	case volume.Secret != nil:
+		return "Secret", volume.Secret.SecretName
+	case volume.PersistentVolumeClaim != nil:
+		return "PersistentVolumeClaim", volume.PersistentVolumeClaim.ClaimName
+	case volume.EmptyDir != nil:
+		return "EmptyDir", string(volume.EmptyDir.Medium)
+	case volume.HostPath != nil:
+		return "HostPath", volume.HostPath.Path
+	case volume.Projected != nil:
+		return "Projected", fmt.Sprintf("%d sources", len(volume.Projected.Sources))
+	case volume.DownwardAPI != nil:
+		return "DownwardAPI", ""
+	case volume.CSI != nil:
+		return "CSI", volume.CSI.Driver
+	default:
+		return "Other", ""
+	}
+}
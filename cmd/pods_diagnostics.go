@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func newPodsDiagnosticsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diagnostics <pod-name>",
+		Short: "Export a diagnostics bundle for a pod",
+		Long: `Collect everything about a pod into one bundle for support tickets: the pod
+manifest, events, current and previous logs of every container, and metrics
+when metrics-server is installed.
+
+The bundle is written to a directory, or to a gzipped tarball when --out ends
+in .tar.gz or .tgz. Env values that look like credentials are redacted unless
+--include-secrets is given.
+
+Examples:
+  k8s-manager pods diagnostics web-7d4b9
+  k8s-manager pods diagnostics web-7d4b9 --out ./web-diagnostics/
+  k8s-manager pods diagnostics web-7d4b9 --out web.tar.gz -n production`,
+		Args:              cobra.ExactArgs(1),
+		RunE:              runPodsDiagnostics,
+		ValidArgsFunction: completePodNames,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace of the pod (overrides config)")
+	cmd.Flags().String("out", "", "Directory or .tar.gz file to write the bundle to (default <pod-name>-diagnostics-<time>)")
+	cmd.Flags().Bool("include-secrets", false, "Keep env values that look like credentials instead of redacting them")
+
+	return cmd
+}
+
+func runPodsDiagnostics(cmd *cobra.Command, args []string) error {
+	podName := args[0]
+	namespace, _ := cmd.Flags().GetString("namespace")
+	out, _ := cmd.Flags().GetString("out")
+	includeSecrets, _ := cmd.Flags().GetBool("include-secrets")
+
+	if out == "" {
+		out = fmt.Sprintf("%s-diagnostics-%s", podName, time.Now().Format("20060102-150405"))
+	}
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	var bundle k8s.BundleWriter
+	if root, ok := tarballRoot(out); ok {
+		tarball, err := k8s.NewTarBundle(out, root)
+		if err != nil {
+			return err
+		}
+		bundle = tarball
+	} else {
+		if bundle, err = k8s.NewDirBundle(out); err != nil {
+			return err
+		}
+	}
+
+	warnings, err := client.CollectPodDiagnostics(cmd.Context(), namespace, podName, k8s.DiagnosticsOptions{
+		IncludeSecrets: includeSecrets,
+	}, bundle)
+	if tarball, ok := bundle.(*k8s.TarBundle); ok {
+		if closeErr := tarball.Close(); err == nil && closeErr != nil {
+			err = fmt.Errorf("failed to write %s: %w", out, closeErr)
+		}
+		// A partial tarball would make the next run fail as it exists
+		if err != nil {
+			os.Remove(out)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, warning := range warnings {
+		pterm.Warning.Println(warning)
+	}
+	fmt.Printf("✅ Diagnostics for pod %s written to %s\n", podName, out)
+	if !includeSecrets {
+		fmt.Println("Env values that look like credentials were redacted (use --include-secrets to keep them)")
+	}
+	return nil
+}
+
+// Helper functions
+
+// tarballRoot reports whether out names a gzipped tarball and returns the
+// top-level directory for its files
+func tarballRoot(out string) (string, bool) {
+	base := filepath.Base(out)
+	for _, ext := range []string{".tar.gz", ".tgz"} {
+		if strings.HasSuffix(base, ext) {
+			return strings.TrimSuffix(base, ext), true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/karthickk/k8s-manager/pkg/utils"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newPodsImagesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "images",
+		Short: "List the container images in use",
+		Long: `List every distinct container image used by the pods of a namespace, with
+the number of pods running it and the digests it resolved to. Images on the
+latest tag are marked, which makes the list a starting point for
+vulnerability and hygiene audits.
+
+Examples:
+  k8s-manager pods images
+  k8s-manager pods images -A
+  k8s-manager pods images -l app=web`,
+		Args: cobra.NoArgs,
+		RunE: runPodsImages,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace to list images from (overrides config)")
+	cmd.Flags().BoolP("all-namespaces", "A", false, "List images from all namespaces")
+	cmd.Flags().StringP("selector", "l", "", "Selector (label query) to filter pods on")
+	cmd.Flags().Bool("no-headers", false, "Don't print the header row of the table")
+
+	return cmd
+}
+
+func runPodsImages(cmd *cobra.Command, args []string) error {
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	namespace, _ := cmd.Flags().GetString("namespace")
+	allNamespaces, _ := cmd.Flags().GetBool("all-namespaces")
+	selector, _ := cmd.Flags().GetString("selector")
+
+	if allNamespaces {
+		namespace = ""
+	} else {
+		if namespace == "" {
+			namespace = client.GetNamespace()
+		}
+		if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+			return err
+		}
+	}
+
+	pods, err := client.Clientset.CoreV1().Pods(namespace).List(cmd.Context(), metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	usage := k8s.SummarizeImages(pods.Items)
+	if len(usage) == 0 {
+		if allNamespaces {
+			fmt.Println("No pods found in any namespace")
+		} else {
+			fmt.Printf("No pods found in namespace '%s'\n", namespace)
+		}
+		return nil
+	}
+
+	noHeaders, _ := cmd.Flags().GetBool("no-headers")
+	printImageUsage(os.Stdout, usage, noHeaders)
+	return nil
+}
+
+// Helper functions
+
+// printImageUsage writes one row per image with its pod count and digests,
+// flagging images that track the latest tag
+func printImageUsage(out io.Writer, usage []k8s.ImageUsage, noHeaders bool) {
+	w := utils.NewTableWriter(out, noHeaders)
+	w.Header("IMAGE", "PODS", "DIGEST", "NOTES")
+	for _, image := range usage {
+		digests := make([]string, 0, len(image.Digests))
+		for _, digest := range image.Digests {
+			digests = append(digests, k8s.ShortDigest(digest))
+		}
+		digest := strings.Join(digests, ",")
+		if digest == "" {
+			digest = "<unknown>"
+		}
+
+		var notes []string
+		if k8s.ImageTag(image.Image) == "latest" {
+			notes = append(notes, "latest tag")
+		}
+		if len(image.Digests) > 1 {
+			notes = append(notes, "several digests")
+		}
+
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\n", image.Image, image.Pods, digest, strings.Join(notes, ", "))
+	}
+	w.Flush()
+}
@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newPodsPortForwardCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "port-forward <pod-name> <[local:]remote>...",
+		Short: "Forward local ports to a pod",
+		Long: `Forward one or more local ports to a pod until interrupted.
+
+When the connection drops, for instance because the pod restarted, the forward
+is re-established. If the pod is gone, as happens during a rollout, the forward
+moves to a running pod of the same controller once one is up. Reconnections are
+bounded; use --no-reconnect to stop at the first disconnect instead.
+
+A local port of 0, or leaving it out as in :80, binds a free local port, which
+is printed once the forward is listening and kept across reconnections.
+
+Examples:
+  k8s-manager pods port-forward web-7d4b9 8080
+  k8s-manager pods port-forward web-7d4b9 8080:80 9090
+  k8s-manager pods port-forward web-7d4b9 :80
+  k8s-manager pods port-forward db-0 5432 --no-reconnect`,
+		Args:              cobra.MinimumNArgs(2),
+		RunE:              runPodsPortForward,
+		ValidArgsFunction: completePodNames,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace of the pod (overrides config)")
+	cmd.Flags().Bool("no-reconnect", false, "Stop when the connection drops instead of reconnecting")
+	cmd.Flags().Duration("replacement-timeout", k8s.DefaultReconnectOptions().ReplacementTimeout, "How long to wait for a replacement pod when the pod is gone (0 to stop)")
+
+	return cmd
+}
+
+func runPodsPortForward(cmd *cobra.Command, args []string) error {
+	podName := args[0]
+	ports := make([]string, 0, len(args)-1)
+	for _, spec := range args[1:] {
+		mapping, err := parsePortMapping(spec)
+		if err != nil {
+			return err
+		}
+		ports = append(ports, mapping)
+	}
+
+	noReconnect, _ := cmd.Flags().GetBool("no-reconnect")
+	replacementTimeout, _ := cmd.Flags().GetDuration("replacement-timeout")
+	if replacementTimeout < 0 {
+		return fmt.Errorf("--replacement-timeout must not be negative")
+	}
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	namespace, _ := cmd.Flags().GetString("namespace")
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if _, err := client.Clientset.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{}); err != nil {
+		return resourceError(ctx, client, "get", "pod", namespace, podName, err)
+	}
+
+	opts := k8s.DefaultReconnectOptions()
+	opts.Disabled = noReconnect
+	opts.ReplacementTimeout = replacementTimeout
+	opts.Notify = func(message string) {
+		fmt.Fprintf(os.Stderr, "🔄 %s\n", message)
+	}
+
+	fmt.Printf("Forwarding %s to pod %s. Press Ctrl+C to stop\n", strings.Join(ports, ", "), podName)
+	return client.ForwardWithReconnect(ctx, namespace, podName, opts, client.Forwarder(namespace, ports, os.Stdout, os.Stderr))
+}
+
+// Helper functions
+
+// parsePortMapping turns "remote", "local:remote" or ":remote" into
+// "local:remote". A local port of 0, or an empty one, picks a free port.
+func parsePortMapping(spec string) (string, error) {
+	local, remote, found := strings.Cut(spec, ":")
+	if !found {
+		remote = local
+	}
+	if found && (local == "" || local == "0") {
+		local = "0"
+	} else if !validPort(local) {
+		return "", fmt.Errorf("invalid port mapping %q (expected [local:]remote with ports between 1 and 65535)", spec)
+	}
+	if !validPort(remote) {
+		return "", fmt.Errorf("invalid port mapping %q (expected [local:]remote with ports between 1 and 65535)", spec)
+	}
+	return local + ":" + remote, nil
+}
+
+// validPort reports whether a string is a port number between 1 and 65535
+func validPort(port string) bool {
+	number, err := strconv.Atoi(port)
+	return err == nil && number >= 1 && number <= 65535
+}
@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/karthickk/k8s-manager/pkg/utils"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newPodsRestartHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restart-history [pod-name]",
+		Short: "Show container restarts and flapping pods",
+		Long: `Show how often each container restarted, when it last restarted and why the
+previous instance stopped (its last termination reason and exit code).
+Containers in CrashLoopBackOff or that restarted within the last hour are
+flagged as flapping, and the most recent restarts are listed first.
+
+Given a pod name, also print a timeline of its container starts and
+terminations together with the related events (BackOff, Killing, Unhealthy).
+
+Examples:
+  k8s-manager pods restart-history
+  k8s-manager pods restart-history -A
+  k8s-manager pods restart-history web-7d4b9`,
+		Args:              cobra.MaximumNArgs(1),
+		RunE:              runPodsRestartHistory,
+		ValidArgsFunction: completePodNames,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace of the pods (overrides config)")
+	cmd.Flags().BoolP("all-namespaces", "A", false, "Show restarts in all namespaces")
+	cmd.Flags().StringP("selector", "l", "", "Selector (label query) to filter pods on")
+	cmd.Flags().Bool("all", false, "Also list containers that never restarted")
+	cmd.Flags().Bool("no-headers", false, "Don't print the header row of the table")
+
+	return cmd
+}
+
+func runPodsRestartHistory(cmd *cobra.Command, args []string) error {
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	namespace, _ := cmd.Flags().GetString("namespace")
+	allNamespaces, _ := cmd.Flags().GetBool("all-namespaces")
+	selector, _ := cmd.Flags().GetString("selector")
+	showAll, _ := cmd.Flags().GetBool("all")
+	noHeaders, _ := cmd.Flags().GetBool("no-headers")
+
+	if len(args) == 1 && allNamespaces {
+		return fmt.Errorf("a pod name cannot be used with --all-namespaces")
+	}
+
+	if allNamespaces {
+		namespace = ""
+	} else {
+		if namespace == "" {
+			namespace = client.GetNamespace()
+		}
+		if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+			return err
+		}
+	}
+
+	ctx := cmd.Context()
+	if len(args) == 1 {
+		pod, err := client.Clientset.CoreV1().Pods(namespace).Get(ctx, args[0], metav1.GetOptions{})
+		if err != nil {
+			return resourceError(ctx, client, "get", "pod", namespace, args[0], err)
+		}
+
+		events, err := client.GetEventsForObject(ctx, namespace, "Pod", pod.Name)
+		if err != nil {
+			pterm.Warning.Printf("Could not load events: %v\n", err)
+		}
+
+		restarts := k8s.PodRestarts(pod)
+		printContainerRestarts(os.Stdout, restarts, false, noHeaders, time.Now())
+		fmt.Println()
+		fmt.Println("Timeline:")
+		printRestartTimeline(os.Stdout, k8s.RestartTimeline(pod, events))
+		return nil
+	}
+
+	pods, err := k8s.ListAll(ctx, metav1.ListOptions{LabelSelector: selector}, 0, client.Clientset.CoreV1().Pods(namespace).List)
+	if err != nil {
+		return fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	var restarts []k8s.ContainerRestarts
+	for i := range pods.Items {
+		for _, r := range k8s.PodRestarts(&pods.Items[i]) {
+			if showAll || r.Restarts > 0 || r.CrashLooping {
+				restarts = append(restarts, r)
+			}
+		}
+	}
+
+	if len(restarts) == 0 {
+		if allNamespaces {
+			fmt.Println("✅ No container restarts in any namespace")
+		} else {
+			fmt.Printf("✅ No container restarts in namespace '%s'\n", namespace)
+		}
+		return nil
+	}
+
+	k8s.SortRestarts(restarts)
+	printContainerRestarts(os.Stdout, restarts, allNamespaces, noHeaders, time.Now())
+	return nil
+}
+
+// Helper functions
+
+// printContainerRestarts writes one row per container with its restart count
+// and last termination, flagging containers that are flapping
+func printContainerRestarts(out io.Writer, restarts []k8s.ContainerRestarts, allNamespaces, noHeaders bool, now time.Time) {
+	w := utils.NewTableWriter(out, noHeaders)
+	headers := []string{"POD", "CONTAINER", "RESTARTS", "LAST RESTART", "LAST REASON", "EXIT CODE", "STATE", "NOTES"}
+	if allNamespaces {
+		headers = append([]string{"NAMESPACE"}, headers...)
+	}
+	w.Header(headers...)
+
+	for _, r := range restarts {
+		container := r.Container
+		if r.Init {
+			container += " (init)"
+		}
+
+		lastRestart, exitCode := "<none>", "<none>"
+		if !r.LastRestart.IsZero() {
+			lastRestart = utils.FormatAge(r.LastRestart) + " ago"
+			exitCode = fmt.Sprintf("%d", r.LastExitCode)
+		}
+
+		notes := ""
+		switch {
+		case r.CrashLooping:
+			notes = "⚠️  crash looping"
+		case r.Flapping(now):
+			notes = "⚠️  flapping"
+		}
+
+		row := fmt.Sprintf("%s\t%s\t%d\t%s\t%s\t%s\t%s\t%s",
+			r.Pod, container, r.Restarts, lastRestart, valueOrNone(r.LastReason), exitCode, r.State, notes)
+		if allNamespaces {
+			fmt.Fprintf(w, "%s\t%s\n", r.Namespace, row)
+		} else {
+			fmt.Fprintln(w, row)
+		}
+	}
+	w.Flush()
+}
+
+// printRestartTimeline writes the timeline of a pod oldest first, marking
+// warnings
+func printRestartTimeline(out io.Writer, timeline []k8s.RestartEvent) {
+	if len(timeline) == 0 {
+		fmt.Fprintln(out, "  <none>")
+		return
+	}
+
+	w := utils.NewTableWriter(out, true)
+	for _, entry := range timeline {
+		marker := " "
+		if entry.Warning {
+			marker = "⚠️"
+		}
+		container := entry.Container
+		if container == "" {
+			container = "pod"
+		}
+		when := "<unknown>"
+		if !entry.Time.IsZero() {
+			when = utils.FormatAge(entry.Time) + " ago"
+		}
+		fmt.Fprintf(w, "  %s %s\t%s\t%s\n", marker, when, container, entry.What)
+	}
+	w.Flush()
+}
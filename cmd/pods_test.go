@@ -2,10 +2,21 @@ package cmd
 
 import (
 	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/karthickk/k8s-manager/pkg/k8s"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/yaml"
 )
 
 func TestPodsCommand(t *testing.T) {
@@ -38,8 +49,39 @@ func TestPodsCommand(t *testing.T) {
 				"--all-namespaces",
 				"--selector",
 				"--show-labels",
+				"--wide",
+				"--watch",
+				"--refresh-interval",
+				"--output",
+				"--no-headers",
 			},
 		},
+		{
+			name:    "pods images help",
+			args:    []string{"pods", "images", "--help"},
+			wantErr: false,
+			contains: []string{
+				"distinct container image",
+				"--all-namespaces",
+				"--selector",
+			},
+		},
+		{
+			name:    "pods restart-history help",
+			args:    []string{"pods", "restart-history", "--help"},
+			wantErr: false,
+			contains: []string{
+				"last termination reason and exit code",
+				"timeline",
+				"--all-namespaces",
+				"--all ",
+			},
+		},
+		{
+			name:    "pods restart-history too many args",
+			args:    []string{"pods", "restart-history", "a", "b"},
+			wantErr: true,
+		},
 		{
 			name:    "pods get help",
 			args:    []string{"pods", "get", "--help"},
@@ -48,6 +90,31 @@ func TestPodsCommand(t *testing.T) {
 				"Get details of a specific pod",
 				"--namespace",
 				"--yaml",
+				"--output",
+				"--watch",
+			},
+		},
+		{
+			name:    "pods logs help",
+			args:    []string{"pods", "logs", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Print the logs of a container of a pod",
+				"--container",
+				"--follow",
+				"--tail",
+				"--previous",
+				"--since",
+			},
+		},
+		{
+			name:    "pods describe help",
+			args:    []string{"pods", "describe", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Describe a pod",
+				"--namespace",
+				"--output",
 			},
 		},
 		{
@@ -57,6 +124,8 @@ func TestPodsCommand(t *testing.T) {
 			contains: []string{
 				"Restart pods",
 				"--deployment",
+				"--statefulset",
+				"--daemonset",
 				"--force",
 			},
 		},
@@ -68,6 +137,8 @@ func TestPodsCommand(t *testing.T) {
 				"Delete a pod",
 				"--force",
 				"--grace-period",
+				"--selector",
+				"--field-selector",
 			},
 		},
 		{
@@ -80,6 +151,58 @@ func TestPodsCommand(t *testing.T) {
 				"--shell",
 			},
 		},
+		{
+			name:    "pods cp help",
+			args:    []string{"pods", "cp", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Copy files to and from pods",
+				"<pod-name>:<path>",
+				"--namespace",
+				"--container",
+			},
+		},
+		{
+			name:    "pods diagnostics help",
+			args:    []string{"pods", "diagnostics", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Export a diagnostics bundle for a pod",
+				"--out",
+				"--include-secrets",
+				"--namespace",
+			},
+		},
+		{
+			name:    "pods debug help",
+			args:    []string{"pods", "debug", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Debug a pod with an ephemeral container",
+				"--image",
+				"--target",
+				"--share-processes",
+			},
+		},
+		{
+			name:    "pods analyze help",
+			args:    []string{"pods", "analyze", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Explain why a pod is pending",
+				"--namespace",
+			},
+		},
+		{
+			name:    "pods port-forward help",
+			args:    []string{"pods", "port-forward", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Forward local ports to a pod",
+				"--no-reconnect",
+				"--replacement-timeout",
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -117,16 +240,136 @@ func TestPodsCommandArguments(t *testing.T) {
 			args:    []string{"pods", "get"},
 			wantErr: true,
 		},
+		{
+			name:    "pods get unsupported output",
+			args:    []string{"pods", "get", "web-0", "-o", "xml"},
+			wantErr: true,
+		},
+		{
+			name:    "pods get yaml with json output",
+			args:    []string{"pods", "get", "web-0", "--yaml", "-o", "json"},
+			wantErr: true,
+		},
+		{
+			name:    "pods get output with watch",
+			args:    []string{"pods", "get", "web-0", "-o", "yaml", "--watch"},
+			wantErr: true,
+		},
+		{
+			name:    "pods logs missing argument",
+			args:    []string{"pods", "logs"},
+			wantErr: true,
+		},
+		{
+			name:    "pods logs invalid since",
+			args:    []string{"pods", "logs", "web-0", "--since", "yesterday"},
+			wantErr: true,
+		},
+		{
+			name:    "pods logs invalid tail",
+			args:    []string{"pods", "logs", "web-0", "--tail", "-5"},
+			wantErr: true,
+		},
+		{
+			name:    "pods list pod name without interactive",
+			args:    []string{"pods", "list", "web-0"},
+			wantErr: true,
+		},
+		{
+			name:    "pods list too many arguments",
+			args:    []string{"pods", "list", "web-0", "web-1"},
+			wantErr: true,
+		},
+		{
+			name:    "pods list watch with json output",
+			args:    []string{"pods", "list", "-w", "-o", "json"},
+			wantErr: true,
+		},
+		{
+			name:    "pods list watch with limit",
+			args:    []string{"pods", "list", "--watch", "--limit", "5"},
+			wantErr: true,
+		},
+		{
+			name:    "pods list zero chunk size",
+			args:    []string{"pods", "list", "--chunk-size", "0"},
+			wantErr: true,
+		},
+		{
+			name:    "pods describe missing argument",
+			args:    []string{"pods", "describe"},
+			wantErr: true,
+		},
 		{
 			name:    "pods restart missing argument",
 			args:    []string{"pods", "restart"},
 			wantErr: true,
 		},
+		{
+			name:    "pods restart with two workload kinds",
+			args:    []string{"pods", "restart", "web", "--deployment", "--statefulset"},
+			wantErr: true,
+		},
 		{
 			name:    "pods delete missing argument",
 			args:    []string{"pods", "delete"},
 			wantErr: true,
 		},
+		{
+			name:    "pods delete name with selector",
+			args:    []string{"pods", "delete", "web-0", "--selector", "app=web"},
+			wantErr: true,
+		},
+		{
+			name:    "pods delete too many arguments",
+			args:    []string{"pods", "delete", "web-0", "web-1"},
+			wantErr: true,
+		},
+		{
+			name:    "pods cp missing destination",
+			args:    []string{"pods", "cp", "web:/tmp/app.log"},
+			wantErr: true,
+		},
+		{
+			name:    "pods cp between local paths",
+			args:    []string{"pods", "cp", "./a.log", "./b.log"},
+			wantErr: true,
+		},
+		{
+			name:    "pods cp between pods",
+			args:    []string{"pods", "cp", "web:/tmp/a.log", "api:/tmp/a.log"},
+			wantErr: true,
+		},
+		{
+			name:    "pods diagnostics missing argument",
+			args:    []string{"pods", "diagnostics"},
+			wantErr: true,
+		},
+		{
+			name:    "pods port-forward missing ports",
+			args:    []string{"pods", "port-forward", "web-0"},
+			wantErr: true,
+		},
+		{
+			name:    "pods port-forward invalid port",
+			args:    []string{"pods", "port-forward", "web-0", "8080:http"},
+			wantErr: true,
+		},
+		{
+			name:    "pods analyze missing argument",
+			args:    []string{"pods", "analyze"},
+			wantErr: true,
+		},
+		{
+			name:    "pods debug missing argument",
+			args:    []string{"pods", "debug"},
+			wantErr: true,
+		},
+		{
+			name:    "pods debug target without shared processes",
+			args:    []string{"pods", "debug", "web-0", "--target", "app", "--share-processes=false"},
+			wantErr: true,
+		},
 		{
 			name:    "pods ssh missing argument",
 			args:    []string{"pods", "ssh"},
@@ -221,6 +464,22 @@ func TestGetPodReadyStatus(t *testing.T) {
 	}
 }
 
+func TestPodListRow(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "shop", Labels: map[string]string{"app": "web"}},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "web", Image: "nginx:1.27"}}},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+
+	row := podListRow(pod, true, true, true)
+	assert.Equal(t, "shop", row[0])
+	assert.Equal(t, "web", row[1])
+	assert.Equal(t, "nginx:1.27", row[len(row)-2])
+	assert.Equal(t, "app=web", row[len(row)-1])
+
+	assert.Len(t, podListRow(pod, false, false, false), 5)
+}
+
 func TestGetPodRestartCount(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -281,6 +540,27 @@ func TestGetPodRestartCount(t *testing.T) {
 	}
 }
 
+func TestPrintPodTable(t *testing.T) {
+	pods := map[string]*corev1.Pod{}
+	for _, pod := range []*corev1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "shop"}, Status: corev1.PodStatus{Phase: corev1.PodPending}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "api-0", Namespace: "shop"}, Status: corev1.PodStatus{Phase: corev1.PodRunning}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "db-0", Namespace: "data"}, Status: corev1.PodStatus{Phase: corev1.PodRunning}},
+	} {
+		pods[podKey(pod)] = pod
+	}
+
+	out := new(bytes.Buffer)
+	printPodTable(newPodsListCmd(), out, []string{"NAMESPACE", "NAME", "STATUS"}, pods, func(pod *corev1.Pod) []string {
+		return []string{pod.Namespace, pod.Name, string(pod.Status.Phase)}
+	})
+
+	assert.Equal(t, "NAMESPACE   NAME    STATUS\n"+
+		"data        db-0    Running\n"+
+		"shop        api-0   Running\n"+
+		"shop        web-1   Pending\n", out.String())
+}
+
 func TestPodsCommandStructure(t *testing.T) {
 	cmd := newPodsCmd()
 
@@ -291,7 +571,7 @@ func TestPodsCommandStructure(t *testing.T) {
 
 	// Verify subcommands are registered
 	subcommands := cmd.Commands()
-	expectedCommands := []string{"list", "get", "restart", "delete", "ssh"}
+	expectedCommands := []string{"list", "get", "logs", "describe", "restart", "delete", "ssh", "cp", "diagnostics", "debug", "images"}
 
 	for _, expected := range expectedCommands {
 		found := false
@@ -304,3 +584,432 @@ func TestPodsCommandStructure(t *testing.T) {
 		assert.True(t, found, "Expected subcommand %s not found", expected)
 	}
 }
+
+func TestBuildPodDeleteOptions(t *testing.T) {
+	testCases := []struct {
+		name                string
+		gracePeriod         int64
+		force               bool
+		expectedGrace       int64
+		expectedPropagation bool
+	}{
+		{
+			name:          "default grace period",
+			gracePeriod:   30,
+			force:         false,
+			expectedGrace: 30,
+		},
+		{
+			name:          "force with non-zero grace period",
+			gracePeriod:   10,
+			force:         true,
+			expectedGrace: 10,
+		},
+		{
+			name:          "zero grace period without force",
+			gracePeriod:   0,
+			force:         false,
+			expectedGrace: 1,
+		},
+		{
+			name:                "force with zero grace period",
+			gracePeriod:         0,
+			force:               true,
+			expectedGrace:       0,
+			expectedPropagation: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			opts := buildPodDeleteOptions(tc.gracePeriod, tc.force)
+			if assert.NotNil(t, opts.GracePeriodSeconds) {
+				assert.Equal(t, tc.expectedGrace, *opts.GracePeriodSeconds)
+			}
+			if tc.expectedPropagation {
+				if assert.NotNil(t, opts.PropagationPolicy) {
+					assert.Equal(t, metav1.DeletePropagationBackground, *opts.PropagationPolicy)
+				}
+			} else {
+				assert.Nil(t, opts.PropagationPolicy)
+			}
+		})
+	}
+}
+
+func TestFormatContainerState(t *testing.T) {
+	testCases := []struct {
+		name     string
+		state    corev1.ContainerState
+		expected string
+	}{
+		{
+			name: "waiting with message",
+			state: corev1.ContainerState{
+				Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff", Message: "not found"},
+			},
+			expected: "Waiting: ImagePullBackOff (not found)",
+		},
+		{
+			name: "terminated",
+			state: corev1.ContainerState{
+				Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137},
+			},
+			expected: "Terminated: OOMKilled (exit code 137)",
+		},
+		{
+			name:     "unknown",
+			state:    corev1.ContainerState{},
+			expected: "<unknown>",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, formatContainerState(tc.state))
+		})
+	}
+}
+
+func TestPrintPodDetails(t *testing.T) {
+	started := metav1.NewTime(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC))
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-0", Namespace: "shop"},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{
+			{
+				Name:  "web",
+				Image: "nginx:1.25",
+				Ports: []corev1.ContainerPort{{ContainerPort: 80, Protocol: corev1.ProtocolTCP}},
+				ReadinessProbe: &corev1.Probe{ProbeHandler: corev1.ProbeHandler{
+					HTTPGet: &corev1.HTTPGetAction{Path: "/healthz", Port: intstr.FromInt32(8080)},
+				}},
+				LivenessProbe: &corev1.Probe{ProbeHandler: corev1.ProbeHandler{
+					TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromString("http")},
+				}},
+			},
+			{Name: "worker", Image: "worker:2"},
+			{Name: "metrics", Image: "exporter:1"},
+		}},
+		Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{
+			{
+				Name:                 "web",
+				Ready:                true,
+				RestartCount:         2,
+				State:                corev1.ContainerState{Running: &corev1.ContainerStateRunning{StartedAt: started}},
+				LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137}},
+			},
+			{
+				Name:  "worker",
+				State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+			},
+		}},
+	}
+
+	out := new(bytes.Buffer)
+	printPodDetails(out, pod)
+
+	for _, want := range []string{
+		"  - Name:       web\n    Image:      nginx:1.25\n    Ports:      80/TCP\n",
+		"    State:      Running (since 2024-05-01T10:00:00Z)\n",
+		"    Last State: Terminated: OOMKilled (exit code 137)\n",
+		"    Ready:      true\n    Restarts:   2\n",
+		"    Probes:     readiness http-get :8080/healthz, liveness tcp :http\n",
+		"    State:      Waiting: CrashLoopBackOff\n",
+		"    State:      <no status yet>\n",
+		"    Probes:     <none>\n",
+	} {
+		assert.Contains(t, out.String(), want)
+	}
+}
+
+func TestPodManifest(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web-0",
+			Namespace: "shop",
+			Labels:    map[string]string{"app": "web"},
+			Annotations: map[string]string{
+				k8s.LastAppliedAnnotation: `{"kind":"Pod"}`,
+			},
+			ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationApply}},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name:  "web",
+				Image: "nginx:1.25",
+				Env:   []corev1.EnvVar{{Name: "MODE", Value: "production"}},
+				Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("250m"),
+					corev1.ResourceMemory: resource.MustParse("128Mi"),
+				}},
+				VolumeMounts: []corev1.VolumeMount{{Name: "cache", MountPath: "/cache"}},
+			}},
+			Volumes: []corev1.Volume{{Name: "cache", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}}},
+		},
+	}
+
+	for _, format := range []string{"yaml", "json"} {
+		t.Run(format, func(t *testing.T) {
+			out := new(bytes.Buffer)
+			require.NoError(t, printObject(out, format, podManifest(pod)))
+
+			var decoded corev1.Pod
+			require.NoError(t, yaml.Unmarshal(out.Bytes(), &decoded))
+			assert.Equal(t, "v1", decoded.APIVersion)
+			assert.Equal(t, "Pod", decoded.Kind)
+			assert.Empty(t, decoded.ManagedFields)
+			assert.Empty(t, decoded.Annotations)
+			assert.Equal(t, pod.Labels, decoded.Labels)
+			assert.Equal(t, pod.Spec.Containers[0].Env, decoded.Spec.Containers[0].Env)
+			assert.Equal(t, pod.Spec.Containers[0].VolumeMounts, decoded.Spec.Containers[0].VolumeMounts)
+			assert.Equal(t, pod.Spec.Volumes, decoded.Spec.Volumes)
+			assert.True(t, decoded.Spec.Containers[0].Resources.Requests.Cpu().Equal(resource.MustParse("250m")))
+			assert.True(t, decoded.Spec.Containers[0].Resources.Requests.Memory().Equal(resource.MustParse("128Mi")))
+		})
+	}
+
+	// The pod itself is left untouched
+	assert.Len(t, pod.ManagedFields, 1)
+	assert.Contains(t, pod.Annotations, k8s.LastAppliedAnnotation)
+}
+
+func TestDeletePodsConcurrently(t *testing.T) {
+	names := []string{"web-0", "web-1", "web-2", "web-3", "web-4", "web-5", "web-6"}
+
+	var inFlight, maxInFlight int32
+	results := deletePodsConcurrently(context.Background(), names, 3, func(ctx context.Context, name string) error {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			peak := atomic.LoadInt32(&maxInFlight)
+			if current <= peak || atomic.CompareAndSwapInt32(&maxInFlight, peak, current) {
+				break
+			}
+		}
+
+		if name == "web-3" {
+			return fmt.Errorf("forbidden")
+		}
+		return nil
+	})
+
+	assert.LessOrEqual(t, int(maxInFlight), 3)
+	if assert.Len(t, results, len(names)) {
+		for i, result := range results {
+			assert.Equal(t, names[i], result.Name)
+			if result.Name == "web-3" {
+				assert.EqualError(t, result.Err, "forbidden")
+			} else {
+				assert.NoError(t, result.Err)
+			}
+		}
+	}
+}
+
+func TestContainerTransitions(t *testing.T) {
+	waiting := func(reason string) corev1.ContainerState {
+		return corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: reason}}
+	}
+	running := corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}
+
+	podWith := func(statuses ...corev1.ContainerStatus) *corev1.Pod {
+		return &corev1.Pod{Status: corev1.PodStatus{ContainerStatuses: statuses}}
+	}
+
+	testCases := []struct {
+		name     string
+		old      *corev1.Pod
+		updated  *corev1.Pod
+		expected []string
+	}{
+		{
+			name:     "container started",
+			old:      podWith(corev1.ContainerStatus{Name: "app", State: waiting("ContainerCreating")}),
+			updated:  podWith(corev1.ContainerStatus{Name: "app", State: running}),
+			expected: []string{"app: ContainerCreating → Running"},
+		},
+		{
+			name:     "waiting reason changed",
+			old:      podWith(corev1.ContainerStatus{Name: "app", State: waiting("ErrImagePull")}),
+			updated:  podWith(corev1.ContainerStatus{Name: "app", State: waiting("ImagePullBackOff")}),
+			expected: []string{"app: ErrImagePull → ImagePullBackOff"},
+		},
+		{
+			name:     "container status appeared",
+			old:      podWith(),
+			updated:  podWith(corev1.ContainerStatus{Name: "app", State: waiting("ContainerCreating")}),
+			expected: []string{"app: ContainerCreating"},
+		},
+		{
+			name: "unchanged containers are skipped",
+			old: podWith(
+				corev1.ContainerStatus{Name: "app", State: running},
+				corev1.ContainerStatus{Name: "sidecar", State: waiting("ContainerCreating")},
+			),
+			updated: podWith(
+				corev1.ContainerStatus{Name: "app", State: running},
+				corev1.ContainerStatus{Name: "sidecar", State: running},
+			),
+			expected: []string{"sidecar: ContainerCreating → Running"},
+		},
+		{
+			name:     "no changes",
+			old:      podWith(corev1.ContainerStatus{Name: "app", State: running}),
+			updated:  podWith(corev1.ContainerStatus{Name: "app", State: running}),
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, containerTransitions(tc.old, tc.updated))
+		})
+	}
+}
+
+func TestPodReady(t *testing.T) {
+	ready := &corev1.Pod{Status: corev1.PodStatus{Conditions: []corev1.PodCondition{
+		{Type: corev1.PodScheduled, Status: corev1.ConditionTrue},
+		{Type: corev1.PodReady, Status: corev1.ConditionTrue},
+	}}}
+	notReady := &corev1.Pod{Status: corev1.PodStatus{Conditions: []corev1.PodCondition{
+		{Type: corev1.PodReady, Status: corev1.ConditionFalse},
+	}}}
+
+	assert.True(t, podReady(ready))
+	assert.False(t, podReady(notReady))
+	assert.False(t, podReady(&corev1.Pod{}))
+}
+
+func TestParseCopyPath(t *testing.T) {
+	testCases := []struct {
+		arg  string
+		pod  string
+		path string
+	}{
+		{arg: "web-7d4b9:/var/log/app.log", pod: "web-7d4b9", path: "/var/log/app.log"},
+		{arg: "web.v2:/tmp", pod: "web.v2", path: "/tmp"},
+		{arg: "web:relative/path", pod: "web", path: "relative/path"},
+		{arg: "./app.log", pod: "", path: "./app.log"},
+		{arg: "/tmp/backup:old", pod: "", path: "/tmp/backup:old"},
+		{arg: "./backup:old", pod: "", path: "./backup:old"},
+		{arg: "C:\\logs", pod: "", path: "C:\\logs"},
+		{arg: ":/tmp", pod: "", path: ":/tmp"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.arg, func(t *testing.T) {
+			pod, path := parseCopyPath(tc.arg)
+			assert.Equal(t, tc.pod, pod)
+			assert.Equal(t, tc.path, path)
+		})
+	}
+}
+
+func TestTarballRoot(t *testing.T) {
+	testCases := []struct {
+		out         string
+		wantRoot    string
+		wantTarball bool
+	}{
+		{out: "web.tar.gz", wantRoot: "web", wantTarball: true},
+		{out: "/tmp/bundles/web-0.tgz", wantRoot: "web-0", wantTarball: true},
+		{out: "./web-diagnostics/", wantTarball: false},
+		{out: "web.tar", wantTarball: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.out, func(t *testing.T) {
+			root, ok := tarballRoot(tc.out)
+			assert.Equal(t, tc.wantTarball, ok)
+			assert.Equal(t, tc.wantRoot, root)
+		})
+	}
+}
+
+func TestParsePortMapping(t *testing.T) {
+	testCases := []struct {
+		spec    string
+		want    string
+		wantErr bool
+	}{
+		{spec: "8080", want: "8080:8080"},
+		{spec: "9090:80", want: "9090:80"},
+		{spec: "0", wantErr: true},
+		{spec: "8080:70000", wantErr: true},
+		{spec: ":80", want: "0:80"},
+		{spec: "0:80", want: "0:80"},
+		{spec: "8080:0", wantErr: true},
+		{spec: "http", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		got, err := parsePortMapping(tc.spec)
+		if tc.wantErr {
+			assert.Error(t, err, tc.spec)
+			continue
+		}
+		assert.NoError(t, err, tc.spec)
+		assert.Equal(t, tc.want, got)
+	}
+}
+
+func TestPrintImageUsage(t *testing.T) {
+	buf := new(bytes.Buffer)
+	printImageUsage(buf, []k8s.ImageUsage{
+		{Image: "nginx:1.27", Pods: 3, Digests: []string{"sha256:4c1e997385b8fb4ad4d1d3c7e5af7ff3"}},
+		{Image: "busybox", Pods: 1, Digests: []string{"sha256:aaaaaaaaaaaaaaaa", "sha256:bbbbbbbbbbbbbbbb"}},
+		{Image: "envoy:1.30", Pods: 1},
+	}, false)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Len(t, lines, 4)
+	assert.Equal(t, []string{"IMAGE", "PODS", "DIGEST", "NOTES"}, strings.Fields(lines[0]))
+	assert.Equal(t, []string{"nginx:1.27", "3", "sha256:4c1e997385b8"}, strings.Fields(lines[1]))
+	assert.Contains(t, lines[2], "sha256:aaaaaaaaaaaa,sha256:bbbbbbbbbbbb")
+	assert.Contains(t, lines[2], "latest tag, several digests")
+	assert.Contains(t, lines[3], "<unknown>")
+}
+
+func TestPrintContainerRestarts(t *testing.T) {
+	now := time.Now()
+	buf := new(bytes.Buffer)
+	printContainerRestarts(buf, []k8s.ContainerRestarts{
+		{Namespace: "prod", Pod: "web-1", Container: "app", Restarts: 12, LastRestart: now.Add(-2 * time.Minute), LastReason: "Error", LastExitCode: 1, State: "CrashLoopBackOff", CrashLooping: true},
+		{Namespace: "prod", Pod: "web-2", Container: "app", Restarts: 1, LastRestart: now.Add(-10 * time.Minute), LastReason: "OOMKilled", LastExitCode: 137, State: "Running"},
+		{Namespace: "prod", Pod: "db-0", Container: "migrate", Init: true, Restarts: 2, LastRestart: now.Add(-48 * time.Hour), LastReason: "Completed", State: "Terminated"},
+		{Namespace: "prod", Pod: "api-1", Container: "app", State: "Running"},
+	}, true, false, now)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 5)
+	assert.Equal(t, []string{"NAMESPACE", "POD", "CONTAINER", "RESTARTS", "LAST", "RESTART", "LAST", "REASON", "EXIT", "CODE", "STATE", "NOTES"}, strings.Fields(lines[0]))
+	assert.Equal(t, []string{"prod", "web-1", "app", "12", "2m", "ago", "Error", "1", "CrashLoopBackOff", "⚠️", "crash", "looping"}, strings.Fields(lines[1]))
+	assert.Contains(t, lines[2], "OOMKilled")
+	assert.Contains(t, lines[2], "137")
+	assert.Contains(t, lines[2], "flapping")
+	assert.Contains(t, lines[3], "migrate (init)")
+	assert.NotContains(t, lines[3], "⚠️", "an old restart is not flapping")
+	assert.Equal(t, []string{"prod", "api-1", "app", "0", "<none>", "<none>", "<none>", "Running"}, strings.Fields(lines[4]))
+}
+
+func TestPrintRestartTimeline(t *testing.T) {
+	now := time.Now()
+	buf := new(bytes.Buffer)
+	printRestartTimeline(buf, []k8s.RestartEvent{
+		{Time: now.Add(-5 * time.Minute), Container: "app", What: "Terminated: Error (exit code 1)", Warning: true},
+		{Time: now.Add(-time.Minute), What: "BackOff: Back-off restarting failed container (x4)", Warning: true},
+		{Time: now.Add(-30 * time.Second), Container: "app", What: "Started (restart 5)"},
+	})
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	require.Len(t, lines, 3)
+	assert.Contains(t, lines[0], "⚠️ 5m ago")
+	assert.Contains(t, lines[1], "pod")
+	assert.Contains(t, lines[2], "Started (restart 5)")
+	assert.NotContains(t, lines[2], "⚠️")
+
+	buf.Reset()
+	printRestartTimeline(buf, nil)
+	assert.Equal(t, "  <none>\n", buf.String())
+}
@@ -0,0 +1,282 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/karthickk/k8s-manager/pkg/utils"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newPvcCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pvc",
+		Short: "Manage persistent volume claims",
+		Long:  `List and inspect PersistentVolumeClaims and the volumes bound to them.`,
+	}
+
+	cmd.AddCommand(newPvcListCmd())
+	cmd.AddCommand(newPvcGetCmd())
+
+	return cmd
+}
+
+func newPvcListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List persistent volume claims",
+		Long:  `List PersistentVolumeClaims with their status, capacity, access modes, and storage class.`,
+		RunE:  runPvcList,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace to list claims from (overrides config)")
+	cmd.Flags().BoolP("all-namespaces", "A", false, "List claims from all namespaces")
+	addListOutputFlag(cmd)
+
+	return cmd
+}
+
+func newPvcGetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get <pvc-name>",
+		Short: "Get details of a persistent volume claim",
+		Long:  `Get detailed information about a PersistentVolumeClaim, including the PersistentVolume backing it.`,
+		Args:  cobra.ExactArgs(1),
+		RunE:  runPvcGet,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace of the claim (overrides config)")
+
+	return cmd
+}
+
+func runPvcList(cmd *cobra.Command, args []string) error {
+	output, err := parseListFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	namespace, _ := cmd.Flags().GetString("namespace")
+	allNamespaces, _ := cmd.Flags().GetBool("all-namespaces")
+
+	if allNamespaces {
+		namespace = ""
+	} else if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	ctx := cmd.Context()
+	pvcs, err := k8s.ListAll(ctx, metav1.ListOptions{}, output.limit, client.Clientset.CoreV1().PersistentVolumeClaims(namespace).List)
+	if err != nil {
+		return fmt.Errorf("failed to list persistent volume claims: %w", err)
+	}
+
+	if output.structured() {
+		return output.print(os.Stdout, "persistentvolumeclaims", pvcs)
+	}
+
+	if len(pvcs.Items) == 0 {
+		if allNamespaces {
+			fmt.Println("No persistent volume claims found in any namespace")
+		} else {
+			fmt.Printf("No persistent volume claims found in namespace '%s'\n", namespace)
+		}
+		return nil
+	}
+
+	w := newListTableWriter(cmd)
+	if allNamespaces {
+		w.Header("NAMESPACE", "NAME", "STATUS", "VOLUME", "CAPACITY", "ACCESS MODES", "STORAGECLASS", "AGE")
+	} else {
+		w.Header("NAME", "STATUS", "VOLUME", "CAPACITY", "ACCESS MODES", "STORAGECLASS", "AGE")
+	}
+
+	for _, pvc := range pvcs.Items {
+		row := fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%s",
+			pvc.Name,
+			pvc.Status.Phase,
+			valueOrNone(pvc.Spec.VolumeName),
+			getPvcCapacity(&pvc),
+			formatAccessModes(pvc.Status.AccessModes),
+			valueOrNone(getPvcStorageClass(&pvc)),
+		)
+		age := utils.FormatAge(pvc.CreationTimestamp.Time)
+
+		if allNamespaces {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", pvc.Namespace, row, age)
+		} else {
+			fmt.Fprintf(w, "%s\t%s\n", row, age)
+		}
+	}
+	w.Flush()
+	output.warnTruncated(pvcs)
+
+	return nil
+}
+
+func runPvcGet(cmd *cobra.Command, args []string) error {
+	pvcName := args[0]
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	namespace, _ := cmd.Flags().GetString("namespace")
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	ctx := cmd.Context()
+	pvc, err := client.Clientset.CoreV1().PersistentVolumeClaims(namespace).Get(ctx, pvcName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get persistent volume claim %s: %w", pvcName, err)
+	}
+
+	fmt.Printf("Name:          %s\n", pvc.Name)
+	fmt.Printf("Namespace:     %s\n", pvc.Namespace)
+	fmt.Printf("Status:        %s\n", pvc.Status.Phase)
+	fmt.Printf("Volume:        %s\n", valueOrNone(pvc.Spec.VolumeName))
+	fmt.Printf("Capacity:      %s\n", getPvcCapacity(pvc))
+	fmt.Printf("Requested:     %s\n", getPvcRequest(pvc))
+	fmt.Printf("Access Modes:  %s\n", formatAccessModes(pvc.Spec.AccessModes))
+	fmt.Printf("StorageClass:  %s\n", valueOrNone(getPvcStorageClass(pvc)))
+	if pvc.Spec.VolumeMode != nil {
+		fmt.Printf("Volume Mode:   %s\n", *pvc.Spec.VolumeMode)
+	}
+	fmt.Printf("Created:       %s\n", pvc.CreationTimestamp.Format("2006-01-02 15:04:05"))
+	fmt.Println()
+
+	if pvc.Spec.VolumeName != "" {
+		pv, err := client.Clientset.CoreV1().PersistentVolumes().Get(ctx, pvc.Spec.VolumeName, metav1.GetOptions{})
+		if err != nil {
+			fmt.Printf("⚠️  Could not fetch persistent volume %s: %v\n", pvc.Spec.VolumeName, err)
+		} else {
+			printPersistentVolumeSummary(pv)
+		}
+	}
+
+	if pvc.Status.Phase == corev1.ClaimPending {
+		events, err := client.GetEventsForObject(ctx, namespace, "PersistentVolumeClaim", pvc.Name)
+		if err != nil {
+			return err
+		}
+
+		warnings := []corev1.Event{}
+		for _, event := range events {
+			if event.Type == corev1.EventTypeWarning {
+				warnings = append(warnings, event)
+			}
+		}
+
+		if len(warnings) == 0 {
+			fmt.Println("⏳ Claim is pending and no warning events have been recorded yet")
+			return nil
+		}
+
+		fmt.Println("⚠️  Claim is pending. Recent warning events:")
+		w := utils.NewTableWriter(os.Stdout, false)
+		w.Header("  AGE", "REASON", "MESSAGE")
+		for _, event := range warnings {
+			fmt.Fprintf(w, "  %s\t%s\t%s\n",
+				utils.FormatAge(k8s.EventTime(event)), event.Reason, strings.TrimSpace(event.Message))
+		}
+		w.Flush()
+	}
+
+	return nil
+}
+
+func printPersistentVolumeSummary(pv *corev1.PersistentVolume) {
+	capacity := "<none>"
+	if storage, ok := pv.Spec.Capacity[corev1.ResourceStorage]; ok {
+		capacity = storage.String()
+	}
+
+	fmt.Println("Persistent Volume:")
+	fmt.Printf("  Name:            %s\n", pv.Name)
+	fmt.Printf("  Status:          %s\n", pv.Status.Phase)
+	fmt.Printf("  Capacity:        %s\n", capacity)
+	fmt.Printf("  Reclaim Policy:  %s\n", pv.Spec.PersistentVolumeReclaimPolicy)
+	fmt.Printf("  StorageClass:    %s\n", valueOrNone(pv.Spec.StorageClassName))
+	fmt.Printf("  Source:          %s\n", getPersistentVolumeSource(pv))
+	fmt.Println()
+}
+
+// Helper functions
+
+func getPvcCapacity(pvc *corev1.PersistentVolumeClaim) string {
+	if storage, ok := pvc.Status.Capacity[corev1.ResourceStorage]; ok {
+		return storage.String()
+	}
+	return "<none>"
+}
+
+func getPvcRequest(pvc *corev1.PersistentVolumeClaim) string {
+	if storage, ok := pvc.Spec.Resources.Requests[corev1.ResourceStorage]; ok {
+		return storage.String()
+	}
+	return "<none>"
+}
+
+func getPvcStorageClass(pvc *corev1.PersistentVolumeClaim) string {
+	if pvc.Spec.StorageClassName != nil {
+		return *pvc.Spec.StorageClassName
+	}
+	return pvc.Annotations["volume.beta.kubernetes.io/storage-class"]
+}
+
+func getPersistentVolumeSource(pv *corev1.PersistentVolume) string {
+	switch {
+	case pv.Spec.CSI != nil:
+		return fmt.Sprintf("CSI (%s, %s)", pv.Spec.CSI.Driver, pv.Spec.CSI.VolumeHandle)
+	case pv.Spec.GCEPersistentDisk != nil:
+		return fmt.Sprintf("GCE Persistent Disk (%s)", pv.Spec.GCEPersistentDisk.PDName)
+	case pv.Spec.NFS != nil:
+		return fmt.Sprintf("NFS (%s:%s)", pv.Spec.NFS.Server, pv.Spec.NFS.Path)
+	case pv.Spec.HostPath != nil:
+		return fmt.Sprintf("HostPath (%s)", pv.Spec.HostPath.Path)
+	case pv.Spec.Local != nil:
+		return fmt.Sprintf("Local (%s)", pv.Spec.Local.Path)
+	default:
+		return "<unknown>"
+	}
+}
+
+func formatAccessModes(modes []corev1.PersistentVolumeAccessMode) string {
+	if len(modes) == 0 {
+		return "<none>"
+	}
+
+	short := make([]string, 0, len(modes))
+	for _, mode := range modes {
+		switch mode {
+		case corev1.ReadWriteOnce:
+			short = append(short, "RWO")
+		case corev1.ReadOnlyMany:
+			short = append(short, "ROX")
+		case corev1.ReadWriteMany:
+			short = append(short, "RWX")
+		case corev1.ReadWriteOncePod:
+			short = append(short, "RWOP")
+		default:
+			short = append(short, string(mode))
+		}
+	}
+	return strings.Join(short, ",")
+}
+
+func valueOrNone(value string) string {
+	if value == "" {
+		return "<none>"
+	}
+	return value
+}
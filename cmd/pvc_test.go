@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestPvcCommand(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []string
+		wantErr  bool
+		contains []string
+	}{
+		{
+			name:    "pvc help",
+			args:    []string{"pvc", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Manage persistent volume claims",
+				"list",
+				"get",
+			},
+		},
+		{
+			name:    "pvc list help",
+			args:    []string{"pvc", "list", "--help"},
+			wantErr: false,
+			contains: []string{
+				"List persistent volume claims",
+				"--namespace",
+				"--all-namespaces",
+			},
+		},
+		{
+			name:    "pvc get help",
+			args:    []string{"pvc", "get", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Get details of a persistent volume claim",
+				"--namespace",
+			},
+		},
+		{
+			name:    "pvc get missing argument",
+			args:    []string{"pvc", "get"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := newRootCmd("test")
+			buf := new(bytes.Buffer)
+			cmd.SetOut(buf)
+			cmd.SetErr(buf)
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			output := buf.String()
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			for _, expected := range tc.contains {
+				assert.Contains(t, output, expected)
+			}
+		})
+	}
+}
+
+func TestFormatAccessModes(t *testing.T) {
+	testCases := []struct {
+		name     string
+		modes    []corev1.PersistentVolumeAccessMode
+		expected string
+	}{
+		{
+			name:     "no modes",
+			modes:    nil,
+			expected: "<none>",
+		},
+		{
+			name:     "single mode",
+			modes:    []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			expected: "RWO",
+		},
+		{
+			name:     "multiple modes",
+			modes:    []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany, corev1.ReadOnlyMany},
+			expected: "RWX,ROX",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, formatAccessModes(tc.modes))
+		})
+	}
+}
@@ -3,12 +3,37 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"time"
 
+	"github.com/karthickk/k8s-manager/pkg/config"
+	"github.com/karthickk/k8s-manager/pkg/k8s"
 	"github.com/karthickk/k8s-manager/pkg/ui"
+	"github.com/pterm/pterm"
 	"github.com/spf13/cobra"
 )
 
-var interactiveMode bool
+var (
+	interactiveMode bool
+	requestTimeout  time.Duration
+	verbose         bool
+	insecureSkipTLS bool
+	caFile          string
+	proxyURL        string
+	auditLog        string
+	fieldManager    string
+	asUser          string
+	asGroups        []string
+	asUID           string
+	noColor         bool
+)
+
+// helpTemplate shows the one-line summary ahead of the long description so
+// every help page starts with the same text used in command listings.
+const helpTemplate = `{{with .Short}}{{. | trimTrailingWhitespaces}}
+
+{{end}}{{with .Long}}{{. | trimTrailingWhitespaces}}
+
+{{end}}{{if or .Runnable .HasSubCommands}}{{.UsageString}}{{end}}`
 
 func newRootCmd(version string) *cobra.Command {
 	cmd := &cobra.Command{
@@ -16,6 +41,35 @@ func newRootCmd(version string) *cobra.Command {
 		Short: "Kubernetes cluster manager for GCP",
 		Long: `K8s Manager is a comprehensive CLI tool for managing Kubernetes clusters on Google Cloud Platform.
 It provides functionality for configuration management, secrets handling, pod operations, and more.`,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			opts := k8s.ClientOptions{
+				RequestTimeout:        requestTimeout,
+				Verbose:               verbose,
+				InsecureSkipTLSVerify: insecureSkipTLS,
+				CertificateAuthority:  caFile,
+				Proxy:                 proxyURL,
+				AuditLog:              auditLog,
+				FieldManager:          fieldManager,
+				ImpersonateUser:       asUser,
+				ImpersonateGroups:     asGroups,
+				ImpersonateUID:        asUID,
+			}
+			if opts.AuditLog == "" {
+				if cfg := config.Get(); cfg != nil {
+					opts.AuditLog = cfg.AuditLog
+				}
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if opts.InsecureSkipTLSVerify {
+				fmt.Fprintln(os.Stderr, "⚠️  WARNING: TLS certificate verification is disabled. The connection to the cluster is not secure; use this only for dev or lab clusters.")
+			}
+			k8s.SetClientOptions(opts)
+			applyTheme(cmd)
+			checkPodActions(cmd)
+			return nil
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// If no arguments provided, show interactive mode
 			if len(os.Args) == 1 || interactiveMode {
@@ -29,16 +83,80 @@ It provides functionality for configuration management, secrets handling, pod op
 	cmd.AddCommand(newVersionCmd(version))
 	cmd.AddCommand(newConfigCmd())
 	cmd.AddCommand(newSecretsCmd())
+	cmd.AddCommand(newConfigMapsCmd())
 	cmd.AddCommand(newPodsCmd())
 	cmd.AddCommand(newLogsCmd())
 	cmd.AddCommand(newExecCmd())
+	cmd.AddCommand(newPvcCmd())
+	cmd.AddCommand(newJobsCmd())
+	cmd.AddCommand(newCronJobsCmd())
+	cmd.AddCommand(newIngressCmd())
+	cmd.AddCommand(newServicesCmd())
+	cmd.AddCommand(newNamespacesCmd())
+	cmd.AddCommand(newNodesCmd())
+	cmd.AddCommand(newDeploymentsCmd())
+	cmd.AddCommand(newStatefulSetsCmd())
+	cmd.AddCommand(newDaemonSetsCmd())
+	cmd.AddCommand(newApplyCmd())
+	cmd.AddCommand(newWaitCmd())
+	cmd.AddCommand(newEventsCmd())
+	cmd.AddCommand(newFindCmd())
+	cmd.AddCommand(newCompletionCmd())
+
+	cmd.SetHelpTemplate(helpTemplate)
+	registerNamespaceCompletion(cmd)
 
 	// Add flags
 	cmd.PersistentFlags().BoolVarP(&interactiveMode, "interactive", "i", false, "Run in interactive mode")
+	cmd.PersistentFlags().DurationVar(&requestTimeout, "request-timeout", 0, "Timeout for a single API request (e.g. 10s, 1m); 0 means no timeout")
+	cmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Log every Kubernetes API request to stderr")
+	cmd.PersistentFlags().BoolVar(&insecureSkipTLS, "insecure-skip-tls-verify", false, "Do not verify the API server certificate (insecure; for dev and lab clusters only)")
+	cmd.PersistentFlags().StringVar(&caFile, "certificate-authority", "", "Path to a CA certificate file to trust instead of the one in the kubeconfig")
+	cmd.PersistentFlags().StringVar(&proxyURL, "proxy", "", "Proxy URL for API requests (http://, https:// or socks5://); overrides the kubeconfig proxy-url")
+	cmd.PersistentFlags().StringVar(&auditLog, "audit-log", "", "Append a JSON line for every create, update, patch, scale and delete to this file (overrides audit_log in the config)")
+	cmd.PersistentFlags().StringVar(&fieldManager, "field-manager", k8s.DefaultFieldManager, "Name recorded as the owner of the fields this tool writes")
+	cmd.PersistentFlags().StringVar(&asUser, "as", "", "Username or service account (system:serviceaccount:<namespace>:<name>) to impersonate")
+	cmd.PersistentFlags().StringSliceVar(&asGroups, "as-group", nil, "Group to impersonate; repeat for several groups (requires --as)")
+	cmd.PersistentFlags().StringVar(&asUID, "as-uid", "", "UID to impersonate (requires --as)")
+	cmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "Disable colored output (also set by the NO_COLOR environment variable)")
 
 	return cmd
 }
 
+// applyTheme selects the color theme named by ui.theme in the config, or the
+// monochrome theme when --no-color or NO_COLOR asks for plain output. An
+// unknown theme is reported and the default theme is kept.
+func applyTheme(cmd *cobra.Command) {
+	if noColor || ui.NoColorRequested() {
+		pterm.DisableColor()
+		ui.SetTheme(ui.MonochromeTheme)
+		return
+	}
+
+	name := ""
+	if cfg := config.Get(); cfg != nil {
+		name = cfg.UI.Theme
+	}
+	theme, err := ui.ThemeByName(name)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "⚠️  Ignoring ui.theme in the config: %v\n", err)
+		theme = ui.DefaultTheme
+	}
+	ui.SetTheme(theme)
+}
+
+// checkPodActions reports the custom pod actions of the config that are
+// invalid and left out of the pod actions menu
+func checkPodActions(cmd *cobra.Command) {
+	cfg := config.Get()
+	if cfg == nil {
+		return
+	}
+	if _, err := cfg.CustomPodActions(); err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "⚠️  Ignoring invalid pod_actions in the config:\n%v\n", err)
+	}
+}
+
 // Execute invokes the command.
 func Execute(version string) error {
 	// Set up graceful interrupt handling
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"testing"
 
+	"github.com/karthickk/k8s-manager/pkg/ui"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -82,7 +83,7 @@ func TestRootCommandStructure(t *testing.T) {
 
 	// Verify subcommands are registered
 	subcommands := cmd.Commands()
-	expectedCommands := []string{"version", "config", "secrets", "pods", "logs", "exec"}
+	expectedCommands := []string{"version", "config", "secrets", "configmaps", "pods", "logs", "exec", "pvc", "jobs", "cronjobs", "ingress", "services", "namespaces", "deployments", "statefulsets", "daemonsets", "apply", "wait", "completion"}
 
 	for _, expected := range expectedCommands {
 		found := false
@@ -96,6 +97,69 @@ func TestRootCommandStructure(t *testing.T) {
 	}
 }
 
+func TestRootPersistentFlags(t *testing.T) {
+	cmd := newRootCmd("test")
+
+	flag := cmd.PersistentFlags().Lookup("request-timeout")
+	if assert.NotNil(t, flag) {
+		assert.Equal(t, "0s", flag.DefValue)
+	}
+
+	flag = cmd.PersistentFlags().ShorthandLookup("v")
+	if assert.NotNil(t, flag) {
+		assert.Equal(t, "verbose", flag.Name)
+		assert.Equal(t, "false", flag.DefValue)
+	}
+
+	flag = cmd.PersistentFlags().Lookup("insecure-skip-tls-verify")
+	if assert.NotNil(t, flag) {
+		assert.Equal(t, "false", flag.DefValue)
+	}
+
+	assert.NotNil(t, cmd.PersistentFlags().Lookup("certificate-authority"))
+
+	for _, name := range []string{"as", "as-group", "as-uid"} {
+		assert.NotNil(t, cmd.PersistentFlags().Lookup(name), name)
+	}
+
+	assert.NotNil(t, cmd.PersistentFlags().Lookup("no-color"))
+}
+
+func TestRootNoColorSelectsMonochromeTheme(t *testing.T) {
+	defer ui.SetTheme(ui.DefaultTheme)
+
+	cmd := newRootCmd("test")
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetArgs([]string{"version", "--no-color"})
+
+	assert.NoError(t, cmd.Execute())
+	assert.Equal(t, "monochrome", ui.CurrentTheme().Name)
+}
+
+func TestRootRejectsImpersonationWithoutUser(t *testing.T) {
+	cmd := newRootCmd("test")
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetArgs([]string{"version", "--as-group", "system:masters"})
+
+	err := cmd.Execute()
+	assert.ErrorContains(t, err, "require --as")
+}
+
+func TestRootRejectsConflictingTLSFlags(t *testing.T) {
+	cmd := newRootCmd("test")
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetArgs([]string{"version", "--insecure-skip-tls-verify", "--certificate-authority", "ca.crt"})
+
+	err := cmd.Execute()
+	assert.ErrorContains(t, err, "cannot be used with")
+}
+
 func TestExecuteFunction(t *testing.T) {
 	// Test successful execution
 	err := Execute("test-version")
@@ -1,18 +1,22 @@
 package cmd
 
 import (
-	"encoding/base64"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
-	"text/tabwriter"
 
 	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/karthickk/k8s-manager/pkg/ui"
 	"github.com/karthickk/k8s-manager/pkg/utils"
+	"github.com/pterm/pterm"
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
 )
 
 func newSecretsCmd() *cobra.Command {
@@ -25,9 +29,11 @@ func newSecretsCmd() *cobra.Command {
 	cmd.AddCommand(newSecretsListCmd())
 	cmd.AddCommand(newSecretsGetCmd())
 	cmd.AddCommand(newSecretsCreateCmd())
+	cmd.AddCommand(newSecretsApplyCmd())
 	cmd.AddCommand(newSecretsUpdateCmd())
 	cmd.AddCommand(newSecretsDeleteCmd())
 	cmd.AddCommand(newSecretsDecodeCmd())
+	cmd.AddCommand(newSecretsExportAllCmd())
 
 	return cmd
 }
@@ -42,17 +48,19 @@ func newSecretsListCmd() *cobra.Command {
 
 	cmd.Flags().StringP("namespace", "n", "", "Namespace to list secrets from (overrides config)")
 	cmd.Flags().BoolP("all-namespaces", "A", false, "List secrets from all namespaces")
+	addListOutputFlag(cmd)
 
 	return cmd
 }
 
 func newSecretsGetCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "get <secret-name>",
-		Short: "Get details of a specific secret",
-		Long:  `Get detailed information about a specific Kubernetes secret.`,
-		Args:  cobra.ExactArgs(1),
-		RunE:  runSecretsGet,
+		Use:               "get <secret-name>",
+		Short:             "Get details of a specific secret",
+		Long:              `Get detailed information about a specific Kubernetes secret.`,
+		Args:              cobra.ExactArgs(1),
+		RunE:              runSecretsGet,
+		ValidArgsFunction: completeSecretNames,
 	}
 
 	cmd.Flags().StringP("namespace", "n", "", "Namespace of the secret (overrides config)")
@@ -65,15 +73,61 @@ func newSecretsCreateCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create <secret-name>",
 		Short: "Create a new secret",
-		Long:  `Create a new Kubernetes secret with key-value pairs.`,
-		Args:  cobra.ExactArgs(1),
-		RunE:  runSecretsCreate,
+		Long: `Create a new Kubernetes secret with key-value pairs.
+
+Use --generate to fill keys with cryptographically random values of the
+given number of bytes. Generated values are printed once, after the secret
+is created.
+
+Examples:
+  # Create a secret from literal values
+  k8s-manager secrets create db-creds --from-literal username=app
+
+  # Generate a password and an API key
+  k8s-manager secrets create app-keys --generate password=32,apikey=48
+
+  # Generate a hex token
+  k8s-manager secrets create webhook --generate token=20 --charset hex
+
+  # Create a secret from a manifest, or from stdin with '-'
+  k8s-manager secrets create --filename db-creds.yaml
+  sops -d db-creds.enc.yaml | k8s-manager secrets create -f -`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: runSecretsCreate,
 	}
 
 	cmd.Flags().StringP("namespace", "n", "", "Namespace to create the secret in (overrides config)")
 	cmd.Flags().StringSliceP("from-literal", "l", []string{}, "Key-value pairs (key=value)")
-	cmd.Flags().StringSliceP("from-file", "f", []string{}, "Files to include in secret")
+	cmd.Flags().StringSliceP("from-file", "f", []string{}, "Files to include in secret, or '-' to read a Secret manifest from stdin")
 	cmd.Flags().StringP("type", "t", "Opaque", "Secret type")
+	cmd.Flags().StringSlice("generate", []string{}, "Keys to fill with random values (key=bytes)")
+	cmd.Flags().String("charset", utils.CharsetBase64, "Character set for generated values: "+strings.Join(utils.Charsets, ", "))
+	cmd.Flags().String("filename", "", "Secret manifest to create the secret from, or '-' for stdin")
+
+	return cmd
+}
+
+func newSecretsApplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply -f <file|->",
+		Short: "Create or update a secret from a manifest",
+		Long: `Create a secret from a YAML or JSON Secret manifest, or replace the keys of the
+existing secret with the same name. Values may be given base64 encoded under
+data or in plain form under stringData, as written by export-all.
+
+The manifest's namespace is used when it sets one; otherwise the secret is
+created in --namespace or the configured namespace.
+
+Examples:
+  k8s-manager secrets apply -f ./backup/db-creds.yaml
+  sops -d db-creds.enc.yaml | k8s-manager secrets apply -f -`,
+		Args: cobra.NoArgs,
+		RunE: runSecretsApply,
+	}
+
+	cmd.Flags().StringP("filename", "f", "", "Secret manifest, or '-' for stdin (required)")
+	cmd.Flags().StringP("namespace", "n", "", "Namespace for a manifest that does not set one (overrides config)")
+	cmd.MarkFlagRequired("filename")
 
 	return cmd
 }
@@ -97,11 +151,12 @@ func newSecretsUpdateCmd() *cobra.Command {
 
 func newSecretsDeleteCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "delete <secret-name>",
-		Short: "Delete a secret",
-		Long:  `Delete a Kubernetes secret from the cluster.`,
-		Args:  cobra.ExactArgs(1),
-		RunE:  runSecretsDelete,
+		Use:               "delete <secret-name>",
+		Short:             "Delete a secret",
+		Long:              `Delete a Kubernetes secret from the cluster.`,
+		Args:              cobra.ExactArgs(1),
+		RunE:              runSecretsDelete,
+		ValidArgsFunction: completeSecretNames,
 	}
 
 	cmd.Flags().StringP("namespace", "n", "", "Namespace of the secret (overrides config)")
@@ -112,19 +167,65 @@ func newSecretsDeleteCmd() *cobra.Command {
 
 func newSecretsDecodeCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "decode <secret-name> <key>",
+		Use:   "decode <secret-name> [key]",
 		Short: "Decode a specific key from a secret",
-		Long:  `Decode and display the value of a specific key from a Kubernetes secret.`,
-		Args:  cobra.ExactArgs(2),
-		RunE:  runSecretsDecode,
+		Long: `Decode and display the value of a specific key from a Kubernetes secret.
+
+The value of a single key is printed as is, without a trailing newline, so it
+can be piped or captured by scripts. With --all every key is printed, one per
+line, with the values masked unless --show-values is given. Without a key or
+--all, a key is chosen interactively when run in a terminal.
+
+Examples:
+  k8s-manager secrets decode db-credentials password
+  k8s-manager secrets decode db-credentials --all --show-values
+  k8s-manager secrets decode db-credentials`,
+		Args:              cobra.RangeArgs(1, 2),
+		RunE:              runSecretsDecode,
+		ValidArgsFunction: completeSecretNameAndKey,
 	}
 
 	cmd.Flags().StringP("namespace", "n", "", "Namespace of the secret (overrides config)")
+	cmd.Flags().Bool("all", false, "Print every key of the secret")
+	cmd.Flags().Bool("show-values", false, "Show the values printed by --all instead of masking them")
+
+	return cmd
+}
+
+func newSecretsExportAllCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-all",
+		Short: "Export every secret in a namespace as YAML manifests",
+		Long: `Write each secret in a namespace to its own YAML manifest, ready to be applied
+to another cluster. Server-populated metadata is dropped. Service account
+tokens are skipped unless --include-service-account-tokens is given.
+
+The files contain the secret values, so they are created readable by the
+current user only. Existing files are never overwritten.
+
+Examples:
+  k8s-manager secrets export-all --out ./backup
+  k8s-manager secrets export-all -n production -l app=web --out ./web-secrets --decode`,
+		Args: cobra.NoArgs,
+		RunE: runSecretsExportAll,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace to export secrets from (overrides config)")
+	cmd.Flags().String("out", "", "Directory to write the manifests to (required)")
+	cmd.Flags().StringP("selector", "l", "", "Only export secrets matching this label selector")
+	cmd.Flags().Bool("decode", false, "Write text values in plain form under stringData")
+	cmd.Flags().Bool("include-service-account-tokens", false, "Also export service account token secrets")
+	cmd.MarkFlagRequired("out")
 
 	return cmd
 }
 
 func runSecretsList(cmd *cobra.Command, args []string) error {
+	output, err := parseListFlags(cmd)
+	if err != nil {
+		return err
+	}
+
 	client, err := k8s.NewClient()
 	if err != nil {
 		return fmt.Errorf("failed to create Kubernetes client: %w", err)
@@ -137,21 +238,29 @@ func runSecretsList(cmd *cobra.Command, args []string) error {
 		namespace = client.GetNamespace()
 	}
 
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
 	ctx := cmd.Context()
 	var secrets *corev1.SecretList
 
 	if allNamespaces {
-		secrets, err = client.Clientset.CoreV1().Secrets("").List(ctx, metav1.ListOptions{})
+		secrets, err = k8s.ListAll(ctx, metav1.ListOptions{}, output.limit, client.Clientset.CoreV1().Secrets("").List)
 		if err != nil {
 			return fmt.Errorf("failed to list secrets: %w", err)
 		}
 	} else {
-		secrets, err = client.Clientset.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{})
+		secrets, err = k8s.ListAll(ctx, metav1.ListOptions{}, output.limit, client.Clientset.CoreV1().Secrets(namespace).List)
 		if err != nil {
 			return fmt.Errorf("failed to list secrets in namespace %s: %w", namespace, err)
 		}
 	}
 
+	if output.structured() {
+		return output.print(os.Stdout, "secrets", secrets)
+	}
+
 	if len(secrets.Items) == 0 {
 		if allNamespaces {
 			fmt.Println("No secrets found in any namespace")
@@ -162,11 +271,11 @@ func runSecretsList(cmd *cobra.Command, args []string) error {
 	}
 
 	// Display secrets in table format
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	w := newListTableWriter(cmd)
 	if allNamespaces {
-		fmt.Fprintln(w, "NAMESPACE\tNAME\tTYPE\tDATA\tAGE")
+		w.Header("NAMESPACE", "NAME", "TYPE", "DATA", "AGE")
 	} else {
-		fmt.Fprintln(w, "NAME\tTYPE\tDATA\tAGE")
+		w.Header("NAME", "TYPE", "DATA", "AGE")
 	}
 
 	for _, secret := range secrets.Items {
@@ -180,6 +289,7 @@ func runSecretsList(cmd *cobra.Command, args []string) error {
 		}
 	}
 	w.Flush()
+	output.warnTruncated(secrets)
 
 	return nil
 }
@@ -198,10 +308,14 @@ func runSecretsGet(cmd *cobra.Command, args []string) error {
 		namespace = client.GetNamespace()
 	}
 
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
 	ctx := cmd.Context()
 	secret, err := client.Clientset.CoreV1().Secrets(namespace).Get(ctx, secretName, metav1.GetOptions{})
 	if err != nil {
-		return fmt.Errorf("failed to get secret %s: %w", secretName, err)
+		return resourceError(ctx, client, "get", "secret", namespace, secretName, err)
 	}
 
 	fmt.Printf("Name:         %s\n", secret.Name)
@@ -213,14 +327,10 @@ func runSecretsGet(cmd *cobra.Command, args []string) error {
 
 	if len(secret.Data) > 0 {
 		fmt.Println("Data:")
-		for key, value := range secret.Data {
+		for _, key := range secretDataKeys(secret.Data) {
+			value := secret.Data[key]
 			if decode {
-				decoded, err := base64.StdEncoding.DecodeString(string(value))
-				if err != nil {
-					fmt.Printf("  %s: <failed to decode>\n", key)
-				} else {
-					fmt.Printf("  %s: %s\n", key, string(decoded))
-				}
+				fmt.Printf("  %s: %s\n", key, secretValueText(value))
 			} else {
 				fmt.Printf("  %s: <base64 encoded, %d bytes>\n", key, len(value))
 			}
@@ -231,7 +341,25 @@ func runSecretsGet(cmd *cobra.Command, args []string) error {
 }
 
 func runSecretsCreate(cmd *cobra.Command, args []string) error {
+	if filename := secretManifestFilename(cmd); filename != "" {
+		return runSecretsCreateFromManifest(cmd, args, filename)
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("a secret name is required unless --filename is given")
+	}
+
 	secretName := args[0]
+	if err := utils.ValidateResourceName(secretName); err != nil {
+		return err
+	}
+
+	generate, _ := cmd.Flags().GetStringSlice("generate")
+	charset, _ := cmd.Flags().GetString("charset")
+	generated, generatedKeys, err := generateSecretValues(generate, charset)
+	if err != nil {
+		return err
+	}
+
 	client, err := k8s.NewClient()
 	if err != nil {
 		return fmt.Errorf("failed to create Kubernetes client: %w", err)
@@ -246,38 +374,39 @@ func runSecretsCreate(cmd *cobra.Command, args []string) error {
 		namespace = client.GetNamespace()
 	}
 
-	if len(fromLiteral) == 0 && len(fromFile) == 0 {
-		return fmt.Errorf("must specify either --from-literal or --from-file")
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	if len(fromLiteral) == 0 && len(fromFile) == 0 && len(generate) == 0 {
+		return fmt.Errorf("must specify --from-literal, --from-file or --generate")
 	}
 
 	secretData := make(map[string][]byte)
+	for key, value := range generated {
+		secretData[key] = []byte(value)
+	}
 
 	// Process literal values
 	for _, literal := range fromLiteral {
-		parts := strings.SplitN(literal, "=", 2)
-		if len(parts) != 2 {
-			return fmt.Errorf("invalid literal format: %s (expected key=value)", literal)
+		key, value, err := parseLiteral(literal)
+		if err != nil {
+			return err
+		}
+		if _, exists := generated[key]; exists {
+			return fmt.Errorf("key %s is both generated and given a value", key)
 		}
-		secretData[parts[0]] = []byte(parts[1])
+		secretData[key] = value
 	}
 
 	// Process files
-	for _, filePath := range fromFile {
-		parts := strings.SplitN(filePath, "=", 2)
-		var key, path string
-
-		if len(parts) == 2 {
-			key = parts[0]
-			path = parts[1]
-		} else {
-			// Use filename as key
-			path = parts[0]
-			key = filepath.Base(path)
-		}
-
-		data, err := os.ReadFile(path)
+	for _, source := range fromFile {
+		key, data, err := readFileSource(source)
 		if err != nil {
-			return fmt.Errorf("failed to read file %s: %w", path, err)
+			return err
+		}
+		if _, exists := generated[key]; exists {
+			return fmt.Errorf("key %s is both generated and given a value", key)
 		}
 		secretData[key] = data
 	}
@@ -292,12 +421,87 @@ func runSecretsCreate(cmd *cobra.Command, args []string) error {
 	}
 
 	ctx := cmd.Context()
-	_, err = client.Clientset.CoreV1().Secrets(namespace).Create(ctx, secret, metav1.CreateOptions{})
+	_, err = client.Clientset.CoreV1().Secrets(namespace).Create(ctx, secret, metav1.CreateOptions{FieldManager: k8s.FieldManager()})
 	if err != nil {
 		return fmt.Errorf("failed to create secret %s: %w", secretName, err)
 	}
 
 	fmt.Printf("✅ Secret '%s' created successfully in namespace '%s'\n", secretName, namespace)
+	if len(generatedKeys) > 0 {
+		fmt.Println("⚠️  Generated values are shown only once; store them now:")
+		for _, key := range generatedKeys {
+			fmt.Printf("%s=%s\n", key, generated[key])
+		}
+	}
+	return nil
+}
+
+func runSecretsCreateFromManifest(cmd *cobra.Command, args []string, filename string) error {
+	for _, flag := range []string{"from-literal", "from-file", "generate", "type"} {
+		if flag == "from-file" && stdinFileSource(cmd) {
+			continue
+		}
+		if cmd.Flags().Changed(flag) {
+			return fmt.Errorf("--%s cannot be used with --filename", flag)
+		}
+	}
+
+	secret, err := readSecretManifest(cmd, filename)
+	if err != nil {
+		return err
+	}
+	if len(args) == 1 && args[0] != secret.Name {
+		return fmt.Errorf("the manifest is for secret '%s', not '%s'", secret.Name, args[0])
+	}
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	secret.Namespace, err = secretManifestNamespace(cmd, client, secret)
+	if err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	_, err = client.Clientset.CoreV1().Secrets(secret.Namespace).Create(ctx, secret, metav1.CreateOptions{FieldManager: k8s.FieldManager()})
+	if err != nil {
+		return fmt.Errorf("failed to create secret %s: %w", secret.Name, err)
+	}
+
+	fmt.Printf("✅ Secret '%s' created successfully in namespace '%s'\n", secret.Name, secret.Namespace)
+	return nil
+}
+
+func runSecretsApply(cmd *cobra.Command, args []string) error {
+	filename, _ := cmd.Flags().GetString("filename")
+
+	secret, err := readSecretManifest(cmd, filename)
+	if err != nil {
+		return err
+	}
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	secret.Namespace, err = secretManifestNamespace(cmd, client, secret)
+	if err != nil {
+		return err
+	}
+
+	created, err := k8s.ApplySecret(cmd.Context(), client.Clientset, secret)
+	if err != nil {
+		return err
+	}
+
+	if created {
+		fmt.Printf("✅ Secret '%s' created in namespace '%s'\n", secret.Name, secret.Namespace)
+	} else {
+		fmt.Printf("✅ Secret '%s' updated in namespace '%s'\n", secret.Name, secret.Namespace)
+	}
 	return nil
 }
 
@@ -317,49 +521,50 @@ func runSecretsUpdate(cmd *cobra.Command, args []string) error {
 		namespace = client.GetNamespace()
 	}
 
-	ctx := cmd.Context()
-	secret, err := client.Clientset.CoreV1().Secrets(namespace).Get(ctx, secretName, metav1.GetOptions{})
-	if err != nil {
-		return fmt.Errorf("failed to get secret %s: %w", secretName, err)
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
 	}
 
-	// Process literal values
-	for _, literal := range fromLiteral {
-		parts := strings.SplitN(literal, "=", 2)
-		if len(parts) != 2 {
-			return fmt.Errorf("invalid literal format: %s (expected key=value)", literal)
+	// The secret is read again and the changes reapplied if someone else
+	// updated it in the meantime, so their changes are not lost
+	ctx := cmd.Context()
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		secret, err := client.Clientset.CoreV1().Secrets(namespace).Get(ctx, secretName, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get secret %s: %w", secretName, err)
 		}
-		secret.Data[parts[0]] = []byte(parts[1])
-	}
-
-	// Process files
-	for _, filePath := range fromFile {
-		parts := strings.SplitN(filePath, "=", 2)
-		var key, path string
 
-		if len(parts) == 2 {
-			key = parts[0]
-			path = parts[1]
-		} else {
-			path = parts[0]
-			key = filepath.Base(path)
+		// Process literal values
+		for _, literal := range fromLiteral {
+			key, value, err := parseLiteral(literal)
+			if err != nil {
+				return err
+			}
+			secret.Data[key] = value
 		}
 
-		data, err := os.ReadFile(path)
-		if err != nil {
-			return fmt.Errorf("failed to read file %s: %w", path, err)
+		// Process files
+		for _, source := range fromFile {
+			key, data, err := readFileSource(source)
+			if err != nil {
+				return err
+			}
+			secret.Data[key] = data
 		}
-		secret.Data[key] = data
-	}
 
-	// Remove keys
-	for _, key := range removeKeys {
-		delete(secret.Data, key)
-	}
+		// Remove keys
+		for _, key := range removeKeys {
+			delete(secret.Data, key)
+		}
 
-	_, err = client.Clientset.CoreV1().Secrets(namespace).Update(ctx, secret, metav1.UpdateOptions{})
+		_, err = client.Clientset.CoreV1().Secrets(namespace).Update(ctx, secret, metav1.UpdateOptions{FieldManager: k8s.FieldManager()})
+		if err != nil {
+			return fmt.Errorf("failed to update secret %s: %w", secretName, err)
+		}
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("failed to update secret %s: %w", secretName, err)
+		return err
 	}
 
 	fmt.Printf("✅ Secret '%s' updated successfully in namespace '%s'\n", secretName, namespace)
@@ -380,20 +585,23 @@ func runSecretsDelete(cmd *cobra.Command, args []string) error {
 		namespace = client.GetNamespace()
 	}
 
-	if !force {
-		fmt.Printf("Are you sure you want to delete secret '%s' in namespace '%s'? (y/N): ", secretName, namespace)
-		var response string
-		fmt.Scanln(&response)
-		if strings.ToLower(response) != "y" && strings.ToLower(response) != "yes" {
-			fmt.Println("Deletion cancelled")
-			return nil
-		}
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	confirmed, err := ui.ConfirmDestructive("delete", "secret", namespace, secretName, force)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		fmt.Println("Deletion cancelled")
+		return nil
 	}
 
 	ctx := cmd.Context()
 	err = client.Clientset.CoreV1().Secrets(namespace).Delete(ctx, secretName, metav1.DeleteOptions{})
 	if err != nil {
-		return fmt.Errorf("failed to delete secret %s: %w", secretName, err)
+		return resourceError(ctx, client, "delete", "secret", namespace, secretName, err)
 	}
 
 	fmt.Printf("✅ Secret '%s' deleted successfully from namespace '%s'\n", secretName, namespace)
@@ -402,7 +610,19 @@ func runSecretsDelete(cmd *cobra.Command, args []string) error {
 
 func runSecretsDecode(cmd *cobra.Command, args []string) error {
 	secretName := args[0]
-	key := args[1]
+	all, _ := cmd.Flags().GetBool("all")
+	showValues, _ := cmd.Flags().GetBool("show-values")
+
+	if all && len(args) == 2 {
+		return fmt.Errorf("a key cannot be used with --all")
+	}
+	if showValues && !all {
+		return fmt.Errorf("--show-values can only be used with --all")
+	}
+	interactive := !all && len(args) == 1
+	if interactive && !(term.IsTerminal(int(os.Stdin.Fd())) && term.IsTerminal(int(os.Stdout.Fd()))) {
+		return fmt.Errorf("a key is required when not running in a terminal (or use --all)")
+	}
 
 	client, err := k8s.NewClient()
 	if err != nil {
@@ -414,22 +634,293 @@ func runSecretsDecode(cmd *cobra.Command, args []string) error {
 		namespace = client.GetNamespace()
 	}
 
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
 	ctx := cmd.Context()
 	secret, err := client.Clientset.CoreV1().Secrets(namespace).Get(ctx, secretName, metav1.GetOptions{})
 	if err != nil {
-		return fmt.Errorf("failed to get secret %s: %w", secretName, err)
+		return resourceError(ctx, client, "get", "secret", namespace, secretName, err)
+	}
+
+	if all {
+		printSecretValues(os.Stdout, secret.Data, showValues)
+		return nil
 	}
 
+	var key string
+	if interactive {
+		keys := secretDataKeys(secret.Data)
+		if len(keys) == 0 {
+			return fmt.Errorf("secret '%s' has no keys", secretName)
+		}
+		key, err = pterm.DefaultInteractiveSelect.WithOptions(keys).Show("Select a key")
+		if err != nil {
+			return err
+		}
+	} else {
+		key = args[1]
+	}
+
+	// The API client has already decoded the base64 in data
 	value, exists := secret.Data[key]
 	if !exists {
 		return fmt.Errorf("key '%s' not found in secret '%s'", key, secretName)
 	}
 
-	decoded, err := base64.StdEncoding.DecodeString(string(value))
+	os.Stdout.Write(value)
+	return nil
+}
+
+func runSecretsExportAll(cmd *cobra.Command, args []string) error {
+	namespace, _ := cmd.Flags().GetString("namespace")
+	out, _ := cmd.Flags().GetString("out")
+	selector, _ := cmd.Flags().GetString("selector")
+	decode, _ := cmd.Flags().GetBool("decode")
+	includeTokens, _ := cmd.Flags().GetBool("include-service-account-tokens")
+
+	client, err := k8s.NewClient()
 	if err != nil {
-		return fmt.Errorf("failed to decode value for key '%s': %w", key, err)
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	secrets, err := client.Clientset.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return fmt.Errorf("failed to list secrets in namespace %s: %w", namespace, err)
+	}
+
+	if err := os.MkdirAll(out, 0700); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	exported, skipped := 0, 0
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		if secret.Type == corev1.SecretTypeServiceAccountToken && !includeTokens {
+			skipped++
+			continue
+		}
+
+		manifest, err := ui.ExportSecretAsYAML(secret, decode)
+		if err != nil {
+			return err
+		}
+
+		path := filepath.Join(out, secretFileName(secret.Name))
+		if err := writeNewFile(path, manifest); err != nil {
+			return err
+		}
+		fmt.Printf("  %s\n", path)
+		exported++
+	}
+
+	if exported == 0 {
+		fmt.Printf("No secrets to export in namespace '%s'\n", namespace)
+	} else {
+		fmt.Printf("✅ Exported %d %s from namespace '%s' to %s\n", exported, pluralize(exported, "secret", "secrets"), namespace, out)
+	}
+	if skipped > 0 {
+		fmt.Printf("Skipped %d service account %s (use --include-service-account-tokens to export them)\n", skipped, pluralize(skipped, "token", "tokens"))
 	}
 
-	fmt.Print(string(decoded))
 	return nil
 }
+
+// readSecretManifest decodes the Secret manifest in a file, or stdin for "-"
+func readSecretManifest(cmd *cobra.Command, filename string) (*corev1.Secret, error) {
+	in := cmd.InOrStdin()
+	if filename != "-" {
+		file, err := os.Open(filename)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", filename, err)
+		}
+		defer file.Close()
+		in = file
+	}
+
+	secret, err := k8s.DecodeSecretManifest(in)
+	if err != nil {
+		return nil, err
+	}
+	if err := utils.ValidateResourceName(secret.Name); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// secretManifestFilename returns the manifest secrets create reads: the
+// --filename flag, or stdin when -f is given just '-', as with apply
+func secretManifestFilename(cmd *cobra.Command) string {
+	if filename, _ := cmd.Flags().GetString("filename"); filename != "" {
+		return filename
+	}
+	if stdinFileSource(cmd) {
+		return "-"
+	}
+	return ""
+}
+
+// stdinFileSource reports whether --from-file is just '-', which cannot be a
+// file source and stands for a manifest on stdin
+func stdinFileSource(cmd *cobra.Command) bool {
+	fromFile, _ := cmd.Flags().GetStringSlice("from-file")
+	return len(fromFile) == 1 && fromFile[0] == "-"
+}
+
+// secretManifestNamespace returns the namespace a secret manifest is written
+// to: its own, which must agree with --namespace when both are given, or else
+// --namespace or the configured namespace
+func secretManifestNamespace(cmd *cobra.Command, client *k8s.Client, secret *corev1.Secret) (string, error) {
+	namespace, _ := cmd.Flags().GetString("namespace")
+	if secret.Namespace != "" {
+		if namespace != "" && namespace != secret.Namespace {
+			return "", fmt.Errorf("the manifest is for namespace '%s', not '%s'", secret.Namespace, namespace)
+		}
+		namespace = secret.Namespace
+	}
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return "", err
+	}
+	return namespace, nil
+}
+
+// secretDataKeys returns the keys of secret data, sorted
+func secretDataKeys(data map[string][]byte) []string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// secretValueText renders a decoded secret value for display, summarising
+// binary values such as keystores rather than printing them
+func secretValueText(value []byte) string {
+	if utils.IsBinary(value) {
+		return fmt.Sprintf("<binary, %d bytes>", len(value))
+	}
+	return string(value)
+}
+
+// printSecretValues prints every key of secret data, one per line, masking
+// the values unless show is set
+func printSecretValues(out io.Writer, data map[string][]byte, show bool) {
+	for _, key := range secretDataKeys(data) {
+		value := data[key]
+		if show {
+			fmt.Fprintf(out, "%s: %s\n", key, secretValueText(value))
+		} else {
+			fmt.Fprintf(out, "%s: ******** (%d bytes)\n", key, len(value))
+		}
+	}
+}
+
+// secretFileName returns the manifest file name for a secret. Secret names
+// are already safe, but anything other than letters, digits, dots, dashes and
+// underscores is replaced in case the name comes from elsewhere.
+func secretFileName(name string) string {
+	safe := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '-', r == '_':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+	if strings.Trim(safe, ".") == "" {
+		safe = "_" + safe
+	}
+	return safe + ".yaml"
+}
+
+// writeNewFile writes a file readable only by the current user, refusing to
+// replace an existing one
+func writeNewFile(path string, data []byte) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		if os.IsExist(err) {
+			return fmt.Errorf("%s already exists; choose an empty --out directory", path)
+		}
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return file.Close()
+}
+
+// generateSecretValues makes a random value for each key=bytes spec, and
+// returns the keys in the order given
+func generateSecretValues(specs []string, charset string) (map[string]string, []string, error) {
+	if !slices.Contains(utils.Charsets, charset) {
+		return nil, nil, fmt.Errorf("invalid --charset %q (expected %s)", charset, strings.Join(utils.Charsets, ", "))
+	}
+
+	values := map[string]string{}
+	var keys []string
+	for _, spec := range specs {
+		key, length, err := utils.ParseGenerateSpec(spec)
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, exists := values[key]; exists {
+			return nil, nil, fmt.Errorf("key %s is generated more than once", key)
+		}
+
+		value, err := utils.RandomValue(length, charset)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to generate a value for %s: %w", key, err)
+		}
+		values[key] = value
+		keys = append(keys, key)
+	}
+	return values, keys, nil
+}
+
+// readFileSource reads a --from-file value, [key=]path, into its key and
+// the contents of the file. Without a key the file name is the key.
+func readFileSource(source string) (string, []byte, error) {
+	key, path, found := strings.Cut(source, "=")
+	if !found {
+		path = key
+		key = filepath.Base(path)
+	}
+	if err := utils.ValidateDataKey(key); err != nil {
+		return "", nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to read file %s: %w", path, err)
+	}
+	return key, data, nil
+}
+
+// parseLiteral splits a --from-literal value into its key and value,
+// rejecting keys Kubernetes would not accept
+func parseLiteral(literal string) (string, []byte, error) {
+	parts := strings.SplitN(literal, "=", 2)
+	if len(parts) != 2 {
+		return "", nil, fmt.Errorf("invalid literal format: %s (expected key=value)", literal)
+	}
+	if err := utils.ValidateDataKey(parts[0]); err != nil {
+		return "", nil, err
+	}
+	return parts[0], []byte(parts[1]), nil
+}
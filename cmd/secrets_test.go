@@ -2,9 +2,14 @@ package cmd
 
 import (
 	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/karthickk/k8s-manager/pkg/utils"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSecretsCommand(t *testing.T) {
@@ -36,6 +41,8 @@ func TestSecretsCommand(t *testing.T) {
 				"List all secrets in the namespace",
 				"--namespace",
 				"--all-namespaces",
+				"--output",
+				"--no-headers",
 			},
 		},
 		{
@@ -57,6 +64,19 @@ func TestSecretsCommand(t *testing.T) {
 				"--from-literal",
 				"--from-file",
 				"--type",
+				"--generate",
+				"--charset",
+				"--filename",
+			},
+		},
+		{
+			name:    "secrets apply help",
+			args:    []string{"secrets", "apply", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Create or update a secret from a manifest",
+				"--filename",
+				"stringData",
 			},
 		},
 		{
@@ -87,6 +107,18 @@ func TestSecretsCommand(t *testing.T) {
 				"Decode a specific key from a secret",
 			},
 		},
+		{
+			name:    "secrets export-all help",
+			args:    []string{"secrets", "export-all", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Export every secret in a namespace as YAML manifests",
+				"--out",
+				"--selector",
+				"--decode",
+				"--include-service-account-tokens",
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -149,6 +181,61 @@ func TestSecretsCommandArguments(t *testing.T) {
 			args:    []string{"secrets", "decode", "secret-name"},
 			wantErr: true,
 		},
+		{
+			name:    "secrets decode key with all",
+			args:    []string{"secrets", "decode", "secret-name", "password", "--all"},
+			wantErr: true,
+		},
+		{
+			name:    "secrets decode show values without all",
+			args:    []string{"secrets", "decode", "secret-name", "password", "--show-values"},
+			wantErr: true,
+		},
+		{
+			name:    "secrets list unsupported output",
+			args:    []string{"secrets", "list", "-o", "yaml"},
+			wantErr: true,
+		},
+		{
+			name:    "secrets export-all missing out",
+			args:    []string{"secrets", "export-all"},
+			wantErr: true,
+		},
+		{
+			name:    "secrets create invalid name",
+			args:    []string{"secrets", "create", "My_Secret", "--from-literal", "key=value"},
+			wantErr: true,
+		},
+		{
+			name:    "secrets apply missing filename",
+			args:    []string{"secrets", "apply"},
+			wantErr: true,
+		},
+		{
+			name:    "secrets create filename with literals",
+			args:    []string{"secrets", "create", "--filename", "-", "--from-literal", "key=value"},
+			wantErr: true,
+		},
+		{
+			name:    "secrets create stdin with literals",
+			args:    []string{"secrets", "create", "-f", "-", "--from-literal", "key=value"},
+			wantErr: true,
+		},
+		{
+			name:    "secrets create missing manifest",
+			args:    []string{"secrets", "create", "--filename", filepath.Join(t.TempDir(), "missing.yaml")},
+			wantErr: true,
+		},
+		{
+			name:    "secrets create invalid charset",
+			args:    []string{"secrets", "create", "app-keys", "--generate", "password=32", "--charset", "emoji"},
+			wantErr: true,
+		},
+		{
+			name:    "secrets create invalid generate spec",
+			args:    []string{"secrets", "create", "app-keys", "--generate", "password"},
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -170,6 +257,98 @@ func TestSecretsCommandArguments(t *testing.T) {
 	}
 }
 
+func TestSecretsCreateReadsManifestFromStdin(t *testing.T) {
+	cmd := newRootCmd("test")
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetErr(new(bytes.Buffer))
+	cmd.SetIn(strings.NewReader("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: settings\n"))
+	cmd.SetArgs([]string{"secrets", "create", "-f", "-"})
+
+	// '-' is read as a manifest, not as a file named '-'
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "expected a v1 Secret manifest, got v1 ConfigMap")
+}
+
+func TestParseLiteral(t *testing.T) {
+	testCases := []struct {
+		name      string
+		literal   string
+		wantKey   string
+		wantValue string
+		wantErr   string
+	}{
+		{name: "simple pair", literal: "username=admin", wantKey: "username", wantValue: "admin"},
+		{name: "value containing equals", literal: "dsn=user=a;pass=b", wantKey: "dsn", wantValue: "user=a;pass=b"},
+		{name: "empty value", literal: "token=", wantKey: "token", wantValue: ""},
+		{name: "missing equals", literal: "username", wantErr: "expected key=value"},
+		{name: "empty key", literal: "=value", wantErr: "key cannot be empty"},
+		{name: "invalid key", literal: "db password=secret", wantErr: `invalid key "db password"`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			key, value, err := parseLiteral(tc.literal)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantKey, key)
+			assert.Equal(t, tc.wantValue, string(value))
+		})
+	}
+}
+
+func TestReadFileSource(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app.properties")
+	require.NoError(t, os.WriteFile(path, []byte("debug=true\n"), 0o600))
+
+	key, data, err := readFileSource(path)
+	require.NoError(t, err)
+	assert.Equal(t, "app.properties", key)
+	assert.Equal(t, "debug=true\n", string(data))
+
+	key, _, err = readFileSource("settings=" + path)
+	require.NoError(t, err)
+	assert.Equal(t, "settings", key)
+
+	_, _, err = readFileSource("bad key=" + path)
+	assert.ErrorContains(t, err, `invalid key "bad key"`)
+
+	_, _, err = readFileSource(filepath.Join(dir, "missing"))
+	assert.ErrorContains(t, err, "failed to read file")
+}
+
+func TestGenerateSecretValues(t *testing.T) {
+	values, keys, err := generateSecretValues([]string{"password=32", "apikey=16"}, utils.CharsetHex)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"password", "apikey"}, keys)
+	assert.Len(t, values["password"], 64)
+	assert.Len(t, values["apikey"], 32)
+
+	_, _, err = generateSecretValues([]string{"password=32", "password=16"}, utils.CharsetHex)
+	assert.EqualError(t, err, "key password is generated more than once")
+
+	_, _, err = generateSecretValues(nil, "emoji")
+	assert.EqualError(t, err, `invalid --charset "emoji" (expected alnum, hex, base64)`)
+}
+
+func TestReadSecretManifest(t *testing.T) {
+	cmd := newSecretsApplyCmd()
+	cmd.SetIn(strings.NewReader("apiVersion: v1\nkind: Secret\nmetadata:\n  name: db\nstringData:\n  user: app\n"))
+	secret, err := readSecretManifest(cmd, "-")
+	require.NoError(t, err)
+	assert.Equal(t, "db", secret.Name)
+	assert.Equal(t, "app", secret.StringData["user"])
+
+	path := filepath.Join(t.TempDir(), "cm.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: db\n"), 0600))
+	_, err = readSecretManifest(cmd, path)
+	assert.EqualError(t, err, "expected a v1 Secret manifest, got v1 ConfigMap")
+}
+
 func TestSecretsCommandStructure(t *testing.T) {
 	cmd := newSecretsCmd()
 
@@ -180,7 +359,7 @@ func TestSecretsCommandStructure(t *testing.T) {
 
 	// Verify subcommands are registered
 	subcommands := cmd.Commands()
-	expectedCommands := []string{"list", "get", "create", "update", "delete", "decode"}
+	expectedCommands := []string{"list", "get", "create", "apply", "update", "delete", "decode", "export-all"}
 
 	for _, expected := range expectedCommands {
 		found := false
@@ -193,3 +372,37 @@ func TestSecretsCommandStructure(t *testing.T) {
 		assert.True(t, found, "Expected subcommand %s not found", expected)
 	}
 }
+
+func TestSecretFileName(t *testing.T) {
+	assert.Equal(t, "db-credentials.yaml", secretFileName("db-credentials"))
+	assert.Equal(t, "tls.example.com.yaml", secretFileName("tls.example.com"))
+	assert.Equal(t, "_.._etc_passwd.yaml", secretFileName("/../etc/passwd"))
+	assert.Equal(t, "_...yaml", secretFileName(".."))
+}
+
+func TestWriteNewFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "web.yaml")
+	require.NoError(t, writeNewFile(path, []byte("kind: Secret\n")))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	assert.ErrorContains(t, writeNewFile(path, []byte("kind: Secret\n")), "already exists")
+}
+
+func TestPrintSecretValues(t *testing.T) {
+	data := map[string][]byte{
+		"username": []byte("admin"),
+		"password": []byte("s3cret"),
+		"keystore": {0x00, 0x01, 0xfe},
+	}
+
+	var masked bytes.Buffer
+	printSecretValues(&masked, data, false)
+	assert.Equal(t, "keystore: ******** (3 bytes)\npassword: ******** (6 bytes)\nusername: ******** (5 bytes)\n", masked.String())
+
+	var shown bytes.Buffer
+	printSecretValues(&shown, data, true)
+	assert.Equal(t, "keystore: <binary, 3 bytes>\npassword: s3cret\nusername: admin\n", shown.String())
+}
@@ -0,0 +1,462 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/karthickk/k8s-manager/pkg/ui"
+	"github.com/karthickk/k8s-manager/pkg/utils"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func newServicesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "services",
+		Aliases: []string{"service", "svc"},
+		Short:   "Manage Kubernetes services",
+		Long:    `List, inspect and delete Kubernetes services, and check that their endpoints accept connections.`,
+	}
+
+	cmd.AddCommand(newServicesListCmd())
+	cmd.AddCommand(newServicesGetCmd())
+	cmd.AddCommand(newServicesDeleteCmd())
+	cmd.AddCommand(newServicesCheckCmd())
+
+	return cmd
+}
+
+func newServicesListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List services in the namespace",
+		Long: `List Kubernetes services with their type, cluster IP, external address and
+port mappings. The external address of a LoadBalancer service is the IP or
+hostname of its load balancer, or <pending> until one is provisioned.`,
+		RunE: runServicesList,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace to list services from (overrides config)")
+	cmd.Flags().BoolP("all-namespaces", "A", false, "List services from all namespaces")
+	cmd.Flags().Bool("wide", false, "Show the selector of each service")
+	addListOutputFlag(cmd)
+
+	return cmd
+}
+
+func newServicesGetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get <service-name>",
+		Short: "Get details of a specific service",
+		Long: `Get detailed information about a Kubernetes service: its type, addresses,
+ports, selector and endpoints.
+
+With -o yaml or -o json the service manifest is printed instead, without
+managed fields or the last-applied-configuration annotation.`,
+		Args: cobra.ExactArgs(1),
+		RunE: runServicesGet,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace of the service (overrides config)")
+	cmd.Flags().StringP("output", "o", "", "Print the service manifest instead: yaml or json")
+
+	return cmd
+}
+
+func newServicesDeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <service-name>",
+		Short: "Delete a service",
+		Long: `Delete a Kubernetes service. The pods behind it keep running; a LoadBalancer
+service also releases its load balancer.`,
+		Args: cobra.ExactArgs(1),
+		RunE: runServicesDelete,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace of the service (overrides config)")
+	cmd.Flags().BoolP("force", "", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func newServicesCheckCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check <service-name>",
+		Short: "Check that the endpoints of a service are reachable",
+		Long: `Resolve the endpoints of a service and dial each backend pod IP and port over
+TCP, reporting which ones accept connections. This tells a service without
+endpoints apart from one whose endpoints are unreachable.
+
+The checks run from a short-lived probe pod in the service's namespace, so
+they see the same network, including network policies, as other pods do. The
+probe image must provide nc; the pod is deleted when the check finishes.
+
+Examples:
+  k8s-manager services check web
+  k8s-manager services check api -n production --timeout 5s`,
+		Args: cobra.ExactArgs(1),
+		RunE: runServicesCheck,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace of the service (overrides config)")
+	cmd.Flags().String("image", "busybox", "Image of the probe pod (must provide nc)")
+	cmd.Flags().Duration("timeout", 2*time.Second, "Timeout for each connection attempt")
+
+	return cmd
+}
+
+func runServicesList(cmd *cobra.Command, args []string) error {
+	output, err := parseListFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	namespace, _ := cmd.Flags().GetString("namespace")
+	allNamespaces, _ := cmd.Flags().GetBool("all-namespaces")
+	wide, _ := cmd.Flags().GetBool("wide")
+
+	if allNamespaces {
+		namespace = ""
+	} else if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	services, err := k8s.ListAll(ctx, metav1.ListOptions{}, output.limit, client.Clientset.CoreV1().Services(namespace).List)
+	if err != nil {
+		return fmt.Errorf("failed to list services: %w", err)
+	}
+
+	if output.structured() {
+		return output.print(os.Stdout, "services", services)
+	}
+
+	if len(services.Items) == 0 {
+		if allNamespaces {
+			fmt.Println("No services found in any namespace")
+		} else {
+			fmt.Printf("No services found in namespace '%s'\n", namespace)
+		}
+		return nil
+	}
+
+	headers := []string{"NAME", "TYPE", "CLUSTER-IP", "EXTERNAL-IP", "PORT(S)", "AGE"}
+	if allNamespaces {
+		headers = append([]string{"NAMESPACE"}, headers...)
+	}
+	if wide {
+		headers = append(headers, "SELECTOR")
+	}
+
+	w := newListTableWriter(cmd)
+	w.Header(headers...)
+	for i := range services.Items {
+		fmt.Fprintln(w, strings.Join(serviceListRow(&services.Items[i], allNamespaces, wide), "\t"))
+	}
+	w.Flush()
+	output.warnTruncated(services)
+
+	return nil
+}
+
+func runServicesGet(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	namespace, _ := cmd.Flags().GetString("namespace")
+	outputFlag, _ := cmd.Flags().GetString("output")
+
+	output, err := parseObjectOutput(outputFlag)
+	if err != nil {
+		return err
+	}
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	service, err := client.Clientset.CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return resourceError(ctx, client, "get", "service", namespace, name, err)
+	}
+
+	if output != "" {
+		return printObject(cmd.OutOrStdout(), output, serviceManifest(service))
+	}
+
+	out := cmd.OutOrStdout()
+	printServiceDetails(out, service)
+
+	if service.Spec.Type == corev1.ServiceTypeExternalName {
+		return nil
+	}
+
+	endpoints, err := client.ServiceEndpoints(ctx, namespace, name)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(out)
+	if len(endpoints) == 0 {
+		reason, err := noEndpointsReason(cmd, client, service)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "No endpoints: %s\n", reason)
+		return nil
+	}
+
+	fmt.Fprintln(out, "Endpoints:")
+	w := utils.NewTableWriter(out, false)
+	w.Header("  POD", "ENDPOINT", "PORT", "READY")
+	for _, endpoint := range endpoints {
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%t\n", valueOrNone(endpoint.Pod), endpoint.Address(), valueOrNone(endpoint.PortName), endpoint.Ready)
+	}
+	return w.Flush()
+}
+
+func runServicesDelete(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	namespace, _ := cmd.Flags().GetString("namespace")
+	force, _ := cmd.Flags().GetBool("force")
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	service, err := client.Clientset.CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return resourceError(ctx, client, "get", "service", namespace, name, err)
+	}
+
+	if !force && service.Spec.Type == corev1.ServiceTypeLoadBalancer {
+		fmt.Printf("⚠️  Service '%s' is a LoadBalancer; its load balancer (%s) will be released.\n", name, k8s.ServiceExternalAddress(service))
+	}
+	confirmed, err := ui.ConfirmDestructive("delete", "service", namespace, name, force)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		fmt.Println("Deletion cancelled")
+		return nil
+	}
+
+	if err := client.Clientset.CoreV1().Services(namespace).Delete(ctx, name, metav1.DeleteOptions{}); err != nil {
+		return resourceError(ctx, client, "delete", "service", namespace, name, err)
+	}
+
+	fmt.Printf("✅ Service '%s' deleted successfully from namespace '%s'\n", name, namespace)
+	return nil
+}
+
+func runServicesCheck(cmd *cobra.Command, args []string) error {
+	serviceName := args[0]
+	namespace, _ := cmd.Flags().GetString("namespace")
+	image, _ := cmd.Flags().GetString("image")
+	timeout, _ := cmd.Flags().GetDuration("timeout")
+
+	if timeout <= 0 {
+		return fmt.Errorf("--timeout must be greater than 0")
+	}
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	service, err := client.Clientset.CoreV1().Services(namespace).Get(ctx, serviceName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get service %s: %w", serviceName, err)
+	}
+
+	endpoints, err := client.ServiceEndpoints(ctx, namespace, serviceName)
+	if err != nil {
+		return err
+	}
+
+	if len(endpoints) == 0 {
+		reason, err := noEndpointsReason(cmd, client, service)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("⚠️  Service %s has no endpoints: %s\n", serviceName, reason)
+		return nil
+	}
+
+	fmt.Printf("Checking %d %s of service %s...\n", len(endpoints), pluralize(len(endpoints), "endpoint", "endpoints"), serviceName)
+	results, err := client.CheckEndpoints(ctx, namespace, endpoints, k8s.ReachabilityOptions{
+		Image:        image,
+		DialTimeout:  timeout,
+		StartTimeout: debugStartTimeout,
+	})
+	if err != nil {
+		return err
+	}
+
+	printEndpointChecks(results)
+
+	reachable := 0
+	for _, result := range results {
+		if result.Reachable {
+			reachable++
+		}
+	}
+	fmt.Println()
+	if reachable == len(results) {
+		fmt.Printf("✅ All %d endpoints accept connections\n", len(results))
+	} else {
+		fmt.Printf("⚠️  %d of %d endpoints accept connections\n", reachable, len(results))
+	}
+
+	return nil
+}
+
+// Helper functions
+
+func serviceListRow(service *corev1.Service, allNamespaces, wide bool) []string {
+	row := []string{
+		service.Name,
+		string(service.Spec.Type),
+		valueOrNone(service.Spec.ClusterIP),
+		k8s.ServiceExternalAddress(service),
+		k8s.ServicePorts(service),
+		utils.FormatAge(service.CreationTimestamp.Time),
+	}
+	if allNamespaces {
+		row = append([]string{service.Namespace}, row...)
+	}
+	if wide {
+		row = append(row, serviceSelector(service))
+	}
+	return row
+}
+
+// serviceSelector formats the selector of a service, sorted by key
+func serviceSelector(service *corev1.Service) string {
+	if len(service.Spec.Selector) == 0 {
+		return "<none>"
+	}
+	return labels.SelectorFromSet(service.Spec.Selector).String()
+}
+
+// serviceManifest returns a copy of a service as printed by services get -o,
+// in the same way podManifest does for pods
+func serviceManifest(service *corev1.Service) *corev1.Service {
+	manifest := service.DeepCopy()
+	manifest.APIVersion, manifest.Kind = "v1", "Service"
+	trimManifestMeta(&manifest.ObjectMeta)
+	return manifest
+}
+
+// printServiceDetails prints the status block shown by services get
+func printServiceDetails(out io.Writer, service *corev1.Service) {
+	fmt.Fprintf(out, "Name:          %s\n", service.Name)
+	fmt.Fprintf(out, "Namespace:     %s\n", service.Namespace)
+	fmt.Fprintf(out, "Created:       %s (%s ago)\n", service.CreationTimestamp.Format("2006-01-02 15:04:05"), utils.FormatAge(service.CreationTimestamp.Time))
+	fmt.Fprintf(out, "Type:          %s\n", service.Spec.Type)
+	fmt.Fprintf(out, "Cluster IP:    %s\n", valueOrNone(service.Spec.ClusterIP))
+	fmt.Fprintf(out, "External:      %s\n", k8s.ServiceExternalAddress(service))
+	fmt.Fprintf(out, "Selector:      %s\n", serviceSelector(service))
+
+	if len(service.Spec.Ports) == 0 {
+		return
+	}
+	fmt.Fprintln(out, "Ports:")
+	for _, port := range service.Spec.Ports {
+		line := fmt.Sprintf("  - %d/%s -> %s", port.Port, port.Protocol, port.TargetPort.String())
+		if port.Name != "" {
+			line = fmt.Sprintf("  - %s: %d/%s -> %s", port.Name, port.Port, port.Protocol, port.TargetPort.String())
+		}
+		if port.NodePort != 0 {
+			line += fmt.Sprintf(" (node port %d)", port.NodePort)
+		}
+		fmt.Fprintln(out, line)
+	}
+}
+
+func printEndpointChecks(results []k8s.EndpointCheck) {
+	w := utils.NewTableWriter(os.Stdout, false)
+	w.Header("POD", "ENDPOINT", "PORT", "READY", "RESULT")
+	for _, result := range results {
+		endpoint := result.Endpoint
+		status := "✅ open"
+		if !result.Reachable {
+			status = "❌ " + result.Error
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%t\t%s\n",
+			valueOrNone(endpoint.Pod),
+			endpoint.Address(),
+			valueOrNone(endpoint.PortName),
+			endpoint.Ready,
+			status,
+		)
+	}
+	w.Flush()
+}
+
+// noEndpointsReason explains why a service has no endpoints
+func noEndpointsReason(cmd *cobra.Command, client *k8s.Client, service *corev1.Service) (string, error) {
+	if service.Spec.Type == corev1.ServiceTypeExternalName {
+		return fmt.Sprintf("it is an ExternalName service for %s", service.Spec.ExternalName), nil
+	}
+	if len(service.Spec.Selector) == 0 {
+		return "it has no selector, so its endpoints must be managed manually", nil
+	}
+
+	selector := labels.SelectorFromSet(service.Spec.Selector).String()
+	pods, err := client.ListPodsForSelector(cmd.Context(), service.Namespace, selector)
+	if err != nil {
+		return "", err
+	}
+	return describeSelectorMatches(selector, pods), nil
+}
+
+func describeSelectorMatches(selector string, pods []corev1.Pod) string {
+	if len(pods) == 0 {
+		return fmt.Sprintf("selector %s matches no pods", selector)
+	}
+	return fmt.Sprintf("selector %s matches %d %s, but none is running and ready",
+		selector, len(pods), pluralize(len(pods), "pod", "pods"))
+}
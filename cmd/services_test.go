@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestServicesCommand(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []string
+		wantErr  bool
+		contains []string
+	}{
+		{
+			name:    "services help",
+			args:    []string{"services", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Manage Kubernetes services",
+				"list",
+				"get",
+				"delete",
+				"check",
+			},
+		},
+		{
+			name:    "services list help",
+			args:    []string{"services", "list", "--help"},
+			wantErr: false,
+			contains: []string{
+				"List services in the namespace",
+				"--all-namespaces",
+				"--wide",
+				"--output",
+			},
+		},
+		{
+			name:    "services get help",
+			args:    []string{"services", "get", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Get details of a specific service",
+				"--output",
+			},
+		},
+		{
+			name:    "services get missing argument",
+			args:    []string{"services", "get"},
+			wantErr: true,
+		},
+		{
+			name:    "services get invalid output",
+			args:    []string{"services", "get", "web", "-o", "xml"},
+			wantErr: true,
+		},
+		{
+			name:    "services delete help",
+			args:    []string{"svc", "delete", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Delete a service",
+				"--force",
+			},
+		},
+		{
+			name:    "services delete missing argument",
+			args:    []string{"services", "delete"},
+			wantErr: true,
+		},
+		{
+			name:    "services check help",
+			args:    []string{"services", "check", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Check that the endpoints of a service are reachable",
+				"--namespace",
+				"--image",
+				"--timeout",
+			},
+		},
+		{
+			name:    "services check missing argument",
+			args:    []string{"services", "check"},
+			wantErr: true,
+		},
+		{
+			name:    "services check invalid timeout",
+			args:    []string{"services", "check", "web", "--timeout", "0s"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := newRootCmd("test")
+			buf := new(bytes.Buffer)
+			cmd.SetOut(buf)
+			cmd.SetErr(buf)
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			output := buf.String()
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			for _, expected := range tc.contains {
+				assert.Contains(t, output, expected)
+			}
+		})
+	}
+}
+
+func TestDescribeSelectorMatches(t *testing.T) {
+	assert.Equal(t, "selector app=web matches no pods", describeSelectorMatches("app=web", nil))
+	assert.Equal(t, "selector app=web matches 2 pods, but none is running and ready",
+		describeSelectorMatches("app=web", []corev1.Pod{{}, {}}))
+}
+
+func TestServiceListRow(t *testing.T) {
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "shop"},
+		Spec: corev1.ServiceSpec{
+			Type:      corev1.ServiceTypeLoadBalancer,
+			ClusterIP: "10.0.0.12",
+			Selector:  map[string]string{"app": "web"},
+			Ports:     []corev1.ServicePort{{Port: 80, NodePort: 31080, Protocol: corev1.ProtocolTCP}},
+		},
+		Status: corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{Ingress: []corev1.LoadBalancerIngress{{Hostname: "lb.example.com"}}}},
+	}
+
+	row := serviceListRow(service, false, false)
+	assert.Equal(t, []string{"web", "LoadBalancer", "10.0.0.12", "lb.example.com", "80:31080/TCP"}, row[:5])
+
+	row = serviceListRow(service, true, true)
+	assert.Equal(t, "shop", row[0])
+	assert.Equal(t, "app=web", row[len(row)-1])
+}
+
+func TestPrintServiceDetails(t *testing.T) {
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "shop"},
+		Spec: corev1.ServiceSpec{
+			Type:      corev1.ServiceTypeNodePort,
+			ClusterIP: "10.0.0.12",
+			Ports: []corev1.ServicePort{
+				{Name: "http", Port: 80, Protocol: corev1.ProtocolTCP, TargetPort: intstr.FromString("http"), NodePort: 30080},
+				{Port: 9090, Protocol: corev1.ProtocolTCP, TargetPort: intstr.FromInt(9090)},
+			},
+		},
+	}
+
+	out := new(bytes.Buffer)
+	printServiceDetails(out, service)
+
+	for _, want := range []string{
+		"Type:          NodePort\n",
+		"Cluster IP:    10.0.0.12\n",
+		"External:      <none>\n",
+		"Selector:      <none>\n",
+		"  - http: 80/TCP -> http (node port 30080)\n",
+		"  - 9090/TCP -> 9090\n",
+	} {
+		assert.Contains(t, out.String(), want)
+	}
+}
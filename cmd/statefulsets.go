@@ -0,0 +1,230 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/karthickk/k8s-manager/pkg/utils"
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newStatefulSetsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "statefulsets",
+		Aliases: []string{"statefulset", "sts"},
+		Short:   "Manage Kubernetes statefulsets",
+		Long:    `List and inspect Kubernetes statefulsets and the readiness of their pods.`,
+	}
+
+	cmd.AddCommand(newStatefulSetsListCmd())
+	cmd.AddCommand(newStatefulSetsGetCmd())
+
+	return cmd
+}
+
+func newStatefulSetsListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List statefulsets in the namespace",
+		Long:  `List Kubernetes statefulsets with their ready, current and updated replicas, and age.`,
+		RunE:  runStatefulSetsList,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace to list statefulsets from (overrides config)")
+	cmd.Flags().BoolP("all-namespaces", "A", false, "List statefulsets from all namespaces")
+	addListOutputFlag(cmd)
+
+	return cmd
+}
+
+func newStatefulSetsGetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get <statefulset-name>",
+		Short: "Get details of a specific statefulset",
+		Long: `Get detailed information about a Kubernetes statefulset and the readiness of
+each of its pods, in ordinal order.`,
+		Args: cobra.ExactArgs(1),
+		RunE: runStatefulSetsGet,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace of the statefulset (overrides config)")
+
+	return cmd
+}
+
+func runStatefulSetsList(cmd *cobra.Command, args []string) error {
+	output, err := parseListFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	namespace, _ := cmd.Flags().GetString("namespace")
+	allNamespaces, _ := cmd.Flags().GetBool("all-namespaces")
+
+	if allNamespaces {
+		namespace = ""
+	} else if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	statefulSets, err := k8s.ListAll(ctx, metav1.ListOptions{}, output.limit, client.Clientset.AppsV1().StatefulSets(namespace).List)
+	if err != nil {
+		return fmt.Errorf("failed to list statefulsets: %w", err)
+	}
+
+	if output.structured() {
+		return output.print(os.Stdout, "statefulsets", statefulSets)
+	}
+
+	if len(statefulSets.Items) == 0 {
+		if allNamespaces {
+			fmt.Println("No statefulsets found in any namespace")
+		} else {
+			fmt.Printf("No statefulsets found in namespace '%s'\n", namespace)
+		}
+		return nil
+	}
+
+	w := newListTableWriter(cmd)
+	if allNamespaces {
+		w.Header("NAMESPACE", "NAME", "READY", "CURRENT", "UPDATED", "AGE")
+	} else {
+		w.Header("NAME", "READY", "CURRENT", "UPDATED", "AGE")
+	}
+
+	for _, sts := range statefulSets.Items {
+		row := fmt.Sprintf("%s\t%d/%d\t%d\t%d\t%s",
+			sts.Name,
+			sts.Status.ReadyReplicas,
+			statefulSetReplicas(&sts),
+			sts.Status.CurrentReplicas,
+			sts.Status.UpdatedReplicas,
+			utils.FormatAge(sts.CreationTimestamp.Time),
+		)
+		if allNamespaces {
+			fmt.Fprintf(w, "%s\t%s\n", sts.Namespace, row)
+		} else {
+			fmt.Fprintln(w, row)
+		}
+	}
+	w.Flush()
+	output.warnTruncated(statefulSets)
+
+	return nil
+}
+
+func runStatefulSetsGet(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	namespace, _ := cmd.Flags().GetString("namespace")
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	sts, err := client.Clientset.AppsV1().StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get statefulset %s: %w", name, err)
+	}
+
+	fmt.Printf("Name:             %s\n", sts.Name)
+	fmt.Printf("Namespace:        %s\n", sts.Namespace)
+	fmt.Printf("Service:          %s\n", valueOrNone(sts.Spec.ServiceName))
+	fmt.Printf("Replicas:         %d desired, %d ready, %d current, %d updated\n",
+		statefulSetReplicas(sts), sts.Status.ReadyReplicas, sts.Status.CurrentReplicas, sts.Status.UpdatedReplicas)
+	fmt.Printf("Update Strategy:  %s\n", sts.Spec.UpdateStrategy.Type)
+	fmt.Printf("Pod Management:   %s\n", sts.Spec.PodManagementPolicy)
+	fmt.Printf("Created:          %s\n", sts.CreationTimestamp.Format("2006-01-02 15:04:05"))
+	fmt.Println()
+
+	if len(sts.Spec.Template.Spec.Containers) > 0 {
+		fmt.Println("Containers:")
+		for _, container := range sts.Spec.Template.Spec.Containers {
+			fmt.Printf("  - Name:   %s\n", container.Name)
+			fmt.Printf("    Image:  %s\n", container.Image)
+		}
+		fmt.Println()
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(sts.Spec.Selector)
+	if err != nil {
+		return fmt.Errorf("invalid selector on statefulset %s: %w", sts.Name, err)
+	}
+	pods, err := client.ListPodsForSelector(ctx, namespace, selector.String())
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Pods:")
+	w := utils.NewTableWriter(os.Stdout, false)
+	w.Header("  ORDINAL", "NAME", "READY", "STATUS", "RESTARTS", "AGE")
+	for ordinal, pod := range podsByOrdinal(sts, pods) {
+		podName := fmt.Sprintf("%s-%d", sts.Name, ordinal)
+		if pod == nil {
+			fmt.Fprintf(w, "  %d\t%s\t-\t<missing>\t-\t-\n", ordinal, podName)
+			continue
+		}
+		fmt.Fprintf(w, "  %d\t%s\t%s\t%s\t%d\t%s\n",
+			ordinal, podName, getPodReadyStatus(pod), k8s.PodStatus(pod),
+			getPodRestartCount(pod), utils.FormatAge(pod.CreationTimestamp.Time))
+	}
+	w.Flush()
+
+	return nil
+}
+
+// Helper functions
+
+// statefulSetReplicas returns the desired replicas, which default to 1
+func statefulSetReplicas(sts *appsv1.StatefulSet) int32 {
+	if sts.Spec.Replicas == nil {
+		return 1
+	}
+	return *sts.Spec.Replicas
+}
+
+// podsByOrdinal places the pods of a statefulset at their ordinal, leaving
+// nil where a pod is missing. Pods beyond the desired replicas, such as ones
+// still terminating after a scale down, extend the list.
+func podsByOrdinal(sts *appsv1.StatefulSet, pods []corev1.Pod) []*corev1.Pod {
+	byOrdinal := make([]*corev1.Pod, statefulSetReplicas(sts))
+	for i := range pods {
+		suffix, found := strings.CutPrefix(pods[i].Name, sts.Name+"-")
+		if !found {
+			continue
+		}
+		ordinal, err := strconv.Atoi(suffix)
+		if err != nil || ordinal < 0 {
+			continue
+		}
+		for len(byOrdinal) <= ordinal {
+			byOrdinal = append(byOrdinal, nil)
+		}
+		byOrdinal[ordinal] = &pods[i]
+	}
+	return byOrdinal
+}
@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestWorkloadsCommands(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []string
+		wantErr  bool
+		contains []string
+	}{
+		{
+			name:    "statefulsets help",
+			args:    []string{"statefulsets", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Manage Kubernetes statefulsets",
+				"list",
+				"get",
+			},
+		},
+		{
+			name:    "statefulsets list help",
+			args:    []string{"sts", "list", "--help"},
+			wantErr: false,
+			contains: []string{
+				"--all-namespaces",
+				"--output",
+			},
+		},
+		{
+			name:    "daemonsets help",
+			args:    []string{"daemonsets", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Manage Kubernetes daemonsets",
+				"list",
+				"get",
+			},
+		},
+		{
+			name:    "daemonsets list help",
+			args:    []string{"ds", "list", "--help"},
+			wantErr: false,
+			contains: []string{
+				"--all-namespaces",
+				"--no-headers",
+			},
+		},
+		{
+			name:    "statefulsets get missing argument",
+			args:    []string{"statefulsets", "get"},
+			wantErr: true,
+		},
+		{
+			name:    "daemonsets get missing argument",
+			args:    []string{"daemonsets", "get"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := newRootCmd("test")
+			buf := new(bytes.Buffer)
+			cmd.SetOut(buf)
+			cmd.SetErr(buf)
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			output := buf.String()
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			for _, expected := range tc.contains {
+				assert.Contains(t, output, expected)
+			}
+		})
+	}
+}
+
+func TestPodsByOrdinal(t *testing.T) {
+	replicas := int32(3)
+	sts := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "db"},
+		Spec:       appsv1.StatefulSetSpec{Replicas: &replicas},
+	}
+	pods := []corev1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Name: "db-2"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "db-0"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "db-4"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "db-backup-xyz"}},
+	}
+
+	byOrdinal := podsByOrdinal(sts, pods)
+
+	if assert.Len(t, byOrdinal, 5) {
+		assert.Equal(t, "db-0", byOrdinal[0].Name)
+		assert.Nil(t, byOrdinal[1])
+		assert.Equal(t, "db-2", byOrdinal[2].Name)
+		assert.Nil(t, byOrdinal[3])
+		assert.Equal(t, "db-4", byOrdinal[4].Name)
+	}
+
+	assert.Len(t, podsByOrdinal(&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "db"}}, nil), 1)
+}
@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newWaitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wait <resource>/<name> --for=<condition>",
+		Short: "Wait for a resource to reach a condition",
+		Long: `Block until a resource reaches a condition or is deleted.
+
+The condition is either 'delete' or 'condition=<type>[=<status>]', for example
+--for=condition=Ready or --for=condition=Available=true. The command exits with
+a non-zero status if the timeout elapses first, reporting the last status
+observed. While waiting, the status of the resource and, for workloads, a bar
+of the ready replicas are shown; outside a terminal, as in CI, a line is
+printed whenever the status changes.
+
+Examples:
+  k8s-manager wait pod/web --for=condition=Ready --timeout=2m
+  k8s-manager wait deployment/api --for=condition=Available
+  k8s-manager wait pod/migrate --for=delete`,
+		Args: cobra.ExactArgs(1),
+		RunE: runWait,
+	}
+
+	cmd.Flags().StringP("namespace", "n", "", "Namespace of the resource (overrides config)")
+	cmd.Flags().String("for", "", "Condition to wait for: delete or condition=<type>[=<status>]")
+	cmd.Flags().Duration("timeout", 30*time.Second, "How long to wait before giving up; 0 waits forever")
+	_ = cmd.MarkFlagRequired("for")
+
+	return cmd
+}
+
+func runWait(cmd *cobra.Command, args []string) error {
+	namespace, _ := cmd.Flags().GetString("namespace")
+	forValue, _ := cmd.Flags().GetString("for")
+	timeout, _ := cmd.Flags().GetDuration("timeout")
+
+	resource, name, err := k8s.ParseResourceArg(args[0])
+	if err != nil {
+		return err
+	}
+
+	condition, err := k8s.ParseWaitCondition(forValue)
+	if err != nil {
+		return err
+	}
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	if err := validateNamespaceFlag(cmd, client, namespace); err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	progress := startWaitProgress(os.Stdout, fmt.Sprintf("Waiting for %s on %s", condition, args[0]))
+	err = client.WaitFor(ctx, namespace, resource, name, condition, func(obj *unstructured.Unstructured) {
+		ready, desired, _ := k8s.ReplicaCounts(obj)
+		progress.update(ready, desired, k8s.DescribeStatus(obj))
+	})
+	progress.stop()
+	if err != nil {
+		return err
+	}
+
+	if condition.Delete {
+		fmt.Printf("✅ %s deleted\n", args[0])
+	} else {
+		fmt.Printf("✅ %s condition met: %s=%s\n", args[0], condition.ConditionType, condition.ConditionStatus)
+	}
+	return nil
+}
+
+// waitProgress shows the status observed while waiting on a resource: a
+// spinner with the elapsed time on a terminal, and a line per change
+// otherwise so CI logs show how far a wait got
+type waitProgress struct {
+	out     io.Writer
+	title   string
+	spinner *pterm.SpinnerPrinter
+	start   time.Time
+	last    string
+}
+
+// startWaitProgress starts reporting progress on out under a title
+func startWaitProgress(out io.Writer, title string) *waitProgress {
+	p := &waitProgress{out: out, title: title, start: time.Now()}
+	if f, ok := out.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		p.spinner, _ = pterm.DefaultSpinner.WithShowTimer(true).WithRemoveWhenDone(true).Start(title)
+	} else {
+		fmt.Fprintf(out, "⏳ %s\n", title)
+	}
+	return p
+}
+
+// update reports the latest status, after a bar of the ready replicas when
+// desired is positive
+func (p *waitProgress) update(ready, desired int64, status string) {
+	text := status
+	if desired > 0 {
+		text = progressBar(ready, desired) + " " + status
+	}
+	if text == p.last {
+		return
+	}
+	p.last = text
+
+	if p.spinner != nil {
+		p.spinner.UpdateText(p.title + " " + text)
+		return
+	}
+	fmt.Fprintf(p.out, "⏳ [%s] %s\n", time.Since(p.start).Round(time.Second), text)
+}
+
+// stop removes the spinner
+func (p *waitProgress) stop() {
+	if p.spinner != nil {
+		p.spinner.Stop()
+	}
+}
+
+// progressBar draws ready out of desired replicas, e.g. "[██████░░░░] 3/5"
+func progressBar(ready, desired int64) string {
+	const width = 10
+	filled := min(max(ready, 0), desired) * width / desired
+	return fmt.Sprintf("[%s%s] %d/%d",
+		strings.Repeat("█", int(filled)), strings.Repeat("░", int(width-filled)), ready, desired)
+}
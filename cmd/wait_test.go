@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitCommand(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []string
+		wantErr  bool
+		contains []string
+	}{
+		{
+			name:    "wait help",
+			args:    []string{"wait", "--help"},
+			wantErr: false,
+			contains: []string{
+				"Wait for a resource to reach a condition",
+				"--for",
+				"--timeout",
+				"--namespace",
+				"condition=Ready",
+				"last status",
+			},
+		},
+		{
+			name:    "wait missing resource",
+			args:    []string{"wait", "--for=delete"},
+			wantErr: true,
+		},
+		{
+			name:    "wait missing condition",
+			args:    []string{"wait", "pod/web"},
+			wantErr: true,
+		},
+		{
+			name:    "wait resource without name",
+			args:    []string{"wait", "pod", "--for=delete"},
+			wantErr: true,
+		},
+		{
+			name:    "wait unsupported condition",
+			args:    []string{"wait", "pod/web", "--for=jsonpath={.status.phase}=Running"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := newRootCmd("test")
+			buf := new(bytes.Buffer)
+			cmd.SetOut(buf)
+			cmd.SetErr(buf)
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			output := buf.String()
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			for _, expected := range tc.contains {
+				assert.Contains(t, output, expected)
+			}
+		})
+	}
+}
+
+func TestWaitProgressOutsideTerminal(t *testing.T) {
+	var out bytes.Buffer
+	progress := startWaitProgress(&out, "Waiting for deployment 'web' to roll out")
+	progress.update(1, 3, "1/3 ready")
+	progress.update(1, 3, "1/3 ready")
+	progress.update(3, 3, "3/3 ready")
+	progress.stop()
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Len(t, lines, 3, "unchanged statuses are not repeated")
+	assert.Equal(t, "⏳ Waiting for deployment 'web' to roll out", lines[0])
+	assert.Contains(t, lines[1], "[███░░░░░░░] 1/3 1/3 ready")
+	assert.Contains(t, lines[2], "[██████████] 3/3 3/3 ready")
+}
+
+func TestProgressBar(t *testing.T) {
+	assert.Equal(t, "[░░░░░░░░░░] 0/4", progressBar(0, 4))
+	assert.Equal(t, "[█████░░░░░] 2/4", progressBar(2, 4))
+	assert.Equal(t, "[██████████] 5/4", progressBar(5, 4), "surge replicas don't overflow the bar")
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/karthickk/k8s-manager/internal/services"
 	"github.com/karthickk/k8s-manager/internal/ui/components"
+	"github.com/karthickk/k8s-manager/pkg/ui"
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -53,7 +54,7 @@ func runDelete(cmd *cobra.Command, args []string) error {
 
 	// If selector is provided, delete by label
 	if selector != "" {
-		return deleteBySelector(client, namespace)
+		return deleteBySelector(cmd.Context(), client, namespace)
 	}
 
 	// Otherwise, delete specified pods
@@ -62,18 +63,17 @@ func runDelete(cmd *cobra.Command, args []string) error {
 	}
 
 	// Confirm deletion
-	if !force {
-		fmt.Printf("Delete %d pod(s)? [y/N]: ", len(args))
-		var response string
-		fmt.Scanln(&response)
-		if response != "y" && response != "Y" {
-			fmt.Println("Deletion cancelled")
-			return nil
-		}
+	confirmed, err := confirmPods("delete", namespace, args, force)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		fmt.Println("Deletion cancelled")
+		return nil
 	}
 
 	// Delete each pod
-	ctx := context.Background()
+	ctx := cmd.Context()
 	gracePeriodSeconds := int64(gracePeriod)
 	deleteOptions := metav1.DeleteOptions{
 		GracePeriodSeconds: &gracePeriodSeconds,
@@ -96,16 +96,16 @@ func runDelete(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func deleteBySelector(client *services.K8sClient, namespace string) error {
+func deleteBySelector(ctx context.Context, client *services.K8sClient, namespace string) error {
 	// List pods with selector
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	listCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	listOptions := metav1.ListOptions{
 		LabelSelector: selector,
 	}
 
-	pods, err := client.Clientset.CoreV1().Pods(namespace).List(ctx, listOptions)
+	pods, err := client.Clientset.CoreV1().Pods(namespace).List(listCtx, listOptions)
 	if err != nil {
 		return fmt.Errorf("failed to list pods: %w", err)
 	}
@@ -116,19 +116,20 @@ func deleteBySelector(client *services.K8sClient, namespace string) error {
 	}
 
 	// Confirm deletion
-	fmt.Printf("Delete %d pod(s) with selector '%s'?\n", len(pods.Items), selector)
+	names := make([]string, 0, len(pods.Items))
 	for _, pod := range pods.Items {
-		fmt.Printf("  - %s\n", pod.Name)
+		names = append(names, pod.Name)
 	}
-	
 	if !force {
-		fmt.Print("[y/N]: ")
-		var response string
-		fmt.Scanln(&response)
-		if response != "y" && response != "Y" {
-			fmt.Println("Deletion cancelled")
-			return nil
-		}
+		fmt.Printf("Pods matching selector '%s':\n", selector)
+	}
+	confirmed, err := confirmPods("delete", namespace, names, force)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		fmt.Println("Deletion cancelled")
+		return nil
 	}
 
 	// Delete pods
@@ -140,7 +141,7 @@ func deleteBySelector(client *services.K8sClient, namespace string) error {
 	for _, pod := range pods.Items {
 		fmt.Printf("Deleting pod %s...\n", pod.Name)
 		
-		deleteCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		deleteCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
 		err := client.Clientset.CoreV1().Pods(namespace).Delete(deleteCtx, pod.Name, deleteOptions)
 		cancel()
 
@@ -152,4 +153,18 @@ func deleteBySelector(client *services.K8sClient, namespace string) error {
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+// confirmPods asks before an action on the named pods. A single pod is
+// confirmed by name; several are listed first and confirmed together.
+func confirmPods(action, namespace string, names []string, force bool) (bool, error) {
+	if len(names) == 1 {
+		return ui.ConfirmDestructive(action, "pod", namespace, names[0], force)
+	}
+	if !force {
+		for _, name := range names {
+			fmt.Printf("  - %s\n", name)
+		}
+	}
+	return ui.ConfirmDestructive(action, fmt.Sprintf("%d pods", len(names)), namespace, "", force)
+}
@@ -45,7 +45,7 @@ func runGet(cmd *cobra.Command, args []string) error {
 	}
 
 	// Get pod
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(cmd.Context(), 30*time.Second)
 	defer cancel()
 
 	pod, err := client.Clientset.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
@@ -75,7 +75,7 @@ func runGet(cmd *cobra.Command, args []string) error {
 		fmt.Printf("\nName:      %s\n", pod.Name)
 		fmt.Printf("Namespace: %s\n", pod.Namespace)
 		fmt.Printf("Node:      %s\n", pod.Spec.NodeName)
-		fmt.Printf("Status:    %s\n", components.RenderStatus(string(pod.Status.Phase)))
+		fmt.Printf("Status:    %s\n", components.RenderStatus(services.GetPodStatus(pod)))
 		fmt.Printf("IP:        %s\n", pod.Status.PodIP)
 		fmt.Printf("Created:   %s (%s ago)\n", 
 			pod.CreationTimestamp.Format(time.RFC3339),
@@ -79,7 +79,7 @@ func runList(cmd *cobra.Command, args []string) error {
 
 	// Function to fetch and display pods
 	fetchPods := func() error {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(cmd.Context(), 30*time.Second)
 		defer cancel()
 
 		pods, err := client.Clientset.CoreV1().Pods(namespace).List(ctx, listOptions)
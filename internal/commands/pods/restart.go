@@ -44,7 +44,7 @@ func runRestart(cmd *cobra.Command, args []string) error {
 
 	// If selector is provided, get pods by label
 	if selector != "" {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(cmd.Context(), 30*time.Second)
 		defer cancel()
 
 		listOptions := metav1.ListOptions{
@@ -73,21 +73,18 @@ func runRestart(cmd *cobra.Command, args []string) error {
 	}
 
 	// Confirm restart
-	fmt.Printf("Restart %d pod(s)? This will delete and recreate them.\n", len(podsToRestart))
-	for _, podName := range podsToRestart {
-		fmt.Printf("  - %s\n", podName)
+	fmt.Println("Restarting deletes the pods so their controllers recreate them.")
+	confirmed, err := confirmPods("restart", namespace, podsToRestart, false)
+	if err != nil {
+		return err
 	}
-	fmt.Print("[y/N]: ")
-	
-	var response string
-	fmt.Scanln(&response)
-	if response != "y" && response != "Y" {
+	if !confirmed {
 		fmt.Println("Restart cancelled")
 		return nil
 	}
 
 	// Restart each pod
-	ctx := context.Background()
+	ctx := cmd.Context()
 	gracePeriodSeconds := int64(30)
 	deleteOptions := metav1.DeleteOptions{
 		GracePeriodSeconds: &gracePeriodSeconds,
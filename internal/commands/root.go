@@ -5,8 +5,11 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/karthickk/k8s-manager/internal/services"
 	"github.com/karthickk/k8s-manager/internal/ui/components"
 	"github.com/karthickk/k8s-manager/internal/ui/views"
+	"github.com/karthickk/k8s-manager/pkg/config"
+	"github.com/karthickk/k8s-manager/pkg/k8s"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -16,6 +19,13 @@ var (
 	namespace string
 	context   string
 	debug     bool
+	verbose   bool
+	proxyURL  string
+	insecure  bool
+	caFile    string
+	asUser    string
+	asGroups  []string
+	asUID     string
 )
 
 // rootCmd represents the base command
@@ -31,6 +41,9 @@ It provides an intuitive interface for common Kubernetes operations including:
 - Resource monitoring
 - And much more!`,
 	Version: "1.0.0",
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		return configureClient()
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// If no subcommand is provided, launch interactive mode
 		return runInteractiveMode()
@@ -53,10 +66,18 @@ func init() {
 	rootCmd.PersistentFlags().StringVarP(&namespace, "namespace", "n", "", "Kubernetes namespace")
 	rootCmd.PersistentFlags().StringVar(&context, "context", "", "Kubernetes context")
 	rootCmd.PersistentFlags().BoolVar(&debug, "debug", false, "Enable debug mode")
+	// -v is taken by --version on this root
+	rootCmd.PersistentFlags().BoolVar(&verbose, "verbose", false, "Log every Kubernetes API request to stderr")
+	rootCmd.PersistentFlags().BoolVar(&insecure, "insecure-skip-tls-verify", false, "Do not verify the API server certificate (insecure; for dev and lab clusters only)")
+	rootCmd.PersistentFlags().StringVar(&caFile, "certificate-authority", "", "Path to a CA certificate file to trust instead of the one in the kubeconfig")
+	rootCmd.PersistentFlags().StringVar(&proxyURL, "proxy", "", "Proxy URL for API requests (http://, https:// or socks5://); overrides the kubeconfig proxy-url")
+	rootCmd.PersistentFlags().StringVar(&asUser, "as", "", "Username or service account (system:serviceaccount:<namespace>:<name>) to impersonate")
+	rootCmd.PersistentFlags().StringSliceVar(&asGroups, "as-group", nil, "Group to impersonate; repeat for several groups (requires --as)")
+	rootCmd.PersistentFlags().StringVar(&asUID, "as-uid", "", "UID to impersonate (requires --as)")
 
 	// Bind flags to viper
 	viper.BindPFlag("namespace", rootCmd.PersistentFlags().Lookup("namespace"))
-	viper.BindPFlag("context", rootCmd.PersistentFlags().Lookup("context"))
+	viper.BindPFlag(config.ContextSetting, rootCmd.PersistentFlags().Lookup("context"))
 	viper.BindPFlag("debug", rootCmd.PersistentFlags().Lookup("debug"))
 
 	// Set up command aliases
@@ -91,7 +112,31 @@ func initConfig() {
 		if debug {
 			fmt.Fprintln(os.Stderr, "Using config file:", viper.ConfigFileUsed())
 		}
+		config.MigrateContext()
+		config.MigrateNamespace(services.CurrentContextName())
+	}
+}
+
+// configureClient hands the connection flags to the Kubernetes clients, so
+// the cached client of the services package uses them too
+func configureClient() error {
+	opts := k8s.ClientOptions{
+		Verbose:               verbose,
+		InsecureSkipTLSVerify: insecure,
+		CertificateAuthority:  caFile,
+		Proxy:                 proxyURL,
+		ImpersonateUser:       asUser,
+		ImpersonateGroups:     asGroups,
+		ImpersonateUID:        asUID,
+	}
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+	if opts.InsecureSkipTLSVerify {
+		fmt.Fprintln(os.Stderr, "⚠️  WARNING: TLS certificate verification is disabled. The connection to the cluster is not secure; use this only for dev or lab clusters.")
 	}
+	k8s.SetClientOptions(opts)
+	return nil
 }
 
 // customUsageTemplate returns a custom usage template
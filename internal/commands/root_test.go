@@ -0,0 +1,101 @@
+package commands
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+)
+
+// executeRoot runs the root command the binary executes with args before a
+// no-op subcommand, returning a REST config with the client options applied
+func executeRoot(t *testing.T, args ...string) (*rest.Config, error) {
+	t.Setenv("HOME", t.TempDir())
+	resetRoot()
+	t.Cleanup(resetRoot)
+
+	noop := &cobra.Command{Use: "noop", RunE: func(cmd *cobra.Command, args []string) error { return nil }}
+	AddCommand(noop)
+	defer rootCmd.RemoveCommand(noop)
+
+	rootCmd.SetArgs(append(args, "noop"))
+	rootCmd.SetOut(io.Discard)
+	rootCmd.SetErr(io.Discard)
+	if err := rootCmd.Execute(); err != nil {
+		return nil, err
+	}
+
+	config := &rest.Config{Host: "https://cluster.example.com"}
+	k8s.ConfigureRESTConfig(config)
+	return config, nil
+}
+
+// resetRoot puts the global flags and client options back to their defaults
+func resetRoot() {
+	rootCmd.PersistentFlags().VisitAll(func(f *pflag.Flag) {
+		if slice, ok := f.Value.(pflag.SliceValue); ok {
+			slice.Replace(nil)
+		} else {
+			f.Value.Set(f.DefValue)
+		}
+		f.Changed = false
+	})
+	k8s.SetClientOptions(k8s.ClientOptions{})
+}
+
+func TestRootProxyFlagReachesClients(t *testing.T) {
+	config, err := executeRoot(t, "--proxy", "http://proxy.example.com:3128")
+	require.NoError(t, err)
+
+	require.NotNil(t, config.Proxy)
+	req, _ := http.NewRequest(http.MethodGet, config.Host, nil)
+	proxy, err := config.Proxy(req)
+	require.NoError(t, err)
+	assert.Equal(t, "http://proxy.example.com:3128", proxy.String())
+
+	_, err = executeRoot(t, "--proxy", "ftp://proxy.example.com")
+	assert.Error(t, err)
+}
+
+func TestRootImpersonationFlagsReachClients(t *testing.T) {
+	config, err := executeRoot(t, "--as", "jane", "--as-group", "dev", "--as-group", "ops", "--as-uid", "42")
+	require.NoError(t, err)
+	assert.Equal(t, rest.ImpersonationConfig{UserName: "jane", Groups: []string{"dev", "ops"}, UID: "42"}, config.Impersonate)
+
+	_, err = executeRoot(t, "--as-group", "dev")
+	assert.ErrorContains(t, err, "require --as")
+}
+
+func TestRootTLSFlagsReachClients(t *testing.T) {
+	config, err := executeRoot(t, "--insecure-skip-tls-verify")
+	require.NoError(t, err)
+	assert.True(t, config.Insecure)
+
+	ca := filepath.Join(t.TempDir(), "ca.crt")
+	require.NoError(t, os.WriteFile(ca, []byte("cert"), 0600))
+	config, err = executeRoot(t, "--certificate-authority", ca)
+	require.NoError(t, err)
+	assert.False(t, config.Insecure)
+	assert.Equal(t, ca, config.CAFile)
+
+	_, err = executeRoot(t, "--insecure-skip-tls-verify", "--certificate-authority", ca)
+	assert.ErrorContains(t, err, "cannot be used with --insecure-skip-tls-verify")
+}
+
+func TestRootVerboseFlagHasNoShorthand(t *testing.T) {
+	flag := rootCmd.PersistentFlags().Lookup("verbose")
+	require.NotNil(t, flag)
+	assert.Empty(t, flag.Shorthand, "-v is --version")
+
+	config, err := executeRoot(t, "--verbose")
+	require.NoError(t, err)
+	assert.NotNil(t, config.WrapTransport)
+}
@@ -6,6 +6,8 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/karthickk/k8s-manager/pkg/config"
+	"github.com/karthickk/k8s-manager/pkg/k8s"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	corev1 "k8s.io/api/core/v1"
@@ -32,6 +34,7 @@ func GetK8sClient() (*K8sClient, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to build config: %w", err)
 	}
+	k8s.ConfigureRESTConfig(config)
 
 	clientset, err := kubernetes.NewForConfig(config)
 	if err != nil {
@@ -62,7 +65,7 @@ func buildConfig() (*rest.Config, error) {
 	}
 
 	// Override context if specified
-	context := viper.GetString("context")
+	context := configuredContext()
 	if context == "" {
 		// Check for cluster_name as fallback
 		context = viper.GetString("k8s.cluster_name")
@@ -95,6 +98,12 @@ func buildConfig() (*rest.Config, error) {
 	return config, nil
 }
 
+// configuredContext returns the context given with --context or saved in
+// the configuration
+func configuredContext() string {
+	return viper.GetString(config.ContextSetting)
+}
+
 // getKubeconfig returns the path to the kubeconfig file
 func getKubeconfig() string {
 	// Check environment variable
@@ -124,6 +133,13 @@ func GetNamespace(cmd *cobra.Command) string {
 		return ns
 	}
 
+	// Check the namespace remembered for the current context
+	if name := CurrentContextName(); name != "" {
+		if ns := viper.GetString(config.ContextNamespaceKey(name)); ns != "" {
+			return ns
+		}
+	}
+
 	// Check k8s.namespace in config
 	if ns := viper.GetString("k8s.namespace"); ns != "" {
 		return ns
@@ -133,6 +149,12 @@ func GetNamespace(cmd *cobra.Command) string {
 	return "default"
 }
 
+// CurrentContextName returns the name of the Kubernetes context in use, the
+// same one k8s.GetCurrentContext reports
+func CurrentContextName() string {
+	return config.ResolveContext(configuredContext())
+}
+
 // GetCurrentNamespace returns the current namespace without requiring a command
 func GetCurrentNamespace() string {
 	return GetNamespace(nil)
@@ -180,34 +202,8 @@ func FormatAge(t time.Time) string {
 	}
 }
 
-// GetPodStatus returns a formatted pod status
+// GetPodStatus returns the pod status as kubectl prints it, including
+// container waiting and termination reasons
 func GetPodStatus(pod *corev1.Pod) string {
-	// Check for init container statuses
-	for _, cs := range pod.Status.InitContainerStatuses {
-		if cs.State.Waiting != nil {
-			return fmt.Sprintf("Init:%s", cs.State.Waiting.Reason)
-		}
-		if cs.State.Terminated != nil && cs.State.Terminated.ExitCode != 0 {
-			return fmt.Sprintf("Init:Error")
-		}
-	}
-
-	// Check container statuses
-	for _, cs := range pod.Status.ContainerStatuses {
-		if cs.State.Waiting != nil {
-			return cs.State.Waiting.Reason
-		}
-		if cs.State.Terminated != nil {
-			return cs.State.Terminated.Reason
-		}
-	}
-
-	// Check pod conditions
-	for _, condition := range pod.Status.Conditions {
-		if condition.Type == corev1.PodReady && condition.Status == corev1.ConditionFalse {
-			return "NotReady"
-		}
-	}
-
-	return string(pod.Status.Phase)
+	return k8s.PodStatus(pod)
 }
\ No newline at end of file
@@ -0,0 +1,35 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// GetPodDeployment resolves the Deployment that owns a pod by following its
+// ReplicaSet owner reference. It returns an empty name when the pod is not
+// managed by a Deployment.
+func (c *K8sClient) GetPodDeployment(ctx context.Context, pod *corev1.Pod) (string, error) {
+	kind, name, err := k8s.PodWorkload(ctx, c.Clientset, pod)
+	if errors.Is(err, k8s.ErrUnmanagedPod) {
+		return "", nil
+	}
+	if err != nil || kind != k8s.KindDeployment {
+		return "", err
+	}
+	return name, nil
+}
+
+// RestartDeployment triggers a rolling restart of a deployment the same way
+// `kubectl rollout restart` does, by bumping the restartedAt annotation
+func (c *K8sClient) RestartDeployment(ctx context.Context, namespace, name string) error {
+	return k8s.RestartWorkload(ctx, c.Clientset, k8s.KindDeployment, namespace, name)
+}
+
+// WaitForDeploymentRollout polls a deployment until all of its replicas have
+// been updated and are available, or ctx is done
+func (c *K8sClient) WaitForDeploymentRollout(ctx context.Context, namespace, name string) error {
+	return k8s.WaitForDeploymentReady(ctx, c.Clientset, namespace, name, nil)
+}
@@ -1,6 +1,8 @@
 package components
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
 	"unicode"
 
@@ -17,7 +19,9 @@ type InputField struct {
 	Focused     bool
 	Validator   func(string) error
 	Transform   func(string) string
-	cursorPos   int
+	// Accept, when set, rejects typed characters it returns false for
+	Accept    func(rune) bool
+	cursorPos int
 }
 
 // NewInputField creates a new input field
@@ -30,6 +34,33 @@ func NewInputField(label string) *InputField {
 	}
 }
 
+// NewPortInput creates an input field that only takes digits and is valid
+// when it holds a port between 1 and 65535
+func NewPortInput(label string) *InputField {
+	field := NewInputField(label)
+	field.Placeholder = "1-65535"
+	field.Width = 10
+	field.CharLimit = 5
+	field.Accept = unicode.IsDigit
+	field.Validator = func(value string) error {
+		_, err := ParsePort(value)
+		return err
+	}
+	return field
+}
+
+// ParsePort parses a port number, which must be between 1 and 65535
+func ParsePort(value string) (int32, error) {
+	if value == "" {
+		return 0, fmt.Errorf("enter a port between 1 and 65535")
+	}
+	port, err := strconv.Atoi(value)
+	if err != nil || port < 1 || port > 65535 {
+		return 0, fmt.Errorf("port must be between 1 and 65535")
+	}
+	return int32(port), nil
+}
+
 // Focus sets focus on the input field
 func (i *InputField) Focus() {
 	i.Focused = true
@@ -91,7 +122,7 @@ func (i *InputField) Update(msg tea.Msg) tea.Cmd {
 
 			if msg.Type == tea.KeyRunes {
 				for _, r := range msg.Runes {
-					if unicode.IsPrint(r) && len(i.Value) < i.CharLimit {
+					if unicode.IsPrint(r) && (i.Accept == nil || i.Accept(r)) && len(i.Value) < i.CharLimit {
 						// Apply transform if set
 						char := string(r)
 						if i.Transform != nil {
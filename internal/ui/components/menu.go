@@ -15,6 +15,7 @@ type MenuItem struct {
 	Description string
 	Icon        string
 	Shortcut    string
+	Disabled    bool // Shown dimmed; Description explains why
 	Action      func() tea.Cmd
 }
 
@@ -167,7 +168,9 @@ func (m Menu) viewDefault() string {
 		}
 
 		// Apply style based on selection
-		if i == m.selected {
+		if item.Disabled {
+			line.WriteString(DisabledStyle.Render(titleStr))
+		} else if i == m.selected {
 			line.WriteString(m.focusedStyle.Render(titleStr))
 		} else {
 			line.WriteString(m.normalStyle.Render(titleStr))
@@ -223,7 +226,13 @@ func (m Menu) viewDevTools() string {
 
 		// Title
 		titleStr := item.Title
-		if i == m.selected {
+		if item.Disabled {
+			prefix := "  "
+			if i == m.selected {
+				prefix = "▸ "
+			}
+			b.WriteString(DisabledStyle.Render(prefix + titleStr))
+		} else if i == m.selected {
 			titleStr = "▸ " + titleStr
 			b.WriteString(m.focusedStyle.Render(titleStr))
 		} else {
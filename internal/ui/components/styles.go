@@ -62,6 +62,10 @@ var (
 		Foreground(ColorMuted).
 		Italic(true)
 
+	DisabledStyle = lipgloss.NewStyle().
+		Foreground(lipgloss.Color("240")).
+		Strikethrough(true)
+
 	NumberStyle = lipgloss.NewStyle().
 		Foreground(ColorSecondary).
 		Bold(true)
@@ -8,6 +8,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/karthickk/k8s-manager/internal/services"
 	"github.com/karthickk/k8s-manager/internal/ui/components"
+	"github.com/karthickk/k8s-manager/pkg/k8s"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -143,7 +144,7 @@ func (m *AddSecretKeyModel) addKey(key, value string) tea.Cmd {
 		secret.Data[key] = []byte(value)
 
 		// Update the secret
-		_, err = client.Clientset.CoreV1().Secrets(m.namespace).Update(ctx, secret, metav1.UpdateOptions{})
+		_, err = client.Clientset.CoreV1().Secrets(m.namespace).Update(ctx, secret, metav1.UpdateOptions{FieldManager: k8s.FieldManager()})
 		if err != nil {
 			return secretKeyAddedMsg{err: err}
 		}
@@ -289,7 +290,7 @@ func (m *AddConfigMapKeyModel) addKey(key, value string) tea.Cmd {
 		cm.Data[key] = value
 
 		// Update the configmap
-		_, err = client.Clientset.CoreV1().ConfigMaps(m.namespace).Update(ctx, cm, metav1.UpdateOptions{})
+		_, err = client.Clientset.CoreV1().ConfigMaps(m.namespace).Update(ctx, cm, metav1.UpdateOptions{FieldManager: k8s.FieldManager()})
 		if err != nil {
 			return configMapKeyAddedMsg{err: err}
 		}
@@ -254,26 +254,15 @@ func (m *ConfigMapDetailsModel) updateViewport() {
 
 	// Format based on content type
 	var content string
-	if strings.Contains(m.selectedKey, "json") || isJSON(value) {
-		// Pretty print JSON if possible
-		content = formatJSON(value)
-	} else if strings.Contains(m.selectedKey, "yaml") || strings.Contains(m.selectedKey, "yml") {
-		// Keep YAML formatting
-		content = value
-	} else if strings.Contains(m.selectedKey, ".properties") {
+	if strings.Contains(m.selectedKey, ".properties") {
 		// Format properties files
-		content = formatProperties(value)
+		content = syntaxTextStyle.Render(formatProperties(value))
 	} else {
-		// Regular text
-		content = value
+		// Pretty print and highlight JSON and YAML values
+		content = highlightContent(m.selectedKey, value)
 	}
 
-	// Add some styling
-	styledContent := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("86")).
-		Render(content)
-
-	m.viewport.SetContent(styledContent)
+	m.viewport.SetContent(content)
 }
 
 // loadConfigMap loads the configmap details
@@ -10,6 +10,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/karthickk/k8s-manager/internal/services"
 	"github.com/karthickk/k8s-manager/internal/ui/components"
+	"github.com/karthickk/k8s-manager/pkg/k8s"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -26,6 +27,21 @@ type EnvManagerModel struct {
 	quitting      bool
 	errorMsg      string
 	successMsg    string
+	pending       []envChange // Changes staged to be applied in one update
+	reviewing     bool        // Showing the diff of the staged changes
+	confirmQuit   bool        // Asking whether to discard staged changes on quit
+}
+
+// envChange is a staged change to one environment variable
+type envChange struct {
+	Name   string
+	Value  string
+	Delete bool
+}
+
+// envStagedMsg is sent when a change has been entered and staged
+type envStagedMsg struct {
+	change envChange
 }
 
 // envVarsLoadedMsg is sent when env vars are loaded
@@ -59,10 +75,50 @@ func (m *EnvManagerModel) Init() tea.Cmd {
 func (m *EnvManagerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		// Staged changes are only thrown away once the user agrees
+		if m.confirmQuit {
+			switch msg.String() {
+			case "y", "Y", "ctrl+c":
+				m.quitting = true
+				return m, tea.Quit
+			default:
+				m.confirmQuit = false
+				return m, nil
+			}
+		}
+
+		// The staged changes are only applied once the diff is confirmed
+		if m.reviewing {
+			switch msg.String() {
+			case "y", "Y":
+				m.reviewing = false
+				return m, m.applyPendingEnv()
+			case "q", "ctrl+c":
+				m.reviewing = false
+				return m, m.quit()
+			default:
+				m.reviewing = false
+				return m, nil
+			}
+		}
+
 		switch msg.String() {
 		case "q", "esc", "ctrl+c":
-			m.quitting = true
-			return m, tea.Quit
+			return m, m.quit()
+		case "s":
+			// Review staged changes before applying them
+			if len(m.pending) > 0 {
+				m.reviewing = true
+			}
+			return m, nil
+		case "x":
+			// Discard staged changes
+			if len(m.pending) > 0 {
+				m.pending = nil
+				m.successMsg = "Discarded staged changes"
+				m.updateMenu()
+			}
+			return m, nil
 		case "a":
 			// Add new env var
 			return m, m.addEnvVar()
@@ -88,15 +144,24 @@ func (m *EnvManagerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				switch selected.ID {
 				case "add":
 					return m, m.addEnvVar()
+				case "apply":
+					m.reviewing = true
+					return m, nil
 				case "restart":
 					return m, m.restartPod()
 				case "back":
-					m.quitting = true
-					return m, tea.Quit
+					return m, m.quit()
 				}
 			}
 		}
 
+	case envStagedMsg:
+		m.stage(msg.change)
+		m.errorMsg = ""
+		m.successMsg = fmt.Sprintf("Staged %s • %d pending, press s to review and apply", msg.change.Name, len(m.pending))
+		m.updateMenu()
+		return m, nil
+
 	case envVarsLoadedMsg:
 		m.loading = false
 		if msg.err != nil {
@@ -110,6 +175,7 @@ func (m *EnvManagerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case envUpdateMsg:
 		if msg.success {
+			m.pending = nil
 			m.successMsg = msg.message
 			// Reload env vars
 			return m, tea.Sequence(
@@ -162,6 +228,14 @@ func (m *EnvManagerModel) View() string {
 	// Build view
 	var sections []string
 
+	if m.reviewing {
+		return m.reviewView()
+	}
+
+	if m.confirmQuit {
+		return m.confirmQuitView()
+	}
+
 	// Add menu
 	if m.menu != nil {
 		sections = append(sections, m.menu.View())
@@ -184,7 +258,7 @@ func (m *EnvManagerModel) View() string {
 	}
 
 	// Add help text
-	helpText := "a: add • e: edit • d: delete • r: restart pod • q: back"
+	helpText := "a: add • e: edit • d: delete • s: review & apply • x: discard staged • r: restart pod • q: back"
 	sections = append(sections, components.HelpStyle.Render(helpText))
 
 	return strings.Join(sections, "\n\n")
@@ -194,21 +268,48 @@ func (m *EnvManagerModel) View() string {
 func (m *EnvManagerModel) updateMenu() {
 	menuItems := []components.MenuItem{}
 
-	// Add env vars
-	for _, env := range m.envVars {
+	// Add env vars as they will be once the staged changes are applied
+	staged := map[string]bool{}
+	for _, change := range m.pending {
+		staged[change.Name] = true
+	}
+	for _, env := range applyEnvChanges(m.envVars, m.pending) {
 		value := env.Value
 		if len(value) > 50 {
 			value = value[:47] + "..."
 		}
+		title := env.Name
+		if staged[env.Name] {
+			title += " ● staged"
+		}
 		menuItems = append(menuItems, components.MenuItem{
 			ID:          env.Name,
-			Title:       env.Name,
+			Title:       title,
 			Description: value,
 			Icon:        "🔧",
 		})
 	}
+	for _, change := range m.pending {
+		if change.Delete {
+			menuItems = append(menuItems, components.MenuItem{
+				ID:          change.Name,
+				Title:       change.Name + " ● staged for deletion",
+				Description: "Removed when the staged changes are applied",
+				Icon:        "🗑️",
+			})
+		}
+	}
 
 	// Add actions
+	if len(m.pending) > 0 {
+		menuItems = append(menuItems, components.MenuItem{
+			ID:          "apply",
+			Title:       fmt.Sprintf("Apply %d Staged Changes", len(m.pending)),
+			Description: "Review the diff and update the deployment once, in a single rollout",
+			Icon:        "✅",
+			Shortcut:    "s",
+		})
+	}
 	menuItems = append(menuItems,
 		components.MenuItem{
 			ID:          "add",
@@ -241,6 +342,138 @@ func (m *EnvManagerModel) updateMenu() {
 	m.menu = components.NewDevToolsMenu(title, menuItems)
 }
 
+// reviewView shows the staged changes as a diff of the environment
+func (m *EnvManagerModel) reviewView() string {
+	var b strings.Builder
+	b.WriteString(components.RenderTitle("Review Staged Changes", fmt.Sprintf("Deployment: %s", m.deployment)))
+	b.WriteString("\n\n")
+
+	added := lipgloss.NewStyle().Foreground(lipgloss.Color("42"))
+	removed := lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
+	changed := lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+	for _, line := range envDiff(m.envVars, m.pending) {
+		switch line[0] {
+		case '+':
+			b.WriteString(added.Render(line))
+		case '-':
+			b.WriteString(removed.Render(line))
+		default:
+			b.WriteString(changed.Render(line))
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(components.HelpStyle.Render("All changes are applied in one deployment update (a single rollout) • y: apply • any other key: back"))
+	return components.BoxStyle.Render(b.String())
+}
+
+// confirmQuitView asks whether to leave and discard the staged changes
+func (m *EnvManagerModel) confirmQuitView() string {
+	var b strings.Builder
+	b.WriteString(components.RenderTitle("Discard Staged Changes?", fmt.Sprintf("Pod: %s", m.podName)))
+	b.WriteString("\n\n")
+	b.WriteString(components.RenderMessage("warning", fmt.Sprintf("%d staged changes have not been applied and will be lost.", len(m.pending))))
+	b.WriteString("\n\n")
+	b.WriteString(components.HelpStyle.Render("y: discard and quit • any other key: back"))
+	return components.BoxStyle.Render(b.String())
+}
+
+// quit leaves the manager, first asking to discard any staged changes
+func (m *EnvManagerModel) quit() tea.Cmd {
+	if len(m.pending) > 0 {
+		m.confirmQuit = true
+		return nil
+	}
+	m.quitting = true
+	return tea.Quit
+}
+
+// stage records a change, replacing any staged change to the same variable.
+// Deleting a variable that is only staged to be added unstages it.
+func (m *EnvManagerModel) stage(change envChange) {
+	pending := m.pending[:0]
+	for _, existing := range m.pending {
+		if existing.Name != change.Name {
+			pending = append(pending, existing)
+		}
+	}
+	m.pending = pending
+
+	if change.Delete && !hasEnvVar(m.envVars, change.Name) {
+		return
+	}
+	m.pending = append(m.pending, change)
+}
+
+// hasEnvVar reports whether env sets the named variable
+func hasEnvVar(env []corev1.EnvVar, name string) bool {
+	for _, e := range env {
+		if e.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// applyEnvChanges returns env with the changes applied: existing variables
+// are updated in place, new ones appended in the order they were staged and
+// deleted ones removed. env itself is not modified.
+func applyEnvChanges(env []corev1.EnvVar, changes []envChange) []corev1.EnvVar {
+	result := make([]corev1.EnvVar, 0, len(env)+len(changes))
+	result = append(result, env...)
+
+	for _, change := range changes {
+		index := -1
+		for i, e := range result {
+			if e.Name == change.Name {
+				index = i
+				break
+			}
+		}
+
+		switch {
+		case change.Delete && index >= 0:
+			result = append(result[:index], result[index+1:]...)
+		case change.Delete:
+		case index >= 0:
+			result[index] = corev1.EnvVar{Name: change.Name, Value: change.Value}
+		default:
+			result = append(result, corev1.EnvVar{Name: change.Name, Value: change.Value})
+		}
+	}
+	return result
+}
+
+// envDiff describes each staged change against env: "+ NAME=value" for new
+// variables, "- NAME=value" for deleted ones and "~ NAME: old → new" for
+// changed values
+func envDiff(env []corev1.EnvVar, changes []envChange) []string {
+	current := map[string]corev1.EnvVar{}
+	for _, e := range env {
+		current[e.Name] = e
+	}
+
+	lines := make([]string, 0, len(changes))
+	for _, change := range changes {
+		before, exists := current[change.Name]
+		beforeValue := before.Value
+		if before.ValueFrom != nil {
+			beforeValue = "<from reference>"
+		}
+
+		switch {
+		case change.Delete:
+			lines = append(lines, fmt.Sprintf("- %s=%s", change.Name, beforeValue))
+		case !exists:
+			lines = append(lines, fmt.Sprintf("+ %s=%s", change.Name, change.Value))
+		default:
+			lines = append(lines, fmt.Sprintf("~ %s: %s → %s", change.Name, beforeValue, change.Value))
+		}
+	}
+	return lines
+}
+
 // loadEnvVars loads environment variables from the pod
 func (m *EnvManagerModel) loadEnvVars() tea.Msg {
 	client, err := services.GetK8sClient()
@@ -259,16 +492,9 @@ func (m *EnvManagerModel) loadEnvVars() tea.Msg {
 	}
 
 	// Get deployment name from owner references
-	deploymentName := ""
-	for _, owner := range pod.OwnerReferences {
-		if owner.Kind == "ReplicaSet" {
-			// Extract deployment name from ReplicaSet name
-			parts := strings.Split(owner.Name, "-")
-			if len(parts) > 1 {
-				deploymentName = strings.Join(parts[:len(parts)-1], "-")
-				break
-			}
-		}
+	deploymentName, err := client.GetPodDeployment(ctx, pod)
+	if err != nil {
+		return envVarsLoadedMsg{err: err}
 	}
 
 	// Get env vars from the first container
@@ -328,17 +554,17 @@ func (m *EnvManagerModel) addEnvVar() tea.Cmd {
 			}
 		}
 		
-		// Update deployment with new env var
-		return m.updateDeploymentEnv(key, value, false)
+		// Stage the change; it is applied with the others in one update
+		return envStagedMsg{change: envChange{Name: key, Value: value}}
 	}
 }
 
 // editEnvVar edits an existing environment variable
 func (m *EnvManagerModel) editEnvVar(name string) tea.Cmd {
 	return func() tea.Msg {
-		// Find current value
+		// Find current value, including staged changes
 		var currentValue string
-		for _, env := range m.envVars {
+		for _, env := range applyEnvChanges(m.envVars, m.pending) {
 			if env.Name == name {
 				currentValue = env.Value
 				break
@@ -384,15 +610,23 @@ func (m *EnvManagerModel) editEnvVar(name string) tea.Cmd {
 		values := formModel.GetValues()
 		newValue := values["Value"]
 		
-		// Update deployment with new value
-		return m.updateDeploymentEnv(name, newValue, false)
+		// Stage the change; it is applied with the others in one update
+		return envStagedMsg{change: envChange{Name: name, Value: newValue}}
 	}
 }
 
-// deleteEnvVar deletes an environment variable
+// deleteEnvVar stages the deletion of an environment variable
 func (m *EnvManagerModel) deleteEnvVar(name string) tea.Cmd {
 	return func() tea.Msg {
-		return m.updateDeploymentEnv(name, "", true)
+		return envStagedMsg{change: envChange{Name: name, Delete: true}}
+	}
+}
+
+// applyPendingEnv applies the staged changes
+func (m *EnvManagerModel) applyPendingEnv() tea.Cmd {
+	changes := append([]envChange(nil), m.pending...)
+	return func() tea.Msg {
+		return m.updateDeploymentEnv(changes)
 	}
 }
 
@@ -424,8 +658,9 @@ func (m *EnvManagerModel) restartPod() tea.Cmd {
 // clearMessageMsg clears success/error messages
 type clearMessageMsg struct{}
 
-// updateDeploymentEnv updates the deployment with new environment variable
-func (m *EnvManagerModel) updateDeploymentEnv(key, value string, delete bool) envUpdateMsg {
+// updateDeploymentEnv applies the changes to every container of the
+// deployment in a single update, so they roll out together
+func (m *EnvManagerModel) updateDeploymentEnv(changes []envChange) envUpdateMsg {
 	if m.deployment == "" {
 		return envUpdateMsg{
 			success: false,
@@ -448,38 +683,11 @@ func (m *EnvManagerModel) updateDeploymentEnv(key, value string, delete bool) en
 	// Update environment variables in all containers
 	for i := range deployment.Spec.Template.Spec.Containers {
 		container := &deployment.Spec.Template.Spec.Containers[i]
-		
-		if delete {
-			// Remove the environment variable
-			newEnv := []corev1.EnvVar{}
-			for _, env := range container.Env {
-				if env.Name != key {
-					newEnv = append(newEnv, env)
-				}
-			}
-			container.Env = newEnv
-		} else {
-			// Add or update the environment variable
-			found := false
-			for j, env := range container.Env {
-				if env.Name == key {
-					container.Env[j].Value = value
-					found = true
-					break
-				}
-			}
-			
-			if !found {
-				container.Env = append(container.Env, corev1.EnvVar{
-					Name:  key,
-					Value: value,
-				})
-			}
-		}
+		container.Env = applyEnvChanges(container.Env, changes)
 	}
 
 	// Update the deployment
-	_, err = m.client.Clientset.AppsV1().Deployments(m.namespace).Update(ctx, deployment, metav1.UpdateOptions{})
+	_, err = m.client.Clientset.AppsV1().Deployments(m.namespace).Update(ctx, deployment, metav1.UpdateOptions{FieldManager: k8s.FieldManager()})
 	if err != nil {
 		return envUpdateMsg{
 			success: false,
@@ -487,15 +695,8 @@ func (m *EnvManagerModel) updateDeploymentEnv(key, value string, delete bool) en
 		}
 	}
 
-	if delete {
-		return envUpdateMsg{
-			success: true,
-			message: fmt.Sprintf("Deleted environment variable %s. Pod will restart automatically.", key),
-		}
-	}
-
 	return envUpdateMsg{
 		success: true,
-		message: fmt.Sprintf("Set %s=%s. Pod will restart automatically.", key, value),
+		message: fmt.Sprintf("Applied %d environment variable changes in one rollout. Pods will restart automatically.", len(changes)),
 	}
-}
\ No newline at end of file
+}
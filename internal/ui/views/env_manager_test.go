@@ -0,0 +1,90 @@
+package views
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestApplyEnvChanges(t *testing.T) {
+	env := []corev1.EnvVar{
+		{Name: "A", Value: "1"},
+		{Name: "B", Value: "2"},
+	}
+
+	result := applyEnvChanges(env, []envChange{
+		{Name: "A", Value: "10"},
+		{Name: "B", Delete: true},
+		{Name: "C", Value: "3"},
+		{Name: "MISSING", Delete: true},
+	})
+
+	assert.Equal(t, []corev1.EnvVar{
+		{Name: "A", Value: "10"},
+		{Name: "C", Value: "3"},
+	}, result)
+	assert.Equal(t, "1", env[0].Value, "input must not be modified")
+	assert.Len(t, env, 2)
+}
+
+func TestEnvDiff(t *testing.T) {
+	env := []corev1.EnvVar{
+		{Name: "A", Value: "1"},
+		{Name: "B", Value: "2"},
+	}
+
+	lines := envDiff(env, []envChange{
+		{Name: "A", Value: "10"},
+		{Name: "B", Delete: true},
+		{Name: "C", Value: "3"},
+	})
+
+	assert.Equal(t, []string{"~ A: 1 → 10", "- B=2", "+ C=3"}, lines)
+}
+
+func TestEnvManagerStage(t *testing.T) {
+	m := &EnvManagerModel{envVars: []corev1.EnvVar{{Name: "A", Value: "1"}}}
+
+	m.stage(envChange{Name: "A", Value: "2"})
+	m.stage(envChange{Name: "A", Value: "3"})
+	assert.Equal(t, []envChange{{Name: "A", Value: "3"}}, m.pending, "restaging replaces the earlier change")
+
+	m.stage(envChange{Name: "NEW", Value: "x"})
+	m.stage(envChange{Name: "NEW", Delete: true})
+	assert.Equal(t, []envChange{{Name: "A", Value: "3"}}, m.pending, "deleting a staged addition unstages it")
+
+	m.stage(envChange{Name: "A", Delete: true})
+	assert.Equal(t, []envChange{{Name: "A", Delete: true}}, m.pending)
+}
+
+func TestEnvManagerQuitAsksToDiscardStagedChanges(t *testing.T) {
+	m := &EnvManagerModel{podName: "web-0", pending: []envChange{{Name: "A", Value: "2"}}}
+	m.updateMenu()
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+	assert.Nil(t, cmd, "staged changes must not be dropped without asking")
+	assert.True(t, m.confirmQuit)
+	assert.Contains(t, m.View(), "1 staged changes have not been applied")
+
+	// Any other key goes back with the changes kept
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	assert.False(t, m.confirmQuit)
+	assert.False(t, m.quitting)
+	assert.Len(t, m.pending, 1)
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	_, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	assert.True(t, m.quitting)
+	assert.NotNil(t, cmd)
+}
+
+func TestEnvManagerQuitsWithoutStagedChanges(t *testing.T) {
+	m := &EnvManagerModel{podName: "web-0"}
+	m.updateMenu()
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+	assert.True(t, m.quitting)
+	assert.NotNil(t, cmd)
+}
@@ -0,0 +1,194 @@
+package views
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/karthickk/k8s-manager/internal/ui/components"
+)
+
+// contentType is the syntax of a secret or configmap value
+type contentType int
+
+const (
+	contentText contentType = iota
+	contentJSON
+	contentYAML
+)
+
+// Syntax highlighting styles
+var (
+	syntaxKeyStyle         = lipgloss.NewStyle().Foreground(components.ColorPrimary).Bold(true)
+	syntaxStringStyle      = lipgloss.NewStyle().Foreground(components.ColorSuccess)
+	syntaxNumberStyle      = lipgloss.NewStyle().Foreground(components.ColorWarning)
+	syntaxKeywordStyle     = lipgloss.NewStyle().Foreground(components.ColorSecondary)
+	syntaxPunctuationStyle = lipgloss.NewStyle().Foreground(components.ColorMuted)
+	syntaxCommentStyle     = lipgloss.NewStyle().Foreground(components.ColorMuted).Italic(true)
+	syntaxTextStyle        = lipgloss.NewStyle().Foreground(components.ColorInfo)
+)
+
+var (
+	// yamlKeyPattern matches a "key: value" line, optionally a list item
+	yamlKeyPattern = regexp.MustCompile(`^(\s*)(- )?([^\s#:'"\-][^:#]*|"[^"]*"|'[^']*'):(\s+(.*))?$`)
+	// yamlNumberPattern matches YAML integers and floats
+	yamlNumberPattern = regexp.MustCompile(`^[-+]?(\d[\d_]*(\.\d*)?([eE][-+]?\d+)?|\.\d+)$`)
+)
+
+// detectContentType guesses the syntax of a value from its key name, such as
+// config.json or values.yaml, and falls back to sniffing the content
+func detectContentType(key, content string) contentType {
+	key = strings.ToLower(key)
+	switch {
+	case strings.Contains(key, "json"):
+		return contentJSON
+	case strings.Contains(key, "yaml") || strings.Contains(key, "yml"):
+		return contentYAML
+	case isJSON(content):
+		return contentJSON
+	case isYAML(content):
+		return contentYAML
+	}
+	return contentText
+}
+
+// highlightContent pretty-prints JSON and renders a value with syntax
+// highlighting matching its detected type
+func highlightContent(key, content string) string {
+	switch detectContentType(key, content) {
+	case contentJSON:
+		return highlightJSON(formatJSON(content))
+	case contentYAML:
+		return highlightYAML(content)
+	}
+	return syntaxTextStyle.Render(content)
+}
+
+// isYAML reports whether the first meaningful line of a value is a document
+// marker or a "key: value" pair
+func isYAML(str string) bool {
+	for _, line := range strings.Split(str, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		return trimmed == "---" || yamlKeyPattern.MatchString(line)
+	}
+	return false
+}
+
+// highlightJSON colors the keys, strings, numbers, keywords and punctuation
+// of a JSON document
+func highlightJSON(str string) string {
+	var b strings.Builder
+	for i := 0; i < len(str); {
+		c := str[i]
+		switch {
+		case c == '"':
+			end := jsonStringEnd(str, i)
+			token := str[i:end]
+			if rest := strings.TrimLeft(str[end:], " \t\r\n"); strings.HasPrefix(rest, ":") {
+				b.WriteString(syntaxKeyStyle.Render(token))
+			} else {
+				b.WriteString(syntaxStringStyle.Render(token))
+			}
+			i = end
+		case c == '-' || (c >= '0' && c <= '9'):
+			end := i + 1
+			for end < len(str) && strings.IndexByte("0123456789.eE+-", str[end]) >= 0 {
+				end++
+			}
+			b.WriteString(syntaxNumberStyle.Render(str[i:end]))
+			i = end
+		case c >= 'a' && c <= 'z':
+			end := i + 1
+			for end < len(str) && str[end] >= 'a' && str[end] <= 'z' {
+				end++
+			}
+			b.WriteString(syntaxKeywordStyle.Render(str[i:end]))
+			i = end
+		case strings.IndexByte("{}[]:,", c) >= 0:
+			b.WriteString(syntaxPunctuationStyle.Render(string(c)))
+			i++
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+	return b.String()
+}
+
+// jsonStringEnd returns the index just past the string literal starting at
+// start, or the end of str when the literal is unterminated
+func jsonStringEnd(str string, start int) int {
+	for i := start + 1; i < len(str); i++ {
+		switch str[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1
+		case '\n':
+			return i
+		}
+	}
+	return len(str)
+}
+
+// highlightYAML colors the keys, scalars and comments of a YAML document,
+// line by line
+func highlightYAML(str string) string {
+	lines := strings.Split(str, "\n")
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "":
+		case strings.HasPrefix(trimmed, "#"):
+			lines[i] = syntaxCommentStyle.Render(line)
+		case trimmed == "---" || trimmed == "...":
+			lines[i] = syntaxPunctuationStyle.Render(line)
+		default:
+			lines[i] = highlightYAMLLine(line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// highlightYAMLLine colors a single non-comment YAML line
+func highlightYAMLLine(line string) string {
+	if match := yamlKeyPattern.FindStringSubmatch(line); match != nil {
+		indent, dash, key, value := match[1], match[2], match[3], match[5]
+		out := indent
+		if dash != "" {
+			out += syntaxPunctuationStyle.Render("-") + " "
+		}
+		out += syntaxKeyStyle.Render(key) + syntaxPunctuationStyle.Render(":")
+		if match[4] != "" {
+			out += match[4][:len(match[4])-len(value)] + highlightYAMLScalar(value)
+		}
+		return out
+	}
+
+	content := strings.TrimLeft(line, " \t")
+	indent := line[:len(line)-len(content)]
+	if strings.HasPrefix(content, "- ") {
+		return indent + syntaxPunctuationStyle.Render("-") + " " + highlightYAMLScalar(content[2:])
+	}
+	return indent + highlightYAMLScalar(content)
+}
+
+// highlightYAMLScalar colors a YAML value by its type
+func highlightYAMLScalar(value string) string {
+	trimmed := strings.TrimSpace(value)
+	switch strings.ToLower(trimmed) {
+	case "":
+		return value
+	case "|", "|-", ">", ">-":
+		return syntaxPunctuationStyle.Render(value)
+	case "true", "false", "yes", "no", "on", "off", "null", "~":
+		return syntaxKeywordStyle.Render(value)
+	}
+	if yamlNumberPattern.MatchString(trimmed) {
+		return syntaxNumberStyle.Render(value)
+	}
+	return syntaxStringStyle.Render(value)
+}
@@ -0,0 +1,59 @@
+package views
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectContentType(t *testing.T) {
+	testCases := []struct {
+		name     string
+		key      string
+		content  string
+		expected contentType
+	}{
+		{name: "json key", key: "config.json", content: "not parsed", expected: contentJSON},
+		{name: "yaml key", key: "values.yaml", content: "plain", expected: contentYAML},
+		{name: "yml key", key: "Settings.YML", content: "plain", expected: contentYAML},
+		{name: "sniffed json", key: "payload", content: ` {"a": 1} `, expected: contentJSON},
+		{name: "sniffed yaml", key: "manifest", content: "# comment\napiVersion: v1\nkind: Pod", expected: contentYAML},
+		{name: "document marker", key: "manifest", content: "---\n- a", expected: contentYAML},
+		{name: "url", key: "endpoint", content: "https://example.com", expected: contentText},
+		{name: "text", key: "password", content: "hunter2", expected: contentText},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, detectContentType(tc.key, tc.content))
+		})
+	}
+}
+
+func TestHighlightContentKeepsText(t *testing.T) {
+	assert.Equal(t, "{\n  \"a\": [\n    1,\n    true\n  ]\n}", highlightContent("data", `{"a":[1,true]}`))
+
+	yaml := "# app\nname: web\nreplicas: 3\nports:\n  - name: http\n    port: 80\n  - 443\ncommand: |\n  run --fast"
+	assert.Equal(t, yaml, highlightContent("values.yaml", yaml))
+}
+
+func TestHighlightContentColorsTokens(t *testing.T) {
+	lipgloss.SetColorProfile(termenv.ANSI256)
+	t.Cleanup(func() { lipgloss.SetColorProfile(termenv.Ascii) })
+
+	json := highlightContent("data", `{"name":"web","replicas":3,"debug":null}`)
+	assert.Contains(t, json, syntaxKeyStyle.Render(`"name"`))
+	assert.Contains(t, json, syntaxStringStyle.Render(`"web"`))
+	assert.Contains(t, json, syntaxNumberStyle.Render("3"))
+	assert.Contains(t, json, syntaxKeywordStyle.Render("null"))
+
+	yaml := highlightContent("values.yaml", "# app\n- name: web\n  enabled: true\n  port: 8080")
+	assert.Contains(t, yaml, syntaxCommentStyle.Render("# app"))
+	assert.Contains(t, yaml, syntaxKeyStyle.Render("name"))
+	assert.Contains(t, yaml, syntaxStringStyle.Render("web"))
+	assert.Contains(t, yaml, syntaxKeywordStyle.Render("true"))
+	assert.Contains(t, yaml, syntaxNumberStyle.Render("8080"))
+	assert.NotEqual(t, syntaxKeyStyle.Render("name"), syntaxStringStyle.Render("name"))
+}
@@ -0,0 +1,58 @@
+package views
+
+// defaultLogBufferLines is the number of log lines kept in memory when the
+// logs.max_lines setting is not configured
+const defaultLogBufferLines = 10000
+
+// logBuffer is a fixed-capacity ring buffer of log lines. Once full, every
+// appended line evicts the oldest one.
+type logBuffer struct {
+	lines   []string
+	start   int
+	dropped int
+}
+
+// newLogBuffer creates a buffer holding at most capacity lines
+func newLogBuffer(capacity int) *logBuffer {
+	if capacity <= 0 {
+		capacity = defaultLogBufferLines
+	}
+	return &logBuffer{lines: make([]string, 0, capacity)}
+}
+
+// Append adds lines, evicting the oldest ones when the buffer is full
+func (b *logBuffer) Append(lines ...string) {
+	for _, line := range lines {
+		if len(b.lines) < cap(b.lines) {
+			b.lines = append(b.lines, line)
+			continue
+		}
+		b.lines[b.start] = line
+		b.start = (b.start + 1) % len(b.lines)
+		b.dropped++
+	}
+}
+
+// Lines returns the buffered lines, oldest first
+func (b *logBuffer) Lines() []string {
+	out := make([]string, 0, len(b.lines))
+	out = append(out, b.lines[b.start:]...)
+	return append(out, b.lines[:b.start]...)
+}
+
+// Len returns the number of buffered lines
+func (b *logBuffer) Len() int {
+	return len(b.lines)
+}
+
+// Dropped returns how many lines have been evicted since the last reset
+func (b *logBuffer) Dropped() int {
+	return b.dropped
+}
+
+// Reset empties the buffer
+func (b *logBuffer) Reset() {
+	b.lines = b.lines[:0]
+	b.start = 0
+	b.dropped = 0
+}
@@ -0,0 +1,69 @@
+package views
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogBuffer(t *testing.T) {
+	testCases := []struct {
+		name     string
+		capacity int
+		appends  [][]string
+		expected []string
+		dropped  int
+	}{
+		{
+			name:     "under capacity",
+			capacity: 3,
+			appends:  [][]string{{"a", "b"}},
+			expected: []string{"a", "b"},
+		},
+		{
+			name:     "exactly full",
+			capacity: 3,
+			appends:  [][]string{{"a", "b"}, {"c"}},
+			expected: []string{"a", "b", "c"},
+		},
+		{
+			name:     "wraps around",
+			capacity: 3,
+			appends:  [][]string{{"a", "b", "c"}, {"d", "e"}},
+			expected: []string{"c", "d", "e"},
+			dropped:  2,
+		},
+		{
+			name:     "wraps more than once",
+			capacity: 2,
+			appends:  [][]string{{"a", "b", "c", "d", "e"}},
+			expected: []string{"d", "e"},
+			dropped:  3,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := newLogBuffer(tc.capacity)
+			for _, lines := range tc.appends {
+				buf.Append(lines...)
+			}
+
+			assert.Equal(t, tc.expected, buf.Lines())
+			assert.Equal(t, len(tc.expected), buf.Len())
+			assert.Equal(t, tc.dropped, buf.Dropped())
+		})
+	}
+}
+
+func TestLogBufferReset(t *testing.T) {
+	buf := newLogBuffer(2)
+	buf.Append("a", "b", "c")
+	buf.Reset()
+
+	assert.Empty(t, buf.Lines())
+	assert.Equal(t, 0, buf.Dropped())
+
+	buf.Append("d")
+	assert.Equal(t, []string{"d"}, buf.Lines())
+}
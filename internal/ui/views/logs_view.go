@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"regexp"
 	"strings"
 	"sync"
 	"time"
@@ -14,9 +15,21 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/karthickk/k8s-manager/internal/services"
 	"github.com/karthickk/k8s-manager/internal/ui/components"
+	"github.com/spf13/viper"
 	corev1 "k8s.io/api/core/v1"
 )
 
+var (
+	logMatchStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("0")).
+			Background(components.ColorWarning)
+
+	logCurrentMatchStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("0")).
+				Background(components.ColorHighlight).
+				Bold(true)
+)
+
 // LogsViewModel shows pod logs with better formatting
 type LogsViewModel struct {
 	namespace   string
@@ -26,37 +39,64 @@ type LogsViewModel struct {
 	viewport    viewport.Model
 	ready       bool
 	quitting    bool
-	lines       []string
+	buffer      *logBuffer
 	mu          sync.Mutex
 	ctx         context.Context
 	cancel      context.CancelFunc
 	errorMsg    string
 	logStream   io.ReadCloser
 	logReader   *bufio.Reader
+	streamGen   int // Incremented each time the stream is re-issued
+
+	// Stream options that can be changed without leaving the view
+	timestamps     bool
+	since          time.Duration
+	sinceInput     *components.InputField
+	promptingSince bool
+	notice         string
+
+	// Search state
+	searchInput *components.InputField
+	searching   bool   // Search input has focus
+	searchTerm  string // Committed search term
+	filterMode  bool   // Show only matching lines
+	matches     []int  // Viewport line of every matching line
+	matchIdx    int    // Current entry in matches
 }
 
 // NewLogsViewModel creates a new logs view
 func NewLogsViewModel(namespace, podName, container string, follow bool) *LogsViewModel {
 	ctx, cancel := context.WithCancel(context.Background())
+
+	searchInput := components.NewInputField("Search")
+	searchInput.Placeholder = "text to find"
+
+	sinceInput := components.NewInputField("Since")
+	sinceInput.Placeholder = "e.g. 5m, 1h (empty for all)"
+
 	return &LogsViewModel{
-		namespace: namespace,
-		podName:   podName,
-		container: container,
-		follow:    follow,
-		lines:     []string{},
-		ctx:       ctx,
-		cancel:    cancel,
+		namespace:   namespace,
+		podName:     podName,
+		container:   container,
+		follow:      follow,
+		buffer:      newLogBuffer(viper.GetInt("logs.max_lines")),
+		ctx:         ctx,
+		cancel:      cancel,
+		searchInput: searchInput,
+		sinceInput:  sinceInput,
 	}
 }
 
 // logsUpdateMsg is sent when new log lines are available
 type logsUpdateMsg struct {
 	lines []string
+	gen   int
 }
 
 // logsErrorMsg is sent when there's an error
 type logsErrorMsg struct {
 	err error
+	gen int
 }
 
 // Init starts fetching logs
@@ -88,14 +128,49 @@ func (m *LogsViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.updateViewport()
 
 	case tea.KeyMsg:
+		if m.searching {
+			return m, m.updateSearchInput(msg)
+		}
+		if m.promptingSince {
+			return m, m.updateSinceInput(msg)
+		}
+
 		switch msg.String() {
-		case "q", "esc", "ctrl+c":
-			m.quitting = true
-			m.cancel() // Cancel log streaming
-			if m.logStream != nil {
-				m.logStream.Close()
+		case "esc":
+			// Clear an active search before leaving the view
+			if m.searchTerm != "" {
+				m.clearSearch()
+				return m, nil
+			}
+			return m, m.quit()
+		case "q", "ctrl+c":
+			return m, m.quit()
+		case "/":
+			m.searching = true
+			m.searchInput.SetValue(m.searchTerm)
+			m.searchInput.Focus()
+			return m, nil
+		case "n":
+			m.jumpToMatch(1)
+			return m, nil
+		case "N":
+			m.jumpToMatch(-1)
+			return m, nil
+		case "f":
+			if m.searchTerm != "" {
+				m.filterMode = !m.filterMode
+				m.updateViewport()
+				m.jumpToMatch(0)
 			}
-			return m, tea.Quit
+			return m, nil
+		case "t":
+			m.timestamps = !m.timestamps
+			return m, m.restartLogStream()
+		case "s":
+			m.promptingSince = true
+			m.sinceInput.SetValue(formatSince(m.since))
+			m.sinceInput.Focus()
+			return m, nil
 		case "g", "home":
 			m.viewport.GotoTop()
 		case "G", "end":
@@ -103,30 +178,33 @@ func (m *LogsViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "ctrl+l":
 			// Clear logs
 			m.mu.Lock()
-			m.lines = []string{}
+			m.buffer.Reset()
 			m.mu.Unlock()
 			m.updateViewport()
 		}
 
 	case logsUpdateMsg:
-		m.mu.Lock()
-		m.lines = append(m.lines, msg.lines...)
-		// Keep only last 10000 lines for performance
-		if len(m.lines) > 10000 {
-			m.lines = m.lines[len(m.lines)-10000:]
+		// Ignore lines from a stream that has since been replaced
+		if msg.gen != m.streamGen {
+			return m, nil
 		}
+		m.mu.Lock()
+		m.buffer.Append(msg.lines...)
 		m.mu.Unlock()
 		m.updateViewport()
-		// Auto-scroll to bottom if following
-		if m.follow {
+		// Auto-scroll to bottom if following, unless the user is searching
+		if m.follow && m.searchTerm == "" {
 			m.viewport.GotoBottom()
 		}
 		// Continue fetching if following
 		if m.follow {
-			return m, m.readMoreLogs()
+			return m, m.readMoreLogs(m.logReader, msg.gen)
 		}
 
 	case logsErrorMsg:
+		if msg.gen != m.streamGen {
+			return m, nil
+		}
 		m.errorMsg = msg.err.Error()
 		return m, nil
 	}
@@ -164,10 +242,17 @@ func (m *LogsViewModel) View() string {
 
 	// Footer with controls
 	var footerText string
-	if m.follow {
-		footerText = "q/esc/ctrl+c: stop following • g/G: top/bottom • ctrl+l: clear"
-	} else {
-		footerText = "↑/↓: scroll • g/G: top/bottom • ctrl+l: clear • q/esc: back"
+	switch {
+	case m.searching:
+		footerText = "enter: search • esc: cancel"
+	case m.promptingSince:
+		footerText = "enter: re-stream • esc: cancel"
+	case m.searchTerm != "":
+		footerText = "n/N: next/prev match • f: toggle filter • /: new search • esc: clear search"
+	case m.follow:
+		footerText = "q/esc/ctrl+c: stop following • g/G: top/bottom • /: search • t: timestamps • s: since • ctrl+l: clear"
+	default:
+		footerText = "↑/↓: scroll • g/G: top/bottom • /: search • t: timestamps • s: since • ctrl+l: clear • q/esc: back"
 	}
 	
 	scrollPos := ""
@@ -175,17 +260,35 @@ func (m *LogsViewModel) View() string {
 		scrollPos = fmt.Sprintf(" • Line %d/%d", m.viewport.YOffset+1, m.viewport.TotalLineCount())
 	}
 	
-	footer := components.HelpStyle.Render(footerText + scrollPos)
+	footer := components.HelpStyle.Render(footerText + " • " + m.streamState() + scrollPos)
+	if m.searching {
+		footer = m.searchInput.View() + "\n" + footer
+	} else if m.promptingSince {
+		footer = m.sinceInput.View() + "\n" + footer
+	}
 
 	// Logs count
 	m.mu.Lock()
-	logCount := len(m.lines)
+	logCount := m.buffer.Len()
+	dropped := m.buffer.Dropped()
 	m.mu.Unlock()
 	
 	countText := fmt.Sprintf("Showing %d lines", logCount)
+	if dropped > 0 {
+		countText += fmt.Sprintf(" (%d older lines truncated)", dropped)
+	}
+	if m.searchTerm != "" {
+		countText += fmt.Sprintf(" • %d matches for '%s'", len(m.matches), m.searchTerm)
+		if m.filterMode {
+			countText += " [FILTERED]"
+		}
+	}
 	if m.follow {
 		countText += " • 🔴 Live"
 	}
+	if m.notice != "" {
+		countText += " • " + m.notice
+	}
 	countLine := components.DescriptionStyle.Render(countText)
 
 	// Combine all parts with proper spacing
@@ -202,136 +305,326 @@ func (m *LogsViewModel) updateViewport() {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
+	pattern := searchPattern(m.searchTerm)
+	m.matches = m.matches[:0]
+
 	// Format logs with minimal spacing
 	var formattedLines []string
-	for _, line := range m.lines {
+	for _, line := range m.buffer.Lines() {
 		// Trim excessive whitespace but keep the line structure
 		trimmed := strings.TrimRight(line, " \t")
-		if trimmed != "" {
-			formattedLines = append(formattedLines, trimmed)
+		if trimmed == "" {
+			continue
+		}
+
+		if pattern != nil && pattern.MatchString(trimmed) {
+			style := logMatchStyle
+			if len(m.matches) == m.matchIdx {
+				style = logCurrentMatchStyle
+			}
+			m.matches = append(m.matches, len(formattedLines))
+			trimmed = highlightMatches(trimmed, pattern, func(match string) string {
+				return style.Render(match)
+			})
+		} else if pattern != nil && m.filterMode {
+			continue
 		}
+
+		formattedLines = append(formattedLines, trimmed)
 	}
 	
 	content := strings.Join(formattedLines, "\n")
 	m.viewport.SetContent(content)
 }
 
+// quit stops log streaming and leaves the view
+func (m *LogsViewModel) quit() tea.Cmd {
+	m.quitting = true
+	m.cancel() // Cancel log streaming
+	if m.logStream != nil {
+		m.logStream.Close()
+	}
+	return tea.Quit
+}
+
+// updateSearchInput handles keys while the search input has focus
+func (m *LogsViewModel) updateSearchInput(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "enter":
+		m.searching = false
+		m.searchInput.Blur()
+		m.searchTerm = m.searchInput.Value
+		m.matchIdx = 0
+		if m.searchTerm == "" {
+			m.filterMode = false
+		}
+		m.updateViewport()
+		m.jumpToMatch(0)
+	case "esc":
+		m.searching = false
+		m.searchInput.Blur()
+	default:
+		return m.searchInput.Update(msg)
+	}
+	return nil
+}
+
+// clearSearch removes the search term and any filtering
+func (m *LogsViewModel) clearSearch() {
+	m.searchTerm = ""
+	m.filterMode = false
+	m.matchIdx = 0
+	m.updateViewport()
+}
+
+// jumpToMatch moves delta matches from the current one, wrapping around, and
+// scrolls it into the middle of the viewport
+func (m *LogsViewModel) jumpToMatch(delta int) {
+	if len(m.matches) == 0 {
+		return
+	}
+
+	m.matchIdx = (m.matchIdx + delta + len(m.matches)) % len(m.matches)
+	m.updateViewport()
+
+	offset := m.matches[m.matchIdx] - m.viewport.Height/2
+	if offset < 0 {
+		offset = 0
+	}
+	m.viewport.SetYOffset(offset)
+}
+
 // initLogStream initializes the log stream
 func (m *LogsViewModel) initLogStream() tea.Cmd {
+	ctx := m.ctx
+	gen := m.streamGen
+	opts := m.podLogOptions()
+
 	return func() tea.Msg {
 		client, err := services.GetK8sClient()
 		if err != nil {
-			return logsErrorMsg{err: err}
-		}
-
-		// Build log options
-		opts := &corev1.PodLogOptions{
-			Follow: m.follow,
-		}
-		
-		if m.container != "" {
-			opts.Container = m.container
-		}
-		
-		if !m.follow {
-			// For static logs, get last 1000 lines
-			tailLines := int64(1000)
-			opts.TailLines = &tailLines
+			return logsErrorMsg{err: err, gen: gen}
 		}
 
 		// Get log stream
 		req := client.Clientset.CoreV1().Pods(m.namespace).GetLogs(m.podName, opts)
-		stream, err := req.Stream(m.ctx)
+		stream, err := req.Stream(ctx)
 		if err != nil {
-			return logsErrorMsg{err: err}
+			return logsErrorMsg{err: err, gen: gen}
 		}
 
 		// Store stream and reader for later use
+		reader := bufio.NewReader(stream)
 		m.logStream = stream
-		m.logReader = bufio.NewReader(stream)
+		m.logReader = reader
 
 		// Start reading logs
 		if m.follow {
-			return m.readMoreLogs()()
+			return m.readMoreLogs(reader, gen)()
 		} else {
-			return m.readAllLogs()()
+			return m.readAllLogs(reader, stream, gen)()
 		}
 	}
 }
 
-// readAllLogs reads all logs for non-follow mode
-func (m *LogsViewModel) readAllLogs() tea.Cmd {
-	return func() tea.Msg {
-		if m.logReader == nil {
-			return logsErrorMsg{err: fmt.Errorf("log reader not initialized")}
+// podLogOptions builds the log request from the current view settings
+func (m *LogsViewModel) podLogOptions() *corev1.PodLogOptions {
+	opts := &corev1.PodLogOptions{
+		Follow:     m.follow,
+		Timestamps: m.timestamps,
+	}
+
+	if m.container != "" {
+		opts.Container = m.container
+	}
+
+	if !m.follow {
+		// For static logs, get last 1000 lines
+		tailLines := int64(1000)
+		opts.TailLines = &tailLines
+	}
+
+	if m.since > 0 {
+		sinceSeconds := int64(m.since.Seconds())
+		opts.SinceSeconds = &sinceSeconds
+	}
+
+	return opts
+}
+
+// restartLogStream replaces the current stream with one using the current
+// options, discarding the lines already shown
+func (m *LogsViewModel) restartLogStream() tea.Cmd {
+	m.cancel()
+	if m.logStream != nil {
+		m.logStream.Close()
+	}
+
+	m.ctx, m.cancel = context.WithCancel(context.Background())
+	m.streamGen++
+	m.errorMsg = ""
+
+	m.mu.Lock()
+	m.buffer.Reset()
+	m.mu.Unlock()
+	m.updateViewport()
+
+	return m.initLogStream()
+}
+
+// updateSinceInput handles keys while the since prompt has focus
+func (m *LogsViewModel) updateSinceInput(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "enter":
+		m.promptingSince = false
+		m.sinceInput.Blur()
+
+		since, err := parseSince(m.sinceInput.Value)
+		if err != nil {
+			m.notice = err.Error()
+			return nil
 		}
+		m.notice = ""
+		m.since = since
+		return m.restartLogStream()
+	case "esc":
+		m.promptingSince = false
+		m.sinceInput.Blur()
+	default:
+		return m.sinceInput.Update(msg)
+	}
+	return nil
+}
+
+// streamState describes the active stream options for the footer
+func (m *LogsViewModel) streamState() string {
+	timestamps := "off"
+	if m.timestamps {
+		timestamps = "on"
+	}
+	since := "all"
+	if m.since > 0 {
+		since = formatSince(m.since)
+	}
+	return fmt.Sprintf("timestamps: %s • since: %s", timestamps, since)
+}
 
+// readAllLogs reads all logs for non-follow mode
+func (m *LogsViewModel) readAllLogs(reader *bufio.Reader, stream io.Closer, gen int) tea.Cmd {
+	return func() tea.Msg {
 		var allLines []string
 		for {
-			line, err := m.logReader.ReadString('\n')
+			line, err := reader.ReadString('\n')
 			if err != nil {
 				if err == io.EOF {
 					break
 				}
-				return logsErrorMsg{err: err}
+				return logsErrorMsg{err: err, gen: gen}
 			}
 			// Remove newline
 			line = strings.TrimSuffix(line, "\n")
 			allLines = append(allLines, line)
 		}
-		
+
 		// Close the stream for non-follow mode
-		if m.logStream != nil {
-			m.logStream.Close()
-		}
-		
-		return logsUpdateMsg{lines: allLines}
+		stream.Close()
+
+		return logsUpdateMsg{lines: allLines, gen: gen}
 	}
 }
 
 // readMoreLogs reads more logs for follow mode
-func (m *LogsViewModel) readMoreLogs() tea.Cmd {
+func (m *LogsViewModel) readMoreLogs(reader *bufio.Reader, gen int) tea.Cmd {
+	ctx := m.ctx
+
 	return func() tea.Msg {
-		if m.logReader == nil {
-			return logsErrorMsg{err: fmt.Errorf("log reader not initialized")}
+		if reader == nil {
+			return logsErrorMsg{err: fmt.Errorf("log reader not initialized"), gen: gen}
 		}
 
 		var newLines []string
 		for {
 			select {
-			case <-m.ctx.Done():
+			case <-ctx.Done():
 				return nil
 			default:
-				// Set a read deadline to avoid blocking forever
-				line, err := m.logReader.ReadString('\n')
+				line, err := reader.ReadString('\n')
 				if err != nil {
 					if err == io.EOF {
 						// If we have some lines, send them
 						if len(newLines) > 0 {
-							return logsUpdateMsg{lines: newLines}
+							return logsUpdateMsg{lines: newLines, gen: gen}
 						}
 						// Otherwise, wait a bit and try again
 						time.Sleep(100 * time.Millisecond)
-						return m.readMoreLogs()()
+						continue
+					}
+					if ctx.Err() != nil {
+						return nil
 					}
-					return logsErrorMsg{err: err}
+					return logsErrorMsg{err: err, gen: gen}
 				}
-				
+
 				// Remove newline
 				line = strings.TrimSuffix(line, "\n")
 				if line != "" {
 					newLines = append(newLines, line)
 				}
-				
+
 				// Send batch updates for better performance
 				if len(newLines) >= 10 {
-					return logsUpdateMsg{lines: newLines}
+					return logsUpdateMsg{lines: newLines, gen: gen}
 				}
 			}
 		}
 	}
 }
 
+// parseSince parses a since duration such as "5m" or "1h"; empty means no limit
+func parseSince(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, nil
+	}
+	since, err := time.ParseDuration(value)
+	if err != nil || since < 0 {
+		return 0, fmt.Errorf("invalid since duration %q (use e.g. 5m, 1h)", value)
+	}
+	return since, nil
+}
+
+// formatSince formats a since duration compactly, e.g. "5m" rather than "5m0s"
+func formatSince(since time.Duration) string {
+	if since <= 0 {
+		return ""
+	}
+	s := since.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = strings.TrimSuffix(s, "0s")
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+	return s
+}
+
+// searchPattern compiles a case-insensitive literal pattern for term, or
+// returns nil when there is nothing to search for
+func searchPattern(term string) *regexp.Regexp {
+	if term == "" {
+		return nil
+	}
+	return regexp.MustCompile("(?i)" + regexp.QuoteMeta(term))
+}
+
+// highlightMatches wraps every match of pattern in line with mark
+func highlightMatches(line string, pattern *regexp.Regexp, mark func(string) string) string {
+	if pattern == nil {
+		return line
+	}
+	return pattern.ReplaceAllStringFunc(line, mark)
+}
+
 // ShowLogsView shows the logs viewer
 func ShowLogsView(namespace, podName, container string, follow bool) tea.Cmd {
 	return func() tea.Msg {
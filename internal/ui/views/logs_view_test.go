@@ -0,0 +1,52 @@
+package views
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHighlightMatches(t *testing.T) {
+	mark := func(s string) string { return "[" + s + "]" }
+
+	assert.Equal(t, "GET [/api] 200 [/API]", highlightMatches("GET /api 200 /API", searchPattern("/api"), mark))
+	assert.Equal(t, "a[.]b", highlightMatches("a.b", searchPattern("."), mark))
+	assert.Equal(t, "no match", highlightMatches("no match", searchPattern("xyz"), mark))
+	assert.Equal(t, "unchanged", highlightMatches("unchanged", searchPattern(""), mark))
+}
+
+func TestParseSince(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected time.Duration
+		err      bool
+	}{
+		{input: "", expected: 0},
+		{input: "5m", expected: 5 * time.Minute},
+		{input: " 1h ", expected: time.Hour},
+		{input: "1h30m", expected: 90 * time.Minute},
+		{input: "yesterday", err: true},
+		{input: "-5m", err: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			since, err := parseSince(tc.input)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, since)
+		})
+	}
+}
+
+func TestFormatSince(t *testing.T) {
+	assert.Equal(t, "", formatSince(0))
+	assert.Equal(t, "5m", formatSince(5*time.Minute))
+	assert.Equal(t, "1h", formatSince(time.Hour))
+	assert.Equal(t, "1h30m", formatSince(90*time.Minute))
+	assert.Equal(t, "45s", formatSince(45*time.Second))
+}
@@ -1,14 +1,22 @@
 package views
 
 import (
+	"bufio"
 	"context"
 	"fmt"
+	"io"
+	"os"
 	"os/exec"
+	"os/signal"
+	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/karthickk/k8s-manager/internal/services"
 	"github.com/karthickk/k8s-manager/internal/ui/components"
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/karthickk/k8s-manager/pkg/ui"
+	"github.com/karthickk/k8s-manager/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -24,6 +32,7 @@ type PodActionsModel struct {
 	quitting        bool
 	executing       bool
 	currentAction   string
+	deployment      string // Owning deployment, empty if none
 }
 
 // ShowPodActionsView shows the pod actions menu
@@ -84,6 +93,14 @@ func ShowPodActionsView(namespace, name string) (tea.Model, tea.Cmd) {
 			Icon:        "🔄",
 			Shortcut:    "r",
 		},
+		{
+			ID:          "restart-deployment",
+			Title:       "Restart Deployment",
+			Description: "Resolving owning deployment...",
+			Icon:        "♻️",
+			Shortcut:    "R",
+			Disabled:    true,
+		},
 		{
 			ID:          "delete",
 			Title:       "Delete Pod",
@@ -128,6 +145,8 @@ func ShowPodActionsView(namespace, name string) (tea.Model, tea.Cmd) {
 			item.Action = model.manageEnv
 		case "restart":
 			item.Action = model.restartPod
+		case "restart-deployment":
+			item.Action = model.restartDeployment
 		case "delete":
 			item.Action = model.deletePod
 		case "back":
@@ -176,7 +195,9 @@ func (m *PodActionsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case podLoadedMsg:
 		m.pod = msg.pod
+		m.deployment = msg.deployment
 		m.loading = false
+		m.updateRestartDeploymentItem()
 		// Update menu title with pod status
 		if m.pod != nil {
 			status := services.GetPodStatus(m.pod)
@@ -249,6 +270,12 @@ func (m *PodActionsModel) View() string {
 
 // handleAction handles menu item selection
 func (m *PodActionsModel) handleAction(actionID string) (tea.Model, tea.Cmd) {
+	for _, item := range m.menu.Items {
+		if item.ID == actionID && item.Disabled {
+			return m, nil
+		}
+	}
+
 	switch actionID {
 	case "describe":
 		m.executing = true
@@ -278,6 +305,10 @@ func (m *PodActionsModel) handleAction(actionID string) (tea.Model, tea.Cmd) {
 		m.executing = true
 		m.currentAction = "Restart Pod"
 		return m, m.restartPod()
+	case "restart-deployment":
+		m.executing = true
+		m.currentAction = "Restart Deployment"
+		return m, m.restartDeployment()
 	case "delete":
 		m.executing = true
 		m.currentAction = "Delete Pod"
@@ -291,8 +322,9 @@ func (m *PodActionsModel) handleAction(actionID string) (tea.Model, tea.Cmd) {
 // Pod action implementations
 
 type podLoadedMsg struct {
-	pod *corev1.Pod
-	err error
+	pod        *corev1.Pod
+	deployment string
+	err        error
 }
 
 func (m *PodActionsModel) loadPod() tea.Msg {
@@ -304,7 +336,28 @@ func (m *PodActionsModel) loadPod() tea.Msg {
 		return podLoadedMsg{err: err}
 	}
 
-	return podLoadedMsg{pod: pod}
+	// A failed lookup only disables the deployment restart action
+	deployment, _ := m.client.GetPodDeployment(ctx, pod)
+
+	return podLoadedMsg{pod: pod, deployment: deployment}
+}
+
+// updateRestartDeploymentItem enables the deployment restart action only when
+// the pod is managed by a Deployment
+func (m *PodActionsModel) updateRestartDeploymentItem() {
+	for i := range m.menu.Items {
+		item := &m.menu.Items[i]
+		if item.ID != "restart-deployment" {
+			continue
+		}
+		if m.deployment == "" {
+			item.Disabled = true
+			item.Description = "Unavailable: pod is not managed by a Deployment"
+		} else {
+			item.Disabled = false
+			item.Description = fmt.Sprintf("Rolling restart of deployment '%s'", m.deployment)
+		}
+	}
 }
 
 func (m *PodActionsModel) describePod() tea.Cmd {
@@ -316,7 +369,7 @@ func (m *PodActionsModel) viewLogs() tea.Cmd {
 		// Get container name if multiple containers
 		container := ""
 		if m.pod != nil && len(m.pod.Spec.Containers) > 1 {
-			container = m.pod.Spec.Containers[0].Name
+			container = utils.PrimaryContainer(m.pod)
 		}
 		
 		// Show logs view
@@ -334,7 +387,7 @@ func (m *PodActionsModel) followLogs() tea.Cmd {
 		// Get container name if multiple containers
 		container := ""
 		if m.pod != nil && len(m.pod.Spec.Containers) > 1 {
-			container = m.pod.Spec.Containers[0].Name
+			container = utils.PrimaryContainer(m.pod)
 		}
 		
 		// Show logs view with follow mode
@@ -363,9 +416,9 @@ func (m *PodActionsModel) getExecCommand() string {
 	
 	// Check for multiple containers
 	if m.pod != nil && len(m.pod.Spec.Containers) > 1 {
-		// For now, use the first container
+		// Use the primary container, skipping sidecars such as mesh proxies
 		// TODO: Add container selection UI
-		baseCmd += fmt.Sprintf(" -c %s", m.pod.Spec.Containers[0].Name)
+		baseCmd += fmt.Sprintf(" -c %s", utils.PrimaryContainer(m.pod))
 	}
 	
 	// Try bash first, fall back to sh
@@ -375,24 +428,52 @@ func (m *PodActionsModel) getExecCommand() string {
 }
 
 func (m *PodActionsModel) portForward() tea.Cmd {
-	return func() tea.Msg {
-		// TODO: Add proper UI for port input
-		// For now, use common ports
-		localPort := 8080
-		remotePort := 80
-		
-		cmd := fmt.Sprintf("echo 'Port forwarding %d:%d... Press Ctrl+C to stop' && kubectl port-forward pod/%s %d:%d -n %s",
-			localPort, remotePort, m.name, localPort, remotePort, m.namespace)
-		
-		return tea.ExecProcess(exec.Command("bash", "-c", cmd), func(err error) tea.Msg {
-			if err != nil {
-				return components.ErrorMsg{Error: err}
-			}
-			return actionCompletedMsg{}
-		})()
+	// TODO: Add proper UI for port input
+	// For now, use common ports
+	forward := &portForwardExec{
+		client:    &k8s.Client{Clientset: m.client.Clientset, Config: m.client.Config},
+		namespace: m.namespace,
+		name:      m.name,
+		ports:     []string{"8080:80"},
+		stdout:    os.Stdout,
+		stderr:    os.Stderr,
 	}
+	return tea.Exec(forward, func(err error) tea.Msg {
+		if err != nil {
+			return components.ErrorMsg{Error: err}
+		}
+		return actionCompletedMsg{}
+	})
+}
+
+// portForwardExec forwards ports to a pod through tea.Exec, which hands it
+// the terminal, until Ctrl+C is pressed
+type portForwardExec struct {
+	client    *k8s.Client
+	namespace string
+	name      string
+	ports     []string
+	stdout    io.Writer
+	stderr    io.Writer
 }
 
+func (e *portForwardExec) Run() error {
+	fmt.Fprintf(e.stdout, "Port forwarding %s to pod %s... Press Ctrl+C to stop\n", strings.Join(e.ports, ", "), e.name)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	opts := k8s.DefaultReconnectOptions()
+	opts.Notify = func(message string) {
+		fmt.Fprintf(e.stderr, "🔄 %s\n", message)
+	}
+	return e.client.ForwardWithReconnect(ctx, e.namespace, e.name, opts, e.client.Forwarder(e.namespace, e.ports, e.stdout, e.stderr))
+}
+
+func (e *portForwardExec) SetStdin(io.Reader)    {}
+func (e *portForwardExec) SetStdout(w io.Writer) { e.stdout = w }
+func (e *portForwardExec) SetStderr(w io.Writer) { e.stderr = w }
+
 func (m *PodActionsModel) manageEnv() tea.Cmd {
 	return func() tea.Msg {
 		// Show environment variable manager
@@ -406,68 +487,155 @@ func (m *PodActionsModel) manageEnv() tea.Cmd {
 }
 
 func (m *PodActionsModel) restartPod() tea.Cmd {
-	return func() tea.Msg {
-		// TODO: Show confirmation dialog
-		// dialog := components.NewConfirmDialog(
-		//     "Restart Pod",
-		//     fmt.Sprintf("Are you sure you want to restart pod '%s'?", m.name),
-		// )
-		
-		// TODO: Show dialog and handle confirmation
-		
-		// For now, just delete the pod
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-		defer cancel()
-		
-		gracePeriod := int64(30)
-		err := m.client.Clientset.CoreV1().Pods(m.namespace).Delete(ctx, m.name, metav1.DeleteOptions{
-			GracePeriodSeconds: &gracePeriod,
-		})
-		
+	restart := m.newPodDeletionExec("restart", 30)
+	return tea.Exec(restart, func(err error) tea.Msg {
 		if err != nil {
-			fmt.Printf("Error restarting pod: %v\n", err)
-		} else {
-			fmt.Println("Pod restart initiated successfully")
+			return components.ErrorMsg{Error: err}
 		}
-		
-		fmt.Println("\nPress Enter to continue...")
-		fmt.Scanln()
-		
 		return actionCompletedMsg{}
+	})
+}
+
+func (m *PodActionsModel) restartDeployment() tea.Cmd {
+	restart := &deploymentRestartExec{
+		client:    m.client,
+		namespace: m.namespace,
+		name:      m.deployment,
+		stdin:     os.Stdin,
+		stdout:    os.Stdout,
 	}
+	return tea.Exec(restart, func(err error) tea.Msg {
+		if err != nil {
+			return components.ErrorMsg{Error: err}
+		}
+		if restart.restarted {
+			// The selected pod is replaced by the rollout
+			return tea.Quit()
+		}
+		return actionCompletedMsg{}
+	})
 }
 
-func (m *PodActionsModel) deletePod() tea.Cmd {
-	return func() tea.Msg {
-		// Show confirmation dialog
-		fmt.Printf("Are you sure you want to delete pod '%s'? [y/N]: ", m.name)
-		
-		var response string
-		fmt.Scanln(&response)
-		
-		if response != "y" && response != "Y" {
-			fmt.Println("Deletion cancelled")
-			time.Sleep(1 * time.Second)
-			return nil
+// deploymentRestartExec confirms and restarts a deployment through tea.Exec,
+// which hands it the terminal, optionally waiting for the rollout
+type deploymentRestartExec struct {
+	client    *services.K8sClient
+	namespace string
+	name      string
+	restarted bool
+	stdin     io.Reader
+	stdout    io.Writer
+}
+
+func (e *deploymentRestartExec) Run() error {
+	// One reader for every answer, so none is lost to another's buffer
+	reader := bufio.NewReader(e.stdin)
+	confirmed, err := ui.ConfirmDestructiveOn(reader, e.stdout, "restart", "deployment", e.namespace, e.name, false)
+	if err != nil || !confirmed {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := e.client.RestartDeployment(ctx, e.namespace, e.name); err != nil {
+		return err
+	}
+	e.restarted = true
+	fmt.Fprintf(e.stdout, "Deployment '%s' restart initiated\n", e.name)
+
+	fmt.Fprint(e.stdout, "Wait for the rollout to finish? [y/N]: ")
+	if answer, _ := reader.ReadString('\n'); strings.EqualFold(strings.TrimSpace(answer), "y") {
+		fmt.Fprintln(e.stdout, "Waiting for rollout...")
+		waitCtx, waitCancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer waitCancel()
+
+		if err := e.client.WaitForDeploymentRollout(waitCtx, e.namespace, e.name); err != nil {
+			fmt.Fprintf(e.stdout, "Error: %v\n", err)
+		} else {
+			fmt.Fprintf(e.stdout, "Deployment '%s' successfully rolled out\n", e.name)
 		}
-		
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-		defer cancel()
-		
-		gracePeriod := int64(0)
-		err := m.client.Clientset.CoreV1().Pods(m.namespace).Delete(ctx, m.name, metav1.DeleteOptions{
-			GracePeriodSeconds: &gracePeriod,
-		})
-		
+	}
+
+	fmt.Fprint(e.stdout, "\nPress Enter to continue...")
+	reader.ReadString('\n')
+	return nil
+}
+
+func (e *deploymentRestartExec) SetStdin(r io.Reader)  { e.stdin = r }
+func (e *deploymentRestartExec) SetStdout(w io.Writer) { e.stdout = w }
+func (e *deploymentRestartExec) SetStderr(io.Writer)   {}
+
+func (m *PodActionsModel) deletePod() tea.Cmd {
+	removal := m.newPodDeletionExec("delete", 0)
+	return tea.Exec(removal, func(err error) tea.Msg {
 		if err != nil {
-			fmt.Printf("Error deleting pod: %v\n", err)
+			return components.ErrorMsg{Error: err}
+		}
+		if removal.deleted {
+			// The pod this view shows is gone
+			return tea.Quit()
+		}
+		return actionCompletedMsg{}
+	})
+}
+
+func (m *PodActionsModel) newPodDeletionExec(action string, gracePeriod int64) *podDeletionExec {
+	return &podDeletionExec{
+		client:      m.client,
+		namespace:   m.namespace,
+		name:        m.name,
+		action:      action,
+		gracePeriod: gracePeriod,
+		stdin:       os.Stdin,
+		stdout:      os.Stdout,
+	}
+}
+
+// podDeletionExec confirms and deletes a pod through tea.Exec, which hands it
+// the terminal. A restart is a deletion the pod's controller recovers from.
+type podDeletionExec struct {
+	client      *services.K8sClient
+	namespace   string
+	name        string
+	action      string
+	gracePeriod int64
+	deleted     bool
+	stdin       io.Reader
+	stdout      io.Writer
+}
+
+func (e *podDeletionExec) Run() error {
+	// One reader for every answer, so none is lost to another's buffer
+	reader := bufio.NewReader(e.stdin)
+	confirmed, err := ui.ConfirmDestructiveOn(reader, e.stdout, e.action, "pod", e.namespace, e.name, false)
+	if err != nil || !confirmed {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	gracePeriod := e.gracePeriod
+	err = e.client.Clientset.CoreV1().Pods(e.namespace).Delete(ctx, e.name, metav1.DeleteOptions{
+		GracePeriodSeconds: &gracePeriod,
+	})
+	if err != nil {
+		fmt.Fprintf(e.stdout, "Error: failed to %s pod: %v\n", e.action, err)
+	} else {
+		e.deleted = true
+		if e.action == "restart" {
+			fmt.Fprintln(e.stdout, "Pod restart initiated successfully")
 		} else {
-			fmt.Println("Pod deleted successfully")
+			fmt.Fprintln(e.stdout, "Pod deleted successfully")
 		}
-		
-		fmt.Println("\nPress Enter to continue...")
-		fmt.Scanln()
-		
-		return tea.Quit
 	}
-}
\ No newline at end of file
+
+	fmt.Fprint(e.stdout, "\nPress Enter to continue...")
+	reader.ReadString('\n')
+	return nil
+}
+
+func (e *podDeletionExec) SetStdin(r io.Reader)  { e.stdin = r }
+func (e *podDeletionExec) SetStdout(w io.Writer) { e.stdout = w }
+func (e *podDeletionExec) SetStderr(io.Writer)   {}
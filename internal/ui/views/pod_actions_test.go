@@ -0,0 +1,86 @@
+package views
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/karthickk/k8s-manager/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestDeploymentRestartExecConfirmsOnItsOwnTerminal(t *testing.T) {
+	var patches []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPatch {
+			patches = append(patches, r.URL.Path)
+		}
+		w.Write([]byte(`{"kind":"Deployment","apiVersion":"apps/v1","metadata":{"name":"web","namespace":"shop"}}`))
+	}))
+	t.Cleanup(server.Close)
+	config := &rest.Config{Host: server.URL}
+	clientset, err := kubernetes.NewForConfig(config)
+	require.NoError(t, err)
+	client := &services.K8sClient{Clientset: clientset, Config: config}
+
+	declined := &deploymentRestartExec{client: client, namespace: "shop", name: "web"}
+	out := new(bytes.Buffer)
+	declined.SetStdin(strings.NewReader("n\n"))
+	declined.SetStdout(out)
+	require.NoError(t, declined.Run())
+	assert.False(t, declined.restarted)
+	assert.Contains(t, out.String(), "restart deployment 'web' in namespace 'shop'")
+	assert.Empty(t, patches)
+
+	// The confirmation, the rollout question and the final Enter all come
+	// from the same terminal
+	confirmed := &deploymentRestartExec{client: client, namespace: "shop", name: "web"}
+	out.Reset()
+	confirmed.SetStdin(strings.NewReader("y\nn\n\n"))
+	confirmed.SetStdout(out)
+	require.NoError(t, confirmed.Run())
+	assert.True(t, confirmed.restarted)
+	assert.Equal(t, []string{"/apis/apps/v1/namespaces/shop/deployments/web"}, patches)
+	assert.Contains(t, out.String(), "Wait for the rollout to finish?")
+	assert.NotContains(t, out.String(), "Waiting for rollout")
+}
+
+func TestPodDeletionExecConfirmsOnItsOwnTerminal(t *testing.T) {
+	var deletes []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodDelete {
+			deletes = append(deletes, r.URL.Path)
+		}
+		w.Write([]byte(`{"kind":"Pod","apiVersion":"v1","metadata":{"name":"web-1","namespace":"shop"}}`))
+	}))
+	t.Cleanup(server.Close)
+	config := &rest.Config{Host: server.URL}
+	clientset, err := kubernetes.NewForConfig(config)
+	require.NoError(t, err)
+	model := &PodActionsModel{client: &services.K8sClient{Clientset: clientset, Config: config}, namespace: "shop", name: "web-1"}
+
+	declined := model.newPodDeletionExec("delete", 0)
+	out := new(bytes.Buffer)
+	declined.SetStdin(strings.NewReader("n\n"))
+	declined.SetStdout(out)
+	require.NoError(t, declined.Run())
+	assert.False(t, declined.deleted)
+	assert.Contains(t, out.String(), "delete pod 'web-1' in namespace 'shop'")
+	assert.Empty(t, deletes)
+
+	confirmed := model.newPodDeletionExec("restart", 30)
+	out.Reset()
+	confirmed.SetStdin(strings.NewReader("y\n\n"))
+	confirmed.SetStdout(out)
+	require.NoError(t, confirmed.Run())
+	assert.True(t, confirmed.deleted)
+	assert.Contains(t, out.String(), "restart pod 'web-1' in namespace 'shop'")
+	assert.Equal(t, []string{"/api/v1/namespaces/shop/pods/web-1"}, deletes)
+}
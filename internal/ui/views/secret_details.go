@@ -1,8 +1,10 @@
 package views
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -277,20 +279,8 @@ func (m *SecretDetailsModel) updateViewport() {
 
 	var content string
 	if m.showDecoded {
-		// Show decoded content
-		decoded := string(data)
-		
-		// Format based on content type
-		if strings.Contains(m.selectedKey, "json") || isJSON(decoded) {
-			// Pretty print JSON if possible
-			content = formatJSON(decoded)
-		} else if strings.Contains(m.selectedKey, "yaml") || strings.Contains(m.selectedKey, "yml") {
-			// Keep YAML formatting
-			content = decoded
-		} else {
-			// Regular text
-			content = decoded
-		}
+		// Pretty print and highlight JSON and YAML values
+		content = highlightContent(m.selectedKey, string(data))
 	} else {
 		// Show base64 encoded content with line breaks
 		encoded := base64.StdEncoding.EncodeToString(data)
@@ -303,15 +293,10 @@ func (m *SecretDetailsModel) updateViewport() {
 			}
 			lines = append(lines, encoded[i:end])
 		}
-		content = strings.Join(lines, "\n")
+		content = syntaxTextStyle.Render(strings.Join(lines, "\n"))
 	}
 
-	// Add some styling
-	styledContent := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("86")).
-		Render(content)
-
-	m.viewport.SetContent(styledContent)
+	m.viewport.SetContent(content)
 }
 
 // loadSecret loads the secret details
@@ -334,22 +319,25 @@ func (m *SecretDetailsModel) loadSecret() tea.Msg {
 
 // Helper functions
 
+// isJSON reports whether a value is a JSON object or array
 func isJSON(str string) bool {
 	str = strings.TrimSpace(str)
-	return (strings.HasPrefix(str, "{") && strings.HasSuffix(str, "}")) ||
-		(strings.HasPrefix(str, "[") && strings.HasSuffix(str, "]"))
+	return (strings.HasPrefix(str, "{") || strings.HasPrefix(str, "[")) && json.Valid([]byte(str))
 }
 
+// formatJSON indents JSON by two spaces, returning the value unchanged when
+// it isn't valid JSON
 func formatJSON(jsonStr string) string {
-	// Simple JSON formatter - in production, use encoding/json
-	// For now, just add some basic indentation
-	result := jsonStr
-	result = strings.ReplaceAll(result, ",", ",\n  ")
-	result = strings.ReplaceAll(result, "{", "{\n  ")
-	result = strings.ReplaceAll(result, "}", "\n}")
-	result = strings.ReplaceAll(result, "[", "[\n  ")
-	result = strings.ReplaceAll(result, "]", "\n]")
-	return result
+	trimmed := []byte(strings.TrimSpace(jsonStr))
+	if !json.Valid(trimmed) {
+		return jsonStr
+	}
+
+	var out bytes.Buffer
+	if err := json.Indent(&out, trimmed, "", "  "); err != nil {
+		return jsonStr
+	}
+	return out.String()
 }
 
 // ShowSecretDetails shows the secret details view
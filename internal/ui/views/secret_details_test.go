@@ -0,0 +1,48 @@
+package views
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatJSON(t *testing.T) {
+	// Commas, braces and brackets inside string literals used to be split
+	// onto new lines, corrupting the value
+	value := `{"dsn":"host=db,port=5432","template":"{{ .Name }} [x]","nested":{"list":[1,{"a":"b"}]}}`
+
+	formatted := formatJSON(value)
+	assert.Equal(t, `{
+  "dsn": "host=db,port=5432",
+  "template": "{{ .Name }} [x]",
+  "nested": {
+    "list": [
+      1,
+      {
+        "a": "b"
+      }
+    ]
+  }
+}`, formatted)
+
+	var original, roundTrip any
+	require.NoError(t, json.Unmarshal([]byte(value), &original))
+	require.NoError(t, json.Unmarshal([]byte(formatted), &roundTrip))
+	assert.Equal(t, original, roundTrip)
+}
+
+func TestFormatJSONKeepsInvalidContent(t *testing.T) {
+	for _, value := range []string{"{not json}", `{"a": 1,}`, "[1, 2", "plain, text"} {
+		assert.Equal(t, value, formatJSON(value))
+	}
+}
+
+func TestIsJSON(t *testing.T) {
+	assert.True(t, isJSON(` {"a": "}"} `))
+	assert.True(t, isJSON("[1, 2]"))
+	assert.False(t, isJSON("{not json}"))
+	assert.False(t, isJSON("42"))
+	assert.False(t, isJSON(`"quoted"`))
+}
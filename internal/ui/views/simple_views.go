@@ -3,6 +3,7 @@ package views
 import (
 	"context"
 	"fmt"
+	"io"
 	"strings"
 	"time"
 
@@ -10,6 +11,8 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/karthickk/k8s-manager/internal/services"
 	"github.com/karthickk/k8s-manager/internal/ui/components"
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/karthickk/k8s-manager/pkg/ui"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -266,9 +269,26 @@ func (m *ConfigMapsViewModelSimple) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.loading = true
 			return m, m.fetchConfigMaps
 		case "a":
-			// TODO: Add new ConfigMap
+			create := &configMapCreatorExec{namespace: services.GetCurrentNamespace()}
+			// Create in the cluster this view lists from
+			if m.client != nil {
+				create.client = &k8s.Client{Clientset: m.client.Clientset, Config: m.client.Config}
+			}
+			return m, tea.Exec(create, func(err error) tea.Msg {
+				return configMapCreatorDoneMsg{created: create.created, err: err}
+			})
+		}
+
+	case configMapCreatorDoneMsg:
+		if msg.err != nil {
+			m.err = msg.err
 			return m, nil
 		}
+		if msg.created {
+			m.loading = true
+			return m, m.fetchConfigMaps
+		}
+		return m, nil
 
 	case configMapsFetchedMsg:
 		m.loading = false
@@ -366,6 +386,30 @@ func (m *ConfigMapsViewModelSimple) updateList() {
 	m.list.SetHelpText("enter: view details • a: add new • r: refresh • esc/b: back • ctrl+c: quit")
 }
 
+// configMapCreatorDoneMsg is sent when the config map creator exits
+type configMapCreatorDoneMsg struct {
+	created bool
+	err     error
+}
+
+// configMapCreatorExec runs the config map creator through tea.Exec, which
+// hands it the terminal
+type configMapCreatorExec struct {
+	client    *k8s.Client
+	namespace string
+	created   bool
+}
+
+func (e *configMapCreatorExec) Run() error {
+	created, err := ui.ShowConfigMapCreator(e.client, e.namespace)
+	e.created = created
+	return err
+}
+
+func (e *configMapCreatorExec) SetStdin(io.Reader)  {}
+func (e *configMapCreatorExec) SetStdout(io.Writer) {}
+func (e *configMapCreatorExec) SetStderr(io.Writer) {}
+
 // SecretsViewModelSimple is a simplified secrets view
 type SecretsViewModelSimple struct {
 	client  *services.K8sClient
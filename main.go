@@ -3,9 +3,13 @@ package main
 import (
 	"fmt"
 
+	"github.com/karthickk/k8s-manager/cmd"
+
 	"github.com/karthickk/k8s-manager/internal/commands"
 	"github.com/karthickk/k8s-manager/internal/commands/pods"
+	"github.com/karthickk/k8s-manager/internal/services"
 	"github.com/karthickk/k8s-manager/internal/ui/views"
+	"github.com/karthickk/k8s-manager/pkg/config"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -22,10 +26,12 @@ func main() {
 func registerCommands() {
 	// Pod management
 	commands.AddCommand(pods.NewPodsCmd())
+
+	// ConfigMap management
+	commands.AddCommand(cmd.NewConfigMapsCmd())
 	
 	// TODO: Add more commands as they are implemented
 	// commands.AddCommand(secrets.NewSecretsCmd())
-	// commands.AddCommand(configmaps.NewConfigMapsCmd()) 
 	// commands.AddCommand(namespaces.NewNamespacesCmd())
 
 	// Interactive mode command
@@ -71,7 +77,7 @@ func newConfigGetContextCmd() *cobra.Command {
 		Use:   "get-context",
 		Short: "Get current Kubernetes context",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			fmt.Println("Current context:", viper.GetString("context"))
+			fmt.Println("Current context:", viper.GetString(config.ContextSetting))
 			return nil
 		},
 	}
@@ -83,7 +89,8 @@ func newConfigSetContextCmd() *cobra.Command {
 		Short: "Set Kubernetes context",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			viper.Set("context", args[0])
+			// The same key the other CLI and the UI connect with
+			viper.Set(config.ContextSetting, args[0])
 			return viper.WriteConfig()
 		},
 	}
@@ -94,7 +101,7 @@ func newConfigGetNamespaceCmd() *cobra.Command {
 		Use:   "get-namespace",
 		Short: "Get current namespace",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			fmt.Println("Current namespace:", viper.GetString("k8s.namespace"))
+			fmt.Println("Current namespace:", services.GetCurrentNamespace())
 			return nil
 		},
 	}
@@ -106,7 +113,12 @@ func newConfigSetNamespaceCmd() *cobra.Command {
 		Short: "Set default namespace",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			viper.Set("k8s.namespace", args[0])
+			// Remember the namespace for the current context only
+			key := "k8s.namespace"
+			if name := services.CurrentContextName(); name != "" {
+				key = config.ContextNamespaceKey(name)
+			}
+			viper.Set(key, args[0])
 			return viper.WriteConfig()
 		},
 	}
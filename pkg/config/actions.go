@@ -0,0 +1,88 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// PodAction is a custom action defined under pod_actions in the
+// configuration and listed in the pod actions menu, for example:
+//
+//	pod_actions:
+//	  - name: Curl healthz
+//	    description: Check the health endpoint
+//	    command: kubectl exec -n {{.Namespace}} {{.Name}} -c {{.Container}} -- curl -s localhost:8080/healthz
+type PodAction struct {
+	Name        string `mapstructure:"name"`
+	Description string `mapstructure:"description"`
+	// Command is run by the shell after the {{.Namespace}}, {{.Name}} and
+	// {{.Container}} placeholders are filled in
+	Command string `mapstructure:"command"`
+}
+
+// PodActionData is what the placeholders of a pod action command refer to
+type PodActionData struct {
+	Namespace string
+	Name      string
+	Container string
+}
+
+// CustomPodAction is a pod action whose command template has been checked
+type CustomPodAction struct {
+	Name        string
+	Description string
+	command     *template.Template
+}
+
+// Render fills in the command of the action for a pod
+func (a CustomPodAction) Render(data PodActionData) (string, error) {
+	var command strings.Builder
+	if err := a.command.Execute(&command, data); err != nil {
+		return "", fmt.Errorf("failed to render the command of pod action %q: %w", a.Name, err)
+	}
+	return command.String(), nil
+}
+
+// CustomPodActions returns the pod actions of the configuration whose name
+// and command are valid. Actions without a name or command, or whose
+// template doesn't parse or uses an unknown placeholder, are left out and
+// reported in the returned error.
+func (c *Config) CustomPodActions() ([]CustomPodAction, error) {
+	var actions []CustomPodAction
+	var errs []error
+	for i, action := range c.PodActions {
+		custom, err := parsePodAction(action)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("pod_actions[%d]: %w", i, err))
+			continue
+		}
+		actions = append(actions, custom)
+	}
+	return actions, errors.Join(errs...)
+}
+
+// parsePodAction checks a pod action and compiles its command template
+func parsePodAction(action PodAction) (CustomPodAction, error) {
+	name := strings.TrimSpace(action.Name)
+	if name == "" {
+		return CustomPodAction{}, fmt.Errorf("name is required")
+	}
+	if strings.TrimSpace(action.Command) == "" {
+		return CustomPodAction{}, fmt.Errorf("%q: command is required", name)
+	}
+
+	command, err := template.New(name).Option("missingkey=error").Parse(action.Command)
+	if err != nil {
+		return CustomPodAction{}, fmt.Errorf("%q: invalid command template: %w", name, err)
+	}
+	// Placeholders are only resolved on execution, so a typo such as
+	// {{.Pod}} is caught with sample data
+	sample := PodActionData{Namespace: "default", Name: "pod", Container: "app"}
+	if err := command.Execute(&strings.Builder{}, sample); err != nil {
+		return CustomPodAction{}, fmt.Errorf("%q: invalid command template: %w", name, err)
+	}
+
+	return CustomPodAction{Name: name, Description: action.Description, command: command}, nil
+}
@@ -0,0 +1,49 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomPodActions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "k8s-manager.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`pod_actions:
+  - name: Curl healthz
+    description: Check the health endpoint
+    command: kubectl exec -n {{.Namespace}} {{.Name}} -c {{.Container}} -- curl -s localhost:8080/healthz
+  - name: Missing command
+  - command: echo no name
+  - name: Unknown placeholder
+    command: echo {{.Pod}}
+  - name: Broken template
+    command: echo {{.Name
+`), 0644))
+	t.Setenv(PathEnvVar, path)
+	cfg, err := Load()
+	require.NoError(t, err)
+
+	actions, err := cfg.CustomPodActions()
+	require.Len(t, actions, 1)
+	assert.Equal(t, "Curl healthz", actions[0].Name)
+	assert.Equal(t, "Check the health endpoint", actions[0].Description)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `pod_actions[1]: "Missing command": command is required`)
+	assert.Contains(t, err.Error(), "pod_actions[2]: name is required")
+	assert.Contains(t, err.Error(), `pod_actions[3]: "Unknown placeholder": invalid command template`)
+	assert.Contains(t, err.Error(), `pod_actions[4]: "Broken template": invalid command template`)
+
+	command, err := actions[0].Render(PodActionData{Namespace: "shop", Name: "web-0", Container: "app"})
+	require.NoError(t, err)
+	assert.Equal(t, "kubectl exec -n shop web-0 -c app -- curl -s localhost:8080/healthz", command)
+}
+
+func TestCustomPodActionsEmpty(t *testing.T) {
+	actions, err := (&Config{}).CustomPodActions()
+	assert.NoError(t, err)
+	assert.Empty(t, actions)
+}
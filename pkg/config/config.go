@@ -1,19 +1,34 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strings"
 
 	"github.com/spf13/viper"
+	"k8s.io/client-go/tools/clientcmd"
 )
 
 // Config holds the application configuration
 type Config struct {
-	GCP      GCPConfig `mapstructure:"gcp"`
-	K8s      K8sConfig `mapstructure:"k8s"`
-	SSH      SSHConfig `mapstructure:"ssh"`
-	LogLevel string    `mapstructure:"log_level"`
+	GCP      GCPConfig                `mapstructure:"gcp"`
+	K8s      K8sConfig                `mapstructure:"k8s"`
+	SSH      SSHConfig                `mapstructure:"ssh"`
+	UI       UIConfig                 `mapstructure:"ui"`
+	Contexts map[string]ContextConfig `mapstructure:"contexts"`
+	LogLevel string                   `mapstructure:"log_level"`
+	// AuditLog is a file mutating actions are recorded in; empty disables it
+	AuditLog string `mapstructure:"audit_log"`
+	// Favorites are the pinned resources shown at the top of the main menu,
+	// see Favorite
+	Favorites []string `mapstructure:"favorites"`
+	// PodActions are custom actions added to the pod actions menu, see
+	// PodAction
+	PodActions []PodAction `mapstructure:"pod_actions"`
 }
 
 // GCPConfig holds GCP-specific configuration
@@ -29,6 +44,17 @@ type K8sConfig struct {
 	ClusterName string `mapstructure:"cluster_name"`
 	Namespace   string `mapstructure:"namespace"`
 	Context     string `mapstructure:"context"`
+	// ProdPattern is a regular expression matched against context names to
+	// flag production clusters in the UI
+	ProdPattern string `mapstructure:"prod_pattern"`
+	// HighRiskNamespacePattern is a regular expression matched against
+	// namespaces in which destructive actions require typing the resource name
+	HighRiskNamespacePattern string `mapstructure:"high_risk_namespace_pattern"`
+}
+
+// ContextConfig holds settings remembered separately for each Kubernetes context
+type ContextConfig struct {
+	Namespace string `mapstructure:"namespace"`
 }
 
 // SSHConfig holds SSH-specific configuration
@@ -38,6 +64,24 @@ type SSHConfig struct {
 	Port     int    `mapstructure:"port"`
 }
 
+// UIConfig holds settings for the interactive interface
+type UIConfig struct {
+	// Theme names the color theme: default, high-contrast or monochrome
+	Theme string `mapstructure:"theme"`
+}
+
+// PathEnvVar names an environment variable that overrides the location of
+// the configuration file
+const PathEnvVar = "K8S_MANAGER_CONFIG"
+
+// ContextSetting is the configuration key holding the Kubernetes context
+// clients connect to. Older versions of the interactive CLI saved it under
+// the flat legacyContextSetting key.
+const (
+	ContextSetting       = "k8s.context"
+	legacyContextSetting = "context"
+)
+
 var cfg *Config
 
 // Load loads the configuration from file and environment variables
@@ -48,14 +92,18 @@ func Load() (*Config, error) {
 	viper.SetConfigName("k8s-manager")
 	viper.SetConfigType("yaml")
 
-	// Add config paths
-	viper.AddConfigPath(".")
-	homeDir := os.Getenv("HOME")
-	if homeDir != "" {
-		viper.AddConfigPath(filepath.Join(homeDir, ".config", "k8s-manager"))
+	// Add config paths, unless the file is named explicitly
+	if path := os.Getenv(PathEnvVar); path != "" {
+		viper.SetConfigFile(path)
+	} else {
+		viper.AddConfigPath(".")
+		homeDir := os.Getenv("HOME")
+		if homeDir != "" {
+			viper.AddConfigPath(filepath.Join(homeDir, ".config", "k8s-manager"))
+		}
+		viper.AddConfigPath("$HOME/.config/k8s-manager")
+		viper.AddConfigPath("/etc/k8s-manager")
 	}
-	viper.AddConfigPath("$HOME/.config/k8s-manager")
-	viper.AddConfigPath("/etc/k8s-manager")
 
 	// Set default values
 	setDefaults()
@@ -66,7 +114,8 @@ func Load() (*Config, error) {
 
 	// Read config file if it exists
 	if err := viper.ReadInConfig(); err != nil {
-		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
+		// A file named by K8S_MANAGER_CONFIG may not have been created yet
+		if _, ok := err.(viper.ConfigFileNotFoundError); !ok && !errors.Is(err, fs.ErrNotExist) {
 			// For config init command, we need to allow initialization even without existing config
 			// Just log the error and continue with defaults
 			if !viper.IsSet("gcp.project_id") && !viper.IsSet("k8s.cluster_name") {
@@ -78,6 +127,9 @@ func Load() (*Config, error) {
 		// Config file not found is ok, we'll create one during init
 	}
 
+	MigrateContext()
+	MigrateNamespace(currentContextName())
+
 	cfg = &Config{}
 	if err := viper.Unmarshal(cfg); err != nil {
 		return nil, fmt.Errorf("error unmarshaling config: %w", err)
@@ -100,23 +152,44 @@ func Save() error {
 		return fmt.Errorf("no configuration to save")
 	}
 
-	configDir := filepath.Join(os.Getenv("HOME"), ".config", "k8s-manager")
-	if err := os.MkdirAll(configDir, 0755); err != nil {
+	configFile := Path()
+	if err := os.MkdirAll(filepath.Dir(configFile), 0755); err != nil {
 		return fmt.Errorf("error creating config directory: %w", err)
 	}
 
-	configFile := filepath.Join(configDir, "k8s-manager.yaml")
 	return viper.WriteConfigAs(configFile)
 }
 
+// Path returns the configuration file that is read and saved: the one named
+// by K8S_MANAGER_CONFIG, else the one Load found, else the file Save creates
+// under ~/.config/k8s-manager
+func Path() string {
+	if path := os.Getenv(PathEnvVar); path != "" {
+		return path
+	}
+	if used := viper.ConfigFileUsed(); used != "" {
+		return used
+	}
+	return filepath.Join(os.Getenv("HOME"), ".config", "k8s-manager", "k8s-manager.yaml")
+}
+
+// Settings returns the effective configuration after merging defaults, the
+// configuration file and environment variables
+func Settings() map[string]interface{} {
+	return viper.AllSettings()
+}
+
 // setDefaults sets default configuration values
 func setDefaults() {
 	viper.SetDefault("gcp.zone", "us-central1-a")
 	viper.SetDefault("gcp.region", "us-central1")
 	viper.SetDefault("k8s.namespace", "default")
+	viper.SetDefault("k8s.prod_pattern", "prod")
+	viper.SetDefault("k8s.high_risk_namespace_pattern", "prod")
 	viper.SetDefault("ssh.port", 22)
 	viper.SetDefault("ssh.username", "root")
 	viper.SetDefault("log_level", "info")
+	viper.SetDefault("ui.theme", "default")
 }
 
 // Update updates a configuration value
@@ -132,6 +205,123 @@ func Update(key string, value interface{}) error {
 	return Save()
 }
 
+// ContextName returns the name of the context per-context settings are
+// stored under, the context clients connect with, see ResolveContext
+func (c *Config) ContextName() string {
+	return ResolveContext(c.K8s.Context)
+}
+
+// KubeconfigPath returns the kubeconfig file clients are built from
+func KubeconfigPath() string {
+	return filepath.Join(os.Getenv("HOME"), ".kube", "config")
+}
+
+// ResolveContext returns the kubeconfig context clients connect with: the
+// configured context if the kubeconfig has it, otherwise the kubeconfig's
+// current context. Without a readable kubeconfig the configured context is
+// returned as it is.
+func ResolveContext(configured string) string {
+	kubeConfig, err := clientcmd.LoadFromFile(KubeconfigPath())
+	if err != nil {
+		return configured
+	}
+	if configured != "" && kubeConfig.Contexts[configured] != nil {
+		return configured
+	}
+	return kubeConfig.CurrentContext
+}
+
+// IsProdContext reports whether a context name matches the configured
+// production pattern. The match is case-insensitive; a pattern that is not a
+// valid regular expression is matched as plain text.
+func (c *Config) IsProdContext(contextName string) bool {
+	return matchesPattern(c.K8s.ProdPattern, contextName)
+}
+
+// IsHighRiskNamespace reports whether a namespace matches the configured
+// high-risk pattern, matched the same way as IsProdContext
+func (c *Config) IsHighRiskNamespace(namespace string) bool {
+	return matchesPattern(c.K8s.HighRiskNamespacePattern, namespace)
+}
+
+// matchesPattern matches name against a case-insensitive regular expression,
+// or as plain text if the pattern does not compile. An empty pattern or name
+// never matches.
+func matchesPattern(pattern, name string) bool {
+	if pattern == "" || name == "" {
+		return false
+	}
+
+	re, err := regexp.Compile("(?i)" + pattern)
+	if err != nil {
+		return strings.Contains(strings.ToLower(name), strings.ToLower(pattern))
+	}
+	return re.MatchString(name)
+}
+
+// Namespace returns the namespace remembered for the current context,
+// falling back to the global k8s.namespace setting
+func (c *Config) Namespace() string {
+	if ctx, ok := c.Contexts[contextKey(c.ContextName())]; ok && ctx.Namespace != "" {
+		return ctx.Namespace
+	}
+	return c.K8s.Namespace
+}
+
+// SetNamespace stores the namespace for the current context and saves the
+// configuration
+func SetNamespace(namespace string) error {
+	name := currentContextName()
+	if name == "" {
+		return Update("k8s.namespace", namespace)
+	}
+	return Update(ContextNamespaceKey(name), namespace)
+}
+
+// ContextNamespaceKey returns the configuration key holding the namespace of
+// a context
+func ContextNamespaceKey(contextName string) string {
+	return "contexts." + contextKey(contextName) + ".namespace"
+}
+
+// MigrateNamespace copies a namespace saved under the flat k8s.namespace key
+// by older versions to the given context, unless the context already has one.
+// The change is kept in memory and written with the next save.
+func MigrateNamespace(contextName string) {
+	if contextName == "" || !viper.InConfig("k8s.namespace") {
+		return
+	}
+
+	key := ContextNamespaceKey(contextName)
+	if viper.IsSet(key) {
+		return
+	}
+	viper.Set(key, viper.GetString("k8s.namespace"))
+}
+
+// MigrateContext carries a context saved under the flat context key by older
+// versions over to k8s.context, unless that is configured already. It is set
+// as a default so that a --context flag still wins, and is written with the
+// next save.
+func MigrateContext() {
+	if !viper.InConfig(legacyContextSetting) || viper.InConfig(ContextSetting) {
+		return
+	}
+	viper.SetDefault(ContextSetting, viper.GetString(legacyContextSetting))
+}
+
+// currentContextName mirrors Config.ContextName for the loaded settings
+func currentContextName() string {
+	return ResolveContext(viper.GetString(ContextSetting))
+}
+
+// contextKey turns a context name into a single configuration key segment.
+// Configuration keys are case-insensitive and dot-separated, so dots in
+// context names are replaced.
+func contextKey(contextName string) string {
+	return strings.ToLower(strings.ReplaceAll(contextName, ".", "_"))
+}
+
 // Validate validates the current configuration
 func (c *Config) Validate() error {
 	if c.GCP.ProjectID == "" {
@@ -2,6 +2,7 @@ package config
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -25,6 +26,7 @@ func TestConfigDefaults(t *testing.T) {
 	assert.Equal(t, 22, cfg.SSH.Port)
 	assert.Equal(t, "root", cfg.SSH.Username)
 	assert.Equal(t, "info", cfg.LogLevel)
+	assert.Equal(t, "prod", cfg.K8s.ProdPattern)
 }
 
 func TestConfigValidation(t *testing.T) {
@@ -116,7 +118,191 @@ func TestConfigGet(t *testing.T) {
 	assert.Equal(t, cfg, cfg2)
 }
 
+func TestConfigPath(t *testing.T) {
+	tempDir := t.TempDir()
+	t.Setenv("HOME", tempDir)
+
+	_, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(tempDir, ".config", "k8s-manager", "k8s-manager.yaml"), Path())
+}
+
+func TestConfigPathOverride(t *testing.T) {
+	tempDir := t.TempDir()
+	t.Setenv("HOME", tempDir)
+	path := filepath.Join(tempDir, "custom", "manager.yaml")
+	t.Setenv(PathEnvVar, path)
+
+	// The file does not exist until something is saved
+	_, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, path, Path())
+
+	require.NoError(t, Update("k8s.namespace", "staging"))
+	assert.FileExists(t, path)
+	assert.NoFileExists(t, filepath.Join(tempDir, ".config", "k8s-manager", "k8s-manager.yaml"))
+
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, "staging", cfg.K8s.Namespace)
+	assert.Equal(t, "staging", Settings()["k8s"].(map[string]interface{})["namespace"])
+}
+
 func TestConfigEnvironmentVariables(t *testing.T) {
 	t.Skip("Skipping environment variable test due to global state interference")
 	// TODO: Refactor config to use dependency injection for better testability
 }
+
+// writeKubeConfig writes a kubeconfig with the alpha and beta contexts under
+// home, with alpha as the current context
+func writeKubeConfig(t *testing.T, home string) {
+	kubeConfig := `apiVersion: v1
+kind: Config
+clusters:
+- name: alpha
+  cluster:
+    server: https://alpha.example.com
+- name: beta
+  cluster:
+    server: https://beta.example.com
+contexts:
+- name: alpha
+  context:
+    cluster: alpha
+- name: beta
+  context:
+    cluster: beta
+current-context: alpha
+`
+	require.NoError(t, os.MkdirAll(filepath.Join(home, ".kube"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(home, ".kube", "config"), []byte(kubeConfig), 0600))
+}
+
+func TestConfigNamespacePerContext(t *testing.T) {
+	tempDir := t.TempDir()
+	os.Setenv("HOME", tempDir)
+	defer os.Unsetenv("HOME")
+	writeKubeConfig(t, tempDir)
+
+	// A config written before namespaces were stored per context
+	configDir := filepath.Join(tempDir, ".config", "k8s-manager")
+	require.NoError(t, os.MkdirAll(configDir, 0755))
+	legacy := "k8s:\n  cluster_name: gke-cluster\n  namespace: legacy\n"
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, "k8s-manager.yaml"), []byte(legacy), 0644))
+
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, "legacy", cfg.Namespace())
+	assert.Equal(t, "legacy", cfg.Contexts["alpha"].Namespace, "flat namespace should be migrated to the current context")
+
+	require.NoError(t, SetNamespace("team-a"))
+	assert.Equal(t, "team-a", Get().Namespace())
+
+	// Switching to another context does not carry the namespace over
+	require.NoError(t, Update("k8s.context", "beta"))
+	assert.Equal(t, "legacy", Get().Namespace())
+	require.NoError(t, SetNamespace("team-b"))
+	assert.Equal(t, "team-b", Get().Namespace())
+
+	// Switching back restores the namespace used with the first context
+	require.NoError(t, Update("k8s.context", "alpha"))
+	assert.Equal(t, "team-a", Get().Namespace())
+
+	// The cluster name is not a context
+	require.NoError(t, Update("k8s.cluster_name", "beta"))
+	assert.Equal(t, "team-a", Get().Namespace())
+}
+
+func TestConfigResolveContext(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	// Without a kubeconfig the configured context is all there is
+	assert.Equal(t, "staging", ResolveContext("staging"))
+
+	writeKubeConfig(t, home)
+	assert.Equal(t, "beta", ResolveContext("beta"))
+	assert.Equal(t, "alpha", ResolveContext(""))
+	// A context the kubeconfig does not have is not connected to
+	assert.Equal(t, "alpha", ResolveContext("gone"))
+}
+
+func TestConfigContextMigration(t *testing.T) {
+	testCases := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{name: "flat key of older versions", content: "context: staging\n", want: "staging"},
+		{name: "both keys", content: "context: staging\nk8s:\n  context: prod\n", want: "prod"},
+		{name: "current key", content: "k8s:\n  context: prod\n", want: "prod"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("HOME", t.TempDir())
+			path := filepath.Join(t.TempDir(), "k8s-manager.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(tc.content), 0644))
+			t.Setenv(PathEnvVar, path)
+
+			cfg, err := Load()
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, cfg.K8s.Context)
+			assert.Equal(t, tc.want, cfg.ContextName())
+
+			// The migrated context is written under the current key
+			require.NoError(t, Save())
+			cfg, err = Load()
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, cfg.K8s.Context)
+		})
+	}
+}
+
+func TestConfigNamespaceContextKey(t *testing.T) {
+	cfg := &Config{
+		K8s: K8sConfig{Context: "Prod.EU", Namespace: "default"},
+		Contexts: map[string]ContextConfig{
+			"prod_eu": {Namespace: "payments"},
+		},
+	}
+
+	assert.Equal(t, "payments", cfg.Namespace())
+	assert.Equal(t, "contexts.prod_eu.namespace", ContextNamespaceKey("Prod.EU"))
+}
+
+func TestConfigIsProdContext(t *testing.T) {
+	testCases := []struct {
+		name     string
+		pattern  string
+		context  string
+		expected bool
+	}{
+		{name: "default pattern matches", pattern: "prod", context: "gke_acme_us-central1_prod-cluster", expected: true},
+		{name: "match is case-insensitive", pattern: "prod", context: "PROD-EU", expected: true},
+		{name: "default pattern does not match staging", pattern: "prod", context: "staging", expected: false},
+		{name: "regular expression", pattern: "^(live|prd)-", context: "prd-us", expected: true},
+		{name: "anchored regular expression", pattern: "^(live|prd)-", context: "dev-prd-us", expected: false},
+		{name: "invalid expression matched as text", pattern: "prod[", context: "my-prod[1]", expected: true},
+		{name: "empty pattern disables", pattern: "", context: "prod", expected: false},
+		{name: "empty context", pattern: "prod", context: "", expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := &Config{K8s: K8sConfig{ProdPattern: tc.pattern}}
+			assert.Equal(t, tc.expected, cfg.IsProdContext(tc.context))
+		})
+	}
+}
+
+func TestConfigIsHighRiskNamespace(t *testing.T) {
+	cfg := &Config{K8s: K8sConfig{HighRiskNamespacePattern: "^(prod|payments)"}}
+	assert.True(t, cfg.IsHighRiskNamespace("prod-eu"))
+	assert.True(t, cfg.IsHighRiskNamespace("Payments"))
+	assert.False(t, cfg.IsHighRiskNamespace("staging"))
+	assert.False(t, cfg.IsHighRiskNamespace(""))
+
+	cfg.K8s.HighRiskNamespacePattern = ""
+	assert.False(t, cfg.IsHighRiskNamespace("prod"))
+}
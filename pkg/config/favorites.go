@@ -0,0 +1,100 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Kinds of resources that can be pinned as favorites
+const (
+	FavoritePod       = "pod"
+	FavoriteSecret    = "secret"
+	FavoriteNamespace = "namespace"
+)
+
+// Favorite is a resource pinned for quick access from the main menu. It is
+// stored as kind/namespace/name, or namespace/name for a namespace.
+type Favorite struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// String returns the favorite in the form it is stored and pinned with
+func (f Favorite) String() string {
+	if f.Kind == FavoriteNamespace {
+		return f.Kind + "/" + f.Name
+	}
+	return f.Kind + "/" + f.Namespace + "/" + f.Name
+}
+
+// ParseFavorite parses pod/<namespace>/<name>, secret/<namespace>/<name> or
+// namespace/<name>
+func ParseFavorite(value string) (Favorite, error) {
+	parts := strings.Split(value, "/")
+	kind := strings.ToLower(parts[0])
+
+	switch {
+	case (kind == FavoritePod || kind == FavoriteSecret) && len(parts) == 3 && parts[1] != "" && parts[2] != "":
+		return Favorite{Kind: kind, Namespace: parts[1], Name: parts[2]}, nil
+	case kind == FavoriteNamespace && len(parts) == 2 && parts[1] != "":
+		return Favorite{Kind: kind, Name: parts[1]}, nil
+	}
+	return Favorite{}, fmt.Errorf("invalid favorite %q (expected pod/<namespace>/<name>, secret/<namespace>/<name> or namespace/<name>)", value)
+}
+
+// PinnedFavorites returns the pinned resources in the order they were pinned,
+// skipping entries that cannot be parsed
+func (c *Config) PinnedFavorites() []Favorite {
+	favorites := make([]Favorite, 0, len(c.Favorites))
+	for _, value := range c.Favorites {
+		if favorite, err := ParseFavorite(value); err == nil {
+			favorites = append(favorites, favorite)
+		}
+	}
+	return favorites
+}
+
+// IsFavorite reports whether a resource is pinned
+func (c *Config) IsFavorite(favorite Favorite) bool {
+	return slices.Contains(c.Favorites, favorite.String())
+}
+
+// PinFavorite pins a resource and saves the configuration. Pinning a
+// resource twice keeps one entry.
+func PinFavorite(favorite Favorite) error {
+	favorites := pinnedValues()
+	if slices.Contains(favorites, favorite.String()) {
+		return nil
+	}
+	return Update("favorites", append(slices.Clone(favorites), favorite.String()))
+}
+
+// UnpinFavorites removes resources from the favorites and saves the
+// configuration
+func UnpinFavorites(remove ...Favorite) error {
+	favorites := slices.DeleteFunc(slices.Clone(pinnedValues()), func(value string) bool {
+		return slices.ContainsFunc(remove, func(favorite Favorite) bool {
+			return favorite.String() == value
+		})
+	})
+	return Update("favorites", favorites)
+}
+
+// ToggleFavorite pins a resource that is not pinned and unpins one that is,
+// reporting whether it is pinned afterwards
+func ToggleFavorite(favorite Favorite) (bool, error) {
+	if slices.Contains(pinnedValues(), favorite.String()) {
+		return false, UnpinFavorites(favorite)
+	}
+	return true, PinFavorite(favorite)
+}
+
+// pinnedValues returns the favorites of the loaded configuration as stored
+func pinnedValues() []string {
+	if c := Get(); c != nil {
+		return c.Favorites
+	}
+	return nil
+}
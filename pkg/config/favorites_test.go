@@ -0,0 +1,54 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFavorite(t *testing.T) {
+	favorite, err := ParseFavorite("pod/shop/web-0")
+	require.NoError(t, err)
+	assert.Equal(t, Favorite{Kind: FavoritePod, Namespace: "shop", Name: "web-0"}, favorite)
+	assert.Equal(t, "pod/shop/web-0", favorite.String())
+
+	favorite, err = ParseFavorite("Namespace/payments")
+	require.NoError(t, err)
+	assert.Equal(t, Favorite{Kind: FavoriteNamespace, Name: "payments"}, favorite)
+	assert.Equal(t, "namespace/payments", favorite.String())
+
+	for _, value := range []string{"pod/web", "deployment/shop/web", "secret/shop/", "namespace/a/b", ""} {
+		_, err := ParseFavorite(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestPinFavorites(t *testing.T) {
+	tempDir := t.TempDir()
+	t.Setenv("HOME", tempDir)
+	t.Setenv(PathEnvVar, filepath.Join(tempDir, "k8s-manager.yaml"))
+	_, err := Load()
+	require.NoError(t, err)
+
+	web := Favorite{Kind: FavoritePod, Namespace: "shop", Name: "web"}
+	payments := Favorite{Kind: FavoriteNamespace, Name: "payments"}
+
+	require.NoError(t, PinFavorite(web))
+	require.NoError(t, PinFavorite(web))
+	require.NoError(t, PinFavorite(payments))
+
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, []Favorite{web, payments}, cfg.PinnedFavorites(), "pins are saved once each, in order")
+	assert.True(t, cfg.IsFavorite(web))
+
+	pinned, err := ToggleFavorite(web)
+	require.NoError(t, err)
+	assert.False(t, pinned)
+	assert.Equal(t, []Favorite{payments}, Get().PinnedFavorites())
+
+	require.NoError(t, UnpinFavorites(payments))
+	assert.Empty(t, Get().PinnedFavorites())
+}
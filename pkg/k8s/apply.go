@@ -0,0 +1,213 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+)
+
+// DefaultFieldManager identifies this tool as the owner of the fields it
+// writes unless --field-manager names another manager
+const DefaultFieldManager = "k8s-manager"
+
+// FieldManager returns the field manager recorded for every write
+func FieldManager() string {
+	if clientOptions.FieldManager != "" {
+		return clientOptions.FieldManager
+	}
+	return DefaultFieldManager
+}
+
+// ApplyResult describes what applying an object did to the cluster
+type ApplyResult string
+
+const (
+	ApplyCreated    ApplyResult = "created"
+	ApplyConfigured ApplyResult = "configured"
+	ApplyUnchanged  ApplyResult = "unchanged"
+)
+
+// ApplyOptions holds the options used when applying manifests
+type ApplyOptions struct {
+	// Namespace is used for namespaced objects that do not set one
+	Namespace string
+	// EnforceNamespace rejects objects that set a different namespace
+	EnforceNamespace bool
+	DryRun           bool
+	// ForceConflicts takes ownership of fields managed by someone else
+	// instead of failing with a conflict
+	ForceConflicts bool
+}
+
+// Applier server-side applies unstructured objects of any kind
+type Applier struct {
+	dynamic dynamic.Interface
+	mapper  meta.RESTMapper
+	opts    ApplyOptions
+}
+
+// NewApplier creates an applier backed by the dynamic client and a discovery
+// based REST mapper
+func (c *Client) NewApplier(opts ApplyOptions) (*Applier, error) {
+	dynamicClient, err := dynamic.NewForConfig(c.Config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dynamic client: %w", err)
+	}
+
+	return &Applier{
+		dynamic: dynamicClient,
+		mapper:  c.restMapper(),
+		opts:    opts,
+	}, nil
+}
+
+// restMapper maps kinds and resource names, including short names such as
+// "deploy", to API resources using discovery
+func (c *Client) restMapper() meta.RESTMapper {
+	discoveryClient := memory.NewMemCacheClient(c.Clientset.Discovery())
+	return restmapper.NewShortcutExpander(restmapper.NewDeferredDiscoveryRESTMapper(discoveryClient), discoveryClient)
+}
+
+// Apply server-side applies an object and reports whether it was created,
+// configured or left unchanged
+func (a *Applier) Apply(ctx context.Context, obj *unstructured.Unstructured) (ApplyResult, error) {
+	gvk := obj.GroupVersionKind()
+	mapping, err := a.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return "", fmt.Errorf("unknown resource type %s: %w", gvk.String(), err)
+	}
+
+	var resource dynamic.ResourceInterface
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		namespace := obj.GetNamespace()
+		switch {
+		case namespace == "":
+			namespace = a.opts.Namespace
+		case a.opts.EnforceNamespace && namespace != a.opts.Namespace:
+			return "", fmt.Errorf("namespace %q in the manifest does not match the requested namespace %q", namespace, a.opts.Namespace)
+		}
+		obj.SetNamespace(namespace)
+		resource = a.dynamic.Resource(mapping.Resource).Namespace(namespace)
+	} else {
+		resource = a.dynamic.Resource(mapping.Resource)
+	}
+
+	existing, err := resource.Get(ctx, obj.GetName(), metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		existing = nil
+	case err != nil:
+		return "", fmt.Errorf("failed to get current state: %w", err)
+	}
+
+	data, err := runtime.Encode(unstructured.UnstructuredJSONScheme, obj)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode object: %w", err)
+	}
+
+	patchOptions := metav1.PatchOptions{FieldManager: FieldManager(), Force: boolPtr(a.opts.ForceConflicts)}
+	if a.opts.DryRun {
+		patchOptions.DryRun = []string{metav1.DryRunAll}
+	}
+
+	applied, err := resource.Patch(ctx, obj.GetName(), types.ApplyPatchType, data, patchOptions)
+	if apierrors.IsConflict(err) {
+		return "", fmt.Errorf("%w; rerun with --force-conflicts to take ownership of these fields", err)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case existing == nil:
+		return ApplyCreated, nil
+	case equalIgnoringServerFields(existing, applied):
+		return ApplyUnchanged, nil
+	default:
+		return ApplyConfigured, nil
+	}
+}
+
+// ResourceName returns the kubectl style name of an object, e.g. deployment.apps/web
+func ResourceName(obj *unstructured.Unstructured) string {
+	gvk := obj.GroupVersionKind()
+	kind := strings.ToLower(gvk.Kind)
+	if gvk.Group != "" {
+		kind += "." + gvk.Group
+	}
+	return kind + "/" + obj.GetName()
+}
+
+// DecodeManifests decodes every object in a stream of YAML or JSON documents.
+// Empty documents are skipped and List objects are expanded into their items.
+func DecodeManifests(r io.Reader) ([]*unstructured.Unstructured, error) {
+	decoder := utilyaml.NewYAMLOrJSONDecoder(r, 4096)
+
+	var objects []*unstructured.Unstructured
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("failed to decode manifest: %w", err)
+		}
+
+		if len(obj.Object) == 0 {
+			continue
+		}
+
+		if obj.IsList() {
+			err := obj.EachListItem(func(item runtime.Object) error {
+				objects = append(objects, item.(*unstructured.Unstructured))
+				return nil
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to read list items: %w", err)
+			}
+			continue
+		}
+
+		if obj.GetKind() == "" || obj.GetAPIVersion() == "" {
+			return nil, fmt.Errorf("manifest is missing apiVersion or kind")
+		}
+		if obj.GetName() == "" {
+			return nil, fmt.Errorf("%s manifest is missing metadata.name", obj.GetKind())
+		}
+
+		objects = append(objects, obj)
+	}
+
+	return objects, nil
+}
+
+// equalIgnoringServerFields compares two objects without the metadata the
+// server bumps on every write
+func equalIgnoringServerFields(a, b *unstructured.Unstructured) bool {
+	strip := func(obj *unstructured.Unstructured) map[string]interface{} {
+		copied := obj.DeepCopy()
+		copied.SetResourceVersion("")
+		copied.SetManagedFields(nil)
+		copied.SetGeneration(0)
+		return copied.Object
+	}
+	return equality.Semantic.DeepEqual(strip(a), strip(b))
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,119 @@
+package k8s
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestDecodeManifests(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected []string
+		wantErr  bool
+	}{
+		{
+			name: "multiple documents with empty ones",
+			input: `---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+---
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: shop
+`,
+			expected: []string{"configmap/settings", "deployment.apps/web"},
+		},
+		{
+			name:     "json document",
+			input:    `{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "token"}}`,
+			expected: []string{"secret/token"},
+		},
+		{
+			name: "list is expanded",
+			input: `apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: Service
+  metadata:
+    name: web
+- apiVersion: networking.k8s.io/v1
+  kind: Ingress
+  metadata:
+    name: web
+`,
+			expected: []string{"service/web", "ingress.networking.k8s.io/web"},
+		},
+		{
+			name:    "missing kind",
+			input:   "apiVersion: v1\nmetadata:\n  name: web\n",
+			wantErr: true,
+		},
+		{
+			name:    "missing name",
+			input:   "apiVersion: v1\nkind: ConfigMap\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid yaml",
+			input:   "apiVersion: v1\nkind: [\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			objects, err := DecodeManifests(strings.NewReader(tc.input))
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			names := make([]string, 0, len(objects))
+			for _, obj := range objects {
+				names = append(names, ResourceName(obj))
+			}
+			assert.Equal(t, tc.expected, names)
+		})
+	}
+}
+
+func TestEqualIgnoringServerFields(t *testing.T) {
+	existing := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":            "settings",
+			"resourceVersion": "10",
+		},
+		"data": map[string]interface{}{"mode": "fast"},
+	}}
+
+	reapplied := existing.DeepCopy()
+	reapplied.SetResourceVersion("11")
+	assert.True(t, equalIgnoringServerFields(existing, reapplied))
+
+	changed := existing.DeepCopy()
+	changed.Object["data"] = map[string]interface{}{"mode": "slow"}
+	assert.False(t, equalIgnoringServerFields(existing, changed))
+}
+
+func TestFieldManager(t *testing.T) {
+	t.Cleanup(func() { SetClientOptions(ClientOptions{}) })
+
+	assert.Equal(t, DefaultFieldManager, FieldManager())
+
+	SetClientOptions(ClientOptions{FieldManager: "ci-deployer"})
+	assert.Equal(t, "ci-deployer", FieldManager())
+}
@@ -0,0 +1,180 @@
+package k8s
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// AuditEntry is one line of the audit log: a mutating API request and its
+// outcome. Request and response bodies are never recorded, so secret values
+// cannot end up in the log.
+type AuditEntry struct {
+	Time        time.Time `json:"time"`
+	Context     string    `json:"context,omitempty"`
+	Action      string    `json:"action"`
+	Namespace   string    `json:"namespace,omitempty"`
+	Resource    string    `json:"resource"`
+	Name        string    `json:"name,omitempty"`
+	Subresource string    `json:"subresource,omitempty"`
+	DryRun      bool      `json:"dryRun,omitempty"`
+	Outcome     string    `json:"outcome"`
+	Status      int       `json:"status,omitempty"`
+	Error       string    `json:"error,omitempty"`
+}
+
+// auditLogger appends an AuditEntry to a JSON lines file for every mutating
+// request made through the wrapped transport
+type auditLogger struct {
+	next    http.RoundTripper
+	path    string
+	context string
+
+	mu     sync.Mutex
+	warned bool
+}
+
+// newAuditLogger wraps a transport so mutating requests are appended to the
+// audit log at path
+func newAuditLogger(next http.RoundTripper, path, context string) http.RoundTripper {
+	return &auditLogger{next: next, path: path, context: context}
+}
+
+func (l *auditLogger) RoundTrip(req *http.Request) (*http.Response, error) {
+	entry, ok := auditEntryFor(req)
+	if !ok {
+		return l.next.RoundTrip(req)
+	}
+
+	resp, err := l.next.RoundTrip(req)
+
+	entry.Time = time.Now().UTC()
+	entry.Context = l.context
+	switch {
+	case err != nil:
+		entry.Outcome = "error"
+		entry.Error = err.Error()
+	case resp.StatusCode >= http.StatusBadRequest:
+		entry.Outcome = "failure"
+		entry.Status = resp.StatusCode
+	default:
+		entry.Outcome = "success"
+		entry.Status = resp.StatusCode
+	}
+	l.write(entry)
+
+	return resp, err
+}
+
+// write appends an entry to the log. A log that cannot be written is
+// reported once rather than failing the request.
+func (l *auditLogger) write(entry AuditEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := appendAuditEntry(l.path, entry); err != nil && !l.warned {
+		l.warned = true
+		fmt.Fprintf(os.Stderr, "⚠️  Failed to write audit log: %v\n", err)
+	}
+}
+
+// appendAuditEntry appends one JSON encoded entry to the file at path
+func appendAuditEntry(path string, entry AuditEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// auditEntryFor describes a request for the audit log. Reads, and requests
+// that only ask the server a question such as access reviews, are not audited.
+func auditEntryFor(req *http.Request) (AuditEntry, bool) {
+	var action string
+	switch req.Method {
+	case http.MethodPost:
+		action = "create"
+	case http.MethodPut:
+		action = "update"
+	case http.MethodPatch:
+		action = "patch"
+	case http.MethodDelete:
+		action = "delete"
+	default:
+		return AuditEntry{}, false
+	}
+
+	entry, ok := parseResourcePath(req.URL.Path)
+	if !ok {
+		return AuditEntry{}, false
+	}
+	if strings.HasSuffix(entry.Resource, "reviews") {
+		return AuditEntry{}, false
+	}
+
+	// Scaling is an update of the scale subresource; name it for what it is
+	if entry.Subresource == "scale" && action != "create" {
+		action = "scale"
+	}
+	entry.Action = action
+	entry.DryRun = req.URL.Query().Get("dryRun") != ""
+	return entry, true
+}
+
+// parseResourcePath extracts the namespace, resource, name and subresource
+// from an API path such as /apis/apps/v1/namespaces/web/deployments/api/scale
+func parseResourcePath(path string) (AuditEntry, bool) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+
+	// Skip /api/<version> or /apis/<group>/<version>
+	switch {
+	case len(parts) >= 3 && parts[0] == "api":
+		parts = parts[2:]
+	case len(parts) >= 4 && parts[0] == "apis":
+		parts = parts[3:]
+	default:
+		return AuditEntry{}, false
+	}
+
+	var entry AuditEntry
+	if len(parts) >= 3 && parts[0] == "namespaces" && !isNamespaceSubresource(parts) {
+		entry.Namespace = parts[1]
+		parts = parts[2:]
+	}
+
+	switch len(parts) {
+	case 1:
+		entry.Resource = parts[0]
+	case 2:
+		entry.Resource, entry.Name = parts[0], parts[1]
+	case 3:
+		entry.Resource, entry.Name, entry.Subresource = parts[0], parts[1], parts[2]
+	default:
+		return AuditEntry{}, false
+	}
+
+	// A namespace itself is addressed as /api/v1/namespaces/<name>
+	if entry.Resource == "namespaces" && entry.Namespace == "" && entry.Name != "" {
+		entry.Namespace = entry.Name
+	}
+	return entry, true
+}
+
+// isNamespaceSubresource reports whether a path under /namespaces addresses a
+// subresource of the namespace itself rather than a namespaced resource
+func isNamespaceSubresource(parts []string) bool {
+	return len(parts) == 3 && (parts[2] == "status" || parts[2] == "finalize")
+}
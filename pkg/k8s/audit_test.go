@@ -0,0 +1,167 @@
+package k8s
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+)
+
+func TestAuditEntryFor(t *testing.T) {
+	testCases := []struct {
+		name    string
+		method  string
+		url     string
+		want    AuditEntry
+		audited bool
+	}{
+		{
+			name:   "reads are not audited",
+			method: http.MethodGet,
+			url:    "/api/v1/namespaces/web/secrets/db",
+		},
+		{
+			name:    "create in a namespace",
+			method:  http.MethodPost,
+			url:     "/api/v1/namespaces/web/secrets",
+			want:    AuditEntry{Action: "create", Namespace: "web", Resource: "secrets"},
+			audited: true,
+		},
+		{
+			name:    "restart patches the deployment",
+			method:  http.MethodPatch,
+			url:     "/apis/apps/v1/namespaces/web/deployments/api",
+			want:    AuditEntry{Action: "patch", Namespace: "web", Resource: "deployments", Name: "api"},
+			audited: true,
+		},
+		{
+			name:    "scale subresource",
+			method:  http.MethodPut,
+			url:     "/apis/apps/v1/namespaces/web/deployments/api/scale",
+			want:    AuditEntry{Action: "scale", Namespace: "web", Resource: "deployments", Name: "api", Subresource: "scale"},
+			audited: true,
+		},
+		{
+			name:    "namespace delete",
+			method:  http.MethodDelete,
+			url:     "/api/v1/namespaces/staging",
+			want:    AuditEntry{Action: "delete", Namespace: "staging", Resource: "namespaces", Name: "staging"},
+			audited: true,
+		},
+		{
+			name:    "namespace finalize",
+			method:  http.MethodPut,
+			url:     "/api/v1/namespaces/staging/finalize",
+			want:    AuditEntry{Action: "update", Namespace: "staging", Resource: "namespaces", Name: "staging", Subresource: "finalize"},
+			audited: true,
+		},
+		{
+			name:    "dry run",
+			method:  http.MethodPatch,
+			url:     "/api/v1/namespaces/web/configmaps/app?dryRun=All",
+			want:    AuditEntry{Action: "patch", Namespace: "web", Resource: "configmaps", Name: "app", DryRun: true},
+			audited: true,
+		},
+		{
+			name:   "access reviews are not audited",
+			method: http.MethodPost,
+			url:    "/apis/authorization.k8s.io/v1/selfsubjectaccessreviews",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, "https://cluster"+tc.url, nil)
+			entry, ok := auditEntryFor(req)
+			assert.Equal(t, tc.audited, ok)
+			assert.Equal(t, tc.want, entry)
+		})
+	}
+}
+
+func TestAuditLogger(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	responses := []struct {
+		status int
+		err    error
+	}{
+		{status: http.StatusOK},
+		{status: http.StatusForbidden},
+		{err: errors.New("connection refused")},
+		{status: http.StatusOK},
+	}
+
+	calls := 0
+	logger := newAuditLogger(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		response := responses[calls]
+		calls++
+		if response.err != nil {
+			return nil, response.err
+		}
+		return &http.Response{StatusCode: response.status, Body: http.NoBody}, nil
+	}), path, "gke_acme_prod")
+
+	for range responses[:3] {
+		body := strings.NewReader(`{"data":{"password":"c2VjcmV0"}}`)
+		req := httptest.NewRequest(http.MethodPut, "https://cluster/api/v1/namespaces/web/secrets/db", body)
+		logger.RoundTrip(req)
+	}
+	// Reads pass through without being recorded
+	logger.RoundTrip(httptest.NewRequest(http.MethodGet, "https://cluster/api/v1/namespaces/web/secrets/db", nil))
+	assert.Equal(t, 4, calls)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "c2VjcmV0", "secret values are never logged")
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 3)
+
+	var entries []AuditEntry
+	for _, line := range lines {
+		var entry AuditEntry
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		entries = append(entries, entry)
+	}
+
+	assert.Equal(t, "gke_acme_prod", entries[0].Context)
+	assert.Equal(t, "update", entries[0].Action)
+	assert.Equal(t, "web", entries[0].Namespace)
+	assert.Equal(t, "secrets", entries[0].Resource)
+	assert.Equal(t, "db", entries[0].Name)
+	assert.Equal(t, "success", entries[0].Outcome)
+	assert.Equal(t, http.StatusOK, entries[0].Status)
+	assert.False(t, entries[0].Time.IsZero())
+
+	assert.Equal(t, "failure", entries[1].Outcome)
+	assert.Equal(t, http.StatusForbidden, entries[1].Status)
+
+	assert.Equal(t, "error", entries[2].Outcome)
+	assert.Equal(t, "connection refused", entries[2].Error)
+}
+
+func TestApplyClientOptionsAuditLog(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	config := &rest.Config{}
+	applyClientOptions(config, ClientOptions{AuditLog: filepath.Join(t.TempDir(), "audit.log")})
+	require.NotNil(t, config.WrapTransport)
+	_, ok := config.WrapTransport(http.DefaultTransport).(*auditLogger)
+	assert.True(t, ok)
+}
+
+func TestClientOptionsValidateAuditLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	assert.NoError(t, ClientOptions{AuditLog: path}.Validate())
+	assert.FileExists(t, path)
+
+	assert.ErrorContains(t, ClientOptions{AuditLog: filepath.Join(t.TempDir(), "missing", "audit.log")}.Validate(), "failed to open audit log")
+}
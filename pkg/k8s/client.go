@@ -3,13 +3,15 @@ package k8s
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
 	"os"
 	"os/exec"
-	"path/filepath"
 	"strings"
-	"syscall"
+	"time"
 
 	"github.com/karthickk/k8s-manager/pkg/config"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
@@ -23,6 +25,75 @@ type Client struct {
 	cfg       *config.Config
 }
 
+// ClientOptions holds connection settings supplied on the command line
+type ClientOptions struct {
+	// RequestTimeout bounds every API request; zero means no timeout
+	RequestTimeout time.Duration
+	// Verbose logs the method, path, status and latency of every API request to stderr
+	Verbose bool
+	// InsecureSkipTLSVerify disables verification of the API server certificate
+	InsecureSkipTLSVerify bool
+	// CertificateAuthority is a CA bundle file trusted instead of the one in the kubeconfig
+	CertificateAuthority string
+	// Proxy is an http, https or socks5 proxy URL used instead of the
+	// kubeconfig's proxy-url and the HTTPS_PROXY environment variable
+	Proxy string
+	// AuditLog is a file that every mutating API request is appended to as
+	// a JSON line; empty disables auditing
+	AuditLog string
+	// FieldManager is recorded as the owner of the fields this tool writes;
+	// empty means DefaultFieldManager
+	FieldManager string
+	// ImpersonateUser, ImpersonateGroups and ImpersonateUID make every request
+	// as another user, like kubectl's --as, --as-group and --as-uid
+	ImpersonateUser   string
+	ImpersonateGroups []string
+	ImpersonateUID    string
+}
+
+// Validate checks that the TLS settings can be used together and that the
+// certificate authority file is readable
+func (o ClientOptions) Validate() error {
+	if o.ImpersonateUser == "" && (len(o.ImpersonateGroups) > 0 || o.ImpersonateUID != "") {
+		return fmt.Errorf("--as-group and --as-uid require --as to name the user to impersonate")
+	}
+	if o.Proxy != "" {
+		if _, err := parseProxyURL(o.Proxy); err != nil {
+			return err
+		}
+	}
+	if o.AuditLog != "" {
+		f, err := os.OpenFile(o.AuditLog, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return fmt.Errorf("failed to open audit log: %w", err)
+		}
+		f.Close()
+	}
+	if o.CertificateAuthority == "" {
+		return nil
+	}
+	if o.InsecureSkipTLSVerify {
+		return fmt.Errorf("--certificate-authority cannot be used with --insecure-skip-tls-verify")
+	}
+	if _, err := os.ReadFile(o.CertificateAuthority); err != nil {
+		return fmt.Errorf("failed to read certificate authority: %w", err)
+	}
+	return nil
+}
+
+var clientOptions ClientOptions
+
+// SetClientOptions sets the options applied to clients created by NewClient
+func SetClientOptions(opts ClientOptions) {
+	clientOptions = opts
+}
+
+// ConfigureRESTConfig applies the options set with SetClientOptions to a REST
+// config built outside NewClient, so every client shares the same settings
+func ConfigureRESTConfig(config *rest.Config) {
+	applyClientOptions(config, clientOptions)
+}
+
 // NewClient creates a new Kubernetes client using gcloud CLI for authentication
 func NewClient() (*Client, error) {
 	cfg := config.Get()
@@ -45,6 +116,7 @@ func NewClient() (*Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to build kube config: %w", err)
 	}
+	applyClientOptions(kubeConfig, clientOptions)
 
 	// Create the clientset
 	clientset, err := kubernetes.NewForConfig(kubeConfig)
@@ -118,12 +190,31 @@ func getClusterCredentials(cfg *config.Config) error {
 	return nil
 }
 
-// buildKubeConfig builds the Kubernetes client configuration
+// kubeConfigPath returns the kubeconfig file the client is built from
+func kubeConfigPath() string {
+	return config.KubeconfigPath()
+}
+
+// buildKubeConfig builds the Kubernetes client configuration from the
+// kubeconfig file, using the context saved with SetContext if there is one.
+// A saved context the kubeconfig no longer has is reported and skipped.
 func buildKubeConfig(cfg *config.Config) (*rest.Config, error) {
-	kubeConfigPath := filepath.Join(os.Getenv("HOME"), ".kube", "config")
+	if cfg != nil && cfg.K8s.Context != "" {
+		if !missingContext(cfg.K8s.Context) {
+			config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+				&clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeConfigPath()},
+				&clientcmd.ConfigOverrides{CurrentContext: cfg.K8s.Context},
+			).ClientConfig()
+			if err != nil {
+				return nil, fmt.Errorf("failed to build config for context %s: %w", cfg.K8s.Context, err)
+			}
+			return config, nil
+		}
+		fmt.Fprintf(os.Stderr, "Warning: context %q not found in the kubeconfig, using its current context\n", cfg.K8s.Context)
+	}
 
 	// Use the kubeconfig file
-	config, err := clientcmd.BuildConfigFromFlags("", kubeConfigPath)
+	config, err := clientcmd.BuildConfigFromFlags("", kubeConfigPath())
 	if err != nil {
 		return nil, fmt.Errorf("failed to build config from kubeconfig: %w", err)
 	}
@@ -131,10 +222,77 @@ func buildKubeConfig(cfg *config.Config) (*rest.Config, error) {
 	return config, nil
 }
 
-// GetNamespace returns the configured namespace or default
+// missingContext reports whether the kubeconfig file loads and lacks the
+// named context
+func missingContext(name string) bool {
+	kubeConfig, err := clientcmd.LoadFromFile(kubeConfigPath())
+	return err == nil && kubeConfig.Contexts[name] == nil
+}
+
+// applyClientOptions applies command line connection settings to a REST config
+func applyClientOptions(config *rest.Config, opts ClientOptions) {
+	if opts.RequestTimeout > 0 {
+		config.Timeout = opts.RequestTimeout
+	}
+	if opts.Verbose {
+		config.Wrap(newRequestLogger)
+	}
+	if opts.AuditLog != "" {
+		context, _ := GetCurrentContext()
+		config.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+			return newAuditLogger(rt, opts.AuditLog, context)
+		})
+	}
+
+	// The REST client refuses a CA together with the insecure flag, so the
+	// kubeconfig's CA is dropped whenever either override is given
+	switch {
+	case opts.InsecureSkipTLSVerify:
+		config.Insecure = true
+		config.CAFile, config.CAData = "", nil
+	case opts.CertificateAuthority != "":
+		config.CAFile, config.CAData = opts.CertificateAuthority, nil
+	}
+
+	if opts.ImpersonateUser != "" {
+		config.Impersonate = rest.ImpersonationConfig{
+			UserName: opts.ImpersonateUser,
+			Groups:   opts.ImpersonateGroups,
+			UID:      opts.ImpersonateUID,
+		}
+	}
+
+	// A proxy-url in the kubeconfig is already set on the config; the flag
+	// takes precedence over it
+	if opts.Proxy != "" {
+		if proxyURL, err := parseProxyURL(opts.Proxy); err == nil {
+			config.Proxy = http.ProxyURL(proxyURL)
+		}
+	}
+}
+
+// parseProxyURL parses a proxy URL, accepting the schemes the REST client
+// can dial through
+func parseProxyURL(proxy string) (*url.URL, error) {
+	proxyURL, err := url.Parse(proxy)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy URL %q: %w", proxy, err)
+	}
+	switch proxyURL.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("invalid proxy URL %q: scheme must be http, https or socks5", proxy)
+	}
+	if proxyURL.Host == "" {
+		return nil, fmt.Errorf("invalid proxy URL %q: missing host", proxy)
+	}
+	return proxyURL, nil
+}
+
+// GetNamespace returns the namespace configured for the current context or default
 func (c *Client) GetNamespace() string {
-	if c.cfg.K8s.Namespace != "" {
-		return c.cfg.K8s.Namespace
+	if namespace := c.cfg.Namespace(); namespace != "" {
+		return namespace
 	}
 	return "default"
 }
@@ -148,20 +306,43 @@ func (c *Client) ValidateConnection(ctx context.Context) error {
 	return nil
 }
 
-// SwitchNamespace switches the current namespace context
+// NamespaceExists reports whether a namespace exists in the cluster. Callers
+// that may not get namespaces are given the benefit of the doubt.
+func (c *Client) NamespaceExists(ctx context.Context, namespace string) (bool, error) {
+	_, err := c.Clientset.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	switch {
+	case err == nil, errors.IsForbidden(err):
+		return true, nil
+	case errors.IsNotFound(err):
+		return false, nil
+	default:
+		return false, fmt.Errorf("failed to check namespace %s: %w", namespace, err)
+	}
+}
+
+// SwitchNamespace switches the namespace remembered for the current context
 func (c *Client) SwitchNamespace(namespace string) error {
-	c.cfg.K8s.Namespace = namespace
-	return config.Update("k8s.namespace", namespace)
+	if err := config.SetNamespace(namespace); err != nil {
+		return err
+	}
+	c.cfg = config.Get()
+	return nil
 }
 
-// GetCurrentContext returns the current kubectl context
+// GetCurrentContext returns the context clients connect to: the one saved
+// with SetContext, or else the current-context of the kubeconfig file
 func GetCurrentContext() (string, error) {
-	cmd := exec.Command("kubectl", "config", "current-context")
-	output, err := cmd.Output()
+	kubeConfig, err := clientcmd.LoadFromFile(kubeConfigPath())
 	if err != nil {
 		return "", fmt.Errorf("failed to get current context: %w", err)
 	}
-	return string(output), nil
+	if name := configuredContext(); name != "" && kubeConfig.Contexts[name] != nil {
+		return name, nil
+	}
+	if kubeConfig.CurrentContext == "" {
+		return "", fmt.Errorf("failed to get current context: current-context is not set")
+	}
+	return kubeConfig.CurrentContext, nil
 }
 
 // ListClusters lists available GKE clusters
@@ -198,30 +379,3 @@ func ListClusters(projectID, zone, region string) ([]string, error) {
 
 	return clusters, nil
 }
-
-// ExecIntoPod executes an interactive shell in a pod
-func ExecIntoPod(namespace, podName, containerName, shell string) error {
-	args := []string{
-		"exec", "-it",
-		"-n", namespace,
-		podName,
-	}
-
-	if containerName != "" {
-		args = append(args, "-c", containerName)
-	}
-
-	args = append(args, "--", shell)
-
-	cmd := exec.Command("kubectl", args...)
-	cmd.Stdin = os.Stdin
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-
-	// Handle interrupt signals properly
-	cmd.SysProcAttr = &syscall.SysProcAttr{
-		Setpgid: true,
-	}
-
-	return cmd.Run()
-}
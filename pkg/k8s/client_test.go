@@ -0,0 +1,204 @@
+package k8s
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestGetCurrentContext(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	_, err := GetCurrentContext()
+	assert.Error(t, err, "missing kubeconfig")
+
+	require.NoError(t, os.MkdirAll(filepath.Join(home, ".kube"), 0755))
+	kubeConfig := `apiVersion: v1
+kind: Config
+clusters:
+- name: prod
+  cluster:
+    server: https://prod.example.com
+contexts:
+- name: gke_acme_prod
+  context:
+    cluster: prod
+    user: admin
+current-context: gke_acme_prod
+users:
+- name: admin
+  user: {}
+`
+	require.NoError(t, os.WriteFile(filepath.Join(home, ".kube", "config"), []byte(kubeConfig), 0600))
+
+	name, err := GetCurrentContext()
+	require.NoError(t, err)
+	assert.Equal(t, "gke_acme_prod", name)
+}
+
+func TestApplyClientOptionsTLS(t *testing.T) {
+	testCases := []struct {
+		name         string
+		opts         ClientOptions
+		wantInsecure bool
+		wantCAFile   string
+		wantCAData   []byte
+	}{
+		{
+			name:       "kubeconfig settings kept by default",
+			opts:       ClientOptions{},
+			wantCAFile: "/kube/ca.crt",
+			wantCAData: []byte("kubeconfig-ca"),
+		},
+		{
+			name:         "skip verification drops the kubeconfig CA",
+			opts:         ClientOptions{InsecureSkipTLSVerify: true},
+			wantInsecure: true,
+		},
+		{
+			name:       "custom CA replaces the kubeconfig CA",
+			opts:       ClientOptions{CertificateAuthority: "/tmp/lab-ca.crt"},
+			wantCAFile: "/tmp/lab-ca.crt",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := &rest.Config{TLSClientConfig: rest.TLSClientConfig{
+				CAFile: "/kube/ca.crt",
+				CAData: []byte("kubeconfig-ca"),
+			}}
+
+			applyClientOptions(config, tc.opts)
+
+			assert.Equal(t, tc.wantInsecure, config.Insecure)
+			assert.Equal(t, tc.wantCAFile, config.CAFile)
+			assert.Equal(t, tc.wantCAData, config.CAData)
+		})
+	}
+}
+
+func TestClientOptionsValidate(t *testing.T) {
+	caFile := filepath.Join(t.TempDir(), "ca.crt")
+	require.NoError(t, os.WriteFile(caFile, []byte("-----BEGIN CERTIFICATE-----\n"), 0600))
+
+	assert.NoError(t, ClientOptions{InsecureSkipTLSVerify: true}.Validate())
+	assert.NoError(t, ClientOptions{CertificateAuthority: caFile}.Validate())
+	assert.ErrorContains(t, ClientOptions{CertificateAuthority: caFile, InsecureSkipTLSVerify: true}.Validate(), "cannot be used with")
+	assert.ErrorContains(t, ClientOptions{CertificateAuthority: filepath.Join(t.TempDir(), "missing.crt")}.Validate(), "failed to read certificate authority")
+}
+
+func TestClientOptionsValidateProxy(t *testing.T) {
+	assert.NoError(t, ClientOptions{Proxy: "http://proxy.corp:3128"}.Validate())
+	assert.NoError(t, ClientOptions{Proxy: "socks5://127.0.0.1:1080"}.Validate())
+	assert.ErrorContains(t, ClientOptions{Proxy: "ftp://proxy.corp"}.Validate(), "scheme must be")
+	assert.ErrorContains(t, ClientOptions{Proxy: "http://"}.Validate(), "missing host")
+}
+
+func TestApplyClientOptionsProxy(t *testing.T) {
+	var proxied []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A proxied plain HTTP request carries the target in its request URI
+		proxied = append(proxied, r.Host+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"major":"1","minor":"28","gitVersion":"v1.28.4"}`))
+	}))
+	defer proxy.Close()
+
+	config := &rest.Config{Host: "http://cluster.internal:8080"}
+	applyClientOptions(config, ClientOptions{Proxy: proxy.URL})
+	require.NotNil(t, config.Proxy)
+
+	clientset, err := kubernetes.NewForConfig(config)
+	require.NoError(t, err)
+	version, err := clientset.Discovery().ServerVersion()
+	require.NoError(t, err)
+
+	assert.Equal(t, "v1.28.4", version.GitVersion)
+	assert.Equal(t, []string{"cluster.internal:8080/version"}, proxied)
+}
+
+func TestBuildKubeConfigProxyURL(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(home, ".kube"), 0755))
+	kubeConfig := `apiVersion: v1
+kind: Config
+clusters:
+- name: prod
+  cluster:
+    server: https://prod.example.com
+    proxy-url: socks5://127.0.0.1:1080
+contexts:
+- name: prod
+  context:
+    cluster: prod
+    user: admin
+current-context: prod
+users:
+- name: admin
+  user: {}
+`
+	require.NoError(t, os.WriteFile(filepath.Join(home, ".kube", "config"), []byte(kubeConfig), 0600))
+
+	config, err := buildKubeConfig(nil)
+	require.NoError(t, err)
+	require.NotNil(t, config.Proxy)
+
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://prod.example.com/api", nil)
+	require.NoError(t, err)
+	proxyURL, err := config.Proxy(request)
+	require.NoError(t, err)
+	assert.Equal(t, "socks5://127.0.0.1:1080", proxyURL.String())
+
+	// The flag wins over the kubeconfig setting
+	applyClientOptions(config, ClientOptions{Proxy: "http://proxy.corp:3128"})
+	proxyURL, err = config.Proxy(request)
+	require.NoError(t, err)
+	assert.Equal(t, "http://proxy.corp:3128", proxyURL.String())
+}
+
+func TestApplyClientOptionsImpersonate(t *testing.T) {
+	config := &rest.Config{}
+	applyClientOptions(config, ClientOptions{})
+	assert.Equal(t, rest.ImpersonationConfig{}, config.Impersonate)
+
+	applyClientOptions(config, ClientOptions{
+		ImpersonateUser:   "system:serviceaccount:ci:deployer",
+		ImpersonateGroups: []string{"system:serviceaccounts", "system:serviceaccounts:ci"},
+		ImpersonateUID:    "1234",
+	})
+	assert.Equal(t, rest.ImpersonationConfig{
+		UserName: "system:serviceaccount:ci:deployer",
+		Groups:   []string{"system:serviceaccounts", "system:serviceaccounts:ci"},
+		UID:      "1234",
+	}, config.Impersonate)
+}
+
+func TestConfigureRESTConfigImpersonates(t *testing.T) {
+	t.Cleanup(func() { SetClientOptions(ClientOptions{}) })
+
+	// Clients built outside NewClient, like the cached one, impersonate too
+	SetClientOptions(ClientOptions{ImpersonateUser: "jane", ImpersonateGroups: []string{"dev"}})
+	config := &rest.Config{}
+	ConfigureRESTConfig(config)
+
+	assert.Equal(t, "jane", config.Impersonate.UserName)
+	assert.Equal(t, []string{"dev"}, config.Impersonate.Groups)
+}
+
+func TestClientOptionsValidateImpersonation(t *testing.T) {
+	assert.NoError(t, ClientOptions{ImpersonateUser: "jane", ImpersonateGroups: []string{"dev"}, ImpersonateUID: "1"}.Validate())
+	assert.ErrorContains(t, ClientOptions{ImpersonateGroups: []string{"dev"}}.Validate(), "require --as")
+	assert.ErrorContains(t, ClientOptions{ImpersonateUID: "1"}.Validate(), "require --as")
+}
@@ -0,0 +1,143 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HealthEndpoints are the API server endpoints checked for cluster health
+var HealthEndpoints = []string{"/healthz", "/readyz"}
+
+// HealthCheck is the answer of one API server health endpoint
+type HealthCheck struct {
+	Endpoint string
+	Healthy  bool
+	// Detail is the reason given when the check fails
+	Detail string
+}
+
+// ComponentHealth is the health of a control plane component such as etcd
+// or the scheduler
+type ComponentHealth struct {
+	Name    string
+	Healthy bool
+	Message string
+}
+
+// ClusterInfo summarises the cluster the client is connected to
+type ClusterInfo struct {
+	Version    string
+	Platform   string
+	Nodes      int
+	ReadyNodes int
+	Namespaces int
+	Checks     []HealthCheck
+	// Components is empty when the cluster no longer reports component
+	// statuses, as managed control planes often don't
+	Components []ComponentHealth
+}
+
+// Healthy reports whether every health check passed
+func (i *ClusterInfo) Healthy() bool {
+	for _, check := range i.Checks {
+		if !check.Healthy {
+			return false
+		}
+	}
+	return true
+}
+
+// GetClusterInfo collects the server version, node and namespace counts and
+// the health of the API server and its control plane components. A failing
+// health endpoint is reported in Checks rather than as an error.
+func (c *Client) GetClusterInfo(ctx context.Context) (*ClusterInfo, error) {
+	serverVersion, err := c.Clientset.Discovery().ServerVersion()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get server version: %w", err)
+	}
+	info := &ClusterInfo{
+		Version:  serverVersion.GitVersion,
+		Platform: serverVersion.Platform,
+	}
+
+	nodes, err := c.Clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	info.Nodes = len(nodes.Items)
+	for i := range nodes.Items {
+		if nodeReady(&nodes.Items[i]) {
+			info.ReadyNodes++
+		}
+	}
+
+	namespaces, err := c.Clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list namespaces: %w", err)
+	}
+	info.Namespaces = len(namespaces.Items)
+
+	for _, endpoint := range HealthEndpoints {
+		info.Checks = append(info.Checks, c.checkHealth(ctx, endpoint))
+	}
+
+	// Component statuses are deprecated and missing on many clusters, so
+	// an error here only means there is nothing to show
+	statuses, err := c.Clientset.CoreV1().ComponentStatuses().List(ctx, metav1.ListOptions{})
+	if err == nil {
+		for _, status := range statuses.Items {
+			info.Components = append(info.Components, componentHealth(status))
+		}
+	}
+
+	return info, nil
+}
+
+// checkHealth asks an API server health endpoint whether it is healthy
+func (c *Client) checkHealth(ctx context.Context, endpoint string) HealthCheck {
+	check := HealthCheck{Endpoint: endpoint}
+
+	body, err := c.Clientset.Discovery().RESTClient().Get().AbsPath(endpoint).DoRaw(ctx)
+	if err != nil {
+		// A failing endpoint explains itself in the body, one line per check
+		check.Detail = strings.TrimSpace(string(body))
+		if check.Detail == "" {
+			check.Detail = err.Error()
+		}
+		return check
+	}
+
+	check.Healthy = strings.TrimSpace(string(body)) == "ok"
+	if !check.Healthy {
+		check.Detail = strings.TrimSpace(string(body))
+	}
+	return check
+}
+
+func componentHealth(status corev1.ComponentStatus) ComponentHealth {
+	health := ComponentHealth{Name: status.Name}
+	for _, condition := range status.Conditions {
+		if condition.Type != corev1.ComponentHealthy {
+			continue
+		}
+		health.Healthy = condition.Status == corev1.ConditionTrue
+		health.Message = condition.Message
+		if condition.Error != "" {
+			health.Message = condition.Error
+		}
+	}
+	return health
+}
+
+func nodeReady(node *corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,129 @@
+package k8s
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestGetClusterInfo(t *testing.T) {
+	readyNode := func(name string, status corev1.ConditionStatus) corev1.Node {
+		return corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: status},
+			}},
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		readyz         int
+		components     bool
+		wantHealthy    bool
+		wantComponents []ComponentHealth
+	}{
+		{
+			name:        "healthy with component statuses",
+			readyz:      http.StatusOK,
+			components:  true,
+			wantHealthy: true,
+			wantComponents: []ComponentHealth{
+				{Name: "etcd-0", Healthy: true, Message: "ok"},
+				{Name: "scheduler", Healthy: false, Message: "connection refused"},
+			},
+		},
+		{
+			name:        "not ready without component statuses",
+			readyz:      http.StatusInternalServerError,
+			wantHealthy: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/healthz":
+					w.Write([]byte("ok"))
+					return
+				case "/readyz":
+					w.WriteHeader(tc.readyz)
+					if tc.readyz == http.StatusOK {
+						w.Write([]byte("ok"))
+					} else {
+						w.Write([]byte("[-]etcd failed: reason withheld\nreadyz check failed"))
+					}
+					return
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/version":
+					json.NewEncoder(w).Encode(&version.Info{GitVersion: "v1.30.2", Platform: "linux/amd64"})
+				case "/api/v1/nodes":
+					json.NewEncoder(w).Encode(&corev1.NodeList{Items: []corev1.Node{
+						readyNode("node-a", corev1.ConditionTrue),
+						readyNode("node-b", corev1.ConditionFalse),
+					}})
+				case "/api/v1/namespaces":
+					json.NewEncoder(w).Encode(&corev1.NamespaceList{Items: []corev1.Namespace{
+						{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+						{ObjectMeta: metav1.ObjectMeta{Name: "kube-system"}},
+						{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}},
+					}})
+				case "/api/v1/componentstatuses":
+					if !tc.components {
+						w.WriteHeader(http.StatusNotFound)
+						json.NewEncoder(w).Encode(&metav1.Status{Status: metav1.StatusFailure, Reason: metav1.StatusReasonNotFound, Code: http.StatusNotFound})
+						return
+					}
+					json.NewEncoder(w).Encode(&corev1.ComponentStatusList{Items: []corev1.ComponentStatus{
+						{
+							ObjectMeta: metav1.ObjectMeta{Name: "etcd-0"},
+							Conditions: []corev1.ComponentCondition{{Type: corev1.ComponentHealthy, Status: corev1.ConditionTrue, Message: "ok"}},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{Name: "scheduler"},
+							Conditions: []corev1.ComponentCondition{{Type: corev1.ComponentHealthy, Status: corev1.ConditionFalse, Error: "connection refused"}},
+						},
+					}})
+				default:
+					t.Errorf("unexpected request %s", r.URL.Path)
+				}
+			}))
+			defer server.Close()
+
+			cs, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+			require.NoError(t, err)
+			client := &Client{Clientset: cs}
+
+			info, err := client.GetClusterInfo(t.Context())
+			require.NoError(t, err)
+
+			assert.Equal(t, "v1.30.2", info.Version)
+			assert.Equal(t, "linux/amd64", info.Platform)
+			assert.Equal(t, 2, info.Nodes)
+			assert.Equal(t, 1, info.ReadyNodes)
+			assert.Equal(t, 3, info.Namespaces)
+			assert.Equal(t, tc.wantHealthy, info.Healthy())
+			assert.Equal(t, tc.wantComponents, info.Components)
+
+			require.Len(t, info.Checks, 2)
+			assert.Equal(t, HealthCheck{Endpoint: "/healthz", Healthy: true}, info.Checks[0])
+			assert.Equal(t, "/readyz", info.Checks[1].Endpoint)
+			if !tc.wantHealthy {
+				assert.Contains(t, info.Checks[1].Detail, "[-]etcd failed")
+			}
+		})
+	}
+}
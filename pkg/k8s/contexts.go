@@ -0,0 +1,71 @@
+package k8s
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/karthickk/k8s-manager/pkg/config"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// KubeContext is a context of the kubeconfig file
+type KubeContext struct {
+	Name      string
+	Cluster   string
+	User      string
+	Namespace string
+}
+
+// ListContexts returns the contexts of the kubeconfig file sorted by name,
+// and the name of the active one
+func ListContexts() ([]KubeContext, string, error) {
+	kubeConfig, err := clientcmd.LoadFromFile(kubeConfigPath())
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+
+	contexts := make([]KubeContext, 0, len(kubeConfig.Contexts))
+	for name, context := range kubeConfig.Contexts {
+		contexts = append(contexts, KubeContext{
+			Name:      name,
+			Cluster:   context.Cluster,
+			User:      context.AuthInfo,
+			Namespace: context.Namespace,
+		})
+	}
+	sort.Slice(contexts, func(i, j int) bool { return contexts[i].Name < contexts[j].Name })
+
+	current := kubeConfig.CurrentContext
+	if name := configuredContext(); name != "" && kubeConfig.Contexts[name] != nil {
+		current = name
+	}
+	return contexts, current, nil
+}
+
+// SetContext checks that a context exists in the kubeconfig and saves it as
+// the context clients connect to. The kubeconfig file itself is left alone.
+func SetContext(name string) error {
+	contexts, _, err := ListContexts()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(contexts))
+	for _, context := range contexts {
+		if context.Name == name {
+			return config.Update(config.ContextSetting, name)
+		}
+		names = append(names, context.Name)
+	}
+	return fmt.Errorf("context %q not found in the kubeconfig (available: %v)", name, names)
+}
+
+// configuredContext returns the context chosen with SetContext, or "" to use
+// the current-context of the kubeconfig. Callers fall back to the
+// current-context too when the kubeconfig no longer has the chosen one.
+func configuredContext() string {
+	if cfg := config.Get(); cfg != nil {
+		return cfg.K8s.Context
+	}
+	return ""
+}
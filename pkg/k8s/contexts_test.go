@@ -0,0 +1,100 @@
+package k8s
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/karthickk/k8s-manager/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const contextsKubeConfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: prod
+  cluster:
+    server: https://prod.example.com
+- name: staging
+  cluster:
+    server: https://staging.example.com
+contexts:
+- name: staging
+  context:
+    cluster: staging
+    user: dev
+    namespace: web
+- name: gke_acme_prod
+  context:
+    cluster: prod
+    user: admin
+current-context: gke_acme_prod
+users:
+- name: admin
+  user: {}
+- name: dev
+  user: {}
+`
+
+func TestSetContext(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv(config.PathEnvVar, filepath.Join(home, "k8s-manager.yaml"))
+	require.NoError(t, os.MkdirAll(filepath.Join(home, ".kube"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(home, ".kube", "config"), []byte(contextsKubeConfig), 0600))
+	_, err := config.Load()
+	require.NoError(t, err)
+	t.Cleanup(func() { config.Update(config.ContextSetting, "") })
+
+	contexts, current, err := ListContexts()
+	require.NoError(t, err)
+	assert.Equal(t, "gke_acme_prod", current)
+	assert.Equal(t, []KubeContext{
+		{Name: "gke_acme_prod", Cluster: "prod", User: "admin"},
+		{Name: "staging", Cluster: "staging", User: "dev", Namespace: "web"},
+	}, contexts)
+
+	assert.ErrorContains(t, SetContext("dev"), `context "dev" not found`)
+
+	require.NoError(t, SetContext("staging"))
+	_, current, err = ListContexts()
+	require.NoError(t, err)
+	assert.Equal(t, "staging", current)
+
+	name, err := GetCurrentContext()
+	require.NoError(t, err)
+	assert.Equal(t, "staging", name)
+
+	restConfig, err := buildKubeConfig(config.Get())
+	require.NoError(t, err)
+	assert.Equal(t, "https://staging.example.com", restConfig.Host)
+
+	cfg, err := config.Load()
+	require.NoError(t, err)
+	assert.Equal(t, "staging", cfg.K8s.Context, "the context is saved")
+}
+
+func TestStaleContextFallsBackToCurrentContext(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv(config.PathEnvVar, filepath.Join(home, "k8s-manager.yaml"))
+	require.NoError(t, os.MkdirAll(filepath.Join(home, ".kube"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(home, ".kube", "config"), []byte(contextsKubeConfig), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(home, "k8s-manager.yaml"), []byte("k8s:\n  context: deleted-cluster\n"), 0644))
+	_, err := config.Load()
+	require.NoError(t, err)
+	t.Cleanup(func() { config.Update(config.ContextSetting, "") })
+
+	_, current, err := ListContexts()
+	require.NoError(t, err)
+	assert.Equal(t, "gke_acme_prod", current)
+
+	name, err := GetCurrentContext()
+	require.NoError(t, err)
+	assert.Equal(t, "gke_acme_prod", name)
+
+	restConfig, err := buildKubeConfig(config.Get())
+	require.NoError(t, err)
+	assert.Equal(t, "https://prod.example.com", restConfig.Host)
+}
@@ -0,0 +1,235 @@
+package k8s
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+// CopyFromPod copies a file or directory from a container to the local
+// machine by streaming it through tar, as kubectl cp does. If destPath is an
+// existing directory the source is copied into it. It returns the number of
+// files copied.
+func (c *Client) CopyFromPod(ctx context.Context, namespace, pod, container, srcPath, destPath string) (int, error) {
+	srcPath = path.Clean(srcPath)
+	if srcPath == "/" || srcPath == "." {
+		return 0, fmt.Errorf("cannot copy %q: specify a file or directory", srcPath)
+	}
+
+	if info, err := os.Stat(destPath); err == nil && info.IsDir() {
+		destPath = filepath.Join(destPath, path.Base(srcPath))
+	}
+
+	reader, writer := io.Pipe()
+	defer reader.Close()
+
+	var stderr bytes.Buffer
+	go func() {
+		err := c.Exec(ctx, namespace, pod, ExecOptions{
+			Container: container,
+			Command:   []string{"tar", "cf", "-", "-C", path.Dir(srcPath), path.Base(srcPath)},
+			Stdout:    writer,
+			Stderr:    &stderr,
+		})
+		writer.CloseWithError(err)
+	}()
+
+	copied, err := untar(reader, path.Base(srcPath), destPath)
+	if err != nil {
+		return copied, copyError(err, stderr.String(), pod, container)
+	}
+	if copied == 0 {
+		return 0, fmt.Errorf("nothing to copy from %s:%s", pod, srcPath)
+	}
+	return copied, nil
+}
+
+// CopyToPod copies a local file or directory into a container by streaming
+// it through tar. destPath is the path the source is written to; a trailing
+// slash copies the source into that directory instead. It returns the
+// number of files copied.
+func (c *Client) CopyToPod(ctx context.Context, namespace, pod, container, srcPath, destPath string) (int, error) {
+	if _, err := os.Stat(srcPath); err != nil {
+		return 0, fmt.Errorf("cannot copy %s: %w", srcPath, err)
+	}
+
+	if strings.HasSuffix(destPath, "/") {
+		destPath += filepath.Base(srcPath)
+	}
+	destPath = path.Clean(destPath)
+
+	reader, writer := io.Pipe()
+	defer reader.Close()
+
+	copied := make(chan int, 1)
+	go func() {
+		files, err := tarPath(writer, srcPath, path.Base(destPath))
+		writer.CloseWithError(err)
+		copied <- files
+	}()
+
+	var stderr bytes.Buffer
+	err := c.Exec(ctx, namespace, pod, ExecOptions{
+		Container: container,
+		Command:   []string{"tar", "-xmf", "-", "-C", path.Dir(destPath)},
+		Stdin:     reader,
+		Stderr:    &stderr,
+	})
+	if err != nil {
+		return 0, copyError(err, stderr.String(), pod, container)
+	}
+
+	// tar may stop reading before the archive padding; unblock the writer
+	reader.Close()
+	return <-copied, nil
+}
+
+// tarPath writes src, a file or a directory tree, to w as a tar archive
+// whose entries are rooted at name. Only regular files and directories are
+// archived. It returns the number of files written.
+func tarPath(w io.Writer, src, name string) (int, error) {
+	tw := tar.NewWriter(w)
+	files := 0
+
+	err := filepath.Walk(src, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() && !info.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(src, file)
+		if err != nil {
+			return err
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = path.Join(name, filepath.ToSlash(rel))
+		if info.IsDir() {
+			header.Name += "/"
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		f, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		if _, err := io.Copy(tw, f); err != nil {
+			return err
+		}
+		files++
+		return nil
+	})
+	if err != nil {
+		return files, err
+	}
+
+	return files, tw.Close()
+}
+
+// untar extracts the entries of a tar archive rooted at prefix to dest, so
+// "prefix/a/b" is written to "dest/a/b". Entries outside prefix, or that
+// would escape dest, are rejected; links and special files are skipped. It
+// returns the number of files written.
+func untar(r io.Reader, prefix, dest string) (int, error) {
+	tr := tar.NewReader(r)
+	files := 0
+
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return files, nil
+		}
+		if err != nil {
+			return files, err
+		}
+
+		name := path.Clean(header.Name)
+		var rel string
+		switch {
+		case name == prefix:
+		case strings.HasPrefix(name, prefix+"/"):
+			rel = strings.TrimPrefix(name, prefix+"/")
+		default:
+			return files, fmt.Errorf("refusing to extract %q: outside of %q", header.Name, prefix)
+		}
+		if rel == ".." || strings.HasPrefix(rel, "../") {
+			return files, fmt.Errorf("refusing to extract %q: outside of %q", header.Name, prefix)
+		}
+
+		target := filepath.Join(dest, filepath.FromSlash(rel))
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return files, err
+			}
+
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return files, err
+			}
+			f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, header.FileInfo().Mode().Perm())
+			if err != nil {
+				return files, err
+			}
+			_, err = io.Copy(f, tr)
+			f.Close()
+			if err != nil {
+				return files, err
+			}
+			files++
+		}
+	}
+}
+
+// copyError explains why a copy failed, calling out the common case of a
+// container image without tar
+func copyError(err error, stderr, pod, container string) error {
+	if tarMissing(err, stderr) {
+		target := "pod " + pod
+		if container != "" {
+			target = fmt.Sprintf("container %s of pod %s", container, pod)
+		}
+		return fmt.Errorf("tar is not available in %s; copying files requires tar in the container image", target)
+	}
+
+	if stderr = strings.TrimSpace(stderr); stderr != "" {
+		return fmt.Errorf("copy failed: %s: %w", stderr, err)
+	}
+	return fmt.Errorf("copy failed: %w", err)
+}
+
+// tarMissing reports whether a command failed because tar could not be
+// found in the container
+func tarMissing(err error, stderr string) bool {
+	var exitErr utilexec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitStatus() == 127 {
+		return true
+	}
+
+	message := err.Error() + "\n" + stderr
+	return strings.Contains(message, "executable file not found") ||
+		strings.Contains(message, "tar: not found") ||
+		strings.Contains(message, "tar: command not found")
+}
@@ -0,0 +1,134 @@
+package k8s
+
+import (
+	"archive/tar"
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+func TestTarPathUntarRoundTrip(t *testing.T) {
+	src := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "conf", "nested"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "conf", "app.yaml"), []byte("port: 8080\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "conf", "nested", "run.sh"), []byte("#!/bin/sh\n"), 0755))
+
+	var archive bytes.Buffer
+	files, err := tarPath(&archive, filepath.Join(src, "conf"), "settings")
+	require.NoError(t, err)
+	assert.Equal(t, 2, files)
+
+	dest := filepath.Join(t.TempDir(), "restored")
+	files, err = untar(&archive, "settings", dest)
+	require.NoError(t, err)
+	assert.Equal(t, 2, files)
+
+	data, err := os.ReadFile(filepath.Join(dest, "app.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "port: 8080\n", string(data))
+
+	info, err := os.Stat(filepath.Join(dest, "nested", "run.sh"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0755), info.Mode().Perm())
+}
+
+func TestTarPathSingleFile(t *testing.T) {
+	src := filepath.Join(t.TempDir(), "dump.sql")
+	require.NoError(t, os.WriteFile(src, []byte("select 1;"), 0600))
+
+	var archive bytes.Buffer
+	_, err := tarPath(&archive, src, "backup.sql")
+	require.NoError(t, err)
+
+	dest := filepath.Join(t.TempDir(), "backup.sql")
+	files, err := untar(&archive, "backup.sql", dest)
+	require.NoError(t, err)
+	assert.Equal(t, 1, files)
+
+	data, err := os.ReadFile(dest)
+	require.NoError(t, err)
+	assert.Equal(t, "select 1;", string(data))
+}
+
+func TestUntarRejectsEntriesOutsidePrefix(t *testing.T) {
+	testCases := []struct {
+		name  string
+		entry string
+	}{
+		{name: "parent directory", entry: "logs/../../etc/passwd"},
+		{name: "absolute path", entry: "/etc/passwd"},
+		{name: "other prefix", entry: "other/file"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var archive bytes.Buffer
+			tw := tar.NewWriter(&archive)
+			require.NoError(t, tw.WriteHeader(&tar.Header{Name: tc.entry, Mode: 0644, Size: 1, Typeflag: tar.TypeReg}))
+			_, err := tw.Write([]byte("x"))
+			require.NoError(t, err)
+			require.NoError(t, tw.Close())
+
+			dest := t.TempDir()
+			_, err = untar(&archive, "logs", dest)
+			assert.ErrorContains(t, err, "refusing to extract")
+		})
+	}
+}
+
+func TestUntarSkipsLinks(t *testing.T) {
+	var archive bytes.Buffer
+	tw := tar.NewWriter(&archive)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "logs/", Mode: 0755, Typeflag: tar.TypeDir}))
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "logs/shadow", Linkname: "/etc/shadow", Typeflag: tar.TypeSymlink}))
+	require.NoError(t, tw.Close())
+
+	dest := filepath.Join(t.TempDir(), "logs")
+	files, err := untar(&archive, "logs", dest)
+	require.NoError(t, err)
+	assert.Equal(t, 0, files)
+
+	_, err = os.Lstat(filepath.Join(dest, "shadow"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestCopyErrorReportsMissingTar(t *testing.T) {
+	testCases := []struct {
+		name      string
+		err       error
+		stderr    string
+		container string
+		wantErr   string
+	}{
+		{
+			name:    "exit status 127",
+			err:     utilexec.CodeExitError{Err: errors.New("command terminated with exit code 127"), Code: 127},
+			wantErr: "tar is not available in pod web",
+		},
+		{
+			name:      "runtime cannot find tar",
+			err:       errors.New(`OCI runtime exec failed: exec: "tar": executable file not found in $PATH`),
+			container: "app",
+			wantErr:   "tar is not available in container app of pod web",
+		},
+		{
+			name:    "other failure includes stderr",
+			err:     utilexec.CodeExitError{Err: errors.New("command terminated with exit code 2"), Code: 2},
+			stderr:  "tar: /data/missing: No such file or directory\n",
+			wantErr: "copy failed: tar: /data/missing: No such file or directory",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := copyError(tc.err, tc.stderr, "web", tc.container)
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/karthickk/k8s-manager/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// DefaultContainerAnnotation names the container kubectl picks when none is given
+const DefaultContainerAnnotation = "kubectl.kubernetes.io/default-container"
+
+// minEphemeralContainersVersion is the first release with ephemeral
+// containers enabled by default
+var minEphemeralContainersVersion = version.MustParseGeneric("1.23.0")
+
+// DebugOptions describes an ephemeral debug container
+type DebugOptions struct {
+	Image string
+	// Target is the container whose process namespace the debug container
+	// joins; empty keeps it in its own
+	Target string
+}
+
+// AddDebugContainer adds an ephemeral container running opts.Image to a pod
+// and returns its name. Clusters older than 1.23, where ephemeral containers
+// are missing or disabled by default, are refused with an explanation.
+func (c *Client) AddDebugContainer(ctx context.Context, namespace, podName string, opts DebugOptions) (string, error) {
+	if err := c.checkEphemeralContainersSupported(); err != nil {
+		return "", err
+	}
+
+	pod, err := c.Clientset.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get pod %s: %w", podName, err)
+	}
+
+	if opts.Target != "" && !hasAppContainer(pod, opts.Target) {
+		return "", fmt.Errorf("container %s not found in pod %s", opts.Target, podName)
+	}
+
+	name := debugContainerName(pod)
+	updated := pod.DeepCopy()
+	updated.Spec.EphemeralContainers = append(updated.Spec.EphemeralContainers, corev1.EphemeralContainer{
+		EphemeralContainerCommon: corev1.EphemeralContainerCommon{
+			Name:                     name,
+			Image:                    opts.Image,
+			ImagePullPolicy:          corev1.PullIfNotPresent,
+			Stdin:                    true,
+			TTY:                      true,
+			TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+		},
+		TargetContainerName: opts.Target,
+	})
+
+	_, err = c.Clientset.CoreV1().Pods(namespace).UpdateEphemeralContainers(ctx, podName, updated, metav1.UpdateOptions{FieldManager: FieldManager()})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return "", fmt.Errorf("ephemeral containers are not enabled on this cluster (the EphemeralContainers feature gate may be off): %w", err)
+		}
+		return "", fmt.Errorf("failed to add debug container to pod %s: %w", podName, err)
+	}
+
+	return name, nil
+}
+
+// WaitForEphemeralContainer waits until an ephemeral container of a pod is
+// running. It fails if the container exits first or its image cannot be pulled.
+func (c *Client) WaitForEphemeralContainer(ctx context.Context, namespace, podName, container string) error {
+	pod, err := c.Clientset.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get pod %s: %w", podName, err)
+	}
+
+	done, err := ephemeralContainerStarted(pod, container)
+	if done || err != nil {
+		return err
+	}
+
+	var waitErr error
+	err = c.WatchPod(ctx, namespace, podName, pod.ResourceVersion, func(event watch.EventType, updated *corev1.Pod) bool {
+		if event == watch.Deleted {
+			waitErr = fmt.Errorf("pod %s was deleted", podName)
+			return true
+		}
+		done, waitErr = ephemeralContainerStarted(updated, container)
+		return done || waitErr != nil
+	})
+	if err != nil {
+		return err
+	}
+	if waitErr != nil {
+		return waitErr
+	}
+	if !done {
+		return fmt.Errorf("timed out waiting for debug container %s to start: %w", container, ctx.Err())
+	}
+	return nil
+}
+
+// DefaultContainer returns the container commands default to: the one named
+// by the default-container annotation, or else the primary container picked
+// by utils.PrimaryContainer
+func DefaultContainer(pod *corev1.Pod) string {
+	if name := pod.Annotations[DefaultContainerAnnotation]; name != "" && hasAppContainer(pod, name) {
+		return name
+	}
+	return utils.PrimaryContainer(pod)
+}
+
+func (c *Client) checkEphemeralContainersSupported() error {
+	info, err := c.Clientset.Discovery().ServerVersion()
+	if err != nil {
+		return fmt.Errorf("failed to get server version: %w", err)
+	}
+
+	serverVersion, err := version.ParseGeneric(info.GitVersion)
+	if err != nil {
+		// Unusual version strings should not block debugging; the API call
+		// itself reports if the feature is missing
+		return nil
+	}
+	if serverVersion.LessThan(minEphemeralContainersVersion) {
+		return fmt.Errorf("ephemeral debug containers need Kubernetes %s or later, but the cluster runs %s",
+			minEphemeralContainersVersion, info.GitVersion)
+	}
+	return nil
+}
+
+// ephemeralContainerStarted reports whether an ephemeral container is
+// running, or returns an error if it never will be
+func ephemeralContainerStarted(pod *corev1.Pod, container string) (bool, error) {
+	for _, status := range pod.Status.EphemeralContainerStatuses {
+		if status.Name != container {
+			continue
+		}
+		switch {
+		case status.State.Running != nil:
+			return true, nil
+		case status.State.Terminated != nil:
+			return false, fmt.Errorf("debug container %s exited: %s", container, terminatedReason(status.State.Terminated))
+		case status.State.Waiting != nil:
+			switch status.State.Waiting.Reason {
+			case "ErrImagePull", "ImagePullBackOff", "InvalidImageName":
+				return false, fmt.Errorf("debug container %s cannot start: %s: %s",
+					container, status.State.Waiting.Reason, status.State.Waiting.Message)
+			}
+		}
+	}
+	return false, nil
+}
+
+// debugContainerName picks a name not used by any container of the pod
+func debugContainerName(pod *corev1.Pod) string {
+	for {
+		name := "debugger-" + utilrand.String(5)
+		if !hasContainer(pod, name) {
+			return name
+		}
+	}
+}
+
+// hasAppContainer reports whether a pod has a regular container named name;
+// only those can be targeted by an ephemeral container
+func hasAppContainer(pod *corev1.Pod, name string) bool {
+	for _, container := range pod.Spec.Containers {
+		if container.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func hasContainer(pod *corev1.Pod, name string) bool {
+	if hasAppContainer(pod, name) {
+		return true
+	}
+	for _, container := range pod.Spec.InitContainers {
+		if container.Name == name {
+			return true
+		}
+	}
+	for _, container := range pod.Spec.EphemeralContainers {
+		if container.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,138 @@
+package k8s
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestAddDebugContainer(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-0", Namespace: "default"},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+	}
+
+	testCases := []struct {
+		name          string
+		serverVersion string
+		ephemeralAPI  bool
+		target        string
+		wantErr       string
+	}{
+		{name: "adds container targeting app", serverVersion: "v1.29.2-gke.1", ephemeralAPI: true, target: "app"},
+		{name: "cluster too old", serverVersion: "v1.22.17", ephemeralAPI: true, wantErr: "need Kubernetes 1.23.0 or later"},
+		{name: "feature disabled", serverVersion: "v1.23.4", wantErr: "ephemeral containers are not enabled"},
+		{name: "unknown target", serverVersion: "v1.29.2", ephemeralAPI: true, target: "sidecar", wantErr: "container sidecar not found"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var added *corev1.Pod
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.URL.Path == "/version":
+					json.NewEncoder(w).Encode(&version.Info{GitVersion: tc.serverVersion})
+				case r.URL.Path == "/api/v1/namespaces/default/pods/web-0" && r.Method == http.MethodGet:
+					json.NewEncoder(w).Encode(pod)
+				case r.URL.Path == "/api/v1/namespaces/default/pods/web-0/ephemeralcontainers" && tc.ephemeralAPI:
+					added = &corev1.Pod{}
+					assert.NoError(t, json.NewDecoder(r.Body).Decode(added))
+					json.NewEncoder(w).Encode(added)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+					json.NewEncoder(w).Encode(&metav1.Status{Status: metav1.StatusFailure, Reason: metav1.StatusReasonNotFound, Code: http.StatusNotFound})
+				}
+			}))
+			defer server.Close()
+
+			cs, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+			require.NoError(t, err)
+			client := &Client{Clientset: cs}
+
+			name, err := client.AddDebugContainer(t.Context(), "default", "web-0", DebugOptions{Image: "busybox", Target: tc.target})
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Regexp(t, `^debugger-[a-z0-9]{5}$`, name)
+			require.NotNil(t, added)
+			require.Len(t, added.Spec.EphemeralContainers, 1)
+			debug := added.Spec.EphemeralContainers[0]
+			assert.Equal(t, name, debug.Name)
+			assert.Equal(t, "busybox", debug.Image)
+			assert.Equal(t, tc.target, debug.TargetContainerName)
+			assert.True(t, debug.Stdin)
+			assert.True(t, debug.TTY)
+		})
+	}
+}
+
+func TestEphemeralContainerStarted(t *testing.T) {
+	testCases := []struct {
+		name    string
+		state   corev1.ContainerState
+		want    bool
+		wantErr string
+	}{
+		{name: "creating", state: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ContainerCreating"}}},
+		{name: "running", state: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}, want: true},
+		{
+			name:    "image pull failure",
+			state:   corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ErrImagePull", Message: "not found"}},
+			wantErr: "cannot start: ErrImagePull: not found",
+		},
+		{
+			name:    "exited",
+			state:   corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1}},
+			wantErr: "exited: ExitCode:1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pod := &corev1.Pod{Status: corev1.PodStatus{
+				EphemeralContainerStatuses: []corev1.ContainerStatus{{Name: "debugger-abcde", State: tc.state}},
+			}}
+
+			started, err := ephemeralContainerStarted(pod, "debugger-abcde")
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, started)
+		})
+	}
+
+	started, err := ephemeralContainerStarted(&corev1.Pod{}, "debugger-abcde")
+	assert.NoError(t, err)
+	assert.False(t, started, "no status yet")
+}
+
+func TestDefaultContainer(t *testing.T) {
+	pod := &corev1.Pod{Spec: corev1.PodSpec{
+		InitContainers: []corev1.Container{{Name: "migrate"}},
+		Containers:     []corev1.Container{{Name: "istio-proxy"}, {Name: "app"}},
+	}}
+	assert.Equal(t, "app", DefaultContainer(pod), "sidecars are skipped")
+
+	pod.Annotations = map[string]string{DefaultContainerAnnotation: "istio-proxy"}
+	assert.Equal(t, "istio-proxy", DefaultContainer(pod), "the annotation wins")
+
+	pod.Annotations[DefaultContainerAnnotation] = "migrate"
+	assert.Equal(t, "app", DefaultContainer(pod), "init containers cannot be the default")
+
+	assert.Empty(t, DefaultContainer(&corev1.Pod{}))
+}
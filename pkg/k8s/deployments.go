@@ -0,0 +1,330 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// deploymentPollInterval is how often rollout progress is checked
+const deploymentPollInterval = 2 * time.Second
+
+// progressDeadlineExceededReason is the reason of the Progressing condition
+// of a deployment whose rollout got stuck
+const progressDeadlineExceededReason = "ProgressDeadlineExceeded"
+
+const (
+	// RevisionAnnotation holds the rollout revision of a deployment and of
+	// the ReplicaSets it owns
+	RevisionAnnotation = "deployment.kubernetes.io/revision"
+	// ChangeCauseAnnotation records why a revision was rolled out
+	ChangeCauseAnnotation = "kubernetes.io/change-cause"
+)
+
+// DeploymentProgress is a snapshot of how far a deployment is from its desired state
+type DeploymentProgress struct {
+	Desired   int32
+	Current   int32 // Pods that exist, including ones still terminating
+	Updated   int32
+	Ready     int32
+	Available int32
+	// Stalled holds the reason the rollout stopped making progress, once
+	// the deployment has exceeded its progress deadline
+	Stalled  string
+	observed bool
+}
+
+// Done reports whether the deployment has settled on its desired replica count
+func (p DeploymentProgress) Done() bool {
+	return p.observed &&
+		p.Current == p.Desired &&
+		p.Updated == p.Desired &&
+		p.Available == p.Desired
+}
+
+// String formats the progress as shown while waiting on a deployment
+func (p DeploymentProgress) String() string {
+	return fmt.Sprintf("%d/%d ready, %d up-to-date, %d available", p.Ready, p.Desired, p.Updated, p.Available)
+}
+
+// NewDeploymentProgress summarises the replica status of a deployment
+func NewDeploymentProgress(deployment *appsv1.Deployment) DeploymentProgress {
+	desired := int32(1)
+	if deployment.Spec.Replicas != nil {
+		desired = *deployment.Spec.Replicas
+	}
+
+	progress := DeploymentProgress{
+		Desired:   desired,
+		Current:   deployment.Status.Replicas,
+		Updated:   deployment.Status.UpdatedReplicas,
+		Ready:     deployment.Status.ReadyReplicas,
+		Available: deployment.Status.AvailableReplicas,
+		observed:  deployment.Status.ObservedGeneration >= deployment.Generation,
+	}
+	for _, condition := range deployment.Status.Conditions {
+		if condition.Type == appsv1.DeploymentProgressing &&
+			condition.Status == corev1.ConditionFalse &&
+			condition.Reason == progressDeadlineExceededReason {
+			progress.Stalled = condition.Message
+		}
+	}
+	return progress
+}
+
+// ValidateReplicas checks that a replica count is a non-negative int32
+func ValidateReplicas(replicas int64) (int32, error) {
+	if replicas < 0 {
+		return 0, fmt.Errorf("replicas must be a non-negative integer, got %d", replicas)
+	}
+	if replicas > 1<<31-1 {
+		return 0, fmt.Errorf("replicas must be at most %d, got %d", 1<<31-1, replicas)
+	}
+	return int32(replicas), nil
+}
+
+// ParseReplicas parses a replica count typed by the user
+func ParseReplicas(input string) (int32, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return 0, fmt.Errorf("replicas must be a non-negative integer")
+	}
+
+	replicas, err := strconv.ParseInt(input, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("replicas must be a non-negative integer, got %q", input)
+	}
+
+	return ValidateReplicas(replicas)
+}
+
+// ScaleDeployment sets the desired replica count of a deployment
+func ScaleDeployment(ctx context.Context, client kubernetes.Interface, namespace, name string, replicas int32) error {
+	patch := fmt.Sprintf(`{"spec":{"replicas":%d}}`, replicas)
+
+	_, err := client.AppsV1().Deployments(namespace).Patch(
+		ctx, name, types.MergePatchType, []byte(patch), metav1.PatchOptions{FieldManager: FieldManager()})
+	if err != nil {
+		return fmt.Errorf("failed to scale deployment %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// GetDeploymentProgress fetches the current replica status of a deployment
+func GetDeploymentProgress(ctx context.Context, client kubernetes.Interface, namespace, name string) (DeploymentProgress, error) {
+	deployment, err := client.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return DeploymentProgress{}, fmt.Errorf("failed to get deployment %s: %w", name, err)
+	}
+	return NewDeploymentProgress(deployment), nil
+}
+
+// WaitForDeploymentReady polls a deployment until it has settled on its
+// desired replica count or ctx is done. onProgress is called whenever the
+// observed progress changes. A rollout that exceeds its progress deadline
+// fails right away rather than when ctx is done.
+func WaitForDeploymentReady(ctx context.Context, client kubernetes.Interface, namespace, name string, onProgress func(DeploymentProgress)) error {
+	ticker := time.NewTicker(deploymentPollInterval)
+	defer ticker.Stop()
+
+	var last DeploymentProgress
+	first := true
+	for {
+		progress, err := GetDeploymentProgress(ctx, client, namespace, name)
+		if err != nil {
+			return err
+		}
+
+		if onProgress != nil && (first || progress != last) {
+			onProgress(progress)
+		}
+		first = false
+		last = progress
+
+		if progress.Done() {
+			return nil
+		}
+		if progress.Stalled != "" {
+			return fmt.Errorf("deployment %s exceeded its progress deadline (%s): %s", name, progress, progress.Stalled)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for deployment %s (%s): %w", name, last, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// DeploymentRevision is one entry in the rollout history of a deployment,
+// backed by the ReplicaSet created for it
+type DeploymentRevision struct {
+	Revision    int64
+	ReplicaSet  string
+	ChangeCause string
+	Images      []string
+	Created     time.Time
+	Template    corev1.PodTemplateSpec
+}
+
+// DeploymentHistory returns a deployment and its rollout history, oldest
+// revision first
+func DeploymentHistory(ctx context.Context, client kubernetes.Interface, namespace, name string) (*appsv1.Deployment, []DeploymentRevision, error) {
+	deployment, err := client.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get deployment %s: %w", name, err)
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid selector on deployment %s: %w", name, err)
+	}
+
+	replicaSets, err := client.AppsV1().ReplicaSets(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list replica sets of deployment %s: %w", name, err)
+	}
+
+	return deployment, DeploymentRevisions(deployment, replicaSets.Items), nil
+}
+
+// DeploymentRevisions builds the rollout history of a deployment from the
+// ReplicaSets it controls, oldest revision first. ReplicaSets without a
+// revision annotation are skipped.
+func DeploymentRevisions(deployment *appsv1.Deployment, replicaSets []appsv1.ReplicaSet) []DeploymentRevision {
+	var revisions []DeploymentRevision
+	for i := range replicaSets {
+		rs := &replicaSets[i]
+		if !metav1.IsControlledBy(rs, deployment) {
+			continue
+		}
+
+		revision, err := strconv.ParseInt(rs.Annotations[RevisionAnnotation], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		var images []string
+		for _, container := range rs.Spec.Template.Spec.Containers {
+			images = append(images, container.Image)
+		}
+
+		revisions = append(revisions, DeploymentRevision{
+			Revision:    revision,
+			ReplicaSet:  rs.Name,
+			ChangeCause: rs.Annotations[ChangeCauseAnnotation],
+			Images:      images,
+			Created:     rs.CreationTimestamp.Time,
+			Template:    rs.Spec.Template,
+		})
+	}
+
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Revision < revisions[j].Revision
+	})
+	return revisions
+}
+
+// CurrentRevision returns the revision a deployment is rolled out at, or 0
+// if it has none yet
+func CurrentRevision(deployment *appsv1.Deployment) int64 {
+	revision, _ := strconv.ParseInt(deployment.Annotations[RevisionAnnotation], 10, 64)
+	return revision
+}
+
+// SelectRollbackRevision picks the revision to roll back to. A target of 0
+// means the latest revision before the current one.
+func SelectRollbackRevision(revisions []DeploymentRevision, current, target int64) (DeploymentRevision, error) {
+	if target < 0 {
+		return DeploymentRevision{}, fmt.Errorf("revision must be a positive integer, got %d", target)
+	}
+
+	if target == 0 {
+		for i := len(revisions) - 1; i >= 0; i-- {
+			if revisions[i].Revision < current {
+				return revisions[i], nil
+			}
+		}
+		return DeploymentRevision{}, fmt.Errorf("no previous revision to roll back to")
+	}
+
+	for _, revision := range revisions {
+		if revision.Revision == target {
+			return revision, nil
+		}
+	}
+	return DeploymentRevision{}, fmt.Errorf("revision %d not found", target)
+}
+
+// RollbackDeployment rolls a deployment back to an earlier revision by
+// restoring that revision's pod template, as kubectl rollout undo does. A
+// toRevision of 0 rolls back to the previous revision. It returns the
+// revision rolled back to, and false if the deployment already runs that
+// template and nothing was changed.
+func RollbackDeployment(ctx context.Context, client kubernetes.Interface, namespace, name string, toRevision int64) (int64, bool, error) {
+	deployment, revisions, err := DeploymentHistory(ctx, client, namespace, name)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if deployment.Spec.Paused {
+		return 0, false, fmt.Errorf("deployment %s is paused; resume it before rolling back", name)
+	}
+
+	target, err := SelectRollbackRevision(revisions, CurrentRevision(deployment), toRevision)
+	if err != nil {
+		return 0, false, fmt.Errorf("cannot roll back deployment %s: %w", name, err)
+	}
+
+	template := rollbackTemplate(target.Template)
+	if equality.Semantic.DeepEqual(template, deployment.Spec.Template) {
+		return target.Revision, false, nil
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, err := client.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		current.Spec.Template = template
+		if current.Annotations == nil {
+			current.Annotations = map[string]string{}
+		}
+		if target.ChangeCause != "" {
+			current.Annotations[ChangeCauseAnnotation] = target.ChangeCause
+		} else {
+			delete(current.Annotations, ChangeCauseAnnotation)
+		}
+
+		_, err = client.AppsV1().Deployments(namespace).Update(ctx, current, metav1.UpdateOptions{FieldManager: FieldManager()})
+		return err
+	})
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to roll back deployment %s: %w", name, err)
+	}
+
+	return target.Revision, true, nil
+}
+
+// rollbackTemplate returns the pod template of a ReplicaSet without the
+// pod-template-hash label the deployment controller adds to it
+func rollbackTemplate(template corev1.PodTemplateSpec) corev1.PodTemplateSpec {
+	template = *template.DeepCopy()
+	delete(template.Labels, appsv1.DefaultDeploymentUniqueLabelKey)
+	return template
+}
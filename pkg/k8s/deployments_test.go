@@ -0,0 +1,201 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestParseReplicas(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected int32
+		wantErr  bool
+	}{
+		{name: "positive", input: "3", expected: 3},
+		{name: "zero", input: "0", expected: 0},
+		{name: "surrounding spaces", input: " 5 ", expected: 5},
+		{name: "empty", input: "", wantErr: true},
+		{name: "negative", input: "-1", wantErr: true},
+		{name: "not a number", input: "three", wantErr: true},
+		{name: "fraction", input: "1.5", wantErr: true},
+		{name: "too large", input: "4294967296", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			replicas, err := ParseReplicas(tc.input)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, replicas)
+		})
+	}
+}
+
+func TestDeploymentProgressDone(t *testing.T) {
+	replicas := int32(3)
+	newDeployment := func(status appsv1.DeploymentStatus) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Generation: 2},
+			Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+			Status:     status,
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		status   appsv1.DeploymentStatus
+		expected bool
+	}{
+		{
+			name: "all replicas available",
+			status: appsv1.DeploymentStatus{
+				ObservedGeneration: 2, Replicas: 3, UpdatedReplicas: 3, ReadyReplicas: 3, AvailableReplicas: 3,
+			},
+			expected: true,
+		},
+		{
+			name: "scaling up",
+			status: appsv1.DeploymentStatus{
+				ObservedGeneration: 2, Replicas: 3, UpdatedReplicas: 3, ReadyReplicas: 1, AvailableReplicas: 1,
+			},
+			expected: false,
+		},
+		{
+			name: "old pods still terminating",
+			status: appsv1.DeploymentStatus{
+				ObservedGeneration: 2, Replicas: 5, UpdatedReplicas: 3, ReadyReplicas: 3, AvailableReplicas: 3,
+			},
+			expected: false,
+		},
+		{
+			name: "new spec not yet observed",
+			status: appsv1.DeploymentStatus{
+				ObservedGeneration: 1, Replicas: 3, UpdatedReplicas: 3, ReadyReplicas: 3, AvailableReplicas: 3,
+			},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			progress := NewDeploymentProgress(newDeployment(tc.status))
+			assert.Equal(t, tc.expected, progress.Done())
+		})
+	}
+}
+
+func TestDeploymentProgressStalled(t *testing.T) {
+	deployment := &appsv1.Deployment{Status: appsv1.DeploymentStatus{
+		Conditions: []appsv1.DeploymentCondition{{
+			Type:    appsv1.DeploymentProgressing,
+			Status:  corev1.ConditionFalse,
+			Reason:  "ProgressDeadlineExceeded",
+			Message: `ReplicaSet "web-7d9f" has timed out progressing.`,
+		}},
+	}}
+	assert.Equal(t, `ReplicaSet "web-7d9f" has timed out progressing.`, NewDeploymentProgress(deployment).Stalled)
+
+	deployment.Status.Conditions[0].Status = corev1.ConditionTrue
+	deployment.Status.Conditions[0].Reason = "NewReplicaSetAvailable"
+	assert.Empty(t, NewDeploymentProgress(deployment).Stalled)
+}
+
+func newRevisionReplicaSet(deployment *appsv1.Deployment, name, revision, changeCause, image string) appsv1.ReplicaSet {
+	annotations := map[string]string{RevisionAnnotation: revision}
+	if changeCause != "" {
+		annotations[ChangeCauseAnnotation] = changeCause
+	}
+
+	return appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Annotations:     annotations,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(deployment, appsv1.SchemeGroupVersion.WithKind("Deployment"))},
+		},
+		Spec: appsv1.ReplicaSetSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{
+					"app":                                  "web",
+					appsv1.DefaultDeploymentUniqueLabelKey: name,
+				}},
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "web", Image: image}}},
+			},
+		},
+	}
+}
+
+func TestDeploymentRevisions(t *testing.T) {
+	deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web", UID: "web-uid"}}
+	other := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "api", UID: "api-uid"}}
+
+	unannotated := newRevisionReplicaSet(deployment, "web-legacy", "", "", "web:0")
+	delete(unannotated.Annotations, RevisionAnnotation)
+
+	replicaSets := []appsv1.ReplicaSet{
+		newRevisionReplicaSet(deployment, "web-3", "3", "bump to v3", "web:3"),
+		newRevisionReplicaSet(deployment, "web-1", "1", "", "web:1"),
+		newRevisionReplicaSet(other, "api-2", "2", "", "api:2"),
+		newRevisionReplicaSet(deployment, "web-10", "10", "", "web:10"),
+		unannotated,
+	}
+
+	revisions := DeploymentRevisions(deployment, replicaSets)
+
+	var numbers []int64
+	for _, revision := range revisions {
+		numbers = append(numbers, revision.Revision)
+	}
+	assert.Equal(t, []int64{1, 3, 10}, numbers, "only owned, annotated ReplicaSets in revision order")
+	assert.Equal(t, "web-3", revisions[1].ReplicaSet)
+	assert.Equal(t, "bump to v3", revisions[1].ChangeCause)
+	assert.Equal(t, []string{"web:3"}, revisions[1].Images)
+}
+
+func TestSelectRollbackRevision(t *testing.T) {
+	revisions := []DeploymentRevision{{Revision: 1}, {Revision: 3}, {Revision: 4}}
+
+	testCases := []struct {
+		name     string
+		current  int64
+		target   int64
+		expected int64
+		wantErr  string
+	}{
+		{name: "previous revision", current: 4, expected: 3},
+		{name: "previous skips gaps", current: 3, expected: 1},
+		{name: "explicit revision", current: 4, target: 1, expected: 1},
+		{name: "no previous revision", current: 1, wantErr: "no previous revision"},
+		{name: "unknown revision", current: 4, target: 2, wantErr: "revision 2 not found"},
+		{name: "negative revision", current: 4, target: -1, wantErr: "positive integer"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			revision, err := SelectRollbackRevision(revisions, tc.current, tc.target)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, revision.Revision)
+		})
+	}
+}
+
+func TestRollbackTemplateDropsPodTemplateHash(t *testing.T) {
+	deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web", UID: "web-uid"}}
+	rs := newRevisionReplicaSet(deployment, "web-1", "1", "", "web:1")
+
+	template := rollbackTemplate(rs.Spec.Template)
+
+	assert.Equal(t, map[string]string{"app": "web"}, template.Labels)
+	assert.Contains(t, rs.Spec.Template.Labels, appsv1.DefaultDeploymentUniqueLabelKey, "the ReplicaSet is not modified")
+}
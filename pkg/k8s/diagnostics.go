@@ -0,0 +1,274 @@
+package k8s
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// RedactedValue replaces secret values in a diagnostics bundle
+const RedactedValue = "<redacted>"
+
+// LastAppliedAnnotation holds a copy of the manifest last applied with
+// kubectl, including any env values, so it is dropped from the manifests
+// that are printed or bundled
+const LastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// sensitiveEnvWords mark env var names whose values are treated as secrets
+var sensitiveEnvWords = []string{"PASSWORD", "PASSWD", "SECRET", "TOKEN", "KEY", "CREDENTIAL", "AUTH", "DSN"}
+
+// DiagnosticsOptions controls what a pod diagnostics bundle contains
+type DiagnosticsOptions struct {
+	// IncludeSecrets keeps env values that look like credentials instead of
+	// redacting them
+	IncludeSecrets bool
+}
+
+// BundleWriter receives the files of a diagnostics bundle
+type BundleWriter interface {
+	WriteFile(name string, data []byte) error
+}
+
+// CollectPodDiagnostics writes the manifest, events, current and previous
+// logs of every container, and metrics of a pod to w. Parts that cannot be
+// collected are skipped and returned as warnings, which are also written to
+// the bundle; only failing to get the pod or to write the bundle is an error.
+func (c *Client) CollectPodDiagnostics(ctx context.Context, namespace, name string, opts DiagnosticsOptions, w BundleWriter) ([]string, error) {
+	pod, err := c.Clientset.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pod %s: %w", name, err)
+	}
+
+	var warnings []string
+
+	manifest := pod.DeepCopy()
+	manifest.APIVersion, manifest.Kind = "v1", "Pod"
+	manifest.ManagedFields = nil
+	if !opts.IncludeSecrets {
+		RedactPodSecrets(manifest)
+	}
+	if err := writeYAML(w, "pod.yaml", manifest); err != nil {
+		return nil, err
+	}
+
+	events, err := c.GetEventsForObject(ctx, namespace, "Pod", name)
+	if err != nil {
+		warnings = append(warnings, err.Error())
+	} else {
+		list := &corev1.EventList{Items: events}
+		list.APIVersion, list.Kind = "v1", "EventList"
+		if err := writeYAML(w, "events.yaml", list); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, container := range diagnosticsContainers(pod) {
+		logs, err := c.containerLogs(ctx, pod, container, false)
+		if err != nil {
+			warnings = append(warnings, err.Error())
+		} else if err := w.WriteFile(path.Join("logs", container+".log"), logs); err != nil {
+			return nil, err
+		}
+
+		if !restarted(pod, container) {
+			continue
+		}
+		logs, err = c.containerLogs(ctx, pod, container, true)
+		if err != nil {
+			warnings = append(warnings, err.Error())
+		} else if err := w.WriteFile(path.Join("logs", container+".previous.log"), logs); err != nil {
+			return nil, err
+		}
+	}
+
+	metrics, err := c.podMetrics(ctx, namespace, name)
+	if err != nil {
+		warnings = append(warnings, fmt.Sprintf("metrics not available (is metrics-server installed?): %v", err))
+	} else if err := w.WriteFile("metrics.yaml", metrics); err != nil {
+		return nil, err
+	}
+
+	if len(warnings) > 0 {
+		if err := w.WriteFile("warnings.txt", []byte(strings.Join(warnings, "\n")+"\n")); err != nil {
+			return nil, err
+		}
+	}
+
+	return warnings, nil
+}
+
+// RedactPodSecrets replaces the literal values of env vars whose names look
+// like credentials, and drops the last-applied-configuration annotation
+// which repeats them. Values taken from secrets are references and are kept.
+func RedactPodSecrets(pod *corev1.Pod) {
+	for i := range pod.Spec.InitContainers {
+		redactEnv(pod.Spec.InitContainers[i].Env)
+	}
+	for i := range pod.Spec.Containers {
+		redactEnv(pod.Spec.Containers[i].Env)
+	}
+	for i := range pod.Spec.EphemeralContainers {
+		redactEnv(pod.Spec.EphemeralContainers[i].Env)
+	}
+
+	delete(pod.Annotations, LastAppliedAnnotation)
+}
+
+// DirBundle writes a diagnostics bundle to a directory
+type DirBundle struct {
+	dir string
+}
+
+// NewDirBundle creates dir for a diagnostics bundle. An existing directory
+// must be empty so earlier bundles are never mixed with or overwritten by a
+// new one.
+func NewDirBundle(dir string) (*DirBundle, error) {
+	entries, err := os.ReadDir(dir)
+	if err == nil && len(entries) > 0 {
+		return nil, fmt.Errorf("output directory %s is not empty", dir)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create output directory: %w", err)
+	}
+	return &DirBundle{dir: dir}, nil
+}
+
+// WriteFile writes a file of the bundle
+func (b *DirBundle) WriteFile(name string, data []byte) error {
+	target := filepath.Join(b.dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", filepath.Dir(target), err)
+	}
+	if err := os.WriteFile(target, data, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", target, err)
+	}
+	return nil
+}
+
+// TarBundle writes a diagnostics bundle as a gzipped tarball with its files
+// under a single top-level directory
+type TarBundle struct {
+	root string
+	file *os.File
+	gz   *gzip.Writer
+	tw   *tar.Writer
+}
+
+// NewTarBundle creates the tarball at path. Its files are placed under root.
+func NewTarBundle(path, root string) (*TarBundle, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	gz := gzip.NewWriter(file)
+	return &TarBundle{root: root, file: file, gz: gz, tw: tar.NewWriter(gz)}, nil
+}
+
+// WriteFile adds a file to the tarball
+func (b *TarBundle) WriteFile(name string, data []byte) error {
+	header := &tar.Header{
+		Name:    path.Join(b.root, name),
+		Mode:    0644,
+		Size:    int64(len(data)),
+		ModTime: time.Now(),
+	}
+	if err := b.tw.WriteHeader(header); err != nil {
+		return fmt.Errorf("failed to write %s: %w", header.Name, err)
+	}
+	if _, err := b.tw.Write(data); err != nil {
+		return fmt.Errorf("failed to write %s: %w", header.Name, err)
+	}
+	return nil
+}
+
+// Close finishes the tarball
+func (b *TarBundle) Close() error {
+	err := b.tw.Close()
+	if gzErr := b.gz.Close(); err == nil {
+		err = gzErr
+	}
+	if fileErr := b.file.Close(); err == nil {
+		err = fileErr
+	}
+	return err
+}
+
+func (c *Client) containerLogs(ctx context.Context, pod *corev1.Pod, container string, previous bool) ([]byte, error) {
+	var logs bytes.Buffer
+	opts := LogOptions{Container: container, Previous: previous, Timestamps: true, TailLines: -1}
+	if err := c.StreamLogs(ctx, pod.Namespace, pod.Name, opts, &logs); err != nil {
+		return nil, fmt.Errorf("container %s: %w", container, err)
+	}
+	return logs.Bytes(), nil
+}
+
+// podMetrics fetches the current usage of a pod from the metrics API
+func (c *Client) podMetrics(ctx context.Context, namespace, name string) ([]byte, error) {
+	data, err := c.fetchPodMetrics(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.JSONToYAML(data)
+}
+
+// diagnosticsContainers lists the init and app containers of a pod
+func diagnosticsContainers(pod *corev1.Pod) []string {
+	var names []string
+	for _, container := range pod.Spec.InitContainers {
+		names = append(names, container.Name)
+	}
+	for _, container := range pod.Spec.Containers {
+		names = append(names, container.Name)
+	}
+	return names
+}
+
+// restarted reports whether a container has a previous instance with logs
+func restarted(pod *corev1.Pod, container string) bool {
+	var statuses []corev1.ContainerStatus
+	statuses = append(statuses, pod.Status.InitContainerStatuses...)
+	statuses = append(statuses, pod.Status.ContainerStatuses...)
+	for _, status := range statuses {
+		if status.Name == container {
+			return status.RestartCount > 0 || status.LastTerminationState.Terminated != nil
+		}
+	}
+	return false
+}
+
+func redactEnv(env []corev1.EnvVar) {
+	for i := range env {
+		if env[i].Value != "" && sensitiveEnvName(env[i].Name) {
+			env[i].Value = RedactedValue
+		}
+	}
+}
+
+func sensitiveEnvName(name string) bool {
+	upper := strings.ToUpper(name)
+	for _, word := range sensitiveEnvWords {
+		if strings.Contains(upper, word) {
+			return true
+		}
+	}
+	return false
+}
+
+func writeYAML(w BundleWriter, name string, obj interface{}) error {
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", name, err)
+	}
+	return w.WriteFile(name, data)
+}
@@ -0,0 +1,161 @@
+package k8s
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+type memoryBundle map[string]string
+
+func (b memoryBundle) WriteFile(name string, data []byte) error {
+	b[name] = string(data)
+	return nil
+}
+
+func diagnosticsTestPod() *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "web-0",
+			Namespace:   "default",
+			Annotations: map[string]string{LastAppliedAnnotation: `{"env":"hunter2"}`, "team": "payments"},
+		},
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "migrate", Env: []corev1.EnvVar{{Name: "DB_PASSWORD", Value: "hunter2"}}}},
+			Containers: []corev1.Container{{
+				Name: "app",
+				Env: []corev1.EnvVar{
+					{Name: "LOG_LEVEL", Value: "debug"},
+					{Name: "api_token", Value: "abc123"},
+					{Name: "SESSION_SECRET", ValueFrom: &corev1.EnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "web"}, Key: "session"},
+					}},
+				},
+			}},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{Name: "app", RestartCount: 2}},
+		},
+	}
+}
+
+func TestRedactPodSecrets(t *testing.T) {
+	pod := diagnosticsTestPod()
+	RedactPodSecrets(pod)
+
+	assert.Equal(t, RedactedValue, pod.Spec.InitContainers[0].Env[0].Value)
+	assert.Equal(t, "debug", pod.Spec.Containers[0].Env[0].Value)
+	assert.Equal(t, RedactedValue, pod.Spec.Containers[0].Env[1].Value)
+	assert.Empty(t, pod.Spec.Containers[0].Env[2].Value, "secret references have no value to redact")
+	assert.NotNil(t, pod.Spec.Containers[0].Env[2].ValueFrom)
+	assert.NotContains(t, pod.Annotations, LastAppliedAnnotation)
+	assert.Equal(t, "payments", pod.Annotations["team"])
+}
+
+func TestCollectPodDiagnostics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/namespaces/default/pods/web-0":
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(diagnosticsTestPod())
+		case "/api/v1/namespaces/default/events":
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(&corev1.EventList{Items: []corev1.Event{{
+				ObjectMeta: metav1.ObjectMeta{Name: "web-0.1"},
+				Reason:     "BackOff",
+			}}})
+		case "/api/v1/namespaces/default/pods/web-0/log":
+			container := r.URL.Query().Get("container")
+			if r.URL.Query().Get("previous") == "true" {
+				io.WriteString(w, container+" crashed\n")
+				return
+			}
+			io.WriteString(w, container+" running\n")
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	cs, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+	client := &Client{Clientset: cs}
+
+	testCases := []struct {
+		name           string
+		includeSecrets bool
+		wantEnv        string
+	}{
+		{name: "secrets redacted by default", wantEnv: RedactedValue},
+		{name: "secrets included", includeSecrets: true, wantEnv: "abc123"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bundle := memoryBundle{}
+			warnings, err := client.CollectPodDiagnostics(t.Context(), "default", "web-0", DiagnosticsOptions{IncludeSecrets: tc.includeSecrets}, bundle)
+			require.NoError(t, err)
+
+			assert.Contains(t, bundle["pod.yaml"], "kind: Pod")
+			assert.Contains(t, bundle["pod.yaml"], "value: "+tc.wantEnv)
+			assert.Contains(t, bundle["events.yaml"], "reason: BackOff")
+			assert.Equal(t, "migrate running\n", bundle["logs/migrate.log"])
+			assert.Equal(t, "app running\n", bundle["logs/app.log"])
+			assert.Equal(t, "app crashed\n", bundle["logs/app.previous.log"])
+			assert.NotContains(t, bundle, "logs/migrate.previous.log")
+
+			// The test server has no metrics API
+			assert.NotContains(t, bundle, "metrics.yaml")
+			require.Len(t, warnings, 1)
+			assert.Contains(t, warnings[0], "metrics not available")
+			assert.Contains(t, bundle["warnings.txt"], "metrics not available")
+		})
+	}
+}
+
+func TestNewDirBundleRejectsNonEmptyDirectory(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pod.yaml"), []byte("old"), 0644))
+
+	_, err := NewDirBundle(dir)
+	assert.ErrorContains(t, err, "is not empty")
+}
+
+func TestTarBundle(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "web.tar.gz")
+	bundle, err := NewTarBundle(out, "web")
+	require.NoError(t, err)
+	require.NoError(t, bundle.WriteFile("logs/app.log", []byte("started\n")))
+	require.NoError(t, bundle.Close())
+
+	file, err := os.Open(out)
+	require.NoError(t, err)
+	defer file.Close()
+	gz, err := gzip.NewReader(file)
+	require.NoError(t, err)
+
+	tr := tar.NewReader(gz)
+	header, err := tr.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "web/logs/app.log", header.Name)
+	data, err := io.ReadAll(tr)
+	require.NoError(t, err)
+	assert.Equal(t, "started\n", string(data))
+
+	// The bundle is never written over an existing file
+	_, err = NewTarBundle(out, "web")
+	assert.Error(t, err)
+}
@@ -0,0 +1,123 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EventFilter narrows a list of events. Type matches the event type
+// (Normal or Warning) case-insensitively and Reason matches any event whose
+// reason contains it; empty fields match every event.
+type EventFilter struct {
+	Type   string
+	Reason string
+}
+
+// ListEvents returns the events of a namespace, or of all namespaces when
+// namespace is empty, that match the filter, oldest first
+func (c *Client) ListEvents(ctx context.Context, namespace string, filter EventFilter) ([]corev1.Event, error) {
+	events, err := ListAll(ctx, metav1.ListOptions{}, 0, c.Clientset.CoreV1().Events(namespace).List)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+
+	items := FilterEvents(events.Items, filter)
+	SortEvents(items)
+	return items, nil
+}
+
+// GetEventsForObject returns the events recorded for an object, oldest first.
+// An empty kind matches events for any kind with the given name.
+func (c *Client) GetEventsForObject(ctx context.Context, namespace, kind, name string) ([]corev1.Event, error) {
+	fieldSelector := fmt.Sprintf("involvedObject.name=%s", name)
+	if kind != "" {
+		fieldSelector += fmt.Sprintf(",involvedObject.kind=%s", kind)
+	}
+
+	events, err := c.Clientset.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fieldSelector,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events for %s: %w", name, err)
+	}
+
+	items := events.Items
+	SortEvents(items)
+
+	return items, nil
+}
+
+// FilterEvents returns the events that match the filter
+func FilterEvents(events []corev1.Event, filter EventFilter) []corev1.Event {
+	reason := strings.ToLower(filter.Reason)
+	matched := make([]corev1.Event, 0, len(events))
+	for _, event := range events {
+		if filter.Type != "" && !strings.EqualFold(event.Type, filter.Type) {
+			continue
+		}
+		if reason != "" && !strings.Contains(strings.ToLower(event.Reason), reason) {
+			continue
+		}
+		matched = append(matched, event)
+	}
+	return matched
+}
+
+// SortEvents orders events oldest first by EventTime. Events seen at the
+// same time are ordered by namespace and name so the order is stable across
+// calls.
+func SortEvents(events []corev1.Event) {
+	sort.SliceStable(events, func(i, j int) bool {
+		ti, tj := EventTime(events[i]), EventTime(events[j])
+		if !ti.Equal(tj) {
+			return ti.Before(tj)
+		}
+		if events[i].Namespace != events[j].Namespace {
+			return events[i].Namespace < events[j].Namespace
+		}
+		return events[i].Name < events[j].Name
+	})
+}
+
+// EventTime returns the most relevant timestamp of an event
+func EventTime(event corev1.Event) time.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.Time
+	}
+	if !event.EventTime.IsZero() {
+		return event.EventTime.Time
+	}
+	if !event.FirstTimestamp.IsZero() {
+		return event.FirstTimestamp.Time
+	}
+	return event.CreationTimestamp.Time
+}
+
+// EventFirstSeen returns when an event was first recorded
+func EventFirstSeen(event corev1.Event) time.Time {
+	if !event.FirstTimestamp.IsZero() {
+		return event.FirstTimestamp.Time
+	}
+	if !event.EventTime.IsZero() {
+		return event.EventTime.Time
+	}
+	return event.CreationTimestamp.Time
+}
+
+// EventCount returns how many times an event was seen, counting events
+// reported through a series or with no count as seen once
+func EventCount(event corev1.Event) int32 {
+	if event.Series != nil && event.Series.Count > event.Count {
+		return event.Series.Count
+	}
+	if event.Count == 0 {
+		return 1
+	}
+	return event.Count
+}
@@ -0,0 +1,61 @@
+package k8s
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func testEvent(name, eventType, reason string, last time.Time) corev1.Event {
+	return corev1.Event{
+		ObjectMeta:    metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Type:          eventType,
+		Reason:        reason,
+		LastTimestamp: metav1.NewTime(last),
+	}
+}
+
+func eventNames(events []corev1.Event) []string {
+	names := make([]string, 0, len(events))
+	for _, event := range events {
+		names = append(names, event.Name)
+	}
+	return names
+}
+
+func TestFilterEvents(t *testing.T) {
+	now := time.Now()
+	events := []corev1.Event{
+		testEvent("web.1", "Warning", "BackOff", now),
+		testEvent("web.2", "Normal", "Pulled", now),
+		testEvent("web.3", "Warning", "FailedScheduling", now),
+		testEvent("web.4", "Normal", "ImagePullBackOff", now),
+	}
+
+	assert.Equal(t, []string{"web.1", "web.2", "web.3", "web.4"}, eventNames(FilterEvents(events, EventFilter{})))
+	assert.Equal(t, []string{"web.1", "web.3"}, eventNames(FilterEvents(events, EventFilter{Type: "warning"})))
+	assert.Equal(t, []string{"web.1", "web.4"}, eventNames(FilterEvents(events, EventFilter{Reason: "backoff"})))
+	assert.Equal(t, []string{"web.1"}, eventNames(FilterEvents(events, EventFilter{Type: "Warning", Reason: "BackOff"})))
+}
+
+func TestSortEventsIsDeterministic(t *testing.T) {
+	now := time.Now()
+	events := []corev1.Event{
+		testEvent("web.c", "Normal", "Pulled", now),
+		testEvent("web.b", "Normal", "Pulled", now),
+		testEvent("web.a", "Normal", "Pulled", now.Add(time.Minute)),
+		testEvent("web.d", "Normal", "Pulled", now.Add(-time.Minute)),
+	}
+
+	SortEvents(events)
+	assert.Equal(t, []string{"web.d", "web.b", "web.c", "web.a"}, eventNames(events))
+}
+
+func TestEventCount(t *testing.T) {
+	assert.Equal(t, int32(1), EventCount(corev1.Event{}))
+	assert.Equal(t, int32(4), EventCount(corev1.Event{Count: 4}))
+	assert.Equal(t, int32(9), EventCount(corev1.Event{Series: &corev1.EventSeries{Count: 9}}))
+}
@@ -0,0 +1,172 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"golang.org/x/term"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+// fallbackShell is tried when the shell requested for an interactive
+// session is missing from the container
+const fallbackShell = "/bin/sh"
+
+// ExecOptions describes a command to run in a container
+type ExecOptions struct {
+	// Container defaults to the pod's only container when empty
+	Container string
+	Command   []string
+	Stdin     io.Reader
+	Stdout    io.Writer
+	Stderr    io.Writer
+	TTY       bool
+	// TerminalSizeQueue reports terminal resizes when TTY is set
+	TerminalSizeQueue remotecommand.TerminalSizeQueue
+}
+
+// Exec runs a command in a pod through the API server, streaming its
+// input and output over SPDY as kubectl exec does. A non-zero exit status
+// is returned as an error implementing k8s.io/client-go/util/exec.ExitError.
+func (c *Client) Exec(ctx context.Context, namespace, pod string, opts ExecOptions) error {
+	req := c.Clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(pod).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: opts.Container,
+			Command:   opts.Command,
+			Stdin:     opts.Stdin != nil,
+			Stdout:    opts.Stdout != nil,
+			Stderr:    opts.Stderr != nil,
+			TTY:       opts.TTY,
+		}, scheme.ParameterCodec)
+
+	executor, err := remotecommand.NewSPDYExecutor(c.Config, "POST", req.URL())
+	if err != nil {
+		return fmt.Errorf("failed to create executor for pod %s: %w", pod, err)
+	}
+
+	return executor.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdin:             opts.Stdin,
+		Stdout:            opts.Stdout,
+		Stderr:            opts.Stderr,
+		Tty:               opts.TTY,
+		TerminalSizeQueue: opts.TerminalSizeQueue,
+	})
+}
+
+// ExecIntoPod opens an interactive shell in a container of a pod, attached
+// to the local terminal. When the container has no such shell, /bin/sh is
+// started instead.
+func ExecIntoPod(namespace, podName, containerName, shell string) error {
+	client, err := NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	// The session itself exits with the status of the last command typed,
+	// so whether the shell exists is checked before it starts
+	ctx := context.Background()
+	if shell != fallbackShell && client.shellMissing(ctx, namespace, podName, containerName, shell) {
+		fmt.Fprintf(os.Stderr, "%s is not available in the container, falling back to %s\n", shell, fallbackShell)
+		shell = fallbackShell
+	}
+	return client.ExecInTerminal(ctx, namespace, podName, containerName, []string{shell})
+}
+
+// shellMissing runs the shell without a terminal to see whether the
+// container can start it
+func (c *Client) shellMissing(ctx context.Context, namespace, pod, container, shell string) bool {
+	err := c.Exec(ctx, namespace, pod, ExecOptions{
+		Container: container,
+		Command:   []string{shell, "-c", "exit 0"},
+		Stdout:    io.Discard,
+		Stderr:    io.Discard,
+	})
+	return shellNotFound(err)
+}
+
+// shellNotFound reports whether an exec failed because the command could
+// not be found or run, which container runtimes report with the exit codes
+// shells use for that: 127 and 126. Only a command that exits straight away
+// tells this apart from a shell whose last command failed.
+func shellNotFound(err error) bool {
+	var exitErr utilexec.ExitError
+	if !errors.As(err, &exitErr) {
+		return false
+	}
+	return exitErr.ExitStatus() == 126 || exitErr.ExitStatus() == 127
+}
+
+// ExecInTerminal runs a command in a container attached to the local
+// terminal. When stdin is a terminal it is switched to raw mode and a TTY is
+// allocated in the container, sized to match and kept in sync on resize.
+func (c *Client) ExecInTerminal(ctx context.Context, namespace, pod, container string, command []string) error {
+	opts := ExecOptions{
+		Container: container,
+		Command:   command,
+		Stdin:     os.Stdin,
+		Stdout:    os.Stdout,
+		Stderr:    os.Stderr,
+	}
+
+	fd := int(os.Stdin.Fd())
+	if term.IsTerminal(fd) {
+		state, err := term.MakeRaw(fd)
+		if err != nil {
+			return fmt.Errorf("failed to set up terminal: %w", err)
+		}
+		defer term.Restore(fd, state)
+
+		sizes := newTerminalSizeQueue(fd)
+		defer sizes.stop()
+
+		// A TTY merges stderr into stdout
+		opts.Stderr = nil
+		opts.TTY = true
+		opts.TerminalSizeQueue = sizes
+	}
+
+	return c.Exec(ctx, namespace, pod, opts)
+}
+
+// terminalSizeQueue reports the local terminal size to a remote TTY, once
+// at the start and again on every resize
+type terminalSizeQueue struct {
+	fd      int
+	resized chan os.Signal
+}
+
+func newTerminalSizeQueue(fd int) *terminalSizeQueue {
+	q := &terminalSizeQueue{fd: fd, resized: make(chan os.Signal, 1)}
+	q.resized <- syscall.SIGWINCH
+	signal.Notify(q.resized, syscall.SIGWINCH)
+	return q
+}
+
+// Next blocks until the terminal is resized, returning nil once stopped
+func (q *terminalSizeQueue) Next() *remotecommand.TerminalSize {
+	if _, ok := <-q.resized; !ok {
+		return nil
+	}
+	width, height, err := term.GetSize(q.fd)
+	if err != nil {
+		return nil
+	}
+	return &remotecommand.TerminalSize{Width: uint16(width), Height: uint16(height)}
+}
+
+func (q *terminalSizeQueue) stop() {
+	signal.Stop(q.resized)
+	close(q.resized)
+}
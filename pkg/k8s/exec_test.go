@@ -0,0 +1,31 @@
+package k8s
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+func TestShellNotFound(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "no error", err: nil, expected: false},
+		{name: "command not found", err: utilexec.CodeExitError{Err: errors.New("command terminated with exit code 127"), Code: 127}, expected: true},
+		{name: "not executable", err: utilexec.CodeExitError{Err: errors.New("command terminated with exit code 126"), Code: 126}, expected: true},
+		{name: "wrapped", err: fmt.Errorf("exec failed: %w", utilexec.CodeExitError{Err: errors.New("exit 127"), Code: 127}), expected: true},
+		{name: "session exit status", err: utilexec.CodeExitError{Err: errors.New("command terminated with exit code 1"), Code: 1}, expected: false},
+		{name: "connection error", err: errors.New("error dialing backend"), expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, shellNotFound(tc.err))
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// FindKinds lists the kinds FindResources searches, in the order results are
+// returned
+var FindKinds = []string{"pods", "deployments", "services", "secrets", "configmaps"}
+
+// FindMatch is a resource whose name matched a search
+type FindMatch struct {
+	Namespace string
+	Name      string
+}
+
+// FindResult holds the matches for one kind, or the error listing it
+type FindResult struct {
+	Kind    string
+	Matches []FindMatch
+	Err     error
+}
+
+// FindResources lists every kind concurrently and returns the resources whose
+// name contains term, ignoring case. A kind that cannot be listed, for
+// example because access is denied, reports its error without failing the
+// others. Results follow the order of kinds; matches are sorted by namespace
+// and name.
+func FindResources(ctx context.Context, client kubernetes.Interface, namespace, term string, kinds []string) []FindResult {
+	term = strings.ToLower(term)
+	results := make([]FindResult, len(kinds))
+
+	var wg sync.WaitGroup
+	for i, kind := range kinds {
+		wg.Add(1)
+		go func(i int, kind string) {
+			defer wg.Done()
+			results[i] = FindResult{Kind: kind}
+
+			objects, err := listObjects(ctx, client, kind, namespace)
+			if err != nil {
+				results[i].Err = err
+				return
+			}
+			for _, obj := range objects {
+				if strings.Contains(strings.ToLower(obj.GetName()), term) {
+					results[i].Matches = append(results[i].Matches, FindMatch{Namespace: obj.GetNamespace(), Name: obj.GetName()})
+				}
+			}
+			sort.Slice(results[i].Matches, func(a, b int) bool {
+				ma, mb := results[i].Matches[a], results[i].Matches[b]
+				if ma.Namespace != mb.Namespace {
+					return ma.Namespace < mb.Namespace
+				}
+				return ma.Name < mb.Name
+			})
+		}(i, kind)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// listObjects lists every object of a kind searched by FindResources
+func listObjects(ctx context.Context, client kubernetes.Interface, kind, namespace string) ([]metav1.Object, error) {
+	var list ListObject
+	var err error
+
+	opts := metav1.ListOptions{}
+	switch kind {
+	case "pods":
+		list, err = ListAll(ctx, opts, 0, client.CoreV1().Pods(namespace).List)
+	case "deployments":
+		list, err = ListAll(ctx, opts, 0, client.AppsV1().Deployments(namespace).List)
+	case "services":
+		list, err = ListAll(ctx, opts, 0, client.CoreV1().Services(namespace).List)
+	case "secrets":
+		list, err = ListAll(ctx, opts, 0, client.CoreV1().Secrets(namespace).List)
+	case "configmaps":
+		list, err = ListAll(ctx, opts, 0, client.CoreV1().ConfigMaps(namespace).List)
+	default:
+		return nil, fmt.Errorf("unsupported kind %q (supported: %s)", kind, strings.Join(FindKinds, ", "))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", kind, err)
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+	objects := make([]metav1.Object, 0, len(items))
+	for _, item := range items {
+		obj, err := meta.Accessor(item)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, obj)
+	}
+	return objects, nil
+}
@@ -0,0 +1,45 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFindResources(t *testing.T) {
+	meta := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Name: name, Namespace: "prod"}
+	}
+	client := newOwnersServer(t, map[string]any{
+		"/api/v1/namespaces/prod/pods": &corev1.PodList{Items: []corev1.Pod{
+			{ObjectMeta: meta("redis-1")}, {ObjectMeta: meta("web-7d")}, {ObjectMeta: meta("redis-0")},
+		}},
+		"/apis/apps/v1/namespaces/prod/deployments": &appsv1.DeploymentList{Items: []appsv1.Deployment{
+			{ObjectMeta: meta("web")},
+		}},
+		"/api/v1/namespaces/prod/services": &corev1.ServiceList{Items: []corev1.Service{
+			{ObjectMeta: meta("Redis-Primary")},
+		}},
+		"/api/v1/namespaces/prod/configmaps": &corev1.ConfigMapList{},
+		// secrets are not served, as if listing them was denied
+	})
+
+	results := FindResources(context.Background(), client, "prod", "REDIS", FindKinds)
+	require.Len(t, results, len(FindKinds))
+
+	assert.Equal(t, "pods", results[0].Kind)
+	assert.Equal(t, []FindMatch{{Namespace: "prod", Name: "redis-0"}, {Namespace: "prod", Name: "redis-1"}}, results[0].Matches)
+	assert.Empty(t, results[1].Matches)
+	assert.Equal(t, []FindMatch{{Namespace: "prod", Name: "Redis-Primary"}}, results[2].Matches)
+	assert.ErrorContains(t, results[3].Err, "failed to list secrets")
+	assert.NoError(t, results[4].Err)
+
+	results = FindResources(context.Background(), client, "prod", "web", []string{"deployments"})
+	require.Len(t, results, 1)
+	assert.Equal(t, []FindMatch{{Namespace: "prod", Name: "web"}}, results[0].Matches)
+}
@@ -0,0 +1,150 @@
+package k8s
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ImageUsage is a distinct container image and how widely it is used
+type ImageUsage struct {
+	Image   string
+	Pods    int      // Number of pods with at least one container running it
+	Digests []string // Distinct digests the image resolved to, sorted
+}
+
+// ImageTag returns the tag of an image reference, "latest" when the
+// reference has neither a tag nor a digest, and "" when it is pinned by
+// digest only
+func ImageTag(image string) string {
+	name := image
+	if at := strings.Index(name, "@"); at >= 0 {
+		name = name[:at]
+		// A colon after the last slash is a tag; earlier ones are a registry port
+		if colon := strings.LastIndex(name, ":"); colon > strings.LastIndex(name, "/") {
+			return name[colon+1:]
+		}
+		return ""
+	}
+	if colon := strings.LastIndex(name, ":"); colon > strings.LastIndex(name, "/") {
+		return name[colon+1:]
+	}
+	return "latest"
+}
+
+// ImageDigest returns the sha256 digest from an image reference or a
+// container status image ID, or "" if it has none
+func ImageDigest(ref string) string {
+	if at := strings.LastIndex(ref, "@"); at >= 0 {
+		return ref[at+1:]
+	}
+	if strings.HasPrefix(ref, "sha256:") {
+		return ref
+	}
+	return ""
+}
+
+// ShortDigest shortens a digest to its algorithm and first 12 hex characters
+func ShortDigest(digest string) string {
+	algorithm, hex, found := strings.Cut(digest, ":")
+	if !found || len(hex) <= 12 {
+		return digest
+	}
+	return algorithm + ":" + hex[:12]
+}
+
+// ImageWarnings reports image hygiene problems of a container: running a
+// latest (or untagged) image, and always pulling an image that is not pinned
+// by digest, both of which make restarts pick up unreviewed changes
+func ImageWarnings(container corev1.Container) []string {
+	var warnings []string
+	tag := ImageTag(container.Image)
+	if tag == "latest" {
+		if strings.Contains(container.Image, ":latest") {
+			warnings = append(warnings, "image uses the :latest tag")
+		} else {
+			warnings = append(warnings, "image has no tag and resolves to :latest")
+		}
+	}
+	if container.ImagePullPolicy == corev1.PullAlways && ImageDigest(container.Image) == "" {
+		warnings = append(warnings, fmt.Sprintf("imagePullPolicy Always on mutable tag %q", tag))
+	}
+	return warnings
+}
+
+// PodImages returns the distinct images of a pod's init and app containers
+// in spec order
+func PodImages(pod *corev1.Pod) []string {
+	seen := map[string]bool{}
+	var images []string
+	for _, containers := range [][]corev1.Container{pod.Spec.InitContainers, pod.Spec.Containers} {
+		for _, container := range containers {
+			if !seen[container.Image] {
+				seen[container.Image] = true
+				images = append(images, container.Image)
+			}
+		}
+	}
+	return images
+}
+
+// SummarizeImages counts the pods using each distinct image, with the
+// digests reported by the container statuses. The result is sorted by pod
+// count, most used first, then by image.
+func SummarizeImages(pods []corev1.Pod) []ImageUsage {
+	usage := map[string]*ImageUsage{}
+	digests := map[string]map[string]bool{}
+
+	for i := range pods {
+		pod := &pods[i]
+		for _, image := range PodImages(pod) {
+			if usage[image] == nil {
+				usage[image] = &ImageUsage{Image: image}
+				digests[image] = map[string]bool{}
+			}
+			usage[image].Pods++
+		}
+
+		statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+		images := containerImages(pod)
+		for _, status := range statuses {
+			image, ok := images[status.Name]
+			if !ok {
+				continue
+			}
+			if digest := ImageDigest(status.ImageID); digest != "" {
+				digests[image][digest] = true
+			}
+		}
+	}
+
+	result := make([]ImageUsage, 0, len(usage))
+	for image, u := range usage {
+		for digest := range digests[image] {
+			u.Digests = append(u.Digests, digest)
+		}
+		sort.Strings(u.Digests)
+		result = append(result, *u)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Pods != result[j].Pods {
+			return result[i].Pods > result[j].Pods
+		}
+		return result[i].Image < result[j].Image
+	})
+	return result
+}
+
+// containerImages maps the name of every container of a pod to its image
+func containerImages(pod *corev1.Pod) map[string]string {
+	images := map[string]string{}
+	for _, container := range pod.Spec.InitContainers {
+		images[container.Name] = container.Image
+	}
+	for _, container := range pod.Spec.Containers {
+		images[container.Name] = container.Image
+	}
+	return images
+}
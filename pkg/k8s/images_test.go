@@ -0,0 +1,96 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testDigest = "sha256:4c1e997385b8fb4ad4d1d3c7e5af7ff3f882e70ef2bbd7b6c6d9b3a2e1f0a9b8"
+
+func TestImageTag(t *testing.T) {
+	testCases := map[string]string{
+		"nginx":                                 "latest",
+		"nginx:latest":                          "latest",
+		"nginx:1.27":                            "1.27",
+		"registry.local:5000/team/api":          "latest",
+		"registry.local:5000/team/api:v2":       "v2",
+		"nginx@" + testDigest:                   "",
+		"nginx:1.27@" + testDigest:              "1.27",
+		"registry.local:5000/api@" + testDigest: "",
+	}
+	for image, tag := range testCases {
+		assert.Equal(t, tag, ImageTag(image), image)
+	}
+}
+
+func TestImageDigest(t *testing.T) {
+	assert.Equal(t, testDigest, ImageDigest("docker-pullable://nginx@"+testDigest))
+	assert.Equal(t, testDigest, ImageDigest(testDigest))
+	assert.Empty(t, ImageDigest("nginx:1.27"))
+	assert.Equal(t, "sha256:4c1e997385b8", ShortDigest(testDigest))
+}
+
+func TestImageWarnings(t *testing.T) {
+	testCases := []struct {
+		name      string
+		container corev1.Container
+		expected  []string
+	}{
+		{
+			name:      "pinned tag",
+			container: corev1.Container{Image: "nginx:1.27", ImagePullPolicy: corev1.PullIfNotPresent},
+		},
+		{
+			name:      "latest tag",
+			container: corev1.Container{Image: "nginx:latest", ImagePullPolicy: corev1.PullIfNotPresent},
+			expected:  []string{"image uses the :latest tag"},
+		},
+		{
+			name:      "untagged and always pulled",
+			container: corev1.Container{Image: "nginx", ImagePullPolicy: corev1.PullAlways},
+			expected: []string{
+				"image has no tag and resolves to :latest",
+				`imagePullPolicy Always on mutable tag "latest"`,
+			},
+		},
+		{
+			name:      "always pulled by digest",
+			container: corev1.Container{Image: "nginx@" + testDigest, ImagePullPolicy: corev1.PullAlways},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, ImageWarnings(tc.container))
+		})
+	}
+}
+
+func TestSummarizeImages(t *testing.T) {
+	pod := func(name string, images ...string) corev1.Pod {
+		p := corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		for i, image := range images {
+			container := corev1.Container{Name: string(rune('a' + i)), Image: image}
+			p.Spec.Containers = append(p.Spec.Containers, container)
+			p.Status.ContainerStatuses = append(p.Status.ContainerStatuses, corev1.ContainerStatus{
+				Name: container.Name, ImageID: "docker-pullable://" + image + "@" + testDigest,
+			})
+		}
+		return p
+	}
+
+	usage := SummarizeImages([]corev1.Pod{
+		pod("web-1", "nginx:1.27", "envoy:1.30"),
+		pod("web-2", "nginx:1.27", "nginx:1.27"),
+		pod("worker", "busybox"),
+	})
+
+	assert.Equal(t, []ImageUsage{
+		{Image: "nginx:1.27", Pods: 2, Digests: []string{testDigest}},
+		{Image: "busybox", Pods: 1, Digests: []string{testDigest}},
+		{Image: "envoy:1.30", Pods: 1, Digests: []string{testDigest}},
+	}, usage)
+}
@@ -0,0 +1,92 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DefaultListPageSize is the number of items requested per page when a list
+// is fetched in pages
+const DefaultListPageSize int64 = 500
+
+// ListObject is a typed list such as *corev1.PodList
+type ListObject interface {
+	runtime.Object
+	metav1.ListInterface
+}
+
+// ListFunc fetches one page of a list. The List method of any typed client,
+// such as Clientset.CoreV1().Pods(ns).List, is one.
+type ListFunc[T ListObject] func(ctx context.Context, opts metav1.ListOptions) (T, error)
+
+// ListAll fetches a list page by page, following continue tokens, so large
+// namespaces are never loaded in one response. Pages hold opts.Limit items,
+// or DefaultListPageSize when it is not set. With max greater than zero at
+// most max items are returned and the continue token of the returned list is
+// set when more remain.
+func ListAll[T ListObject](ctx context.Context, opts metav1.ListOptions, max int64, list ListFunc[T]) (T, error) {
+	var result T
+	var items []runtime.Object
+	first := true
+	remaining, err := ListPages(ctx, opts, max, list, func(page T) error {
+		pageItems, err := meta.ExtractList(page)
+		if err != nil {
+			return fmt.Errorf("failed to read list page: %w", err)
+		}
+		items = append(items, pageItems...)
+
+		if first {
+			result = page
+			first = false
+		}
+		return nil
+	})
+	if err != nil {
+		return result, err
+	}
+
+	if err := meta.SetList(result, items); err != nil {
+		return result, fmt.Errorf("failed to merge list pages: %w", err)
+	}
+	result.SetContinue(remaining)
+	result.SetRemainingItemCount(nil)
+	return result, nil
+}
+
+// ListPages fetches a list page by page like ListAll but hands each page to
+// fn as soon as it arrives, so callers can show the first items without
+// waiting for the whole list. It stops at the first error from a request or
+// from fn, and returns the continue token left when max items were reached.
+func ListPages[T ListObject](ctx context.Context, opts metav1.ListOptions, max int64, list ListFunc[T], fn func(page T) error) (string, error) {
+	pageSize := opts.Limit
+	if pageSize <= 0 {
+		pageSize = DefaultListPageSize
+	}
+
+	var count int64
+	for {
+		opts.Limit = pageSize
+		if max > 0 && max-count < pageSize {
+			opts.Limit = max - count
+		}
+
+		page, err := list(ctx, opts)
+		if err != nil {
+			return "", err
+		}
+		count += int64(meta.LenList(page))
+
+		if err := fn(page); err != nil {
+			return "", err
+		}
+
+		opts.Continue = page.GetContinue()
+		if opts.Continue == "" || (max > 0 && count >= max) {
+			return opts.Continue, nil
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// pagedPods serves total pods in pages the size of the requested limit,
+// recording every request it receives
+func pagedPods(total int, requests *[]metav1.ListOptions) ListFunc[*corev1.PodList] {
+	return func(ctx context.Context, opts metav1.ListOptions) (*corev1.PodList, error) {
+		*requests = append(*requests, opts)
+
+		start := 0
+		if opts.Continue != "" {
+			start, _ = strconv.Atoi(opts.Continue)
+		}
+		end := start + int(opts.Limit)
+		if end > total {
+			end = total
+		}
+
+		list := &corev1.PodList{}
+		for i := start; i < end; i++ {
+			list.Items = append(list.Items, corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("pod-%d", i)}})
+		}
+		if end < total {
+			list.Continue = strconv.Itoa(end)
+		}
+		return list, nil
+	}
+}
+
+func TestListAllFollowsContinueTokens(t *testing.T) {
+	var requests []metav1.ListOptions
+	pods, err := ListAll(context.Background(), metav1.ListOptions{Limit: 2, LabelSelector: "app=web"}, 0, pagedPods(5, &requests))
+	require.NoError(t, err)
+
+	require.Len(t, pods.Items, 5)
+	assert.Equal(t, "pod-0", pods.Items[0].Name)
+	assert.Equal(t, "pod-4", pods.Items[4].Name)
+	assert.Empty(t, pods.Continue)
+
+	require.Len(t, requests, 3)
+	for _, opts := range requests {
+		assert.Equal(t, int64(2), opts.Limit)
+		assert.Equal(t, "app=web", opts.LabelSelector, "every page keeps the selectors")
+	}
+	assert.Equal(t, []string{"", "2", "4"}, []string{requests[0].Continue, requests[1].Continue, requests[2].Continue})
+}
+
+func TestListAllStopsAtMax(t *testing.T) {
+	var requests []metav1.ListOptions
+	pods, err := ListAll(context.Background(), metav1.ListOptions{Limit: 2}, 3, pagedPods(10, &requests))
+	require.NoError(t, err)
+
+	assert.Len(t, pods.Items, 3)
+	assert.Equal(t, "3", pods.Continue, "the continue token tells the caller more remain")
+	require.Len(t, requests, 2)
+	assert.Equal(t, int64(1), requests[1].Limit, "the last page only asks for what is missing")
+}
+
+func TestListAllDefaultPageSize(t *testing.T) {
+	var requests []metav1.ListOptions
+	pods, err := ListAll(context.Background(), metav1.ListOptions{}, 0, pagedPods(3, &requests))
+	require.NoError(t, err)
+
+	assert.Len(t, pods.Items, 3)
+	require.Len(t, requests, 1)
+	assert.Equal(t, DefaultListPageSize, requests[0].Limit)
+}
+
+func TestListAllReturnsPageErrors(t *testing.T) {
+	_, err := ListAll(context.Background(), metav1.ListOptions{}, 0, func(ctx context.Context, opts metav1.ListOptions) (*corev1.PodList, error) {
+		return nil, fmt.Errorf("boom")
+	})
+	assert.EqualError(t, err, "boom")
+}
+
+func TestListPagesHandsOverEachPage(t *testing.T) {
+	var requests []metav1.ListOptions
+	var pages [][]string
+	remaining, err := ListPages(context.Background(), metav1.ListOptions{Limit: 2}, 0, pagedPods(5, &requests), func(page *corev1.PodList) error {
+		var names []string
+		for _, pod := range page.Items {
+			names = append(names, pod.Name)
+		}
+		pages = append(pages, names)
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Empty(t, remaining)
+	assert.Equal(t, [][]string{{"pod-0", "pod-1"}, {"pod-2", "pod-3"}, {"pod-4"}}, pages)
+}
+
+func TestListPagesStopsOnCallbackError(t *testing.T) {
+	var requests []metav1.ListOptions
+	_, err := ListPages(context.Background(), metav1.ListOptions{Limit: 2}, 0, pagedPods(5, &requests), func(page *corev1.PodList) error {
+		return fmt.Errorf("write failed")
+	})
+	assert.EqualError(t, err, "write failed")
+	assert.Len(t, requests, 1, "no more pages are fetched")
+}
@@ -0,0 +1,377 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// LogOptions holds the options used when streaming pod logs
+type LogOptions struct {
+	Container  string
+	Follow     bool
+	Previous   bool
+	Timestamps bool
+	TailLines  int64         // Negative means all lines
+	Since      time.Duration // Zero means no limit
+	SinceTime  *time.Time    // Takes precedence over Since when set
+	LimitBytes int64         // Zero means no limit
+}
+
+// PodLogOptions converts the options into the API representation
+func (o LogOptions) PodLogOptions() *corev1.PodLogOptions {
+	opts := &corev1.PodLogOptions{
+		Container:  o.Container,
+		Follow:     o.Follow,
+		Previous:   o.Previous,
+		Timestamps: o.Timestamps,
+	}
+
+	if o.TailLines >= 0 {
+		tail := o.TailLines
+		opts.TailLines = &tail
+	}
+
+	// The API accepts only one of sinceTime and sinceSeconds
+	if o.SinceTime != nil {
+		opts.SinceTime = &metav1.Time{Time: *o.SinceTime}
+	} else if o.Since > 0 {
+		seconds := int64(o.Since.Seconds())
+		if seconds < 1 {
+			seconds = 1
+		}
+		opts.SinceSeconds = &seconds
+	}
+
+	if o.LimitBytes > 0 {
+		limit := o.LimitBytes
+		opts.LimitBytes = &limit
+	}
+
+	return opts
+}
+
+// ParseSinceTime parses an RFC3339 timestamp given as a log start time
+func ParseSinceTime(value string) (*time.Time, error) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid since time %q: expected RFC3339 format such as 2006-01-02T15:04:05Z", value)
+	}
+	return &t, nil
+}
+
+// StreamLogs copies the logs of a pod to out until the stream ends or ctx is cancelled
+func (c *Client) StreamLogs(ctx context.Context, namespace, podName string, opts LogOptions, out io.Writer) error {
+	req := c.Clientset.CoreV1().Pods(namespace).GetLogs(podName, opts.PodLogOptions())
+
+	stream, err := req.Stream(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to open log stream for pod %s: %w", podName, err)
+	}
+	defer stream.Close()
+
+	if _, err := io.Copy(out, stream); err != nil && ctx.Err() == nil {
+		return fmt.Errorf("failed to read logs for pod %s: %w", podName, err)
+	}
+
+	return nil
+}
+
+// StreamAllContainerLogs streams the logs of every container of a pod,
+// including init and ephemeral containers, concurrently. Each container's
+// output goes to the writer writerFor returns for it; the writers are
+// requested up front in container order.
+//
+// When following, containers that have not started yet are attached once
+// they start, and a container that restarts is reattached so its new
+// instance is followed too. Without following, containers that have no logs
+// to show are skipped.
+func (c *Client) StreamAllContainerLogs(ctx context.Context, namespace, podName string, opts LogOptions, writerFor func(container string) io.Writer) error {
+	pod, err := c.Clientset.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get pod %s: %w", podName, err)
+	}
+
+	streams := newLogStreams(c, opts, 0)
+	for _, container := range PodContainerNames(pod) {
+		streams.start(ctx, pod, container, writerFor(container))
+	}
+	return streams.wait()
+}
+
+// StreamSelectorLogs streams the logs of every pod in a namespace matching a
+// label selector, like StreamAllContainerLogs does for a single pod. Only
+// opts.Container is streamed when it is set, and pods without it are
+// skipped. Each stream goes to the writer writerFor returns for its pod and
+// container.
+//
+// At most maxRequests streams are open at once. When following, more
+// matching pods than that are refused up front, and pods created while
+// following are attached as they appear, once a stream is free.
+func (c *Client) StreamSelectorLogs(ctx context.Context, namespace, selector string, opts LogOptions, maxRequests int, writerFor func(pod, container string) io.Writer) error {
+	list, err := c.Clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return fmt.Errorf("failed to list pods for selector %s: %w", selector, err)
+	}
+
+	pods := list.Items
+	sort.Slice(pods, func(i, j int) bool { return pods[i].Name < pods[j].Name })
+
+	if !opts.Follow && len(pods) == 0 {
+		return fmt.Errorf("no pods found for selector %s in namespace %s", selector, namespace)
+	}
+
+	total := 0
+	for i := range pods {
+		total += len(selectedContainers(&pods[i], opts.Container))
+	}
+	if opts.Follow && total > maxRequests {
+		return fmt.Errorf("selector %s matches %d log streams to follow, but at most %d may be open at once; use --max-log-requests to raise the limit",
+			selector, total, maxRequests)
+	}
+
+	streams := newLogStreams(c, opts, maxRequests)
+	// Pods are told apart by UID, as a stateful set recreates them by name
+	seen := map[types.UID]bool{}
+	startPod := func(pod *corev1.Pod) {
+		if seen[pod.UID] {
+			return
+		}
+		seen[pod.UID] = true
+		for _, container := range selectedContainers(pod, opts.Container) {
+			streams.start(ctx, pod, container, writerFor(pod.Name, container))
+		}
+	}
+
+	for i := range pods {
+		startPod(&pods[i])
+	}
+
+	var watchErr error
+	if opts.Follow {
+		watchErr = c.WatchPodsForSelector(ctx, namespace, selector, list.ResourceVersion, func(event watch.EventType, pod *corev1.Pod) bool {
+			if event == watch.Added || event == watch.Modified {
+				startPod(pod)
+			}
+			return false
+		})
+	}
+
+	return errors.Join(watchErr, streams.wait())
+}
+
+// PodContainerNames returns the names of the init, app and ephemeral
+// containers of a pod, in that order
+func PodContainerNames(pod *corev1.Pod) []string {
+	var names []string
+	for _, container := range pod.Spec.InitContainers {
+		names = append(names, container.Name)
+	}
+	for _, container := range pod.Spec.Containers {
+		names = append(names, container.Name)
+	}
+	for _, container := range pod.Spec.EphemeralContainers {
+		names = append(names, container.Name)
+	}
+	return names
+}
+
+// selectedContainers returns the containers of a pod to stream: the named
+// one if the pod has it, or all of them when no name is given
+func selectedContainers(pod *corev1.Pod, container string) []string {
+	if container == "" {
+		return PodContainerNames(pod)
+	}
+	if hasContainer(pod, container) {
+		return []string{container}
+	}
+	return nil
+}
+
+// logStreams runs the log streams of several containers concurrently,
+// at most limit of them at a time
+type logStreams struct {
+	client *Client
+	opts   LogOptions
+	// slots bounds the open streams; nil leaves them unbounded
+	slots chan struct{}
+
+	wg   sync.WaitGroup
+	mu   sync.Mutex
+	errs []error
+}
+
+func newLogStreams(client *Client, opts LogOptions, limit int) *logStreams {
+	s := &logStreams{client: client, opts: opts}
+	if limit > 0 {
+		s.slots = make(chan struct{}, limit)
+	}
+	return s
+}
+
+func (s *logStreams) start(ctx context.Context, pod *corev1.Pod, container string, out io.Writer) {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		if s.slots != nil {
+			select {
+			case s.slots <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			defer func() { <-s.slots }()
+		}
+
+		if err := s.client.streamContainerLogs(ctx, pod, container, s.opts, out); err != nil {
+			s.mu.Lock()
+			s.errs = append(s.errs, err)
+			s.mu.Unlock()
+		}
+	}()
+}
+
+// wait waits for every stream to end and returns their errors
+func (s *logStreams) wait() error {
+	s.wg.Wait()
+	return errors.Join(s.errs...)
+}
+
+// streamContainerLogs streams the logs of one container and, when
+// following, of every later instance of it
+func (c *Client) streamContainerLogs(ctx context.Context, pod *corev1.Pod, container string, opts LogOptions, out io.Writer) error {
+	opts.Container = container
+	instance, started := containerInstance(pod, container)
+
+	if !opts.Follow {
+		if !started || (opts.Previous && containerRestarts(pod, container) == 0) {
+			return nil
+		}
+		return c.StreamLogs(ctx, pod.Namespace, pod.Name, opts, out)
+	}
+
+	for {
+		if !started {
+			var err error
+			instance, started, err = c.waitForContainerInstance(ctx, pod, container, instance)
+			if err != nil || !started {
+				return err
+			}
+		}
+
+		if err := c.StreamLogs(ctx, pod.Namespace, pod.Name, opts, out); err != nil {
+			return fmt.Errorf("container %s: %w", container, err)
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		// A new instance has none of the old output, so it is read in full
+		opts.Previous = false
+		opts.TailLines = -1
+		opts.Since = 0
+		opts.SinceTime = nil
+		started = false
+	}
+}
+
+// waitForContainerInstance waits for a container of a pod to start with an
+// instance other than previous. It reports false without an error when the
+// pod finishes or is deleted, or ctx is done, before that happens.
+func (c *Client) waitForContainerInstance(ctx context.Context, pod *corev1.Pod, container, previous string) (string, bool, error) {
+	current, err := c.Clientset.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+	if err != nil {
+		if ctx.Err() != nil {
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("failed to get pod %s: %w", pod.Name, err)
+	}
+
+	instance, started := containerInstance(current, container)
+	if started && instance != previous {
+		return instance, true, nil
+	}
+	if podFinished(current) {
+		return "", false, nil
+	}
+
+	err = c.WatchPod(ctx, pod.Namespace, pod.Name, current.ResourceVersion, func(event watch.EventType, updated *corev1.Pod) bool {
+		if event == watch.Deleted || podFinished(updated) {
+			return true
+		}
+		instance, started = containerInstance(updated, container)
+		return started && instance != previous
+	})
+	if err != nil || !started || instance == previous {
+		return "", false, err
+	}
+	return instance, true, nil
+}
+
+// containerInstance returns the ID of the current instance of a container
+// and whether it has started, so that it has logs to read
+func containerInstance(pod *corev1.Pod, container string) (string, bool) {
+	status := findContainerStatus(pod, container)
+	if status == nil {
+		return "", false
+	}
+	started := status.State.Running != nil || status.State.Terminated != nil
+	return status.ContainerID, started
+}
+
+func containerRestarts(pod *corev1.Pod, container string) int32 {
+	if status := findContainerStatus(pod, container); status != nil {
+		return status.RestartCount
+	}
+	return 0
+}
+
+func findContainerStatus(pod *corev1.Pod, container string) *corev1.ContainerStatus {
+	for _, statuses := range [][]corev1.ContainerStatus{
+		pod.Status.InitContainerStatuses,
+		pod.Status.ContainerStatuses,
+		pod.Status.EphemeralContainerStatuses,
+	} {
+		for i := range statuses {
+			if statuses[i].Name == container {
+				return &statuses[i]
+			}
+		}
+	}
+	return nil
+}
+
+// podFinished reports whether none of a pod's containers will run again
+func podFinished(pod *corev1.Pod) bool {
+	return pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed
+}
+
+// NewestPod returns the most recently created pod in the list
+func NewestPod(pods []corev1.Pod) *corev1.Pod {
+	var newest *corev1.Pod
+	for i := range pods {
+		if newest == nil || pods[i].CreationTimestamp.After(newest.CreationTimestamp.Time) {
+			newest = &pods[i]
+		}
+	}
+	return newest
+}
+
+// ListPodsForSelector lists the pods in a namespace matching a label selector
+func (c *Client) ListPodsForSelector(ctx context.Context, namespace, selector string) ([]corev1.Pod, error) {
+	pods, err := c.Clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: selector,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods for selector %s: %w", selector, err)
+	}
+	return pods.Items, nil
+}
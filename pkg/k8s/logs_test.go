@@ -0,0 +1,338 @@
+package k8s
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestLogOptionsPodLogOptions(t *testing.T) {
+	sinceTime := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+	int64Ptr := func(v int64) *int64 { return &v }
+
+	testCases := []struct {
+		name     string
+		opts     LogOptions
+		expected *corev1.PodLogOptions
+	}{
+		{
+			name:     "defaults",
+			opts:     LogOptions{TailLines: -1},
+			expected: &corev1.PodLogOptions{},
+		},
+		{
+			name: "since time with previous and container",
+			opts: LogOptions{
+				Container: "app",
+				Previous:  true,
+				TailLines: -1,
+				SinceTime: &sinceTime,
+			},
+			expected: &corev1.PodLogOptions{
+				Container: "app",
+				Previous:  true,
+				SinceTime: &metav1.Time{Time: sinceTime},
+			},
+		},
+		{
+			name: "since time takes precedence over since",
+			opts: LogOptions{
+				TailLines: -1,
+				Since:     time.Hour,
+				SinceTime: &sinceTime,
+			},
+			expected: &corev1.PodLogOptions{
+				SinceTime: &metav1.Time{Time: sinceTime},
+			},
+		},
+		{
+			name: "since duration",
+			opts: LogOptions{TailLines: -1, Since: 5 * time.Minute},
+			expected: &corev1.PodLogOptions{
+				SinceSeconds: int64Ptr(300),
+			},
+		},
+		{
+			name: "limit bytes with tail",
+			opts: LogOptions{TailLines: 100, LimitBytes: 4096},
+			expected: &corev1.PodLogOptions{
+				TailLines:  int64Ptr(100),
+				LimitBytes: int64Ptr(4096),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.opts.PodLogOptions())
+		})
+	}
+}
+
+func TestParseSinceTime(t *testing.T) {
+	parsed, err := ParseSinceTime("2024-05-01T12:30:00Z")
+	require.NoError(t, err)
+	assert.True(t, parsed.Equal(time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)))
+
+	_, err = ParseSinceTime("2024-05-01 12:30")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "RFC3339")
+}
+
+func TestStreamAllContainerLogs(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-0", Namespace: "default"},
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "migrate"}},
+			Containers:     []corev1.Container{{Name: "app"}, {Name: "proxy"}, {Name: "pending"}},
+		},
+		Status: corev1.PodStatus{
+			InitContainerStatuses: []corev1.ContainerStatus{{
+				Name:        "migrate",
+				ContainerID: "containerd://m1",
+				State:       corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{}},
+			}},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "app", ContainerID: "containerd://a2", RestartCount: 1, State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+				{Name: "proxy", ContainerID: "containerd://p1", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+				{Name: "pending", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ContainerCreating"}}},
+			},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/namespaces/default/pods/web-0":
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(pod)
+		case "/api/v1/namespaces/default/pods/web-0/log":
+			container := r.URL.Query().Get("container")
+			if container == "pending" {
+				http.Error(w, "container is waiting to start", http.StatusBadRequest)
+				return
+			}
+			if r.URL.Query().Get("previous") == "true" {
+				io.WriteString(w, container+" crashed\n")
+				return
+			}
+			io.WriteString(w, container+" line\n")
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	cs, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+	client := &Client{Clientset: cs}
+
+	testCases := []struct {
+		name     string
+		previous bool
+		expected map[string]string
+	}{
+		{
+			name: "current logs skip containers not started",
+			expected: map[string]string{
+				"migrate": "migrate line\n",
+				"app":     "app line\n",
+				"proxy":   "proxy line\n",
+				"pending": "",
+			},
+		},
+		{
+			name:     "previous logs only for restarted containers",
+			previous: true,
+			expected: map[string]string{
+				"migrate": "",
+				"app":     "app crashed\n",
+				"proxy":   "",
+				"pending": "",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var order []string
+			buffers := map[string]*bytes.Buffer{}
+			writerFor := func(container string) io.Writer {
+				mu.Lock()
+				defer mu.Unlock()
+				order = append(order, container)
+				buffers[container] = &bytes.Buffer{}
+				return buffers[container]
+			}
+
+			err := client.StreamAllContainerLogs(t.Context(), "default", "web-0", LogOptions{TailLines: -1, Previous: tc.previous}, writerFor)
+			require.NoError(t, err)
+
+			assert.Equal(t, []string{"migrate", "app", "proxy", "pending"}, order)
+			got := map[string]string{}
+			for container, buf := range buffers {
+				got[container] = buf.String()
+			}
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestContainerInstance(t *testing.T) {
+	pod := &corev1.Pod{Status: corev1.PodStatus{
+		ContainerStatuses: []corev1.ContainerStatus{
+			{Name: "app", ContainerID: "containerd://a1", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+			{Name: "crashing", ContainerID: "containerd://c3", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}},
+		},
+		EphemeralContainerStatuses: []corev1.ContainerStatus{
+			{Name: "debugger", ContainerID: "containerd://d1", State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{}}},
+		},
+	}}
+
+	testCases := []struct {
+		container   string
+		wantID      string
+		wantStarted bool
+	}{
+		{container: "app", wantID: "containerd://a1", wantStarted: true},
+		{container: "crashing", wantID: "containerd://c3", wantStarted: false},
+		{container: "debugger", wantID: "containerd://d1", wantStarted: true},
+		{container: "missing"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.container, func(t *testing.T) {
+			id, started := containerInstance(pod, tc.container)
+			assert.Equal(t, tc.wantID, id)
+			assert.Equal(t, tc.wantStarted, started)
+		})
+	}
+}
+
+func TestStreamSelectorLogs(t *testing.T) {
+	running := corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}
+	pods := &corev1.PodList{Items: []corev1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "default", UID: "uid-1"},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}, {Name: "proxy"}}},
+			Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "app", ContainerID: "containerd://a1", State: running},
+				{Name: "proxy", ContainerID: "containerd://p1", State: running},
+			}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-0", Namespace: "default", UID: "uid-0"},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+			Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "app", ContainerID: "containerd://a0", State: running},
+			}},
+		},
+	}}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/namespaces/default/pods":
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Query().Get("labelSelector") != "app=web" {
+				json.NewEncoder(w).Encode(&corev1.PodList{})
+				return
+			}
+			json.NewEncoder(w).Encode(pods)
+		case "/api/v1/namespaces/default/pods/web-0/log", "/api/v1/namespaces/default/pods/web-1/log":
+			io.WriteString(w, r.URL.Query().Get("container")+" line\n")
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	cs, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+	client := &Client{Clientset: cs}
+
+	testCases := []struct {
+		name        string
+		selector    string
+		opts        LogOptions
+		maxRequests int
+		expected    map[string]string
+		wantOrder   []string
+		wantErr     string
+	}{
+		{
+			name:        "all containers of matching pods",
+			selector:    "app=web",
+			opts:        LogOptions{TailLines: -1},
+			maxRequests: 1,
+			expected: map[string]string{
+				"web-0/app":   "app line\n",
+				"web-1/app":   "app line\n",
+				"web-1/proxy": "proxy line\n",
+			},
+			wantOrder: []string{"web-0/app", "web-1/app", "web-1/proxy"},
+		},
+		{
+			name:        "pods without the container are skipped",
+			selector:    "app=web",
+			opts:        LogOptions{TailLines: -1, Container: "proxy"},
+			maxRequests: 5,
+			expected:    map[string]string{"web-1/proxy": "proxy line\n"},
+			wantOrder:   []string{"web-1/proxy"},
+		},
+		{
+			name:        "no matching pods",
+			selector:    "app=missing",
+			opts:        LogOptions{TailLines: -1},
+			maxRequests: 5,
+			wantErr:     "no pods found for selector app=missing",
+		},
+		{
+			name:        "following more streams than allowed",
+			selector:    "app=web",
+			opts:        LogOptions{TailLines: -1, Follow: true},
+			maxRequests: 2,
+			wantErr:     "matches 3 log streams to follow, but at most 2",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var order []string
+			buffers := map[string]*bytes.Buffer{}
+			writerFor := func(pod, container string) io.Writer {
+				mu.Lock()
+				defer mu.Unlock()
+				order = append(order, pod+"/"+container)
+				buffers[pod+"/"+container] = &bytes.Buffer{}
+				return buffers[pod+"/"+container]
+			}
+
+			err := client.StreamSelectorLogs(t.Context(), "default", tc.selector, tc.opts, tc.maxRequests, writerFor)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.wantOrder, order)
+			got := map[string]string{}
+			for name, buf := range buffers {
+				got[name] = buf.String()
+			}
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -0,0 +1,292 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// QuotaUsage is the use of one resource limited by a resource quota
+type QuotaUsage struct {
+	Quota    string
+	Resource corev1.ResourceName
+	Used     resource.Quantity
+	Hard     resource.Quantity
+}
+
+// Fraction returns how much of the hard limit is used: 0 when nothing is,
+// 1 or more at or over the limit
+func (u QuotaUsage) Fraction() float64 {
+	hard := u.Hard.AsApproximateFloat64()
+	used := u.Used.AsApproximateFloat64()
+	if hard <= 0 {
+		if used > 0 {
+			return 1
+		}
+		return 0
+	}
+	return used / hard
+}
+
+// NamespaceSummary describes a namespace, the limits placed on it and how
+// much it holds
+type NamespaceSummary struct {
+	Namespace   *corev1.Namespace
+	Quotas      []QuotaUsage
+	LimitRanges []corev1.LimitRange
+	Pods        int
+	Secrets     int
+	ConfigMaps  int
+}
+
+// DescribeNamespace collects the resource quota usage, limit ranges and
+// object counts of a namespace. Quota usage is sorted by quota and resource.
+func (c *Client) DescribeNamespace(ctx context.Context, name string) (*NamespaceSummary, error) {
+	core := c.Clientset.CoreV1()
+
+	namespace, err := core.Namespaces().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get namespace %s: %w", name, err)
+	}
+	summary := &NamespaceSummary{Namespace: namespace}
+
+	quotas, err := core.ResourceQuotas(name).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resource quotas in namespace %s: %w", name, err)
+	}
+	for _, quota := range quotas.Items {
+		for resourceName, hard := range quota.Status.Hard {
+			summary.Quotas = append(summary.Quotas, QuotaUsage{
+				Quota:    quota.Name,
+				Resource: resourceName,
+				Used:     quota.Status.Used[resourceName],
+				Hard:     hard,
+			})
+		}
+	}
+	sort.Slice(summary.Quotas, func(i, j int) bool {
+		if summary.Quotas[i].Quota != summary.Quotas[j].Quota {
+			return summary.Quotas[i].Quota < summary.Quotas[j].Quota
+		}
+		return summary.Quotas[i].Resource < summary.Quotas[j].Resource
+	})
+
+	limitRanges, err := core.LimitRanges(name).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list limit ranges in namespace %s: %w", name, err)
+	}
+	summary.LimitRanges = limitRanges.Items
+
+	pods, err := core.Pods(name).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods in namespace %s: %w", name, err)
+	}
+	summary.Pods = len(pods.Items)
+
+	secrets, err := core.Secrets(name).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list secrets in namespace %s: %w", name, err)
+	}
+	summary.Secrets = len(secrets.Items)
+
+	configMaps, err := core.ConfigMaps(name).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list config maps in namespace %s: %w", name, err)
+	}
+	summary.ConfigMaps = len(configMaps.Items)
+
+	return summary, nil
+}
+
+// namespacePollInterval is how often a namespace being deleted is checked
+const namespacePollInterval = 2 * time.Second
+
+// systemNamespaces are created by Kubernetes itself and must not be deleted
+var systemNamespaces = map[string]bool{
+	"default":         true,
+	"kube-system":     true,
+	"kube-public":     true,
+	"kube-node-lease": true,
+}
+
+// IsSystemNamespace reports whether a namespace belongs to Kubernetes itself
+func IsSystemNamespace(name string) bool {
+	return systemNamespaces[name]
+}
+
+// ResourceCount is the number of objects of one kind in a namespace
+type ResourceCount struct {
+	Kind  string
+	Count int
+}
+
+// NamespaceContents describes what a namespace holds, as previewed before
+// deleting it
+type NamespaceContents struct {
+	Namespace *corev1.Namespace
+	// Counts lists the kinds with at least one object
+	Counts []ResourceCount
+	// Stuck lists the objects being deleted that still wait on finalizers,
+	// such as "persistentvolumeclaims/data (finalizers: kubernetes.io/pvc-protection)"
+	Stuck []string
+}
+
+// Total returns the number of objects counted
+func (c *NamespaceContents) Total() int {
+	total := 0
+	for _, count := range c.Counts {
+		total += count.Count
+	}
+	return total
+}
+
+// ListNamespaceContents counts the common kinds of objects in a namespace
+// and finds the ones stuck on finalizers. Kinds the user may not list are
+// left out.
+func (c *Client) ListNamespaceContents(ctx context.Context, name string) (*NamespaceContents, error) {
+	namespace, err := c.Clientset.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get namespace %s: %w", name, err)
+	}
+	contents := &NamespaceContents{Namespace: namespace}
+
+	core, apps, batch := c.Clientset.CoreV1(), c.Clientset.AppsV1(), c.Clientset.BatchV1()
+	opts := metav1.ListOptions{}
+	listers := []struct {
+		kind string
+		list func() (runtime.Object, error)
+	}{
+		{"pods", func() (runtime.Object, error) { return core.Pods(name).List(ctx, opts) }},
+		{"deployments", func() (runtime.Object, error) { return apps.Deployments(name).List(ctx, opts) }},
+		{"statefulsets", func() (runtime.Object, error) { return apps.StatefulSets(name).List(ctx, opts) }},
+		{"daemonsets", func() (runtime.Object, error) { return apps.DaemonSets(name).List(ctx, opts) }},
+		{"jobs", func() (runtime.Object, error) { return batch.Jobs(name).List(ctx, opts) }},
+		{"cronjobs", func() (runtime.Object, error) { return batch.CronJobs(name).List(ctx, opts) }},
+		{"services", func() (runtime.Object, error) { return core.Services(name).List(ctx, opts) }},
+		{"ingresses", func() (runtime.Object, error) { return c.Clientset.NetworkingV1().Ingresses(name).List(ctx, opts) }},
+		{"configmaps", func() (runtime.Object, error) { return core.ConfigMaps(name).List(ctx, opts) }},
+		{"secrets", func() (runtime.Object, error) { return core.Secrets(name).List(ctx, opts) }},
+		{"persistentvolumeclaims", func() (runtime.Object, error) { return core.PersistentVolumeClaims(name).List(ctx, opts) }},
+		{"serviceaccounts", func() (runtime.Object, error) { return core.ServiceAccounts(name).List(ctx, opts) }},
+	}
+
+	for _, lister := range listers {
+		list, err := lister.list()
+		if apierrors.IsForbidden(err) || apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s in namespace %s: %w", lister.kind, name, err)
+		}
+
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s in namespace %s: %w", lister.kind, name, err)
+		}
+		if len(items) == 0 {
+			continue
+		}
+		contents.Counts = append(contents.Counts, ResourceCount{Kind: lister.kind, Count: len(items)})
+
+		for _, item := range items {
+			object, err := meta.Accessor(item)
+			if err != nil || object.GetDeletionTimestamp() == nil || len(object.GetFinalizers()) == 0 {
+				continue
+			}
+			contents.Stuck = append(contents.Stuck, fmt.Sprintf("%s/%s (finalizers: %s)",
+				lister.kind, object.GetName(), strings.Join(object.GetFinalizers(), ", ")))
+		}
+	}
+
+	return contents, nil
+}
+
+// DeleteNamespace deletes a namespace with foreground propagation, so it
+// stays visible until everything in it is gone
+func (c *Client) DeleteNamespace(ctx context.Context, name string) error {
+	propagation := metav1.DeletePropagationForeground
+	err := c.Clientset.CoreV1().Namespaces().Delete(ctx, name, metav1.DeleteOptions{PropagationPolicy: &propagation})
+	if err != nil {
+		return fmt.Errorf("failed to delete namespace %s: %w", name, err)
+	}
+	return nil
+}
+
+// WaitForNamespaceDeleted polls a namespace until it is gone or ctx is done.
+// onProgress is called with what the namespace still holds after each
+// check. When ctx is done first, the error lists what blocks the deletion.
+func (c *Client) WaitForNamespaceDeleted(ctx context.Context, name string, onProgress func(*NamespaceContents)) error {
+	ticker := time.NewTicker(namespacePollInterval)
+	defer ticker.Stop()
+
+	var last *NamespaceContents
+	for {
+		contents, err := c.ListNamespaceContents(ctx, name)
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil && ctx.Err() == nil {
+			return err
+		}
+		if err == nil {
+			last = contents
+			if onProgress != nil {
+				onProgress(contents)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			blockers := NamespaceBlockers(last)
+			if len(blockers) == 0 {
+				return fmt.Errorf("timed out waiting for namespace %s to be deleted: %w", name, ctx.Err())
+			}
+			return fmt.Errorf("timed out waiting for namespace %s to be deleted, still blocked by:\n  - %s",
+				name, strings.Join(blockers, "\n  - "))
+		case <-ticker.C:
+		}
+	}
+}
+
+// namespaceDeletionConditions are the namespace conditions that explain why
+// a terminating namespace is not gone yet
+var namespaceDeletionConditions = map[corev1.NamespaceConditionType]bool{
+	corev1.NamespaceDeletionDiscoveryFailure: true,
+	corev1.NamespaceDeletionContentFailure:   true,
+	corev1.NamespaceDeletionGVParsingFailure: true,
+	corev1.NamespaceContentRemaining:         true,
+	corev1.NamespaceFinalizersRemaining:      true,
+}
+
+// NamespaceBlockers explains what keeps a terminating namespace around: the
+// deletion conditions reported by the namespace controller, its own
+// finalizers and the objects stuck on finalizers
+func NamespaceBlockers(contents *NamespaceContents) []string {
+	if contents == nil {
+		return nil
+	}
+
+	var blockers []string
+	for _, condition := range contents.Namespace.Status.Conditions {
+		if namespaceDeletionConditions[condition.Type] && condition.Status == corev1.ConditionTrue {
+			blockers = append(blockers, fmt.Sprintf("%s: %s", condition.Type, condition.Message))
+		}
+	}
+	if finalizers := contents.Namespace.Spec.Finalizers; len(finalizers) > 0 {
+		names := make([]string, 0, len(finalizers))
+		for _, finalizer := range finalizers {
+			names = append(names, string(finalizer))
+		}
+		blockers = append(blockers, "namespace finalizers: "+strings.Join(names, ", "))
+	}
+	return append(blockers, contents.Stuck...)
+}
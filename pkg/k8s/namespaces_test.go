@@ -0,0 +1,178 @@
+package k8s
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestQuotaUsageFraction(t *testing.T) {
+	testCases := []struct {
+		name string
+		used string
+		hard string
+		want float64
+	}{
+		{name: "cpu millicores", used: "3500m", hard: "4", want: 0.875},
+		{name: "memory units", used: "512Mi", hard: "2Gi", want: 0.25},
+		{name: "object count at limit", used: "10", hard: "10", want: 1},
+		{name: "zero hard limit used", used: "1", hard: "0", want: 1},
+		{name: "zero hard limit unused", used: "0", hard: "0", want: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			usage := QuotaUsage{Used: resource.MustParse(tc.used), Hard: resource.MustParse(tc.hard)}
+			assert.InDelta(t, tc.want, usage.Fraction(), 0.0001)
+		})
+	}
+}
+
+func TestDescribeNamespace(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/namespaces/team-a":
+			json.NewEncoder(w).Encode(&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+				Status:     corev1.NamespaceStatus{Phase: corev1.NamespaceActive},
+			})
+		case "/api/v1/namespaces/team-a/resourcequotas":
+			json.NewEncoder(w).Encode(&corev1.ResourceQuotaList{Items: []corev1.ResourceQuota{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "objects"},
+					Status: corev1.ResourceQuotaStatus{
+						Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("10")},
+						Used: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("2")},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "compute"},
+					Status: corev1.ResourceQuotaStatus{
+						Hard: corev1.ResourceList{
+							corev1.ResourceRequestsMemory: resource.MustParse("4Gi"),
+							corev1.ResourceRequestsCPU:    resource.MustParse("4"),
+						},
+						Used: corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("3")},
+					},
+				},
+			}})
+		case "/api/v1/namespaces/team-a/limitranges":
+			json.NewEncoder(w).Encode(&corev1.LimitRangeList{Items: []corev1.LimitRange{
+				{ObjectMeta: metav1.ObjectMeta{Name: "defaults"}},
+			}})
+		case "/api/v1/namespaces/team-a/pods":
+			json.NewEncoder(w).Encode(&corev1.PodList{Items: []corev1.Pod{{}, {}}})
+		case "/api/v1/namespaces/team-a/secrets":
+			json.NewEncoder(w).Encode(&corev1.SecretList{Items: []corev1.Secret{{}}})
+		case "/api/v1/namespaces/team-a/configmaps":
+			json.NewEncoder(w).Encode(&corev1.ConfigMapList{Items: []corev1.ConfigMap{{}, {}, {}}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(&metav1.Status{Status: metav1.StatusFailure, Reason: metav1.StatusReasonNotFound, Code: http.StatusNotFound})
+		}
+	}))
+	defer server.Close()
+
+	cs, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+	client := &Client{Clientset: cs}
+
+	summary, err := client.DescribeNamespace(t.Context(), "team-a")
+	require.NoError(t, err)
+
+	assert.Equal(t, "team-a", summary.Namespace.Name)
+	require.Len(t, summary.Quotas, 3)
+	assert.Equal(t, "compute", summary.Quotas[0].Quota)
+	assert.Equal(t, corev1.ResourceRequestsCPU, summary.Quotas[0].Resource)
+	assert.Equal(t, "3", summary.Quotas[0].Used.String())
+	assert.Equal(t, corev1.ResourceRequestsMemory, summary.Quotas[1].Resource)
+	assert.True(t, summary.Quotas[1].Used.IsZero(), "resources without usage count as unused")
+	assert.Equal(t, "objects", summary.Quotas[2].Quota)
+	require.Len(t, summary.LimitRanges, 1)
+	assert.Equal(t, 2, summary.Pods)
+	assert.Equal(t, 1, summary.Secrets)
+	assert.Equal(t, 3, summary.ConfigMaps)
+
+	_, err = client.DescribeNamespace(t.Context(), "missing")
+	assert.ErrorContains(t, err, "failed to get namespace missing")
+}
+
+func TestListNamespaceContentsAndDelete(t *testing.T) {
+	deleting := metav1.Now()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/namespaces/team-a":
+			if r.Method == http.MethodDelete {
+				var opts metav1.DeleteOptions
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&opts))
+				if assert.NotNil(t, opts.PropagationPolicy) {
+					assert.Equal(t, metav1.DeletePropagationForeground, *opts.PropagationPolicy)
+				}
+			}
+			json.NewEncoder(w).Encode(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}})
+		case "/api/v1/namespaces/team-a/pods":
+			json.NewEncoder(w).Encode(&corev1.PodList{Items: []corev1.Pod{{}, {}}})
+		case "/api/v1/namespaces/team-a/persistentvolumeclaims":
+			json.NewEncoder(w).Encode(&corev1.PersistentVolumeClaimList{Items: []corev1.PersistentVolumeClaim{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "data",
+					DeletionTimestamp: &deleting,
+					Finalizers:        []string{"kubernetes.io/pvc-protection"},
+				},
+			}}})
+		case "/api/v1/namespaces/team-a/secrets":
+			status := apierrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, "", nil).Status()
+			w.WriteHeader(http.StatusForbidden)
+			json.NewEncoder(w).Encode(&status)
+		default:
+			// Every other kind is empty
+			w.Write([]byte(`{"items":[]}`))
+		}
+	}))
+	defer server.Close()
+
+	cs, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+	client := &Client{Clientset: cs}
+
+	contents, err := client.ListNamespaceContents(t.Context(), "team-a")
+	require.NoError(t, err)
+	assert.Equal(t, []ResourceCount{{Kind: "pods", Count: 2}, {Kind: "persistentvolumeclaims", Count: 1}}, contents.Counts)
+	assert.Equal(t, 3, contents.Total())
+	assert.Equal(t, []string{"persistentvolumeclaims/data (finalizers: kubernetes.io/pvc-protection)"}, contents.Stuck)
+
+	require.NoError(t, client.DeleteNamespace(t.Context(), "team-a"))
+}
+
+func TestNamespaceBlockers(t *testing.T) {
+	assert.Nil(t, NamespaceBlockers(nil))
+
+	contents := &NamespaceContents{
+		Namespace: &corev1.Namespace{
+			Spec: corev1.NamespaceSpec{Finalizers: []corev1.FinalizerName{corev1.FinalizerKubernetes}},
+			Status: corev1.NamespaceStatus{Conditions: []corev1.NamespaceCondition{
+				{Type: corev1.NamespaceDeletionDiscoveryFailure, Status: corev1.ConditionFalse, Message: "All resources successfully discovered"},
+				{Type: corev1.NamespaceFinalizersRemaining, Status: corev1.ConditionTrue, Message: "Some content in the namespace has finalizers remaining: example.com/protect in 1 resource instances"},
+			}},
+		},
+		Stuck: []string{"pods/web (finalizers: example.com/protect)"},
+	}
+	assert.Equal(t, []string{
+		"NamespaceFinalizersRemaining: Some content in the namespace has finalizers remaining: example.com/protect in 1 resource instances",
+		"namespace finalizers: kubernetes",
+		"pods/web (finalizers: example.com/protect)",
+	}, NamespaceBlockers(contents))
+}
@@ -0,0 +1,74 @@
+package k8s
+
+import (
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// nodeRoleLabelPrefix prefixes the labels naming the roles of a node,
+	// such as node-role.kubernetes.io/control-plane
+	nodeRoleLabelPrefix = "node-role.kubernetes.io/"
+	// nodeRoleLabel is the older label holding a single role
+	nodeRoleLabel = "kubernetes.io/role"
+)
+
+// NodeRoles returns the roles of a node from its role labels, sorted and
+// comma-separated as kubectl shows them, or "<none>"
+func NodeRoles(node *corev1.Node) string {
+	roles := map[string]bool{}
+	for key, value := range node.Labels {
+		switch {
+		case strings.HasPrefix(key, nodeRoleLabelPrefix):
+			if role := strings.TrimPrefix(key, nodeRoleLabelPrefix); role != "" {
+				roles[role] = true
+			}
+		case key == nodeRoleLabel && value != "":
+			roles[value] = true
+		}
+	}
+	if len(roles) == 0 {
+		return "<none>"
+	}
+
+	names := make([]string, 0, len(roles))
+	for role := range roles {
+		names = append(names, role)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
+
+// NodeStatus describes the Ready condition of a node as Ready, NotReady or
+// Unknown, adding SchedulingDisabled for a cordoned node
+func NodeStatus(node *corev1.Node) string {
+	status := "Unknown"
+	for _, condition := range node.Status.Conditions {
+		if condition.Type != corev1.NodeReady {
+			continue
+		}
+		switch condition.Status {
+		case corev1.ConditionTrue:
+			status = "Ready"
+		case corev1.ConditionFalse:
+			status = "NotReady"
+		}
+	}
+	if node.Spec.Unschedulable {
+		status += ",SchedulingDisabled"
+	}
+	return status
+}
+
+// NodeAddress returns the first address of a type of a node, such as its
+// internal IP, or "" when it has none
+func NodeAddress(node *corev1.Node, addressType corev1.NodeAddressType) string {
+	for _, address := range node.Status.Addresses {
+		if address.Type == addressType {
+			return address.Address
+		}
+	}
+	return ""
+}
@@ -0,0 +1,63 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNodeRoles(t *testing.T) {
+	testCases := []struct {
+		name     string
+		labels   map[string]string
+		expected string
+	}{
+		{name: "no roles", labels: map[string]string{"kubernetes.io/hostname": "node-1"}, expected: "<none>"},
+		{name: "control plane", labels: map[string]string{"node-role.kubernetes.io/control-plane": ""}, expected: "control-plane"},
+		{
+			name: "several roles",
+			labels: map[string]string{
+				"node-role.kubernetes.io/worker":        "",
+				"node-role.kubernetes.io/control-plane": "",
+				"kubernetes.io/role":                    "master",
+			},
+			expected: "control-plane,master,worker",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Labels: tc.labels}}
+			assert.Equal(t, tc.expected, NodeRoles(node))
+		})
+	}
+}
+
+func TestNodeStatus(t *testing.T) {
+	ready := func(status corev1.ConditionStatus) []corev1.NodeCondition {
+		return []corev1.NodeCondition{
+			{Type: corev1.NodeMemoryPressure, Status: corev1.ConditionFalse},
+			{Type: corev1.NodeReady, Status: status},
+		}
+	}
+
+	assert.Equal(t, "Ready", NodeStatus(&corev1.Node{Status: corev1.NodeStatus{Conditions: ready(corev1.ConditionTrue)}}))
+	assert.Equal(t, "NotReady", NodeStatus(&corev1.Node{Status: corev1.NodeStatus{Conditions: ready(corev1.ConditionFalse)}}))
+	assert.Equal(t, "Unknown", NodeStatus(&corev1.Node{Status: corev1.NodeStatus{Conditions: ready(corev1.ConditionUnknown)}}))
+	assert.Equal(t, "Unknown", NodeStatus(&corev1.Node{}))
+	assert.Equal(t, "Ready,SchedulingDisabled", NodeStatus(&corev1.Node{
+		Spec:   corev1.NodeSpec{Unschedulable: true},
+		Status: corev1.NodeStatus{Conditions: ready(corev1.ConditionTrue)},
+	}))
+}
+
+func TestNodeAddress(t *testing.T) {
+	node := &corev1.Node{Status: corev1.NodeStatus{Addresses: []corev1.NodeAddress{
+		{Type: corev1.NodeHostName, Address: "node-1"},
+		{Type: corev1.NodeInternalIP, Address: "10.0.0.5"},
+	}}}
+	assert.Equal(t, "10.0.0.5", NodeAddress(node, corev1.NodeInternalIP))
+	assert.Equal(t, "", NodeAddress(node, corev1.NodeExternalIP))
+}
@@ -0,0 +1,112 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/karthickk/k8s-manager/pkg/utils"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// NotFoundError reports a named resource that does not exist, with the
+// closest existing name when there is one. It wraps the API error, so
+// apierrors.IsNotFound still matches it.
+type NotFoundError struct {
+	Kind       string // "pod", "secret", "config map", "deployment", "service" or "node"
+	Name       string
+	Namespace  string // Empty for cluster-scoped kinds such as nodes
+	Suggestion string
+	Err        error
+}
+
+func (e *NotFoundError) Error() string {
+	msg := fmt.Sprintf("%s %q not found in namespace %q", e.Kind, e.Name, e.Namespace)
+	if e.Namespace == "" {
+		msg = fmt.Sprintf("%s %q not found", e.Kind, e.Name)
+	}
+	if e.Suggestion != "" {
+		msg += fmt.Sprintf("; did you mean %q?", e.Suggestion)
+	}
+	return msg
+}
+
+func (e *NotFoundError) Unwrap() error {
+	return e.Err
+}
+
+// WithNameSuggestion turns the NotFound error of a get or delete into a
+// NotFoundError suggesting the closest name of that kind in the namespace.
+// Other errors are returned unchanged. If the names cannot be listed the
+// error has no suggestion.
+func WithNameSuggestion(ctx context.Context, client kubernetes.Interface, kind, namespace, name string, err error) error {
+	if !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	names, listErr := listNames(ctx, client, kind, namespace)
+	notFound := &NotFoundError{Kind: kind, Name: name, Namespace: namespace, Err: err}
+	if listErr == nil {
+		notFound.Suggestion = utils.SuggestClosest(name, names)
+	}
+	return notFound
+}
+
+// listNames lists the names of one kind of resource in a namespace
+func listNames(ctx context.Context, client kubernetes.Interface, kind, namespace string) ([]string, error) {
+	var names []string
+	switch kind {
+	case "pod":
+		list, err := client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range list.Items {
+			names = append(names, item.Name)
+		}
+	case "secret":
+		list, err := client.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range list.Items {
+			names = append(names, item.Name)
+		}
+	case "config map":
+		list, err := client.CoreV1().ConfigMaps(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range list.Items {
+			names = append(names, item.Name)
+		}
+	case "deployment":
+		list, err := client.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range list.Items {
+			names = append(names, item.Name)
+		}
+	case "service":
+		list, err := client.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range list.Items {
+			names = append(names, item.Name)
+		}
+	case "node":
+		list, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range list.Items {
+			names = append(names, item.Name)
+		}
+	default:
+		return nil, fmt.Errorf("cannot list %ss", kind)
+	}
+	return names, nil
+}
@@ -0,0 +1,53 @@
+package k8s
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestWithNameSuggestion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.Equal(t, "/api/v1/namespaces/prod/pods", r.URL.Path)
+		json.NewEncoder(w).Encode(&corev1.PodList{Items: []corev1.Pod{
+			{ObjectMeta: metav1.ObjectMeta{Name: "web-7d9f8c6b5-x2k4p"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "redis-0"}},
+		}})
+	}))
+	defer server.Close()
+
+	cs, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+
+	apiErr := apierrors.NewNotFound(schema.GroupResource{Resource: "pods"}, "redsi-0")
+	err = WithNameSuggestion(t.Context(), cs, "pod", "prod", "redsi-0", apiErr)
+	assert.EqualError(t, err, `pod "redsi-0" not found in namespace "prod"; did you mean "redis-0"?`)
+	assert.True(t, apierrors.IsNotFound(err))
+
+	var notFound *NotFoundError
+	require.True(t, errors.As(err, &notFound))
+	assert.Equal(t, "redis-0", notFound.Suggestion)
+
+	err = WithNameSuggestion(t.Context(), cs, "pod", "prod", "postgres", apiErr)
+	assert.EqualError(t, err, `pod "postgres" not found in namespace "prod"`)
+
+	other := errors.New("connection refused")
+	assert.Equal(t, other, WithNameSuggestion(t.Context(), cs, "pod", "prod", "redis-0", other))
+}
+
+func TestNotFoundErrorClusterScoped(t *testing.T) {
+	err := &NotFoundError{Kind: "node", Name: "worker-3", Suggestion: "worker-2"}
+	assert.EqualError(t, err, `node "worker-3" not found; did you mean "worker-2"?`)
+}
@@ -0,0 +1,171 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// maxOwnerDepth stops OwnerChain on reference cycles
+const maxOwnerDepth = 10
+
+// Owner is one level of an object's chain of controllers
+type Owner struct {
+	Kind  string
+	Name  string
+	Ready string // Readiness summary, empty for kinds that are not looked up
+	// Missing is set when the owner has been deleted but its reference remains
+	Missing bool
+}
+
+// String formats the owner as Kind/name
+func (o Owner) String() string {
+	return o.Kind + "/" + o.Name
+}
+
+// FormatOwnerChain joins an ownership chain with arrows, starting at the
+// object's direct owner
+func FormatOwnerChain(chain []Owner) string {
+	if len(chain) == 0 {
+		return "<none>"
+	}
+
+	levels := make([]string, len(chain))
+	for i, owner := range chain {
+		levels[i] = owner.String()
+		switch {
+		case owner.Missing:
+			levels[i] += " (not found)"
+		case owner.Ready != "":
+			levels[i] += " (" + owner.Ready + ")"
+		}
+	}
+	return strings.Join(levels, " → ")
+}
+
+// OwnerChain follows the controller references of an object up to the
+// top-level workload, such as ReplicaSet → Deployment or Job → CronJob. An
+// object without owners has an empty chain. The walk stops at kinds it does
+// not know and at owners that no longer exist. On an API error it returns the
+// levels reached so far, the last without readiness, with the error.
+func OwnerChain(ctx context.Context, client kubernetes.Interface, namespace string, refs []metav1.OwnerReference) ([]Owner, error) {
+	var chain []Owner
+	for len(chain) < maxOwnerDepth {
+		ref := controllerRef(refs)
+		if ref == nil {
+			return chain, nil
+		}
+
+		owner := Owner{Kind: ref.Kind, Name: ref.Name}
+		next, err := lookupOwner(ctx, client, namespace, &owner)
+		if apierrors.IsNotFound(err) {
+			owner.Missing = true
+			return append(chain, owner), nil
+		}
+		if err != nil {
+			return append(chain, owner), fmt.Errorf("failed to get %s: %w", owner, err)
+		}
+		chain = append(chain, owner)
+		refs = next
+	}
+	return chain, nil
+}
+
+// controllerRef returns the managing controller among refs, or the first
+// reference when none is marked as the controller
+func controllerRef(refs []metav1.OwnerReference) *metav1.OwnerReference {
+	if len(refs) == 0 {
+		return nil
+	}
+	for i := range refs {
+		if refs[i].Controller != nil && *refs[i].Controller {
+			return &refs[i]
+		}
+	}
+	return &refs[0]
+}
+
+// lookupOwner fills in the readiness of owner and returns its own owner
+// references. Kinds it does not know end the chain.
+func lookupOwner(ctx context.Context, client kubernetes.Interface, namespace string, owner *Owner) ([]metav1.OwnerReference, error) {
+	switch owner.Kind {
+	case "ReplicaSet":
+		rs, err := client.AppsV1().ReplicaSets(namespace).Get(ctx, owner.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		owner.Ready = replicasReady(rs.Status.ReadyReplicas, rs.Spec.Replicas)
+		return rs.OwnerReferences, nil
+
+	case "Deployment":
+		deployment, err := client.AppsV1().Deployments(namespace).Get(ctx, owner.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		owner.Ready = replicasReady(deployment.Status.ReadyReplicas, deployment.Spec.Replicas)
+		return deployment.OwnerReferences, nil
+
+	case "StatefulSet":
+		sts, err := client.AppsV1().StatefulSets(namespace).Get(ctx, owner.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		owner.Ready = replicasReady(sts.Status.ReadyReplicas, sts.Spec.Replicas)
+		return sts.OwnerReferences, nil
+
+	case "DaemonSet":
+		ds, err := client.AppsV1().DaemonSets(namespace).Get(ctx, owner.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		owner.Ready = fmt.Sprintf("%d/%d ready", ds.Status.NumberReady, ds.Status.DesiredNumberScheduled)
+		return ds.OwnerReferences, nil
+
+	case "ReplicationController":
+		rc, err := client.CoreV1().ReplicationControllers(namespace).Get(ctx, owner.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		owner.Ready = replicasReady(rc.Status.ReadyReplicas, rc.Spec.Replicas)
+		return rc.OwnerReferences, nil
+
+	case "Job":
+		job, err := client.BatchV1().Jobs(namespace).Get(ctx, owner.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		completions := int32(1)
+		if job.Spec.Completions != nil {
+			completions = *job.Spec.Completions
+		}
+		owner.Ready = fmt.Sprintf("%d/%d succeeded", job.Status.Succeeded, completions)
+		return job.OwnerReferences, nil
+
+	case "CronJob":
+		cronJob, err := client.BatchV1().CronJobs(namespace).Get(ctx, owner.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		owner.Ready = fmt.Sprintf("%d active", len(cronJob.Status.Active))
+		if cronJob.Spec.Suspend != nil && *cronJob.Spec.Suspend {
+			owner.Ready = "suspended"
+		}
+		return cronJob.OwnerReferences, nil
+	}
+
+	return nil, nil
+}
+
+// replicasReady formats ready replicas against the desired count, which
+// defaults to 1 when unset
+func replicasReady(ready int32, replicas *int32) string {
+	desired := int32(1)
+	if replicas != nil {
+		desired = *replicas
+	}
+	return fmt.Sprintf("%d/%d ready", ready, desired)
+}
@@ -0,0 +1,93 @@
+package k8s
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// newOwnersServer serves the objects by path and 404 for everything else
+func newOwnersServer(t *testing.T, objects map[string]any) *kubernetes.Clientset {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if object, ok := objects[r.URL.Path]; ok {
+			json.NewEncoder(w).Encode(object)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(&metav1.Status{Status: metav1.StatusFailure, Reason: metav1.StatusReasonNotFound, Code: http.StatusNotFound})
+	}))
+	t.Cleanup(server.Close)
+
+	cs, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+	return cs
+}
+
+func controlledBy(kind, name string) []metav1.OwnerReference {
+	controller := true
+	return []metav1.OwnerReference{{Kind: kind, Name: name, Controller: &controller}}
+}
+
+func TestOwnerChain(t *testing.T) {
+	replicas := int32(3)
+	objects := map[string]any{
+		"/apis/apps/v1/namespaces/prod/replicasets/web-7d": &appsv1.ReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-7d", OwnerReferences: controlledBy("Deployment", "web")},
+			Spec:       appsv1.ReplicaSetSpec{Replicas: &replicas},
+			Status:     appsv1.ReplicaSetStatus{ReadyReplicas: 2},
+		},
+		"/apis/apps/v1/namespaces/prod/deployments/web": &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "web"},
+			Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+			Status:     appsv1.DeploymentStatus{ReadyReplicas: 2},
+		},
+		"/apis/batch/v1/namespaces/prod/jobs/backup-28": &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: "backup-28", OwnerReferences: controlledBy("CronJob", "backup")},
+			Status:     batchv1.JobStatus{Succeeded: 1},
+		},
+		"/apis/batch/v1/namespaces/prod/cronjobs/backup": &batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{Name: "backup"},
+		},
+	}
+
+	testCases := []struct {
+		name string
+		refs []metav1.OwnerReference
+		want string
+	}{
+		{name: "deployment", refs: controlledBy("ReplicaSet", "web-7d"), want: "ReplicaSet/web-7d (2/3 ready) → Deployment/web (2/3 ready)"},
+		{name: "cronjob", refs: controlledBy("Job", "backup-28"), want: "Job/backup-28 (1/1 succeeded) → CronJob/backup (0 active)"},
+		{name: "orphan", want: "<none>"},
+		{name: "deleted owner", refs: controlledBy("ReplicaSet", "web-old"), want: "ReplicaSet/web-old (not found)"},
+		{name: "unknown kind", refs: controlledBy("Node", "worker-1"), want: "Node/worker-1"},
+	}
+
+	cs := newOwnersServer(t, objects)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			chain, err := OwnerChain(t.Context(), cs, "prod", tc.refs)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, FormatOwnerChain(chain))
+		})
+	}
+}
+
+func TestControllerRef(t *testing.T) {
+	controller := true
+	refs := []metav1.OwnerReference{{Kind: "ConfigMap", Name: "a"}, {Kind: "ReplicaSet", Name: "b", Controller: &controller}}
+	assert.Equal(t, "b", controllerRef(refs).Name)
+	assert.Equal(t, "a", controllerRef(refs[:1]).Name)
+	assert.Nil(t, controllerRef(nil))
+}
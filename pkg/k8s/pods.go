@@ -0,0 +1,98 @@
+package k8s
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// PodStatus returns the status of a pod the way kubectl prints it. Unlike the
+// pod phase, it surfaces container waiting and termination reasons such as
+// CrashLoopBackOff, ImagePullBackOff or Init:ErrImagePull.
+func PodStatus(pod *corev1.Pod) string {
+	reason := string(pod.Status.Phase)
+	if pod.Status.Reason != "" {
+		reason = pod.Status.Reason
+	}
+
+	initializing := false
+	for i, container := range pod.Status.InitContainerStatuses {
+		state := container.State
+		if state.Terminated != nil && state.Terminated.ExitCode == 0 {
+			continue
+		}
+
+		initializing = true
+		switch {
+		case state.Terminated != nil:
+			reason = "Init:" + terminatedReason(state.Terminated)
+		case state.Waiting != nil && state.Waiting.Reason != "" && state.Waiting.Reason != "PodInitializing":
+			reason = "Init:" + state.Waiting.Reason
+		default:
+			reason = fmt.Sprintf("Init:%d/%d", i, len(pod.Spec.InitContainers))
+		}
+		break
+	}
+
+	if !initializing {
+		hasRunning := false
+		for i := len(pod.Status.ContainerStatuses) - 1; i >= 0; i-- {
+			state := pod.Status.ContainerStatuses[i].State
+			switch {
+			case state.Waiting != nil && state.Waiting.Reason != "":
+				reason = state.Waiting.Reason
+			case state.Terminated != nil:
+				reason = terminatedReason(state.Terminated)
+			case state.Running != nil && pod.Status.ContainerStatuses[i].Ready:
+				hasRunning = true
+			}
+		}
+
+		// A completed container next to running ones means the pod is still up
+		if reason == "Completed" && hasRunning {
+			reason = string(corev1.PodRunning)
+		}
+	}
+
+	if pod.DeletionTimestamp != nil {
+		if pod.Status.Reason == "NodeLost" {
+			return "Unknown"
+		}
+		return "Terminating"
+	}
+
+	return reason
+}
+
+// failingPodReasons are the statuses of a pod that will not recover on its own
+var failingPodReasons = map[string]bool{
+	"Failed":                     true,
+	"Error":                      true,
+	"CrashLoopBackOff":           true,
+	"ImagePullBackOff":           true,
+	"ErrImagePull":               true,
+	"InvalidImageName":           true,
+	"CreateContainerConfigError": true,
+	"OOMKilled":                  true,
+}
+
+// PodFailing reports whether a pod is in a failed or crash looping state,
+// including failures of its init containers
+func PodFailing(pod *corev1.Pod) bool {
+	status := strings.TrimPrefix(PodStatus(pod), "Init:")
+	return failingPodReasons[status] ||
+		strings.HasPrefix(status, "ExitCode:") ||
+		strings.HasPrefix(status, "Signal:")
+}
+
+func terminatedReason(state *corev1.ContainerStateTerminated) string {
+	switch {
+	case state.Reason != "":
+		return state.Reason
+	case state.Signal != 0:
+		return fmt.Sprintf("Signal:%d", state.Signal)
+	default:
+		return fmt.Sprintf("ExitCode:%d", state.ExitCode)
+	}
+}
@@ -0,0 +1,186 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPodStatus(t *testing.T) {
+	waiting := func(reason string) corev1.ContainerState {
+		return corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: reason}}
+	}
+	terminated := func(reason string, exitCode int32) corev1.ContainerState {
+		return corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: reason, ExitCode: exitCode}}
+	}
+	running := corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}
+	now := metav1.Now()
+
+	testCases := []struct {
+		name     string
+		pod      corev1.Pod
+		expected string
+	}{
+		{
+			name: "running",
+			pod: corev1.Pod{Status: corev1.PodStatus{
+				Phase:             corev1.PodRunning,
+				ContainerStatuses: []corev1.ContainerStatus{{State: running, Ready: true}},
+			}},
+			expected: "Running",
+		},
+		{
+			name: "crash loop while phase is running",
+			pod: corev1.Pod{Status: corev1.PodStatus{
+				Phase:             corev1.PodRunning,
+				ContainerStatuses: []corev1.ContainerStatus{{State: waiting("CrashLoopBackOff")}},
+			}},
+			expected: "CrashLoopBackOff",
+		},
+		{
+			name: "image pull backoff while phase is pending",
+			pod: corev1.Pod{Status: corev1.PodStatus{
+				Phase:             corev1.PodPending,
+				ContainerStatuses: []corev1.ContainerStatus{{State: waiting("ImagePullBackOff")}},
+			}},
+			expected: "ImagePullBackOff",
+		},
+		{
+			name: "failing init container",
+			pod: corev1.Pod{
+				Spec: corev1.PodSpec{InitContainers: []corev1.Container{{Name: "migrate"}}},
+				Status: corev1.PodStatus{
+					Phase:                 corev1.PodPending,
+					InitContainerStatuses: []corev1.ContainerStatus{{State: waiting("ErrImagePull")}},
+				},
+			},
+			expected: "Init:ErrImagePull",
+		},
+		{
+			name: "init container progress",
+			pod: corev1.Pod{
+				Spec: corev1.PodSpec{InitContainers: []corev1.Container{{Name: "a"}, {Name: "b"}}},
+				Status: corev1.PodStatus{
+					Phase: corev1.PodPending,
+					InitContainerStatuses: []corev1.ContainerStatus{
+						{State: terminated("Completed", 0)},
+						{State: running},
+					},
+				},
+			},
+			expected: "Init:1/2",
+		},
+		{
+			name: "terminated without reason",
+			pod: corev1.Pod{Status: corev1.PodStatus{
+				Phase:             corev1.PodFailed,
+				ContainerStatuses: []corev1.ContainerStatus{{State: terminated("", 137)}},
+			}},
+			expected: "ExitCode:137",
+		},
+		{
+			name: "completed sidecar next to running container",
+			pod: corev1.Pod{Status: corev1.PodStatus{
+				Phase: corev1.PodRunning,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{State: terminated("Completed", 0)},
+					{State: running, Ready: true},
+				},
+			}},
+			expected: "Running",
+		},
+		{
+			name: "evicted",
+			pod: corev1.Pod{Status: corev1.PodStatus{
+				Phase:  corev1.PodFailed,
+				Reason: "Evicted",
+			}},
+			expected: "Evicted",
+		},
+		{
+			name: "terminating",
+			pod: corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &now},
+				Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+			},
+			expected: "Terminating",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, PodStatus(&tc.pod))
+		})
+	}
+}
+
+func TestPodFailing(t *testing.T) {
+	waiting := func(reason string) corev1.ContainerState {
+		return corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: reason}}
+	}
+
+	testCases := []struct {
+		name     string
+		pod      corev1.Pod
+		expected bool
+	}{
+		{
+			name: "running",
+			pod: corev1.Pod{Status: corev1.PodStatus{
+				Phase:             corev1.PodRunning,
+				ContainerStatuses: []corev1.ContainerStatus{{State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}, Ready: true}},
+			}},
+			expected: false,
+		},
+		{
+			name:     "pending",
+			pod:      corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodPending}},
+			expected: false,
+		},
+		{
+			name:     "succeeded",
+			pod:      corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodSucceeded}},
+			expected: false,
+		},
+		{
+			name:     "failed",
+			pod:      corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodFailed}},
+			expected: true,
+		},
+		{
+			name: "crash loop",
+			pod: corev1.Pod{Status: corev1.PodStatus{
+				Phase:             corev1.PodRunning,
+				ContainerStatuses: []corev1.ContainerStatus{{State: waiting("CrashLoopBackOff")}},
+			}},
+			expected: true,
+		},
+		{
+			name: "init container image pull failure",
+			pod: corev1.Pod{
+				Spec: corev1.PodSpec{InitContainers: []corev1.Container{{Name: "migrate"}}},
+				Status: corev1.PodStatus{
+					Phase:                 corev1.PodPending,
+					InitContainerStatuses: []corev1.ContainerStatus{{State: waiting("ImagePullBackOff")}},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "container creating",
+			pod: corev1.Pod{Status: corev1.PodStatus{
+				Phase:             corev1.PodPending,
+				ContainerStatuses: []corev1.ContainerStatus{{State: waiting("ContainerCreating")}},
+			}},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, PodFailing(&tc.pod))
+		})
+	}
+}
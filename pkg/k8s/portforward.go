@@ -0,0 +1,324 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+)
+
+// ForwardFunc forwards to the named pod until the connection ends or ctx is
+// cancelled
+type ForwardFunc func(ctx context.Context, pod string) error
+
+// Forwarder returns a ForwardFunc that forwards ports, each given as
+// local:remote, through the API server. A local port of 0
+// is bound to a free port on the first connection and kept on reconnection,
+// so clients don't have to follow it around.
+func (c *Client) Forwarder(namespace string, ports []string, stdout, stderr io.Writer) ForwardFunc {
+	ports = append([]string(nil), ports...)
+	return func(ctx context.Context, pod string) error {
+		stopCh := make(chan struct{})
+		done := make(chan struct{})
+		defer close(done)
+		go func() {
+			select {
+			case <-ctx.Done():
+				close(stopCh)
+			case <-done:
+			}
+		}()
+
+		return c.forwardPorts(namespace, pod, ports, stopCh, stdout, stderr, func(bound []portforward.ForwardedPort) {
+			for i := range ports {
+				if i < len(bound) {
+					ports[i] = fmt.Sprintf("%d:%d", bound[i].Local, bound[i].Remote)
+				}
+			}
+		})
+	}
+}
+
+// PortForward forwards local ports to a pod through the API server, as
+// kubectl port-forward does, until stopCh is closed or the connection to the
+// pod is lost. The ports actually bound, such as for a local port of 0, are
+// written to stdout.
+func PortForward(namespace, pod string, ports []string, stopCh chan struct{}) error {
+	client, err := NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+	return client.forwardPorts(namespace, pod, ports, stopCh, os.Stdout, os.Stderr, nil)
+}
+
+// forwardPorts forwards local ports to a pod through the API server, as
+// kubectl port-forward does, until stopCh is closed or the connection to the
+// pod is lost. Each port is given as local:remote, or as :remote or 0:remote
+// to pick a free local port. Once listening, the ports actually bound are
+// written to out and passed to onReady.
+func (c *Client) forwardPorts(namespace, pod string, ports []string, stopCh chan struct{}, out, errOut io.Writer, onReady func([]portforward.ForwardedPort)) error {
+	transport, upgrader, err := spdy.RoundTripperFor(c.Config)
+	if err != nil {
+		return fmt.Errorf("failed to set up port forward to pod %s: %w", pod, err)
+	}
+
+	req := c.Clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(pod).
+		SubResource("portforward")
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, req.URL())
+
+	readyCh := make(chan struct{})
+	forwarder, err := portforward.New(dialer, ports, stopCh, readyCh, io.Discard, errOut)
+	if err != nil {
+		return fmt.Errorf("failed to set up port forward to pod %s: %w", pod, err)
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- forwarder.ForwardPorts()
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-readyCh:
+	}
+
+	bound, err := forwarder.GetPorts()
+	if err == nil {
+		for _, port := range bound {
+			fmt.Fprintf(out, "Forwarding from localhost:%d -> %d\n", port.Local, port.Remote)
+		}
+		if onReady != nil {
+			onReady(bound)
+		}
+	}
+
+	return <-errCh
+}
+
+// ReconnectOptions control how a dropped port forward is resumed
+type ReconnectOptions struct {
+	// Disabled returns the first error of the forward instead of reconnecting
+	Disabled bool
+	// MaxAttempts bounds the reconnections in a row; a forward that stays up
+	// for StableAfter starts the count again
+	MaxAttempts int
+	StableAfter time.Duration
+	// Delay is the pause before each reconnection
+	Delay time.Duration
+	// ReplacementTimeout is how long to wait for a replacement pod from the
+	// same controller once the pod is gone. Zero gives up right away.
+	ReplacementTimeout time.Duration
+	// PollInterval is how often to look for a replacement pod
+	PollInterval time.Duration
+	// Notify is told about each disconnect and reconnection
+	Notify func(message string)
+}
+
+// DefaultReconnectOptions are the options port forwards use unless told
+// otherwise
+func DefaultReconnectOptions() ReconnectOptions {
+	return ReconnectOptions{
+		MaxAttempts:        5,
+		StableAfter:        30 * time.Second,
+		Delay:              time.Second,
+		ReplacementTimeout: 2 * time.Minute,
+		PollInterval:       2 * time.Second,
+	}
+}
+
+// ForwardWithReconnect keeps a port forward to a pod alive. When the forward
+// drops, it is re-established to the same pod while the pod exists, or to a
+// running pod of the same controller once the pod is gone, such as the new
+// pod of a deployment after a rollout. It returns nil once ctx is cancelled.
+func (c *Client) ForwardWithReconnect(ctx context.Context, namespace, podName string, opts ReconnectOptions, forward ForwardFunc) error {
+	pod, err := c.Clientset.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get pod %s: %w", podName, err)
+	}
+
+	notify := opts.Notify
+	if notify == nil {
+		notify = func(string) {}
+	}
+
+	attempts := 0
+	for {
+		started := time.Now()
+		err := forward(ctx, pod.Name)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if opts.Disabled {
+			return err
+		}
+
+		if time.Since(started) >= opts.StableAfter {
+			attempts = 0
+		}
+		attempts++
+		if attempts > opts.MaxAttempts {
+			return fmt.Errorf("gave up after %d reconnection attempts: %w", opts.MaxAttempts, err)
+		}
+
+		reason := "connection closed"
+		if err != nil {
+			reason = err.Error()
+		}
+		notify(fmt.Sprintf("Port forward to pod %s dropped (%s); reconnecting, attempt %d of %d", pod.Name, reason, attempts, opts.MaxAttempts))
+
+		if !sleepContext(ctx, opts.Delay) {
+			return nil
+		}
+
+		next, err := c.resumeTarget(ctx, pod, opts, notify)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if next.Name != pod.Name {
+			notify(fmt.Sprintf("Reconnected to replacement pod %s", next.Name))
+		} else {
+			notify(fmt.Sprintf("Reconnected to pod %s", next.Name))
+		}
+		pod = next
+	}
+}
+
+// resumeTarget returns the pod to forward to after a disconnect: the same
+// pod while it is still running, or else a replacement from its controller
+func (c *Client) resumeTarget(ctx context.Context, pod *corev1.Pod, opts ReconnectOptions, notify func(string)) (*corev1.Pod, error) {
+	current, err := c.Clientset.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get pod %s: %w", pod.Name, err)
+	}
+	if err == nil && forwardable(current) {
+		return current, nil
+	}
+
+	if controllerRef(pod.OwnerReferences) == nil {
+		return nil, fmt.Errorf("pod %s is gone and has no controller to replace it", pod.Name)
+	}
+	if opts.ReplacementTimeout <= 0 {
+		return nil, fmt.Errorf("pod %s is gone", pod.Name)
+	}
+
+	notify(fmt.Sprintf("Pod %s is gone; waiting up to %s for a replacement", pod.Name, opts.ReplacementTimeout))
+	deadline := time.Now().Add(opts.ReplacementTimeout)
+	for {
+		replacement, err := ReplacementPod(ctx, c.Clientset, pod)
+		if err != nil {
+			return nil, err
+		}
+		if replacement != nil {
+			return replacement, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("no replacement for pod %s started within %s", pod.Name, opts.ReplacementTimeout)
+		}
+		if !sleepContext(ctx, opts.PollInterval) {
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// ReplacementPod finds a running pod that took over from pod: one with the
+// same controller or, for a pod of a deployment, one from any of the
+// deployment's replica sets. The newest is returned, or nil when none is
+// running yet.
+func ReplacementPod(ctx context.Context, client kubernetes.Interface, pod *corev1.Pod) (*corev1.Pod, error) {
+	ref := controllerRef(pod.OwnerReferences)
+	if ref == nil {
+		return nil, nil
+	}
+
+	owners := map[string]bool{ref.Kind + "/" + ref.Name: true}
+	if ref.Kind == "ReplicaSet" {
+		if deployment, err := replicaSetDeployment(ctx, client, pod.Namespace, ref.Name); err != nil {
+			return nil, err
+		} else if deployment != "" {
+			replicaSets, err := client.AppsV1().ReplicaSets(pod.Namespace).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list replica sets: %w", err)
+			}
+			for _, rs := range replicaSets.Items {
+				if owner := controllerRef(rs.OwnerReferences); owner != nil && owner.Kind == "Deployment" && owner.Name == deployment {
+					owners["ReplicaSet/"+rs.Name] = true
+				}
+			}
+		}
+	}
+
+	pods, err := client.CoreV1().Pods(pod.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	var candidates []*corev1.Pod
+	for i := range pods.Items {
+		candidate := &pods.Items[i]
+		if candidate.UID == pod.UID || !forwardable(candidate) {
+			continue
+		}
+		if owner := controllerRef(candidate.OwnerReferences); owner != nil && owners[owner.Kind+"/"+owner.Name] {
+			candidates = append(candidates, candidate)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[j].CreationTimestamp.Before(&candidates[i].CreationTimestamp)
+	})
+	return candidates[0], nil
+}
+
+// replicaSetDeployment returns the deployment controlling a replica set, or
+// "" when there is none. A replica set that is already gone has none.
+func replicaSetDeployment(ctx context.Context, client kubernetes.Interface, namespace, name string) (string, error) {
+	rs, err := client.AppsV1().ReplicaSets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get replica set %s: %w", name, err)
+	}
+	if owner := controllerRef(rs.OwnerReferences); owner != nil && owner.Kind == "Deployment" {
+		return owner.Name, nil
+	}
+	return "", nil
+}
+
+// forwardable reports whether a pod can take a port forward: running and
+// not being deleted
+func forwardable(pod *corev1.Pod) bool {
+	return pod.DeletionTimestamp == nil && pod.Status.Phase == corev1.PodRunning
+}
+
+// sleepContext waits for d, returning false if ctx is cancelled first
+func sleepContext(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
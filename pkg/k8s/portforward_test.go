@@ -0,0 +1,255 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// podServer serves pods and replica sets of the prod namespace, which tests
+// change while a forward is running
+type podServer struct {
+	mu          sync.Mutex
+	pods        map[string]*corev1.Pod
+	replicaSets map[string]*appsv1.ReplicaSet
+}
+
+func newPodServer(t *testing.T, pods ...*corev1.Pod) (*podServer, *Client) {
+	t.Helper()
+
+	s := &podServer{pods: map[string]*corev1.Pod{}, replicaSets: map[string]*appsv1.ReplicaSet{}}
+	for _, pod := range pods {
+		s.pods[pod.Name] = pod
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		const podsPath, replicaSetsPath = "/api/v1/namespaces/prod/pods", "/apis/apps/v1/namespaces/prod/replicasets"
+		switch {
+		case r.URL.Path == podsPath:
+			list := &corev1.PodList{}
+			for _, pod := range s.pods {
+				list.Items = append(list.Items, *pod)
+			}
+			json.NewEncoder(w).Encode(list)
+			return
+		case r.URL.Path == replicaSetsPath:
+			list := &appsv1.ReplicaSetList{}
+			for _, rs := range s.replicaSets {
+				list.Items = append(list.Items, *rs)
+			}
+			json.NewEncoder(w).Encode(list)
+			return
+		case len(r.URL.Path) > len(podsPath) && s.pods[r.URL.Path[len(podsPath)+1:]] != nil:
+			json.NewEncoder(w).Encode(s.pods[r.URL.Path[len(podsPath)+1:]])
+			return
+		case len(r.URL.Path) > len(replicaSetsPath) && s.replicaSets[r.URL.Path[len(replicaSetsPath)+1:]] != nil:
+			json.NewEncoder(w).Encode(s.replicaSets[r.URL.Path[len(replicaSetsPath)+1:]])
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(&metav1.Status{Status: metav1.StatusFailure, Reason: metav1.StatusReasonNotFound, Code: http.StatusNotFound})
+	}))
+	t.Cleanup(server.Close)
+
+	cs, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+	return s, &Client{Clientset: cs}
+}
+
+func (s *podServer) update(change func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	change()
+}
+
+func runningPod(name, uid string, created time.Time, owners []metav1.OwnerReference) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "prod",
+			UID:               types.UID(uid),
+			CreationTimestamp: metav1.NewTime(created),
+			OwnerReferences:   owners,
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+}
+
+func fastReconnect() ReconnectOptions {
+	return ReconnectOptions{
+		MaxAttempts:        3,
+		StableAfter:        time.Hour,
+		Delay:              time.Millisecond,
+		ReplacementTimeout: time.Second,
+		PollInterval:       time.Millisecond,
+	}
+}
+
+func TestForwardWithReconnectSamePod(t *testing.T) {
+	_, client := newPodServer(t, runningPod("web-0", "1", time.Now(), nil))
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	var forwarded []string
+	var notices []string
+	opts := fastReconnect()
+	opts.Notify = func(message string) { notices = append(notices, message) }
+
+	err := client.ForwardWithReconnect(ctx, "prod", "web-0", opts, func(ctx context.Context, pod string) error {
+		forwarded = append(forwarded, pod)
+		if len(forwarded) == 2 {
+			cancel()
+			return nil
+		}
+		return errors.New("lost connection to pod")
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"web-0", "web-0"}, forwarded)
+	assert.Equal(t, []string{
+		"Port forward to pod web-0 dropped (lost connection to pod); reconnecting, attempt 1 of 3",
+		"Reconnected to pod web-0",
+	}, notices)
+}
+
+func TestForwardWithReconnectFollowsRollout(t *testing.T) {
+	old := runningPod("web-7d-abc", "1", time.Now().Add(-time.Hour), controlledBy("ReplicaSet", "web-7d"))
+	server, client := newPodServer(t, old)
+	server.replicaSets["web-7d"] = &appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{Name: "web-7d", OwnerReferences: controlledBy("Deployment", "web")}}
+	server.replicaSets["web-9f"] = &appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{Name: "web-9f", OwnerReferences: controlledBy("Deployment", "web")}}
+	server.replicaSets["api-1c"] = &appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{Name: "api-1c", OwnerReferences: controlledBy("Deployment", "api")}}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	var forwarded []string
+	err := client.ForwardWithReconnect(ctx, "prod", old.Name, fastReconnect(), func(ctx context.Context, pod string) error {
+		forwarded = append(forwarded, pod)
+		if len(forwarded) == 2 {
+			cancel()
+			return nil
+		}
+		// The rollout replaces the pod with one from the new replica set
+		server.update(func() {
+			delete(server.pods, old.Name)
+			server.pods["api-1c-xyz"] = runningPod("api-1c-xyz", "2", time.Now(), controlledBy("ReplicaSet", "api-1c"))
+			server.pods["web-9f-def"] = runningPod("web-9f-def", "3", time.Now(), controlledBy("ReplicaSet", "web-9f"))
+		})
+		return errors.New("pod deleted")
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"web-7d-abc", "web-9f-def"}, forwarded)
+}
+
+func TestForwardWithReconnectLimits(t *testing.T) {
+	t.Run("no reconnect", func(t *testing.T) {
+		_, client := newPodServer(t, runningPod("web-0", "1", time.Now(), nil))
+		opts := fastReconnect()
+		opts.Disabled = true
+
+		calls := 0
+		err := client.ForwardWithReconnect(t.Context(), "prod", "web-0", opts, func(ctx context.Context, pod string) error {
+			calls++
+			return errors.New("lost connection to pod")
+		})
+
+		assert.EqualError(t, err, "lost connection to pod")
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("bounded attempts", func(t *testing.T) {
+		_, client := newPodServer(t, runningPod("web-0", "1", time.Now(), nil))
+
+		calls := 0
+		err := client.ForwardWithReconnect(t.Context(), "prod", "web-0", fastReconnect(), func(ctx context.Context, pod string) error {
+			calls++
+			return errors.New("connection refused")
+		})
+
+		assert.EqualError(t, err, "gave up after 3 reconnection attempts: connection refused")
+		assert.Equal(t, 4, calls)
+	})
+
+	t.Run("pod gone without controller", func(t *testing.T) {
+		server, client := newPodServer(t, runningPod("web-0", "1", time.Now(), nil))
+
+		err := client.ForwardWithReconnect(t.Context(), "prod", "web-0", fastReconnect(), func(ctx context.Context, pod string) error {
+			server.update(func() { delete(server.pods, "web-0") })
+			return errors.New("pod deleted")
+		})
+
+		assert.EqualError(t, err, "pod web-0 is gone and has no controller to replace it")
+	})
+
+	t.Run("no replacement in time", func(t *testing.T) {
+		server, client := newPodServer(t, runningPod("db-0", "1", time.Now(), controlledBy("StatefulSet", "db")))
+		opts := fastReconnect()
+		opts.ReplacementTimeout = 10 * time.Millisecond
+
+		err := client.ForwardWithReconnect(t.Context(), "prod", "db-0", opts, func(ctx context.Context, pod string) error {
+			server.update(func() { delete(server.pods, "db-0") })
+			return errors.New("pod deleted")
+		})
+
+		assert.EqualError(t, err, "no replacement for pod db-0 started within 10ms")
+	})
+}
+
+func TestReplacementPodStatefulSet(t *testing.T) {
+	old := runningPod("db-0", "1", time.Now().Add(-time.Hour), controlledBy("StatefulSet", "db"))
+	recreated := runningPod("db-0", "2", time.Now(), controlledBy("StatefulSet", "db"))
+	starting := runningPod("db-1", "3", time.Now(), controlledBy("StatefulSet", "db"))
+	starting.Status.Phase = corev1.PodPending
+	_, client := newPodServer(t, recreated, starting)
+
+	replacement, err := ReplacementPod(t.Context(), client.Clientset, old)
+	require.NoError(t, err)
+	require.NotNil(t, replacement)
+	assert.Equal(t, "2", string(replacement.UID))
+}
+
+func TestPortForwardErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/namespaces/prod/pods/web-0/portforward", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(&metav1.Status{Status: metav1.StatusFailure, Reason: metav1.StatusReasonForbidden, Code: http.StatusForbidden})
+	}))
+	t.Cleanup(server.Close)
+
+	config := &rest.Config{Host: server.URL}
+	cs, err := kubernetes.NewForConfig(config)
+	require.NoError(t, err)
+	client := &Client{Clientset: cs, Config: config}
+
+	ctx := context.Background()
+
+	err = client.Forwarder("prod", []string{"8080:0"}, io.Discard, io.Discard)(ctx, "web-0")
+	assert.ErrorContains(t, err, "remote port must be > 0")
+
+	// The forward fails when the API server refuses the upgrade instead of
+	// waiting for ctx to be cancelled
+	err = client.Forwarder("prod", []string{"0:80"}, io.Discard, io.Discard)(ctx, "web-0")
+	assert.Error(t, err)
+}
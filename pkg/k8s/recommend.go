@@ -0,0 +1,170 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// requestHeadroomPercent is how far above peak usage a recommended
+	// request is set
+	requestHeadroomPercent = 120
+	// underusedRequestPercent is the share of a request below which the
+	// request is considered too generous
+	underusedRequestPercent = 50
+)
+
+// RequestRecommendation compares the CPU or memory request of a container
+// of a deployment with what its pods use
+type RequestRecommendation struct {
+	Container string
+	Resource  corev1.ResourceName
+	Request   *resource.Quantity // nil when not set
+	Average   resource.Quantity
+	Peak      resource.Quantity
+	Suggested *resource.Quantity // nil when the request fits the usage
+	Advice    string
+}
+
+// RecommendDeploymentRequests samples the current usage of the running pods
+// of a deployment from the metrics API and recommends request adjustments
+// for its containers. It returns the recommendations and the number of pods
+// sampled. Nothing is changed in the cluster.
+func (c *Client) RecommendDeploymentRequests(ctx context.Context, namespace, name string) ([]RequestRecommendation, int, error) {
+	deployment, err := c.Clientset.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get deployment %s: %w", name, err)
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid selector on deployment %s: %w", name, err)
+	}
+
+	pods, err := c.Clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list pods of deployment %s: %w", name, err)
+	}
+
+	var samples [][]ContainerUsage
+	var lastErr error
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase != corev1.PodRunning || pod.DeletionTimestamp != nil {
+			continue
+		}
+		usage, err := c.GetPodUsage(ctx, pod)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		samples = append(samples, usage)
+	}
+
+	if len(samples) == 0 {
+		if lastErr != nil {
+			return nil, 0, fmt.Errorf("no metrics available for the pods of deployment %s (is metrics-server installed?): %w", name, lastErr)
+		}
+		return nil, 0, fmt.Errorf("deployment %s has no running pods to sample", name)
+	}
+	return RecommendRequests(deployment.Spec.Template.Spec, samples), len(samples), nil
+}
+
+// RecommendRequests averages the usage of each container of a pod template
+// across samples, one per pod, and compares the busiest pod with the CPU and
+// memory requests. A request below the peak should be raised, one more than
+// twice the peak can be lowered, and a missing one should be set; the
+// suggested value leaves 20% headroom above the peak.
+func RecommendRequests(spec corev1.PodSpec, samples [][]ContainerUsage) []RequestRecommendation {
+	var recommendations []RequestRecommendation
+	for _, container := range spec.Containers {
+		for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+			var total, peak int64
+			var count int64
+			for _, sample := range samples {
+				for _, usage := range sample {
+					if usage.Name != container.Name {
+						continue
+					}
+					value := usageValue(usage, name)
+					total += value
+					peak = max(peak, value)
+					count++
+				}
+			}
+
+			recommendation := RequestRecommendation{Container: container.Name, Resource: name}
+			if request, ok := container.Resources.Requests[name]; ok {
+				recommendation.Request = &request
+			}
+			if count > 0 {
+				recommendation.Average = usageQuantity(name, total/count)
+				recommendation.Peak = usageQuantity(name, peak)
+			}
+			recommend(&recommendation, peak)
+			recommendations = append(recommendations, recommendation)
+		}
+	}
+	return recommendations
+}
+
+// recommend fills in the suggested request and advice from the peak usage,
+// in millicores for CPU and bytes for memory
+func recommend(r *RequestRecommendation, peak int64) {
+	label := "memory"
+	if r.Resource == corev1.ResourceCPU {
+		label = "CPU"
+	}
+
+	if peak == 0 {
+		r.Advice = "no usage measured"
+		return
+	}
+
+	suggested := usageQuantity(r.Resource, peak*requestHeadroomPercent/100)
+	switch {
+	case r.Request == nil:
+		r.Suggested = &suggested
+		r.Advice = fmt.Sprintf("no %s request but peak usage %s — consider requesting %s", label, r.Peak.String(), suggested.String())
+	case r.Peak.Cmp(*r.Request) > 0:
+		r.Suggested = &suggested
+		r.Advice = fmt.Sprintf("%s request %s but peak usage %s — consider raising to %s", label, r.Request.String(), r.Peak.String(), suggested.String())
+	case requestValue(*r.Request, r.Resource)*underusedRequestPercent/100 > peak:
+		r.Suggested = &suggested
+		r.Advice = fmt.Sprintf("%s request %s but peak usage %s — consider lowering to %s", label, r.Request.String(), r.Peak.String(), suggested.String())
+	default:
+		r.Advice = "request fits usage"
+	}
+}
+
+// usageValue returns the usage of a resource by a container in millicores
+// for CPU and bytes for memory
+func usageValue(usage ContainerUsage, name corev1.ResourceName) int64 {
+	if name == corev1.ResourceCPU {
+		return usage.CPU.Usage.MilliValue()
+	}
+	return usage.Memory.Usage.Value()
+}
+
+// requestValue returns a request in the units of usageValue
+func requestValue(q resource.Quantity, name corev1.ResourceName) int64 {
+	if name == corev1.ResourceCPU {
+		return q.MilliValue()
+	}
+	return q.Value()
+}
+
+// usageQuantity turns millicores or bytes into a quantity, rounding CPU up
+// to the millicore and memory up to the mebibyte
+func usageQuantity(name corev1.ResourceName, value int64) resource.Quantity {
+	if name == corev1.ResourceCPU {
+		return *resource.NewMilliQuantity(value, resource.DecimalSI)
+	}
+	const mebibyte = 1024 * 1024
+	mebibytes := (value + mebibyte - 1) / mebibyte
+	return *resource.NewQuantity(mebibytes*mebibyte, resource.BinarySI)
+}
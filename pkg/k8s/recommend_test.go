@@ -0,0 +1,129 @@
+package k8s
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestRecommendRequests(t *testing.T) {
+	spec := corev1.PodSpec{Containers: []corev1.Container{
+		{
+			Name: "app",
+			Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("1"),
+				corev1.ResourceMemory: resource.MustParse("128Mi"),
+			}},
+		},
+		{Name: "worker"},
+		{Name: "idle", Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+			corev1.ResourceCPU: resource.MustParse("100m"),
+		}}},
+	}}
+	sample := func(appCPU, appMemory, workerCPU string) []ContainerUsage {
+		return []ContainerUsage{
+			{Name: "app", CPU: ResourceUsage{Usage: resource.MustParse(appCPU)}, Memory: ResourceUsage{Usage: resource.MustParse(appMemory)}},
+			{Name: "worker", CPU: ResourceUsage{Usage: resource.MustParse(workerCPU)}},
+			{Name: "idle", CPU: ResourceUsage{Usage: resource.MustParse("80m")}},
+		}
+	}
+
+	recommendations := RecommendRequests(spec, [][]ContainerUsage{
+		sample("100m", "300Mi", "40m"),
+		sample("300m", "400Mi", "60m"),
+	})
+	require.Len(t, recommendations, 6)
+
+	appCPU := recommendations[0]
+	assert.Equal(t, corev1.ResourceCPU, appCPU.Resource)
+	assert.Equal(t, "200m", appCPU.Average.String())
+	assert.Equal(t, "300m", appCPU.Peak.String())
+	assert.Equal(t, "360m", appCPU.Suggested.String())
+	assert.Equal(t, "CPU request 1 but peak usage 300m — consider lowering to 360m", appCPU.Advice)
+
+	appMemory := recommendations[1]
+	assert.Equal(t, "350Mi", appMemory.Average.String())
+	assert.Equal(t, "480Mi", appMemory.Suggested.String())
+	assert.Equal(t, "memory request 128Mi but peak usage 400Mi — consider raising to 480Mi", appMemory.Advice)
+
+	workerCPU := recommendations[2]
+	assert.Nil(t, workerCPU.Request)
+	assert.Equal(t, "no CPU request but peak usage 60m — consider requesting 72m", workerCPU.Advice)
+
+	workerMemory := recommendations[3]
+	assert.Nil(t, workerMemory.Suggested)
+	assert.Equal(t, "no usage measured", workerMemory.Advice)
+
+	idleCPU := recommendations[4]
+	assert.Nil(t, idleCPU.Suggested)
+	assert.Equal(t, "request fits usage", idleCPU.Advice)
+}
+
+func TestRecommendDeploymentRequests(t *testing.T) {
+	replicas := int32(2)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "prod"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+			Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{
+				Name: "app",
+				Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("128Mi"),
+				}},
+			}}}},
+		},
+	}
+	pod := func(name string, phase corev1.PodPhase) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "prod"},
+			Spec:       deployment.Spec.Template.Spec,
+			Status:     corev1.PodStatus{Phase: phase},
+		}
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/apis/apps/v1/namespaces/prod/deployments/web":
+			json.NewEncoder(w).Encode(deployment)
+		case "/api/v1/namespaces/prod/pods":
+			assert.Equal(t, "app=web", r.URL.Query().Get("labelSelector"))
+			json.NewEncoder(w).Encode(&corev1.PodList{Items: []corev1.Pod{
+				pod("web-1", corev1.PodRunning),
+				pod("web-2", corev1.PodRunning),
+				pod("web-3", corev1.PodPending),
+			}})
+		case "/apis/metrics.k8s.io/v1beta1/namespaces/prod/pods/web-1":
+			w.Write([]byte(`{"containers":[{"name":"app","usage":{"cpu":"10m","memory":"100Mi"}}]}`))
+		case "/apis/metrics.k8s.io/v1beta1/namespaces/prod/pods/web-2":
+			w.Write([]byte(`{"containers":[{"name":"app","usage":{"cpu":"30m","memory":"400Mi"}}]}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	cs, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+	client := &Client{Clientset: cs}
+
+	recommendations, pods, err := client.RecommendDeploymentRequests(t.Context(), "prod", "web")
+	require.NoError(t, err)
+	assert.Equal(t, 2, pods)
+	require.Len(t, recommendations, 2)
+	assert.Equal(t, "20m", recommendations[0].Average.String())
+	assert.Equal(t, "250Mi", recommendations[1].Average.String())
+	assert.Equal(t, "memory request 128Mi but peak usage 400Mi — consider raising to 480Mi", recommendations[1].Advice)
+}
@@ -0,0 +1,11 @@
+package k8s
+
+import "k8s.io/apimachinery/pkg/runtime/schema"
+
+// Resources commonly addressed through the dynamic client
+var (
+	PodsResource        = schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+	SecretsResource     = schema.GroupVersionResource{Version: "v1", Resource: "secrets"}
+	ConfigMapsResource  = schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	DeploymentsResource = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+)
@@ -0,0 +1,185 @@
+package k8s
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// FlapWindow is how recent a container's last restart must be for it to be
+// considered flapping
+const FlapWindow = time.Hour
+
+// ContainerRestarts summarises the restarts of one container of a pod from
+// its status. The kubelet only keeps the last termination, so the reason and
+// exit code describe the most recent restart.
+type ContainerRestarts struct {
+	Namespace string
+	Pod       string
+	Container string
+	Init      bool
+	Restarts  int32
+	// LastRestart is when the previous instance of the container stopped;
+	// zero if it never restarted
+	LastRestart  time.Time
+	LastReason   string
+	LastExitCode int32
+	// State is the current state, e.g. Running or CrashLoopBackOff
+	State        string
+	CrashLooping bool
+}
+
+// Flapping reports whether the container is crash looping or restarted
+// within FlapWindow of now
+func (r ContainerRestarts) Flapping(now time.Time) bool {
+	if r.CrashLooping {
+		return true
+	}
+	return r.Restarts > 0 && !r.LastRestart.IsZero() && now.Sub(r.LastRestart) < FlapWindow
+}
+
+// PodRestarts returns the restart history of every container of a pod, init
+// containers first
+func PodRestarts(pod *corev1.Pod) []ContainerRestarts {
+	restarts := make([]ContainerRestarts, 0, len(pod.Status.InitContainerStatuses)+len(pod.Status.ContainerStatuses))
+	for _, status := range pod.Status.InitContainerStatuses {
+		restarts = append(restarts, newContainerRestarts(pod, status, true))
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		restarts = append(restarts, newContainerRestarts(pod, status, false))
+	}
+	return restarts
+}
+
+func newContainerRestarts(pod *corev1.Pod, status corev1.ContainerStatus, init bool) ContainerRestarts {
+	r := ContainerRestarts{
+		Namespace: pod.Namespace,
+		Pod:       pod.Name,
+		Container: status.Name,
+		Init:      init,
+		Restarts:  status.RestartCount,
+		State:     containerStateName(status.State),
+	}
+	if status.State.Waiting != nil && status.State.Waiting.Reason == "CrashLoopBackOff" {
+		r.CrashLooping = true
+	}
+	if last := status.LastTerminationState.Terminated; last != nil {
+		r.LastRestart = last.FinishedAt.Time
+		r.LastReason = terminatedReason(last)
+		r.LastExitCode = last.ExitCode
+	}
+	return r
+}
+
+// containerStateName names a container state the way kubectl does
+func containerStateName(state corev1.ContainerState) string {
+	switch {
+	case state.Waiting != nil && state.Waiting.Reason != "":
+		return state.Waiting.Reason
+	case state.Waiting != nil:
+		return "Waiting"
+	case state.Terminated != nil:
+		return terminatedReason(state.Terminated)
+	case state.Running != nil:
+		return "Running"
+	default:
+		return "Unknown"
+	}
+}
+
+// SortRestarts orders containers by their last restart, most recent first,
+// with containers that never restarted last
+func SortRestarts(restarts []ContainerRestarts) {
+	sort.SliceStable(restarts, func(i, j int) bool {
+		if !restarts[i].LastRestart.Equal(restarts[j].LastRestart) {
+			return restarts[i].LastRestart.After(restarts[j].LastRestart)
+		}
+		return restarts[i].Restarts > restarts[j].Restarts
+	})
+}
+
+// RestartEvent is one entry of a pod's restart timeline
+type RestartEvent struct {
+	Time      time.Time
+	Container string
+	What      string
+	Warning   bool
+}
+
+// restartEventReasons are the pod event reasons that belong on a restart
+// timeline
+var restartEventReasons = map[string]bool{
+	"BackOff":    true,
+	"Killing":    true,
+	"Unhealthy":  true,
+	"OOMKilling": true,
+	"Failed":     true,
+}
+
+// RestartTimeline combines the container states of a pod with its restart
+// related events into a timeline, oldest first
+func RestartTimeline(pod *corev1.Pod, events []corev1.Event) []RestartEvent {
+	var timeline []RestartEvent
+
+	statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, status := range statuses {
+		if last := status.LastTerminationState.Terminated; last != nil {
+			if !last.StartedAt.IsZero() {
+				timeline = append(timeline, RestartEvent{Time: last.StartedAt.Time, Container: status.Name, What: "Started"})
+			}
+			timeline = append(timeline, RestartEvent{
+				Time:      last.FinishedAt.Time,
+				Container: status.Name,
+				What:      fmt.Sprintf("Terminated: %s (exit code %d)", terminatedReason(last), last.ExitCode),
+				Warning:   last.ExitCode != 0,
+			})
+		}
+
+		switch state := status.State; {
+		case state.Running != nil:
+			timeline = append(timeline, RestartEvent{
+				Time:      state.Running.StartedAt.Time,
+				Container: status.Name,
+				What:      fmt.Sprintf("Started (restart %d)", status.RestartCount),
+			})
+		case state.Terminated != nil:
+			timeline = append(timeline, RestartEvent{
+				Time:      state.Terminated.FinishedAt.Time,
+				Container: status.Name,
+				What:      fmt.Sprintf("Terminated: %s (exit code %d)", terminatedReason(state.Terminated), state.Terminated.ExitCode),
+				Warning:   state.Terminated.ExitCode != 0,
+			})
+		}
+	}
+
+	for _, event := range events {
+		if !restartEventReasons[event.Reason] {
+			continue
+		}
+		timeline = append(timeline, RestartEvent{
+			Time:      EventTime(event),
+			Container: eventContainer(event),
+			What:      fmt.Sprintf("%s: %s (x%d)", event.Reason, event.Message, EventCount(event)),
+			Warning:   event.Type == corev1.EventTypeWarning,
+		})
+	}
+
+	sort.SliceStable(timeline, func(i, j int) bool {
+		return timeline[i].Time.Before(timeline[j].Time)
+	})
+	return timeline
+}
+
+// eventContainer returns the container an event is about, taken from the
+// field path such as spec.containers{web}
+func eventContainer(event corev1.Event) string {
+	path := event.InvolvedObject.FieldPath
+	start, end := strings.Index(path, "{"), strings.LastIndex(path, "}")
+	if start < 0 || end < start {
+		return ""
+	}
+	return path[start+1 : end]
+}
@@ -0,0 +1,95 @@
+package k8s
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPodRestarts(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "prod"},
+		Status: corev1.PodStatus{
+			InitContainerStatuses: []corev1.ContainerStatus{{
+				Name:  "migrate",
+				State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Completed"}},
+			}},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:         "app",
+					RestartCount: 7,
+					State:        corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+					LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+						ExitCode: 137, Reason: "OOMKilled", FinishedAt: metav1.NewTime(now.Add(-time.Minute)),
+					}},
+				},
+				{
+					Name:         "sidecar",
+					RestartCount: 1,
+					State:        corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+					LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+						ExitCode: 2, FinishedAt: metav1.NewTime(now.Add(-3 * time.Hour)),
+					}},
+				},
+			},
+		},
+	}
+
+	restarts := PodRestarts(pod)
+	require.Len(t, restarts, 3)
+
+	assert.Equal(t, ContainerRestarts{Namespace: "prod", Pod: "web-1", Container: "migrate", Init: true, State: "Completed"}, restarts[0])
+	assert.Equal(t, ContainerRestarts{
+		Namespace: "prod", Pod: "web-1", Container: "app", Restarts: 7,
+		LastRestart: now.Add(-time.Minute), LastReason: "OOMKilled", LastExitCode: 137,
+		State: "CrashLoopBackOff", CrashLooping: true,
+	}, restarts[1])
+	assert.Equal(t, "ExitCode:2", restarts[2].LastReason)
+
+	assert.False(t, restarts[0].Flapping(now))
+	assert.True(t, restarts[1].Flapping(now))
+	assert.False(t, restarts[2].Flapping(now), "the last restart is older than the flap window")
+	assert.True(t, restarts[2].Flapping(now.Add(-150*time.Minute)))
+
+	SortRestarts(restarts)
+	assert.Equal(t, []string{"app", "sidecar", "migrate"}, []string{restarts[0].Container, restarts[1].Container, restarts[2].Container})
+}
+
+func TestRestartTimeline(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	pod := &corev1.Pod{
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:         "app",
+				RestartCount: 3,
+				State:        corev1.ContainerState{Running: &corev1.ContainerStateRunning{StartedAt: metav1.NewTime(now.Add(-time.Minute))}},
+				LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+					ExitCode:   1,
+					Reason:     "Error",
+					StartedAt:  metav1.NewTime(now.Add(-10 * time.Minute)),
+					FinishedAt: metav1.NewTime(now.Add(-5 * time.Minute)),
+				}},
+			}},
+		},
+	}
+	events := []corev1.Event{
+		{
+			Type: corev1.EventTypeWarning, Reason: "BackOff", Message: "Back-off restarting failed container", Count: 4,
+			InvolvedObject: corev1.ObjectReference{FieldPath: "spec.containers{app}"},
+			LastTimestamp:  metav1.NewTime(now.Add(-3 * time.Minute)),
+		},
+		{Type: corev1.EventTypeNormal, Reason: "Scheduled", LastTimestamp: metav1.NewTime(now.Add(-time.Hour))},
+	}
+
+	timeline := RestartTimeline(pod, events)
+	require.Len(t, timeline, 4)
+	assert.Equal(t, RestartEvent{Time: now.Add(-10 * time.Minute), Container: "app", What: "Started"}, timeline[0])
+	assert.Equal(t, RestartEvent{Time: now.Add(-5 * time.Minute), Container: "app", What: "Terminated: Error (exit code 1)", Warning: true}, timeline[1])
+	assert.Equal(t, RestartEvent{Time: now.Add(-3 * time.Minute), Container: "app", What: "BackOff: Back-off restarting failed container (x4)", Warning: true}, timeline[2])
+	assert.Equal(t, RestartEvent{Time: now.Add(-time.Minute), Container: "app", What: "Started (restart 3)"}, timeline[3])
+}
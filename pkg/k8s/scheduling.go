@@ -0,0 +1,288 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Reasons a node cannot run a pod
+const (
+	NodeCordoned         = "cordoned"
+	NodeNotReady         = "not ready"
+	NodeSelectorMismatch = "nodeSelector mismatch"
+	NodeUntoleratedTaint = "untolerated taint"
+	NodeTooManyPods      = "too many pods"
+)
+
+// NodeFit records why a node cannot run a pod
+type NodeFit struct {
+	Name    string
+	Reasons []string
+}
+
+// Fits reports whether nothing keeps the pod off the node
+func (n NodeFit) Fits() bool {
+	return len(n.Reasons) == 0
+}
+
+// PendingAnalysis explains why a pod is not running yet
+type PendingAnalysis struct {
+	// SchedulerMessage is the latest reason the scheduler gave for not
+	// placing the pod
+	SchedulerMessage string
+	// Causes are the likely reasons the pod is pending, in plain words
+	Causes []string
+	// Nodes is empty once the pod has been scheduled
+	Nodes []NodeFit
+}
+
+// AnalyzePendingPod works out why a pending pod is not running from its
+// scheduling events, its persistent volume claims and what each node has
+// left to allocate
+func (c *Client) AnalyzePendingPod(ctx context.Context, pod *corev1.Pod) (*PendingAnalysis, error) {
+	events, err := c.GetEventsForObject(ctx, pod.Namespace, "Pod", pod.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := map[string]*corev1.PersistentVolumeClaim{}
+	for _, volume := range pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim == nil {
+			continue
+		}
+		name := volume.PersistentVolumeClaim.ClaimName
+		claim, err := c.Clientset.CoreV1().PersistentVolumeClaims(pod.Namespace).Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			claims[name] = nil
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get persistent volume claim %s: %w", name, err)
+		}
+		claims[name] = claim
+	}
+
+	// Where the pod is already placed, the nodes no longer matter
+	if pod.Spec.NodeName != "" {
+		return analyzePending(pod, events, claims, nil, nil), nil
+	}
+
+	nodes, err := c.Clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	// Pods that have finished no longer hold their requests
+	running, err := c.Clientset.CoreV1().Pods("").List(ctx, metav1.ListOptions{
+		FieldSelector: "spec.nodeName!=,status.phase!=Succeeded,status.phase!=Failed",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scheduled pods: %w", err)
+	}
+
+	return analyzePending(pod, events, claims, nodes.Items, running.Items), nil
+}
+
+// analyzePending explains a pending pod from data already fetched. Claims
+// maps each claim the pod mounts to the claim, or to nil when it does not
+// exist; scheduled are the pods already placed on the nodes.
+func analyzePending(pod *corev1.Pod, events []corev1.Event, claims map[string]*corev1.PersistentVolumeClaim, nodes []corev1.Node, scheduled []corev1.Pod) *PendingAnalysis {
+	analysis := &PendingAnalysis{SchedulerMessage: schedulerMessage(pod, events)}
+
+	names := make([]string, 0, len(claims))
+	for name := range claims {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		claim := claims[name]
+		switch {
+		case claim == nil:
+			analysis.Causes = append(analysis.Causes, fmt.Sprintf("PVC %s does not exist", name))
+		case claim.Status.Phase != corev1.ClaimBound:
+			analysis.Causes = append(analysis.Causes, fmt.Sprintf("PVC %s is unbound (%s)", name, claim.Status.Phase))
+		}
+	}
+
+	if pod.Spec.NodeName != "" {
+		analysis.Causes = append(analysis.Causes,
+			fmt.Sprintf("scheduled to %s, waiting for containers: %s", pod.Spec.NodeName, PodStatus(pod)))
+		return analysis
+	}
+
+	if len(nodes) == 0 {
+		analysis.Causes = append(analysis.Causes, "the cluster has no nodes")
+		return analysis
+	}
+
+	requested := map[string]corev1.ResourceList{}
+	podCount := map[string]int64{}
+	for i := range scheduled {
+		node := scheduled[i].Spec.NodeName
+		requested[node] = addResources(requested[node], PodRequests(&scheduled[i]))
+		podCount[node]++
+	}
+
+	requests := PodRequests(pod)
+	fits := 0
+	reasonCount := map[string]int{}
+	var reasonOrder []string
+	for i := range nodes {
+		node := &nodes[i]
+		fit := NodeFit{Name: node.Name, Reasons: nodeFitReasons(pod, requests, node, requested[node.Name], podCount[node.Name])}
+		analysis.Nodes = append(analysis.Nodes, fit)
+		if fit.Fits() {
+			fits++
+		}
+		for _, reason := range fit.Reasons {
+			if reasonCount[reason] == 0 {
+				reasonOrder = append(reasonOrder, reason)
+			}
+			reasonCount[reason]++
+		}
+	}
+
+	// While some node has room, the scheduler message says more than the
+	// nodes can
+	if fits > 0 {
+		return analysis
+	}
+
+	for _, reason := range reasonOrder {
+		count := reasonCount[reason]
+		if count == len(nodes) && reason == NodeSelectorMismatch {
+			analysis.Causes = append(analysis.Causes,
+				fmt.Sprintf("no node matches nodeSelector %s", formatSelector(pod.Spec.NodeSelector)))
+			continue
+		}
+		if count == len(nodes) {
+			analysis.Causes = append(analysis.Causes, fmt.Sprintf("%s on all nodes", reason))
+			continue
+		}
+		analysis.Causes = append(analysis.Causes, fmt.Sprintf("%s on %d of %d nodes", reason, count, len(nodes)))
+	}
+
+	return analysis
+}
+
+// PodRequests returns the resources a pod asks for: the sum of its
+// containers, or the largest init container where that is more, plus the
+// pod overhead
+func PodRequests(pod *corev1.Pod) corev1.ResourceList {
+	requests := corev1.ResourceList{}
+	for _, container := range pod.Spec.Containers {
+		requests = addResources(requests, container.Resources.Requests)
+	}
+	for _, container := range pod.Spec.InitContainers {
+		for name, quantity := range container.Resources.Requests {
+			if current, ok := requests[name]; !ok || quantity.Cmp(current) > 0 {
+				requests[name] = quantity.DeepCopy()
+			}
+		}
+	}
+	return addResources(requests, pod.Spec.Overhead)
+}
+
+// nodeFitReasons lists why a node cannot run the pod, given what the pods
+// already on it request
+func nodeFitReasons(pod *corev1.Pod, requests corev1.ResourceList, node *corev1.Node, requested corev1.ResourceList, pods int64) []string {
+	var reasons []string
+	if node.Spec.Unschedulable {
+		reasons = append(reasons, NodeCordoned)
+	}
+	if !nodeReady(node) {
+		reasons = append(reasons, NodeNotReady)
+	}
+	for key, value := range pod.Spec.NodeSelector {
+		if node.Labels[key] != value {
+			reasons = append(reasons, NodeSelectorMismatch)
+			break
+		}
+	}
+	for i := range node.Spec.Taints {
+		if !toleratesTaint(pod.Spec.Tolerations, &node.Spec.Taints[i]) {
+			reasons = append(reasons, NodeUntoleratedTaint)
+			break
+		}
+	}
+
+	if allocatable, ok := node.Status.Allocatable[corev1.ResourcePods]; ok && pods >= allocatable.Value() {
+		reasons = append(reasons, NodeTooManyPods)
+	}
+
+	names := make([]string, 0, len(requests))
+	for name := range requests {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		request := requests[corev1.ResourceName(name)]
+		if request.IsZero() {
+			continue
+		}
+		free := node.Status.Allocatable[corev1.ResourceName(name)].DeepCopy()
+		free.Sub(requested[corev1.ResourceName(name)])
+		if request.Cmp(free) > 0 {
+			reasons = append(reasons, "insufficient "+name)
+		}
+	}
+
+	return reasons
+}
+
+// toleratesTaint reports whether a taint that keeps pods off a node is
+// tolerated. PreferNoSchedule taints only steer the scheduler.
+func toleratesTaint(tolerations []corev1.Toleration, taint *corev1.Taint) bool {
+	if taint.Effect == corev1.TaintEffectPreferNoSchedule {
+		return true
+	}
+	for i := range tolerations {
+		if tolerations[i].ToleratesTaint(taint) {
+			return true
+		}
+	}
+	return false
+}
+
+// schedulerMessage returns the latest FailedScheduling warning, falling
+// back to the message on the pod's PodScheduled condition
+func schedulerMessage(pod *corev1.Pod, events []corev1.Event) string {
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].Type == corev1.EventTypeWarning && events[i].Reason == "FailedScheduling" {
+			return events[i].Message
+		}
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodScheduled && condition.Status == corev1.ConditionFalse {
+			return condition.Message
+		}
+	}
+	return ""
+}
+
+func addResources(total, add corev1.ResourceList) corev1.ResourceList {
+	if total == nil {
+		total = corev1.ResourceList{}
+	}
+	for name, quantity := range add {
+		sum := total[name].DeepCopy()
+		sum.Add(quantity)
+		total[name] = sum
+	}
+	return total
+}
+
+func formatSelector(selector map[string]string) string {
+	pairs := make([]string, 0, len(selector))
+	for key, value := range selector {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
@@ -0,0 +1,131 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func schedulingNode(name, cpu string, labels map[string]string, taints ...corev1.Taint) corev1.Node {
+	return corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		Spec:       corev1.NodeSpec{Taints: taints},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(cpu),
+				corev1.ResourceMemory: resource.MustParse("8Gi"),
+				corev1.ResourcePods:   resource.MustParse("110"),
+			},
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+		},
+	}
+}
+
+func pendingPod(cpu string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-0", Namespace: "prod"},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{
+			Name: "app",
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)},
+			},
+		}}},
+		Status: corev1.PodStatus{Phase: corev1.PodPending},
+	}
+}
+
+func TestAnalyzePendingInsufficientCPU(t *testing.T) {
+	pod := pendingPod("2")
+	nodes := []corev1.Node{
+		schedulingNode("node-1", "4", nil),
+		schedulingNode("node-2", "1", nil),
+	}
+	scheduled := []corev1.Pod{*pendingPod("3")}
+	scheduled[0].Spec.NodeName = "node-1"
+	events := []corev1.Event{
+		{Type: corev1.EventTypeWarning, Reason: "FailedScheduling", Message: "0/2 nodes are available: 1 Insufficient cpu."},
+		{Type: corev1.EventTypeWarning, Reason: "FailedScheduling", Message: "0/2 nodes are available: 2 Insufficient cpu."},
+	}
+
+	analysis := analyzePending(pod, events, nil, nodes, scheduled)
+
+	assert.Equal(t, "0/2 nodes are available: 2 Insufficient cpu.", analysis.SchedulerMessage)
+	assert.Equal(t, []string{"insufficient cpu on all nodes"}, analysis.Causes)
+	assert.Equal(t, []NodeFit{
+		{Name: "node-1", Reasons: []string{"insufficient cpu"}},
+		{Name: "node-2", Reasons: []string{"insufficient cpu"}},
+	}, analysis.Nodes)
+}
+
+func TestAnalyzePendingNodeSelectorAndTaints(t *testing.T) {
+	pod := pendingPod("100m")
+	pod.Spec.NodeSelector = map[string]string{"disktype": "ssd"}
+	pod.Spec.Tolerations = []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "web", Effect: corev1.TaintEffectNoSchedule}}
+	nodes := []corev1.Node{
+		schedulingNode("node-1", "4", map[string]string{"disktype": "hdd"}),
+		schedulingNode("node-2", "4", nil, corev1.Taint{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule}),
+		schedulingNode("node-3", "4", nil, corev1.Taint{Key: "dedicated", Value: "web", Effect: corev1.TaintEffectNoSchedule}),
+	}
+
+	analysis := analyzePending(pod, nil, nil, nodes, nil)
+
+	assert.Equal(t, []string{
+		"no node matches nodeSelector disktype=ssd",
+		"untolerated taint on 1 of 3 nodes",
+	}, analysis.Causes)
+}
+
+func TestAnalyzePendingSomeNodeFits(t *testing.T) {
+	pod := pendingPod("100m")
+	nodes := []corev1.Node{schedulingNode("node-1", "4", nil), schedulingNode("node-2", "4", nil)}
+	nodes[1].Spec.Unschedulable = true
+
+	analysis := analyzePending(pod, nil, nil, nodes, nil)
+
+	assert.Empty(t, analysis.Causes)
+	assert.True(t, analysis.Nodes[0].Fits())
+	assert.Equal(t, []string{NodeCordoned}, analysis.Nodes[1].Reasons)
+}
+
+func TestAnalyzePendingClaims(t *testing.T) {
+	pod := pendingPod("100m")
+	pod.Spec.NodeName = "node-1"
+	pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+		Name:  "app",
+		State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ContainerCreating"}},
+	}}
+	claims := map[string]*corev1.PersistentVolumeClaim{
+		"data":    {Status: corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimPending}},
+		"logs":    {Status: corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimBound}},
+		"archive": nil,
+	}
+
+	analysis := analyzePending(pod, nil, claims, nil, nil)
+
+	assert.Equal(t, []string{
+		"PVC archive does not exist",
+		"PVC data is unbound (Pending)",
+		"scheduled to node-1, waiting for containers: ContainerCreating",
+	}, analysis.Causes)
+	assert.Empty(t, analysis.Nodes)
+}
+
+func TestPodRequests(t *testing.T) {
+	pod := &corev1.Pod{Spec: corev1.PodSpec{
+		InitContainers: []corev1.Container{
+			{Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")}}},
+		},
+		Containers: []corev1.Container{
+			{Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("250m"), corev1.ResourceMemory: resource.MustParse("128Mi")}}},
+			{Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("250m"), corev1.ResourceMemory: resource.MustParse("128Mi")}}},
+		},
+	}}
+
+	requests := PodRequests(pod)
+
+	assert.Equal(t, int64(1000), requests.Cpu().MilliValue())
+	assert.Equal(t, int64(256*1024*1024), requests.Memory().Value())
+}
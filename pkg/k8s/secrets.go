@@ -0,0 +1,73 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// DecodeSecretManifest reads a single Secret from a YAML or JSON manifest,
+// such as one written by secrets export-all. Values may be given under data
+// or stringData; the API server merges stringData into data.
+func DecodeSecretManifest(r io.Reader) (*corev1.Secret, error) {
+	objects, err := DecodeManifests(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(objects) != 1 {
+		return nil, fmt.Errorf("expected a single Secret manifest, found %d objects", len(objects))
+	}
+
+	obj := objects[0]
+	if obj.GetAPIVersion() != "v1" || obj.GetKind() != "Secret" {
+		return nil, fmt.Errorf("expected a v1 Secret manifest, got %s %s", obj.GetAPIVersion(), obj.GetKind())
+	}
+
+	secret := &corev1.Secret{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, secret); err != nil {
+		return nil, fmt.Errorf("invalid Secret manifest: %w", err)
+	}
+
+	// Server-populated fields from another cluster would make the write fail
+	secret.ResourceVersion = ""
+	secret.UID = ""
+	secret.CreationTimestamp = metav1.Time{}
+	secret.ManagedFields = nil
+	return secret, nil
+}
+
+// ApplySecret creates a secret, or replaces the keys and metadata of the one
+// with the same name. It reports whether the secret was created.
+func ApplySecret(ctx context.Context, client kubernetes.Interface, secret *corev1.Secret) (bool, error) {
+	secrets := client.CoreV1().Secrets(secret.Namespace)
+
+	created := false
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		existing, err := secrets.Get(ctx, secret.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			if _, err := secrets.Create(ctx, secret, metav1.CreateOptions{FieldManager: FieldManager()}); err != nil {
+				return fmt.Errorf("failed to create secret %s: %w", secret.Name, err)
+			}
+			created = true
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get secret %s: %w", secret.Name, err)
+		}
+
+		updated := secret.DeepCopy()
+		updated.ResourceVersion = existing.ResourceVersion
+		if _, err := secrets.Update(ctx, updated, metav1.UpdateOptions{FieldManager: FieldManager()}); err != nil {
+			return fmt.Errorf("failed to update secret %s: %w", secret.Name, err)
+		}
+		return nil
+	})
+	return created, err
+}
@@ -0,0 +1,159 @@
+package k8s
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestDecodeSecretManifest(t *testing.T) {
+	testCases := []struct {
+		name     string
+		manifest string
+		wantErr  string
+	}{
+		{
+			name: "yaml",
+			manifest: `apiVersion: v1
+kind: Secret
+metadata:
+  name: db
+  namespace: prod
+  resourceVersion: "42"
+type: Opaque
+data:
+  password: c2VjcmV0
+stringData:
+  user: app
+`,
+		},
+		{
+			name:     "json",
+			manifest: `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"db","namespace":"prod"},"data":{"password":"c2VjcmV0"},"stringData":{"user":"app"}}`,
+		},
+		{
+			name:     "not a secret",
+			manifest: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: db\n",
+			wantErr:  "expected a v1 Secret manifest, got v1 ConfigMap",
+		},
+		{
+			name:     "several objects",
+			manifest: "apiVersion: v1\nkind: Secret\nmetadata:\n  name: a\n---\napiVersion: v1\nkind: Secret\nmetadata:\n  name: b\n",
+			wantErr:  "expected a single Secret manifest, found 2 objects",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			secret, err := DecodeSecretManifest(strings.NewReader(tc.manifest))
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "db", secret.Name)
+			assert.Equal(t, "prod", secret.Namespace)
+			assert.Empty(t, secret.ResourceVersion)
+			assert.Equal(t, []byte("secret"), secret.Data["password"])
+			assert.Equal(t, "app", secret.StringData["user"])
+		})
+	}
+}
+
+func TestApplySecret(t *testing.T) {
+	testCases := []struct {
+		name        string
+		exists      bool
+		wantMethod  string
+		wantCreated bool
+	}{
+		{name: "create", wantMethod: http.MethodPost, wantCreated: true},
+		{name: "update", exists: true, wantMethod: http.MethodPut},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var written corev1.Secret
+			var method string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.Method {
+				case http.MethodGet:
+					if !tc.exists {
+						w.WriteHeader(http.StatusNotFound)
+						json.NewEncoder(w).Encode(&metav1.Status{Status: metav1.StatusFailure, Reason: metav1.StatusReasonNotFound, Code: http.StatusNotFound})
+						return
+					}
+					json.NewEncoder(w).Encode(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "db", ResourceVersion: "7"}})
+				default:
+					method = r.Method
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&written))
+					json.NewEncoder(w).Encode(&written)
+				}
+			}))
+			defer server.Close()
+
+			cs, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+			require.NoError(t, err)
+
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "prod"},
+				Data:       map[string][]byte{"password": []byte("secret")},
+			}
+			created, err := ApplySecret(t.Context(), cs, secret)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantCreated, created)
+			assert.Equal(t, tc.wantMethod, method)
+			assert.Equal(t, []byte("secret"), written.Data["password"])
+			if tc.exists {
+				assert.Equal(t, "7", written.ResourceVersion)
+			}
+		})
+	}
+}
+
+func TestApplySecretRetriesOnConflict(t *testing.T) {
+	gets, puts := 0, 0
+	var fieldManager string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			gets++
+			json.NewEncoder(w).Encode(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "db", ResourceVersion: fmt.Sprint(gets)}})
+			return
+		}
+		puts++
+		fieldManager = r.URL.Query().Get("fieldManager")
+		if puts == 1 {
+			w.WriteHeader(http.StatusConflict)
+			json.NewEncoder(w).Encode(&metav1.Status{Status: metav1.StatusFailure, Reason: metav1.StatusReasonConflict, Code: http.StatusConflict})
+			return
+		}
+		var written corev1.Secret
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&written))
+		assert.Equal(t, "2", written.ResourceVersion, "the retry uses the fresh version")
+		json.NewEncoder(w).Encode(&written)
+	}))
+	defer server.Close()
+
+	cs, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "prod"}}
+	created, err := ApplySecret(t.Context(), cs, secret)
+	require.NoError(t, err)
+	assert.False(t, created)
+	assert.Equal(t, 2, puts)
+	assert.Equal(t, FieldManager(), fieldManager)
+}
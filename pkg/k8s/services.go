@@ -0,0 +1,277 @@
+package k8s
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+// ManagedByLabel marks objects created by k8s-manager itself
+const ManagedByLabel = "app.kubernetes.io/managed-by"
+
+// reachabilityParallelism bounds the dial checks run at the same time
+const reachabilityParallelism = 5
+
+// ServiceEndpoint is one backend address of a service
+type ServiceEndpoint struct {
+	Pod      string
+	IP       string
+	Port     int32
+	PortName string
+	Ready    bool
+}
+
+// Address returns the endpoint as host:port
+func (e ServiceEndpoint) Address() string {
+	return net.JoinHostPort(e.IP, strconv.Itoa(int(e.Port)))
+}
+
+// ServiceExternalAddress returns where a service is reached from outside
+// the cluster: the ingress IPs or hostnames of a load balancer, the name of
+// an ExternalName service or its external IPs. A load balancer that has not
+// been provisioned yet is "<pending>"; other services give "<none>".
+func ServiceExternalAddress(service *corev1.Service) string {
+	var addresses []string
+	switch service.Spec.Type {
+	case corev1.ServiceTypeExternalName:
+		return service.Spec.ExternalName
+	case corev1.ServiceTypeLoadBalancer:
+		for _, ingress := range service.Status.LoadBalancer.Ingress {
+			if ingress.IP != "" {
+				addresses = append(addresses, ingress.IP)
+			} else if ingress.Hostname != "" {
+				addresses = append(addresses, ingress.Hostname)
+			}
+		}
+		addresses = append(addresses, service.Spec.ExternalIPs...)
+		if len(addresses) == 0 {
+			return "<pending>"
+		}
+	default:
+		addresses = append(addresses, service.Spec.ExternalIPs...)
+	}
+	if len(addresses) == 0 {
+		return "<none>"
+	}
+	return strings.Join(addresses, ",")
+}
+
+// ServicePorts formats the ports of a service as kubectl does, such as
+// 80/TCP or 80:30080/TCP when a node port is allocated
+func ServicePorts(service *corev1.Service) string {
+	if len(service.Spec.Ports) == 0 {
+		return "<none>"
+	}
+	ports := make([]string, 0, len(service.Spec.Ports))
+	for _, port := range service.Spec.Ports {
+		mapping := strconv.Itoa(int(port.Port))
+		if port.NodePort != 0 {
+			mapping += ":" + strconv.Itoa(int(port.NodePort))
+		}
+		protocol := port.Protocol
+		if protocol == "" {
+			protocol = corev1.ProtocolTCP
+		}
+		ports = append(ports, mapping+"/"+string(protocol))
+	}
+	return strings.Join(ports, ",")
+}
+
+// EndpointCheck is the result of dialing an endpoint
+type EndpointCheck struct {
+	Endpoint  ServiceEndpoint
+	Reachable bool
+	// Error explains why the endpoint could not be reached
+	Error string
+}
+
+// ReachabilityOptions controls how endpoints are checked
+type ReachabilityOptions struct {
+	// Image runs the probe pod and must provide nc, as busybox does
+	Image string
+	// DialTimeout bounds each connection attempt
+	DialTimeout time.Duration
+	// StartTimeout bounds how long the probe pod may take to start
+	StartTimeout time.Duration
+}
+
+// ServiceEndpoints lists the ready and not ready backends of a service,
+// sorted by pod and port
+func (c *Client) ServiceEndpoints(ctx context.Context, namespace, name string) ([]ServiceEndpoint, error) {
+	endpoints, err := c.Clientset.CoreV1().Endpoints(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get endpoints of service %s: %w", name, err)
+	}
+
+	var result []ServiceEndpoint
+	for _, subset := range endpoints.Subsets {
+		add := func(addresses []corev1.EndpointAddress, ready bool) {
+			for _, address := range addresses {
+				pod := ""
+				if address.TargetRef != nil && address.TargetRef.Kind == "Pod" {
+					pod = address.TargetRef.Name
+				}
+				for _, port := range subset.Ports {
+					if port.Protocol != "" && port.Protocol != corev1.ProtocolTCP {
+						continue
+					}
+					result = append(result, ServiceEndpoint{
+						Pod:      pod,
+						IP:       address.IP,
+						Port:     port.Port,
+						PortName: port.Name,
+						Ready:    ready,
+					})
+				}
+			}
+		}
+		add(subset.Addresses, true)
+		add(subset.NotReadyAddresses, false)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Pod != result[j].Pod {
+			return result[i].Pod < result[j].Pod
+		}
+		if result[i].IP != result[j].IP {
+			return result[i].IP < result[j].IP
+		}
+		return result[i].Port < result[j].Port
+	})
+	return result, nil
+}
+
+// CheckEndpoints dials every endpoint over TCP from a short-lived probe pod
+// in the namespace, so the checks see the same network as other pods do. The
+// probe pod is deleted before returning.
+func (c *Client) CheckEndpoints(ctx context.Context, namespace string, endpoints []ServiceEndpoint, opts ReachabilityOptions) ([]EndpointCheck, error) {
+	probe, err := c.startProbePod(ctx, namespace, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer c.deleteProbePod(namespace, probe)
+
+	timeout := int(opts.DialTimeout.Round(time.Second).Seconds())
+	if timeout < 1 {
+		timeout = 1
+	}
+
+	results := make([]EndpointCheck, len(endpoints))
+	sem := make(chan struct{}, reachabilityParallelism)
+	var wg sync.WaitGroup
+	for i, endpoint := range endpoints {
+		wg.Add(1)
+		go func(i int, endpoint ServiceEndpoint) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			var stderr bytes.Buffer
+			err := c.Exec(ctx, namespace, probe, ExecOptions{
+				Command: []string{"nc", "-z", "-w", strconv.Itoa(timeout), endpoint.IP, strconv.Itoa(int(endpoint.Port))},
+				Stdout:  &bytes.Buffer{},
+				Stderr:  &stderr,
+			})
+			results[i] = EndpointCheck{Endpoint: endpoint, Reachable: err == nil}
+			if err != nil {
+				results[i].Error = dialError(err, stderr.String())
+			}
+		}(i, endpoint)
+	}
+	wg.Wait()
+
+	return results, nil
+}
+
+// startProbePod creates the pod the dial checks run in and waits for it to
+// start. The pod exits on its own after a while in case it is not deleted.
+func (c *Client) startProbePod(ctx context.Context, namespace string, opts ReachabilityOptions) (string, error) {
+	deadline := int64(600)
+	grace := int64(0)
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "k8s-manager-netcheck-",
+			Labels:       map[string]string{ManagedByLabel: "k8s-manager"},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy:                 corev1.RestartPolicyNever,
+			ActiveDeadlineSeconds:         &deadline,
+			TerminationGracePeriodSeconds: &grace,
+			Containers: []corev1.Container{{
+				Name:    "netcheck",
+				Image:   opts.Image,
+				Command: []string{"sleep", strconv.FormatInt(deadline, 10)},
+			}},
+		},
+	}
+
+	created, err := c.Clientset.CoreV1().Pods(namespace).Create(ctx, pod, metav1.CreateOptions{FieldManager: FieldManager()})
+	if err != nil {
+		return "", fmt.Errorf("failed to create probe pod: %w", err)
+	}
+
+	startCtx, cancel := context.WithTimeout(ctx, opts.StartTimeout)
+	defer cancel()
+
+	var startErr error
+	running := created.Status.Phase == corev1.PodRunning
+	if !running {
+		err = c.WatchPod(startCtx, namespace, created.Name, created.ResourceVersion, func(event watch.EventType, updated *corev1.Pod) bool {
+			switch {
+			case event == watch.Deleted:
+				startErr = fmt.Errorf("probe pod %s was deleted", created.Name)
+			case updated.Status.Phase == corev1.PodRunning:
+				running = true
+			case updated.Status.Phase == corev1.PodFailed || updated.Status.Phase == corev1.PodSucceeded || PodFailing(updated):
+				startErr = fmt.Errorf("probe pod %s failed to start: %s", created.Name, PodStatus(updated))
+			}
+			return running || startErr != nil
+		})
+	}
+	if err == nil {
+		err = startErr
+	}
+	if err == nil && !running {
+		err = fmt.Errorf("probe pod %s did not start within %s (is image %s available?)", created.Name, opts.StartTimeout, opts.Image)
+	}
+	if err != nil {
+		c.deleteProbePod(namespace, created.Name)
+		return "", err
+	}
+
+	return created.Name, nil
+}
+
+// deleteProbePod removes a probe pod right away. It does not use the
+// caller's context so the pod is cleaned up even after a cancellation.
+func (c *Client) deleteProbePod(namespace, name string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grace := int64(0)
+	c.Clientset.CoreV1().Pods(namespace).Delete(ctx, name, metav1.DeleteOptions{GracePeriodSeconds: &grace})
+}
+
+// dialError describes a failed nc check
+func dialError(err error, stderr string) string {
+	if msg := strings.TrimSpace(stderr); msg != "" {
+		return msg
+	}
+	var exitErr utilexec.ExitError
+	if errors.As(err, &exitErr) {
+		return "connection refused or timed out"
+	}
+	return err.Error()
+}
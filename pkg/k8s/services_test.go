@@ -0,0 +1,99 @@
+package k8s
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+func TestServiceEndpoints(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/namespaces/default/endpoints/web", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&corev1.Endpoints{Subsets: []corev1.EndpointSubset{{
+			Addresses: []corev1.EndpointAddress{
+				{IP: "10.0.0.7", TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "web-1"}},
+				{IP: "10.0.0.5", TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "web-0"}},
+			},
+			NotReadyAddresses: []corev1.EndpointAddress{
+				{IP: "10.0.0.9", TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "web-2"}},
+			},
+			Ports: []corev1.EndpointPort{
+				{Name: "http", Port: 8080, Protocol: corev1.ProtocolTCP},
+				{Name: "dns", Port: 53, Protocol: corev1.ProtocolUDP},
+			},
+		}}})
+	}))
+	defer server.Close()
+
+	cs, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+	client := &Client{Clientset: cs}
+
+	endpoints, err := client.ServiceEndpoints(t.Context(), "default", "web")
+	require.NoError(t, err)
+
+	assert.Equal(t, []ServiceEndpoint{
+		{Pod: "web-0", IP: "10.0.0.5", Port: 8080, PortName: "http", Ready: true},
+		{Pod: "web-1", IP: "10.0.0.7", Port: 8080, PortName: "http", Ready: true},
+		{Pod: "web-2", IP: "10.0.0.9", Port: 8080, PortName: "http", Ready: false},
+	}, endpoints, "UDP ports are skipped")
+	assert.Equal(t, "10.0.0.5:8080", endpoints[0].Address())
+}
+
+func TestDialError(t *testing.T) {
+	exitErr := utilexec.CodeExitError{Err: errors.New("command terminated with exit code 1"), Code: 1}
+
+	assert.Equal(t, "connection refused or timed out", dialError(exitErr, ""))
+	assert.Equal(t, "nc: bad address '10.0.0.5'", dialError(exitErr, "nc: bad address '10.0.0.5'\n"))
+	assert.Equal(t, "stream closed", dialError(errors.New("stream closed"), ""))
+}
+
+func TestServiceExternalAddress(t *testing.T) {
+	testCases := []struct {
+		name     string
+		service  corev1.Service
+		expected string
+	}{
+		{name: "cluster ip", service: corev1.Service{Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP}}, expected: "<none>"},
+		{name: "external ips", service: corev1.Service{Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeNodePort, ExternalIPs: []string{"1.2.3.4"}}}, expected: "1.2.3.4"},
+		{name: "external name", service: corev1.Service{Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeExternalName, ExternalName: "db.example.com"}}, expected: "db.example.com"},
+		{name: "pending load balancer", service: corev1.Service{Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer}}, expected: "<pending>"},
+		{
+			name: "load balancer ingress",
+			service: corev1.Service{
+				Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+				Status: corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{Ingress: []corev1.LoadBalancerIngress{
+					{IP: "34.1.2.3"},
+					{Hostname: "lb.example.com"},
+				}}},
+			},
+			expected: "34.1.2.3,lb.example.com",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, ServiceExternalAddress(&tc.service))
+		})
+	}
+}
+
+func TestServicePorts(t *testing.T) {
+	service := &corev1.Service{Spec: corev1.ServiceSpec{Ports: []corev1.ServicePort{
+		{Port: 80, Protocol: corev1.ProtocolTCP},
+		{Port: 443, NodePort: 30443},
+		{Port: 53, Protocol: corev1.ProtocolUDP},
+	}}}
+	assert.Equal(t, "80/TCP,443:30443/TCP,53/UDP", ServicePorts(service))
+	assert.Equal(t, "<none>", ServicePorts(&corev1.Service{}))
+}
@@ -0,0 +1,46 @@
+package k8s
+
+import (
+	"log/slog"
+	"net/http"
+	"os"
+	"time"
+)
+
+// requestLogger logs every API request made through the wrapped transport
+type requestLogger struct {
+	next   http.RoundTripper
+	logger *slog.Logger
+}
+
+// newRequestLogger wraps a transport so each request is logged to stderr
+func newRequestLogger(next http.RoundTripper) http.RoundTripper {
+	return &requestLogger{
+		next:   next,
+		logger: slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug})),
+	}
+}
+
+func (l *requestLogger) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := l.next.RoundTrip(req)
+
+	attrs := []any{
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.RequestURI()),
+		slog.Duration("latency", time.Since(start)),
+	}
+
+	if err != nil {
+		l.logger.Error("api request failed", append(attrs, slog.String("error", err.Error()))...)
+		return resp, err
+	}
+
+	level := slog.LevelDebug
+	if resp.StatusCode >= http.StatusBadRequest {
+		level = slog.LevelWarn
+	}
+	l.logger.Log(req.Context(), level, "api request", append(attrs, slog.Int("status", resp.StatusCode))...)
+
+	return resp, nil
+}
@@ -0,0 +1,79 @@
+package k8s
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRequestLogger(t *testing.T) {
+	testCases := []struct {
+		name     string
+		status   int
+		err      error
+		contains []string
+	}{
+		{
+			name:     "successful request",
+			status:   http.StatusOK,
+			contains: []string{"level=DEBUG", "method=GET", "path=\"/api/v1/namespaces/default/pods?limit=10\"", "status=200", "latency="},
+		},
+		{
+			name:     "rejected request",
+			status:   http.StatusNotFound,
+			contains: []string{"level=WARN", "status=404"},
+		},
+		{
+			name:     "transport error",
+			err:      errors.New("connection refused"),
+			contains: []string{"level=ERROR", "api request failed", "error=\"connection refused\""},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			logger := &requestLogger{
+				next: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					if tc.err != nil {
+						return nil, tc.err
+					}
+					return &http.Response{StatusCode: tc.status, Body: http.NoBody}, nil
+				}),
+				logger: slog.New(slog.NewTextHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug})),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "https://cluster/api/v1/namespaces/default/pods?limit=10", nil)
+			_, err := logger.RoundTrip(req)
+			assert.Equal(t, tc.err, err)
+
+			for _, expected := range tc.contains {
+				assert.Contains(t, buf.String(), expected)
+			}
+		})
+	}
+}
+
+func TestApplyClientOptionsVerbose(t *testing.T) {
+	config := &rest.Config{}
+	applyClientOptions(config, ClientOptions{})
+	assert.Nil(t, config.WrapTransport)
+
+	applyClientOptions(config, ClientOptions{Verbose: true})
+	require.NotNil(t, config.WrapTransport)
+	_, ok := config.WrapTransport(http.DefaultTransport).(*requestLogger)
+	assert.True(t, ok)
+}
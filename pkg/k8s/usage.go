@@ -0,0 +1,116 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ResourceUsage is the current use of one resource by a container next to
+// what the container requests and is limited to
+type ResourceUsage struct {
+	Usage   resource.Quantity
+	Request *resource.Quantity // nil when not set
+	Limit   *resource.Quantity // nil when not set
+}
+
+// PercentOfRequest returns usage as a percentage of the request
+func (u ResourceUsage) PercentOfRequest() (int64, bool) {
+	return percentOf(u.Usage, u.Request)
+}
+
+// PercentOfLimit returns usage as a percentage of the limit
+func (u ResourceUsage) PercentOfLimit() (int64, bool) {
+	return percentOf(u.Usage, u.Limit)
+}
+
+// OverRequest reports whether the container uses more than it requests
+func (u ResourceUsage) OverRequest() bool {
+	return u.Request != nil && u.Usage.Cmp(*u.Request) > 0
+}
+
+// ContainerUsage compares the CPU and memory a container uses with its
+// requests and limits
+type ContainerUsage struct {
+	Name   string
+	CPU    ResourceUsage
+	Memory ResourceUsage
+}
+
+// NoRequests reports whether the container sets neither a CPU nor a memory
+// request, which leaves the scheduler guessing
+func (c ContainerUsage) NoRequests() bool {
+	return c.CPU.Request == nil && c.Memory.Request == nil
+}
+
+// podMetrics is the part of a metrics.k8s.io PodMetrics object that is used
+type podMetrics struct {
+	Containers []struct {
+		Name  string              `json:"name"`
+		Usage corev1.ResourceList `json:"usage"`
+	} `json:"containers"`
+}
+
+// GetPodUsage fetches the current usage of a pod's containers from the
+// metrics API and compares it with their requests and limits. It fails when
+// metrics-server is not installed.
+func (c *Client) GetPodUsage(ctx context.Context, pod *corev1.Pod) ([]ContainerUsage, error) {
+	data, err := c.fetchPodMetrics(ctx, pod.Namespace, pod.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	var metrics podMetrics
+	if err := json.Unmarshal(data, &metrics); err != nil {
+		return nil, fmt.Errorf("failed to decode metrics of pod %s: %w", pod.Name, err)
+	}
+
+	usage := map[string]corev1.ResourceList{}
+	for _, container := range metrics.Containers {
+		usage[container.Name] = container.Usage
+	}
+	return PodUsage(pod, usage), nil
+}
+
+// PodUsage pairs the usage of each container of a pod, keyed by container
+// name, with the requests and limits in its spec. Containers without metrics
+// show zero usage.
+func PodUsage(pod *corev1.Pod, usage map[string]corev1.ResourceList) []ContainerUsage {
+	containers := make([]ContainerUsage, 0, len(pod.Spec.Containers))
+	for _, container := range pod.Spec.Containers {
+		containers = append(containers, ContainerUsage{
+			Name:   container.Name,
+			CPU:    resourceUsage(container.Resources, usage[container.Name], corev1.ResourceCPU),
+			Memory: resourceUsage(container.Resources, usage[container.Name], corev1.ResourceMemory),
+		})
+	}
+	return containers
+}
+
+// fetchPodMetrics fetches the PodMetrics object of a pod as JSON
+func (c *Client) fetchPodMetrics(ctx context.Context, namespace, name string) ([]byte, error) {
+	return c.Clientset.CoreV1().RESTClient().Get().
+		AbsPath("/apis/metrics.k8s.io/v1beta1/namespaces", namespace, "pods", name).
+		DoRaw(ctx)
+}
+
+func resourceUsage(requirements corev1.ResourceRequirements, usage corev1.ResourceList, name corev1.ResourceName) ResourceUsage {
+	u := ResourceUsage{Usage: usage[name]}
+	if request, ok := requirements.Requests[name]; ok {
+		u.Request = &request
+	}
+	if limit, ok := requirements.Limits[name]; ok {
+		u.Limit = &limit
+	}
+	return u
+}
+
+func percentOf(usage resource.Quantity, of *resource.Quantity) (int64, bool) {
+	if of == nil || of.IsZero() {
+		return 0, false
+	}
+	return usage.MilliValue() * 100 / of.MilliValue(), true
+}
@@ -0,0 +1,66 @@
+package k8s
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestGetPodUsage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/apis/metrics.k8s.io/v1beta1/namespaces/prod/pods/web-0", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"kind":"PodMetrics","apiVersion":"metrics.k8s.io/v1beta1","containers":[
+			{"name":"app","usage":{"cpu":"120m","memory":"300Mi"}},
+			{"name":"sidecar","usage":{"cpu":"5m","memory":"20Mi"}}]}`))
+	}))
+	defer server.Close()
+
+	cs, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+	client := &Client{Clientset: cs}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-0", Namespace: "prod"},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{
+			{
+				Name: "app",
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("250m"), corev1.ResourceMemory: resource.MustParse("256Mi")},
+					Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+				},
+			},
+			{Name: "sidecar"},
+		}},
+	}
+
+	usage, err := client.GetPodUsage(t.Context(), pod)
+	require.NoError(t, err)
+	require.Len(t, usage, 2)
+
+	app := usage[0]
+	percent, ok := app.CPU.PercentOfRequest()
+	assert.True(t, ok)
+	assert.Equal(t, int64(48), percent)
+	percent, ok = app.CPU.PercentOfLimit()
+	assert.True(t, ok)
+	assert.Equal(t, int64(24), percent)
+	assert.False(t, app.CPU.OverRequest())
+	assert.True(t, app.Memory.OverRequest(), "300Mi is above the 256Mi request")
+	_, ok = app.Memory.PercentOfLimit()
+	assert.False(t, ok)
+	assert.False(t, app.NoRequests())
+
+	sidecar := usage[1]
+	assert.Equal(t, "sidecar", sidecar.Name)
+	assert.True(t, sidecar.NoRequests())
+	assert.False(t, sidecar.CPU.OverRequest())
+}
@@ -0,0 +1,222 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/cache"
+	watchtools "k8s.io/client-go/tools/watch"
+)
+
+// WaitCondition describes the state a resource is waited for
+type WaitCondition struct {
+	// Delete waits for the resource to be removed
+	Delete bool
+	// ConditionType and ConditionStatus match an entry of status.conditions
+	ConditionType   string
+	ConditionStatus string
+}
+
+// ParseWaitCondition parses a --for value: "delete" or
+// "condition=<type>[=<status>]" where the status defaults to True
+func ParseWaitCondition(value string) (WaitCondition, error) {
+	if strings.EqualFold(value, "delete") {
+		return WaitCondition{Delete: true}, nil
+	}
+
+	spec, ok := strings.CutPrefix(value, "condition=")
+	if !ok || spec == "" {
+		return WaitCondition{}, fmt.Errorf("unsupported wait condition %q (expected delete or condition=<type>[=<status>])", value)
+	}
+
+	conditionType, status, found := strings.Cut(spec, "=")
+	if !found {
+		status = string(metav1.ConditionTrue)
+	}
+	if conditionType == "" || status == "" {
+		return WaitCondition{}, fmt.Errorf("unsupported wait condition %q (expected delete or condition=<type>[=<status>])", value)
+	}
+
+	return WaitCondition{ConditionType: conditionType, ConditionStatus: status}, nil
+}
+
+// String describes the condition the way it is passed to --for
+func (w WaitCondition) String() string {
+	if w.Delete {
+		return "delete"
+	}
+	return fmt.Sprintf("condition=%s=%s", w.ConditionType, w.ConditionStatus)
+}
+
+// Met reports whether an object's status.conditions satisfy the condition
+func (w WaitCondition) Met(obj *unstructured.Unstructured) bool {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		conditionType, _ := condition["type"].(string)
+		status, _ := condition["status"].(string)
+		if strings.EqualFold(conditionType, w.ConditionType) && strings.EqualFold(status, w.ConditionStatus) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseResourceArg splits a "<resource>/<name>" argument such as pod/web
+func ParseResourceArg(arg string) (string, string, error) {
+	resource, name, found := strings.Cut(arg, "/")
+	if !found || resource == "" || name == "" || strings.Contains(name, "/") {
+		return "", "", fmt.Errorf("invalid resource %q (expected <resource>/<name>, e.g. pod/web)", arg)
+	}
+	return resource, name, nil
+}
+
+// ReplicaCounts returns the ready and desired replicas of a workload such as
+// a deployment, statefulset or daemonset. ok is false for other objects.
+func ReplicaCounts(obj *unstructured.Unstructured) (ready, desired int64, ok bool) {
+	if desired, found, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas"); found {
+		ready, _, _ := unstructured.NestedInt64(obj.Object, "status", "readyReplicas")
+		return ready, desired, true
+	}
+	if desired, found, _ := unstructured.NestedInt64(obj.Object, "status", "desiredNumberScheduled"); found {
+		ready, _, _ := unstructured.NestedInt64(obj.Object, "status", "numberReady")
+		return ready, desired, true
+	}
+	return 0, 0, false
+}
+
+// DescribeStatus summarises the status of an object, e.g.
+// "1/3 ready, Available=False (MinimumReplicasUnavailable)", for progress
+// output and timeout errors
+func DescribeStatus(obj *unstructured.Unstructured) string {
+	var parts []string
+	if ready, desired, ok := ReplicaCounts(obj); ok {
+		parts = append(parts, fmt.Sprintf("%d/%d ready", ready, desired))
+	}
+	if phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase"); phase != "" {
+		parts = append(parts, "phase "+phase)
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		conditionType, _ := condition["type"].(string)
+		status, _ := condition["status"].(string)
+		part := conditionType + "=" + status
+		if reason, _ := condition["reason"].(string); reason != "" && status != string(metav1.ConditionTrue) {
+			part += " (" + reason + ")"
+		}
+		parts = append(parts, part)
+	}
+
+	if len(parts) == 0 {
+		return "no status reported"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// WaitFor watches a single object until the condition holds or ctx is done.
+// resource may be a kind, plural or short name such as pod, pods or po.
+// onUpdate, if set, is called with every version of the object observed.
+func (c *Client) WaitFor(ctx context.Context, namespace, resource, name string, condition WaitCondition, onUpdate func(*unstructured.Unstructured)) error {
+	mapper := c.restMapper()
+	gvr, err := mapper.ResourceFor(schema.GroupVersionResource{Resource: strings.ToLower(resource)})
+	if err != nil {
+		return fmt.Errorf("unknown resource type %q: %w", resource, err)
+	}
+
+	gvk, err := mapper.KindFor(gvr)
+	if err != nil {
+		return fmt.Errorf("unknown resource type %q: %w", resource, err)
+	}
+	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return fmt.Errorf("unknown resource type %q: %w", resource, err)
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(c.Config)
+	if err != nil {
+		return fmt.Errorf("failed to create dynamic client: %w", err)
+	}
+
+	var client dynamic.ResourceInterface = dynamicClient.Resource(gvr)
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		client = dynamicClient.Resource(gvr).Namespace(namespace)
+	}
+
+	if !condition.Delete {
+		// Fail fast instead of waiting out the timeout for a typo
+		if _, err := client.Get(ctx, name, metav1.GetOptions{}); err != nil {
+			return fmt.Errorf("failed to get %s/%s: %w", resource, name, err)
+		}
+	}
+
+	fieldSelector := fields.OneTermEqualSelector("metadata.name", name).String()
+	lw := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.FieldSelector = fieldSelector
+			return client.List(ctx, options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.FieldSelector = fieldSelector
+			return client.Watch(ctx, options)
+		},
+	}
+
+	// A deleted object may already be gone before the watch starts
+	var precondition watchtools.PreconditionFunc
+	if condition.Delete {
+		precondition = func(store cache.Store) (bool, error) {
+			return len(store.List()) == 0, nil
+		}
+	}
+
+	var last *unstructured.Unstructured
+	_, err = watchtools.UntilWithSync(ctx, lw, &unstructured.Unstructured{}, precondition, func(event watch.Event) (bool, error) {
+		switch event.Type {
+		case watch.Deleted:
+			if condition.Delete {
+				return true, nil
+			}
+			return false, fmt.Errorf("%s/%s was deleted while waiting for %s", resource, name, condition)
+		case watch.Added, watch.Modified:
+			obj, ok := event.Object.(*unstructured.Unstructured)
+			if !ok {
+				return false, nil
+			}
+			last = obj
+			if onUpdate != nil {
+				onUpdate(obj)
+			}
+			return !condition.Delete && condition.Met(obj), nil
+		}
+		return false, nil
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			status := "never observed"
+			if last != nil {
+				status = DescribeStatus(last)
+			}
+			return fmt.Errorf("timed out waiting for %s on %s/%s (last status: %s)", condition, resource, name, status)
+		}
+		return err
+	}
+
+	return nil
+}
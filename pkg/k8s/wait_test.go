@@ -0,0 +1,96 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestParseWaitCondition(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    string
+		expected WaitCondition
+		wantErr  bool
+	}{
+		{name: "delete", value: "delete", expected: WaitCondition{Delete: true}},
+		{name: "condition defaults to true", value: "condition=Ready", expected: WaitCondition{ConditionType: "Ready", ConditionStatus: "True"}},
+		{name: "condition with status", value: "condition=Available=false", expected: WaitCondition{ConditionType: "Available", ConditionStatus: "false"}},
+		{name: "missing type", value: "condition=", wantErr: true},
+		{name: "missing status", value: "condition=Ready=", wantErr: true},
+		{name: "unsupported", value: "jsonpath={.status.phase}", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			condition, err := ParseWaitCondition(tc.value)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, condition)
+		})
+	}
+}
+
+func TestWaitConditionMet(t *testing.T) {
+	pod := &unstructured.Unstructured{Object: map[string]interface{}{
+		"status": map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Initialized", "status": "True"},
+				map[string]interface{}{"type": "Ready", "status": "False"},
+			},
+		},
+	}}
+
+	assert.True(t, WaitCondition{ConditionType: "Initialized", ConditionStatus: "True"}.Met(pod))
+	assert.True(t, WaitCondition{ConditionType: "ready", ConditionStatus: "false"}.Met(pod))
+	assert.False(t, WaitCondition{ConditionType: "Ready", ConditionStatus: "True"}.Met(pod))
+	assert.False(t, WaitCondition{ConditionType: "Ready", ConditionStatus: "True"}.Met(&unstructured.Unstructured{Object: map[string]interface{}{}}))
+}
+
+func TestParseResourceArg(t *testing.T) {
+	resource, name, err := ParseResourceArg("pod/web")
+	assert.NoError(t, err)
+	assert.Equal(t, "pod", resource)
+	assert.Equal(t, "web", name)
+
+	for _, arg := range []string{"pod", "pod/", "/web", "pod/web/extra"} {
+		_, _, err := ParseResourceArg(arg)
+		assert.Error(t, err, arg)
+	}
+}
+
+func TestDescribeStatus(t *testing.T) {
+	deployment := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{"replicas": int64(3)},
+		"status": map[string]interface{}{
+			"readyReplicas": int64(1),
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Available", "status": "False", "reason": "MinimumReplicasUnavailable"},
+				map[string]interface{}{"type": "Progressing", "status": "True", "reason": "ReplicaSetUpdated"},
+			},
+		},
+	}}
+	ready, desired, ok := ReplicaCounts(deployment)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), ready)
+	assert.Equal(t, int64(3), desired)
+	assert.Equal(t, "1/3 ready, Available=False (MinimumReplicasUnavailable), Progressing=True", DescribeStatus(deployment))
+
+	daemonSet := &unstructured.Unstructured{Object: map[string]interface{}{
+		"status": map[string]interface{}{"desiredNumberScheduled": int64(4), "numberReady": int64(4)},
+	}}
+	assert.Equal(t, "4/4 ready", DescribeStatus(daemonSet))
+
+	pod := &unstructured.Unstructured{Object: map[string]interface{}{
+		"status": map[string]interface{}{"phase": "Pending"},
+	}}
+	_, _, ok = ReplicaCounts(pod)
+	assert.False(t, ok)
+	assert.Equal(t, "phase Pending", DescribeStatus(pod))
+
+	assert.Equal(t, "no status reported", DescribeStatus(&unstructured.Unstructured{Object: map[string]interface{}{}}))
+}
@@ -0,0 +1,86 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	watchtools "k8s.io/client-go/tools/watch"
+)
+
+// WatchPod streams changes to a single pod, starting after resourceVersion,
+// until onEvent returns true, the watch reports an error or ctx is done. The
+// watch is re-established transparently when the server closes it.
+func (c *Client) WatchPod(ctx context.Context, namespace, name, resourceVersion string, onEvent func(watch.EventType, *corev1.Pod) bool) error {
+	return c.watchPods(ctx, namespace, resourceVersion, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("metadata.name", name).String(),
+	}, "pod "+name, onEvent)
+}
+
+// WatchPodsForSelector streams changes to the pods of a namespace matching a
+// label selector in the same way WatchPod does for a single pod
+func (c *Client) WatchPodsForSelector(ctx context.Context, namespace, selector, resourceVersion string, onEvent func(watch.EventType, *corev1.Pod) bool) error {
+	return c.watchPods(ctx, namespace, resourceVersion, metav1.ListOptions{
+		LabelSelector: selector,
+	}, "pods for selector "+selector, onEvent)
+}
+
+// WatchPods streams changes to the pods of a namespace, or of every
+// namespace when it is empty, matching the label and field selectors of
+// filter, in the same way WatchPod does for a single pod. A watch that falls
+// too far behind fails with an error for which apierrors.IsResourceExpired
+// or apierrors.IsGone is true; the pods must then be listed again.
+func (c *Client) WatchPods(ctx context.Context, namespace, resourceVersion string, filter metav1.ListOptions, onEvent func(watch.EventType, *corev1.Pod) bool) error {
+	return c.watchPods(ctx, namespace, resourceVersion, filter, "pods", onEvent)
+}
+
+func (c *Client) watchPods(ctx context.Context, namespace, resourceVersion string, filter metav1.ListOptions, what string, onEvent func(watch.EventType, *corev1.Pod) bool) error {
+	watcher, err := watchtools.NewRetryWatcher(resourceVersion, &cache.ListWatch{
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.FieldSelector = filter.FieldSelector
+			options.LabelSelector = filter.LabelSelector
+			return c.Clientset.CoreV1().Pods(namespace).Watch(ctx, options)
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to watch %s: %w", what, err)
+	}
+	defer watcher.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return nil
+			}
+			if event.Type == watch.Error {
+				if status, ok := event.Object.(*metav1.Status); ok {
+					return fmt.Errorf("watch of %s failed: %w", what, &apierrors.StatusError{ErrStatus: *status})
+				}
+				return fmt.Errorf("watch of %s failed: %v", what, apiStatusMessage(event.Object))
+			}
+			pod, ok := event.Object.(*corev1.Pod)
+			if !ok {
+				continue
+			}
+			if onEvent(event.Type, pod) {
+				return nil
+			}
+		}
+	}
+}
+
+// apiStatusMessage extracts the message of a watch error event
+func apiStatusMessage(obj interface{}) string {
+	if status, ok := obj.(*metav1.Status); ok && status.Message != "" {
+		return status.Message
+	}
+	return fmt.Sprintf("%v", obj)
+}
@@ -0,0 +1,186 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// RestartedAtAnnotation is set on a workload's pod template to roll its pods,
+// as kubectl rollout restart does
+const RestartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+// WorkloadKind is a kind of controller whose pods can be restarted together
+type WorkloadKind string
+
+const (
+	KindDeployment  WorkloadKind = "Deployment"
+	KindStatefulSet WorkloadKind = "StatefulSet"
+	KindDaemonSet   WorkloadKind = "DaemonSet"
+)
+
+// ErrWorkloadNotFound is returned by DetectWorkloadKind when no workload has
+// the name
+var ErrWorkloadNotFound = errors.New("workload not found")
+
+// ErrUnmanagedPod is returned by PodWorkload when no deployment, statefulset
+// or daemonset manages the pod
+var ErrUnmanagedPod = errors.New("pod is not managed by a deployment, statefulset or daemonset")
+
+// WorkloadKinds lists the workload kinds in the order they are looked up
+var WorkloadKinds = []WorkloadKind{KindDeployment, KindStatefulSet, KindDaemonSet}
+
+// RestartWorkload rolls every pod of a deployment, statefulset or daemonset
+// by stamping the current time on its pod template
+func RestartWorkload(ctx context.Context, client kubernetes.Interface, kind WorkloadKind, namespace, name string) error {
+	patch := []byte(fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{%q:%q}}}}}`,
+		RestartedAtAnnotation, time.Now().UTC().Format(time.RFC3339)))
+
+	var err error
+	switch kind {
+	case KindDeployment:
+		_, err = client.AppsV1().Deployments(namespace).Patch(ctx, name, types.StrategicMergePatchType, patch, metav1.PatchOptions{FieldManager: FieldManager()})
+	case KindStatefulSet:
+		_, err = client.AppsV1().StatefulSets(namespace).Patch(ctx, name, types.StrategicMergePatchType, patch, metav1.PatchOptions{FieldManager: FieldManager()})
+	case KindDaemonSet:
+		_, err = client.AppsV1().DaemonSets(namespace).Patch(ctx, name, types.StrategicMergePatchType, patch, metav1.PatchOptions{FieldManager: FieldManager()})
+	default:
+		return fmt.Errorf("cannot restart a %s", kind)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to restart %s %s: %w", strings.ToLower(string(kind)), name, err)
+	}
+
+	return nil
+}
+
+// DetectWorkloadKind finds which kind of workload is called name. It fails
+// when there is none, or when several kinds share the name and the caller
+// has to choose.
+func DetectWorkloadKind(ctx context.Context, client kubernetes.Interface, namespace, name string) (WorkloadKind, error) {
+	var found []WorkloadKind
+	for _, kind := range WorkloadKinds {
+		var err error
+		switch kind {
+		case KindDeployment:
+			_, err = client.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+		case KindStatefulSet:
+			_, err = client.AppsV1().StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		case KindDaemonSet:
+			_, err = client.AppsV1().DaemonSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		}
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to look up %s %s: %w", strings.ToLower(string(kind)), name, err)
+		}
+		found = append(found, kind)
+	}
+
+	switch len(found) {
+	case 0:
+		return "", fmt.Errorf("%w: no deployment, statefulset or daemonset named %s in namespace %s", ErrWorkloadNotFound, name, namespace)
+	case 1:
+		return found[0], nil
+	}
+
+	kinds := make([]string, len(found))
+	for i, kind := range found {
+		kinds[i] = strings.ToLower(string(kind))
+	}
+	return "", fmt.Errorf("%s is the name of a %s in namespace %s; choose one with --%s",
+		name, strings.Join(kinds, " and a "), namespace, strings.Join(kinds, " or --"))
+}
+
+// PodWorkload finds the deployment, statefulset or daemonset that manages a
+// pod, going through the ReplicaSet for deployments
+func PodWorkload(ctx context.Context, client kubernetes.Interface, pod *corev1.Pod) (WorkloadKind, string, error) {
+	ref := controllerRef(pod.OwnerReferences)
+	if ref != nil && ref.Kind == "ReplicaSet" {
+		rs, err := client.AppsV1().ReplicaSets(pod.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return "", "", fmt.Errorf("failed to get replicaset %s: %w", ref.Name, err)
+		}
+		ref = controllerRef(rs.OwnerReferences)
+	}
+	if ref == nil {
+		return "", "", fmt.Errorf("%w: %s", ErrUnmanagedPod, pod.Name)
+	}
+
+	for _, kind := range WorkloadKinds {
+		if ref.Kind == string(kind) {
+			return kind, ref.Name, nil
+		}
+	}
+	return "", "", fmt.Errorf("%w: %s is owned by %s %s", ErrUnmanagedPod, pod.Name, ref.Kind, ref.Name)
+}
+
+// SetWorkloadEnv sets env on every container of a workload's pod template,
+// replacing variables of the same name, so that the pods are rolled with it
+func SetWorkloadEnv(ctx context.Context, client kubernetes.Interface, kind WorkloadKind, namespace, name string, env []corev1.EnvVar) error {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		options := metav1.UpdateOptions{FieldManager: FieldManager()}
+		switch kind {
+		case KindDeployment:
+			deployment, err := client.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			mergeTemplateEnv(&deployment.Spec.Template, env)
+			_, err = client.AppsV1().Deployments(namespace).Update(ctx, deployment, options)
+			return err
+		case KindStatefulSet:
+			sts, err := client.AppsV1().StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			mergeTemplateEnv(&sts.Spec.Template, env)
+			_, err = client.AppsV1().StatefulSets(namespace).Update(ctx, sts, options)
+			return err
+		case KindDaemonSet:
+			ds, err := client.AppsV1().DaemonSets(namespace).Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			mergeTemplateEnv(&ds.Spec.Template, env)
+			_, err = client.AppsV1().DaemonSets(namespace).Update(ctx, ds, options)
+			return err
+		}
+		return fmt.Errorf("cannot set the environment of a %s", kind)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update %s %s: %w", strings.ToLower(string(kind)), name, err)
+	}
+
+	return nil
+}
+
+// mergeTemplateEnv replaces the variables of each container that env names
+// and appends the others in order
+func mergeTemplateEnv(template *corev1.PodTemplateSpec, env []corev1.EnvVar) {
+	for i := range template.Spec.Containers {
+		container := &template.Spec.Containers[i]
+		for _, variable := range env {
+			replaced := false
+			for j := range container.Env {
+				if container.Env[j].Name == variable.Name {
+					container.Env[j] = variable
+					replaced = true
+				}
+			}
+			if !replaced {
+				container.Env = append(container.Env, variable)
+			}
+		}
+	}
+}
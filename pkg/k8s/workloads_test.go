@@ -0,0 +1,202 @@
+package k8s
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// newWorkloadsServer serves the named workloads and 404 for everything else
+func newWorkloadsServer(t *testing.T, existing map[string]bool, onPatch func(path string, patch []byte)) *kubernetes.Clientset {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPatch {
+			assert.Equal(t, string(types.StrategicMergePatchType), r.Header.Get("Content-Type"))
+			body, _ := io.ReadAll(r.Body)
+			onPatch(r.URL.Path, body)
+			json.NewEncoder(w).Encode(&appsv1.StatefulSet{})
+			return
+		}
+		if existing[r.URL.Path] {
+			json.NewEncoder(w).Encode(&appsv1.Deployment{})
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(&metav1.Status{Status: metav1.StatusFailure, Reason: metav1.StatusReasonNotFound, Code: http.StatusNotFound})
+	}))
+	t.Cleanup(server.Close)
+
+	cs, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+	return cs
+}
+
+func TestRestartWorkload(t *testing.T) {
+	testCases := []struct {
+		kind     WorkloadKind
+		wantPath string
+	}{
+		{kind: KindDeployment, wantPath: "/apis/apps/v1/namespaces/prod/deployments/web"},
+		{kind: KindStatefulSet, wantPath: "/apis/apps/v1/namespaces/prod/statefulsets/web"},
+		{kind: KindDaemonSet, wantPath: "/apis/apps/v1/namespaces/prod/daemonsets/web"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.kind), func(t *testing.T) {
+			var gotPath string
+			var patch struct {
+				Spec struct {
+					Template struct {
+						Metadata metav1.ObjectMeta `json:"metadata"`
+					} `json:"template"`
+				} `json:"spec"`
+			}
+			cs := newWorkloadsServer(t, nil, func(path string, body []byte) {
+				gotPath = path
+				require.NoError(t, json.Unmarshal(body, &patch))
+			})
+
+			require.NoError(t, RestartWorkload(t.Context(), cs, tc.kind, "prod", "web"))
+			assert.Equal(t, tc.wantPath, gotPath)
+			assert.NotEmpty(t, patch.Spec.Template.Metadata.Annotations[RestartedAtAnnotation])
+		})
+	}
+}
+
+func TestDetectWorkloadKind(t *testing.T) {
+	testCases := []struct {
+		name     string
+		existing map[string]bool
+		want     WorkloadKind
+		wantErr  string
+	}{
+		{
+			name:     "statefulset",
+			existing: map[string]bool{"/apis/apps/v1/namespaces/prod/statefulsets/db": true},
+			want:     KindStatefulSet,
+		},
+		{
+			name:    "nothing of that name",
+			wantErr: "no deployment, statefulset or daemonset named db in namespace prod",
+		},
+		{
+			name: "ambiguous",
+			existing: map[string]bool{
+				"/apis/apps/v1/namespaces/prod/deployments/db": true,
+				"/apis/apps/v1/namespaces/prod/daemonsets/db":  true,
+			},
+			wantErr: "db is the name of a deployment and a daemonset in namespace prod; choose one with --deployment or --daemonset",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cs := newWorkloadsServer(t, tc.existing, nil)
+
+			kind, err := DetectWorkloadKind(t.Context(), cs, "prod", "db")
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, kind)
+		})
+	}
+}
+
+func TestPodWorkload(t *testing.T) {
+	controller := true
+	owned := func(kind, name string) []metav1.OwnerReference {
+		return []metav1.OwnerReference{{Kind: kind, Name: name, Controller: &controller}}
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/apis/apps/v1/namespaces/prod/replicasets/web-7d4b9":
+			json.NewEncoder(w).Encode(&appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{Name: "web-7d4b9", OwnerReferences: owned("Deployment", "web")}})
+		case "/apis/apps/v1/namespaces/prod/replicasets/bare":
+			json.NewEncoder(w).Encode(&appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{Name: "bare"}})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	cs, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		owners   []metav1.OwnerReference
+		wantKind WorkloadKind
+		wantName string
+		wantErr  string
+	}{
+		{name: "deployment through replicaset", owners: owned("ReplicaSet", "web-7d4b9"), wantKind: KindDeployment, wantName: "web"},
+		{name: "statefulset", owners: owned("StatefulSet", "db"), wantKind: KindStatefulSet, wantName: "db"},
+		{name: "daemonset", owners: owned("DaemonSet", "agent"), wantKind: KindDaemonSet, wantName: "agent"},
+		{name: "no owner", wantErr: "not managed by a deployment, statefulset or daemonset: pod-1"},
+		{name: "bare replicaset", owners: owned("ReplicaSet", "bare"), wantErr: "not managed by a deployment, statefulset or daemonset: pod-1"},
+		{name: "job", owners: owned("Job", "migrate"), wantErr: "pod-1 is owned by Job migrate"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod-1", Namespace: "prod", OwnerReferences: tc.owners}}
+
+			kind, name, err := PodWorkload(t.Context(), cs, pod)
+			if tc.wantErr != "" {
+				require.ErrorIs(t, err, ErrUnmanagedPod)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantKind, kind)
+			assert.Equal(t, tc.wantName, name)
+		})
+	}
+}
+
+func TestSetWorkloadEnv(t *testing.T) {
+	var updated appsv1.StatefulSet
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.Equal(t, "/apis/apps/v1/namespaces/prod/statefulsets/db", r.URL.Path)
+		if r.Method == http.MethodPut {
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&updated))
+			json.NewEncoder(w).Encode(&updated)
+			return
+		}
+		sts := appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "prod"}}
+		sts.Spec.Template.Spec.Containers = []corev1.Container{
+			{Name: "db", Env: []corev1.EnvVar{{Name: "MODE", Value: "old"}, {Name: "KEEP", Value: "1"}}},
+			{Name: "exporter"},
+		}
+		json.NewEncoder(w).Encode(&sts)
+	}))
+	t.Cleanup(server.Close)
+	cs, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+
+	env := []corev1.EnvVar{{Name: "MODE", Value: "new"}, {Name: "LEVEL", Value: "debug"}}
+	require.NoError(t, SetWorkloadEnv(t.Context(), cs, KindStatefulSet, "prod", "db", env))
+
+	containers := updated.Spec.Template.Spec.Containers
+	require.Len(t, containers, 2)
+	assert.Equal(t, []corev1.EnvVar{{Name: "MODE", Value: "new"}, {Name: "KEEP", Value: "1"}, {Name: "LEVEL", Value: "debug"}}, containers[0].Env)
+	assert.Equal(t, env, containers[1].Env)
+}
@@ -1,20 +1,40 @@
 package ui
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/karthickk/k8s-manager/pkg/k8s"
 	"github.com/pterm/pterm"
+	corev1 "k8s.io/api/core/v1"
 )
 
-// ShowEnhancedPodsInterface shows the enhanced pods interface with better navigation
-func ShowEnhancedPodsInterface(namespace string, allNamespaces bool) error {
+// ShowEnhancedPodsInterface shows the enhanced pods interface with better
+// navigation. refreshInterval sets how often auto-refresh reloads the list;
+// zero uses DefaultPodsRefreshInterval. A target limits the list to the
+// matching pods, and when it matches exactly one pod its actions open
+// straight away.
+func ShowEnhancedPodsInterface(namespace string, allNamespaces bool, refreshInterval time.Duration, target PodTarget) error {
+	if !target.IsZero() {
+		pod, err := findTargetPod(namespace, allNamespaces, target)
+		if err != nil {
+			return err
+		}
+		if pod != nil {
+			if quit := showEnhancedPodActions(newPodInfos([]corev1.Pod{*pod})[0]); quit {
+				return nil
+			}
+		}
+	}
+
+	autoRefresh := false
 	for {
 		// Show loading spinner first
 		spinner, _ := pterm.DefaultSpinner.Start("Initializing K8s Manager...")
 
-		m := NewEnhancedPodsModel(namespace, allNamespaces)
+		m := NewEnhancedPodsModel(namespace, allNamespaces).WithAutoRefresh(refreshInterval, autoRefresh).WithTarget(target)
 		p := tea.NewProgram(m, tea.WithAltScreen())
 
 		spinner.Stop()
@@ -25,52 +45,75 @@ func ShowEnhancedPodsInterface(namespace string, allNamespaces bool) error {
 		}
 
 		// Check if a pod was selected
-		if model, ok := result.(EnhancedPodsModel); ok {
-			selectedPod := model.GetSelectedPod()
-			if selectedPod != nil {
-				// Clear screen and show pod actions
-				fmt.Print("\033[H\033[2J")
-
-				// Get K8s client
-				client, err := k8s.NewClient()
-				if err != nil {
-					pterm.Error.Printf("Failed to create Kubernetes client: %v\n", err)
-					fmt.Println("\nPress Enter to return to pod list...")
-					fmt.Scanln()
-					continue
-				}
-
-				// Show the enhanced pod actions menu
-				actionsModel := NewEnhancedPodActionsModel(*selectedPod, client)
-				actionsProgram := tea.NewProgram(actionsModel, tea.WithAltScreen())
+		model, ok := result.(EnhancedPodsModel)
+		if !ok {
+			return nil
+		}
+		// Returning to the list after an action keeps auto-refresh as it was
+		autoRefresh = model.AutoRefresh()
+		selectedPod := model.GetSelectedPod()
+		if selectedPod == nil {
+			// No pod selected, exit
+			return nil
+		}
+		if quit := showEnhancedPodActions(*selectedPod); quit {
+			return nil
+		}
+	}
+}
 
-				_, err = actionsProgram.Run()
-				if err != nil {
-					pterm.Error.Printf("Error in pod actions: %v\n", err)
-				}
+// findTargetPod looks up the only pod matching target, or returns nil so the
+// list is shown
+func findTargetPod(namespace string, allNamespaces bool, target PodTarget) (*corev1.Pod, error) {
+	client, err := k8s.NewClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+	if allNamespaces {
+		namespace = ""
+	} else if namespace == "" {
+		namespace = client.GetNamespace()
+	}
 
-				// After action completes, ask if they want to continue
-				fmt.Print("\033[H\033[2J")
-				pterm.DefaultHeader.Println("Action Completed")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	return findUniquePod(ctx, client, namespace, target)
+}
 
-				continuePrompt := pterm.DefaultInteractiveSelect.
-					WithOptions([]string{"Return to pod list", "Quit application"}).
-					WithDefaultOption("Return to pod list")
+// showEnhancedPodActions shows the actions menu of a pod, then asks whether
+// to go back to the pod list. It reports whether the user chose to quit.
+func showEnhancedPodActions(pod PodInfo) bool {
+	// Clear screen and show pod actions
+	fmt.Print("\033[H\033[2J")
+
+	// Get K8s client
+	client, err := k8s.NewClient()
+	if err != nil {
+		pterm.Error.Printf("Failed to create Kubernetes client: %v\n", err)
+		fmt.Println("\nPress Enter to return to pod list...")
+		fmt.Scanln()
+		return false
+	}
 
-				choice, _ := continuePrompt.Show()
+	// Show the enhanced pod actions menu
+	actionsModel := NewEnhancedPodActionsModel(pod, client)
+	actionsProgram := tea.NewProgram(actionsModel, tea.WithAltScreen())
 
-				if choice == "Quit application" {
-					return nil
-				}
-				// Continue to show pod list again
-			} else {
-				// No pod selected, exit
-				return nil
-			}
-		} else {
-			return nil
-		}
+	_, err = actionsProgram.Run()
+	if err != nil {
+		pterm.Error.Printf("Error in pod actions: %v\n", err)
 	}
+
+	// After action completes, ask if they want to continue
+	fmt.Print("\033[H\033[2J")
+	pterm.DefaultHeader.Println("Action Completed")
+
+	continuePrompt := pterm.DefaultInteractiveSelect.
+		WithOptions([]string{"Return to pod list", "Quit application"}).
+		WithDefaultOption("Return to pod list")
+
+	choice, _ := continuePrompt.Show()
+	return choice == "Quit application"
 }
 
 // ShowEnhancedMenu shows the main menu with enhanced UI
@@ -170,11 +213,14 @@ func ShowEnhancedMenu() error {
 			case "all":
 				allNamespaces = true
 			case "specific":
-				fmt.Print("Enter namespace name: ")
-				fmt.Scanln(&namespace)
+				picked, err := PickNamespace("")
+				if err != nil || picked == "" {
+					continue
+				}
+				namespace = picked
 			}
 
-			err := ShowEnhancedPodsInterface(namespace, allNamespaces)
+			err := ShowEnhancedPodsInterface(namespace, allNamespaces, 0, PodTarget{})
 			if err != nil {
 				pterm.Error.Printf("Error: %v\n", err)
 				fmt.Println("\nPress Enter to continue...")
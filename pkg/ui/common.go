@@ -10,102 +10,123 @@ import (
 	"github.com/charmbracelet/lipgloss"
 )
 
-// Common styles for the entire application
+// Common styles for the entire application; the colored ones are built from
+// the current theme by buildCommonStyles
 var (
 	// Main container styles
 	AppStyle = lipgloss.NewStyle().
 		Margin(1, 2).
 		Padding(1, 2)
 
+	ItemStyle = lipgloss.NewStyle().
+		PaddingLeft(2)
+
+	TitleStyle          lipgloss.Style
+	HeaderStyle         lipgloss.Style
+	ListStyle           lipgloss.Style
+	SelectedItemStyle   lipgloss.Style
+	NumberStyle         lipgloss.Style
+	StatusRunningStyle  lipgloss.Style
+	StatusPendingStyle  lipgloss.Style
+	StatusErrorStyle    lipgloss.Style
+	StatusInfoStyle     lipgloss.Style
+	HelpStyle           lipgloss.Style
+	FooterStyle         lipgloss.Style
+	SuccessMessageStyle lipgloss.Style
+	ErrorMessageStyle   lipgloss.Style
+	InfoMessageStyle    lipgloss.Style
+	ContentBoxStyle     lipgloss.Style
+	SpinnerStyle        lipgloss.Style
+)
+
+// buildCommonStyles sets the colored common styles from the theme
+func buildCommonStyles(t Theme) {
 	// Title and header styles
 	TitleStyle = lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("39")).
-		Background(lipgloss.Color("235")).
+		Foreground(t.Title).
+		Background(t.Background).
 		Padding(0, 2).
 		MarginBottom(1)
 
 	HeaderStyle = lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("86")).
+		Foreground(t.Title).
 		BorderStyle(lipgloss.DoubleBorder()).
-		BorderForeground(lipgloss.Color("62")).
+		BorderForeground(t.Border).
 		Padding(0, 2).
 		MarginBottom(1)
 
 	// List and menu styles
 	ListStyle = lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("240")).
+		BorderForeground(t.Muted).
 		Padding(1, 2)
 
-	ItemStyle = lipgloss.NewStyle().
-		PaddingLeft(2)
-
 	SelectedItemStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("170")).
-		Background(lipgloss.Color("235")).
+		Foreground(t.Selected).
+		Background(t.Background).
 		Bold(true).
 		PaddingLeft(1).
 		PaddingRight(1)
 
 	NumberStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("241")).
+		Foreground(t.Muted).
 		Width(4)
 
 	// Status styles
 	StatusRunningStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("42")).
+		Foreground(t.Success).
 		Bold(true)
 
 	StatusPendingStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("214")).
+		Foreground(t.Warning).
 		Bold(true)
 
 	StatusErrorStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("196")).
+		Foreground(t.Error).
 		Bold(true)
 
 	StatusInfoStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("86"))
+		Foreground(t.Title)
 
 	// Help and footer styles
 	HelpStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("241")).
+		Foreground(t.Muted).
 		BorderStyle(lipgloss.NormalBorder()).
 		BorderTop(true).
-		BorderForeground(lipgloss.Color("237")).
+		BorderForeground(t.Muted).
 		MarginTop(1).
 		Padding(1, 2)
 
 	FooterStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("241")).
+		Foreground(t.Muted).
 		MarginTop(1).
 		Align(lipgloss.Center)
 
 	// Message styles
 	SuccessMessageStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("42")).
+		Foreground(t.Success).
 		Bold(true).
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("42")).
+		BorderForeground(t.Success).
 		Padding(0, 2).
 		MarginTop(1).
 		MarginBottom(1)
 
 	ErrorMessageStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("196")).
+		Foreground(t.Error).
 		Bold(true).
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("196")).
+		BorderForeground(t.Error).
 		Padding(0, 2).
 		MarginTop(1).
 		MarginBottom(1)
 
 	InfoMessageStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("86")).
+		Foreground(t.Title).
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("86")).
+		BorderForeground(t.Title).
 		Padding(0, 2).
 		MarginTop(1).
 		MarginBottom(1)
@@ -113,14 +134,14 @@ var (
 	// Box styles for content areas
 	ContentBoxStyle = lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("62")).
+		BorderForeground(t.Border).
 		Padding(1, 2).
 		MarginBottom(1)
 
 	// Spinner style
 	SpinnerStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("205"))
-)
+		Foreground(t.Title)
+}
 
 // MenuItem represents a menu item with keyboard navigation
 type MenuItem struct {
@@ -460,7 +481,9 @@ func (l *List) View() string {
 	return ListStyle.Render(s.String())
 }
 
-// renderMenuItem renders a single menu item
+// renderMenuItem renders a single menu item. Selected and unselected items
+// share the same padding and width, so the number, indicator and icon
+// columns line up and the highlight bar spans the whole row.
 func (l *List) renderMenuItem(item MenuItem, index int, selected bool) string {
 	var s strings.Builder
 
@@ -480,21 +503,37 @@ func (l *List) renderMenuItem(item MenuItem, index int, selected bool) string {
 		s.WriteString("   ")
 	}
 
-	// Icon
-	if item.Icon != "" {
-		s.WriteString(item.Icon + " ")
+	// Icon, padded to the widest icon in the menu
+	if gutter := l.menuIconGutter(); gutter > 0 {
+		s.WriteString(lipgloss.NewStyle().Width(gutter).Render(item.Icon))
 	}
 
 	// Title and description
 	title := item.Title
 	if item.Description != "" {
-		title += " - " + lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Render(item.Description)
+		title += " - " + lipgloss.NewStyle().Foreground(currentTheme.Muted).Render(item.Description)
 	}
 
+	style := ItemStyle
 	if selected {
-		return SelectedItemStyle.Render(s.String() + title)
+		style = SelectedItemStyle.PaddingLeft(ItemStyle.GetPaddingLeft())
 	}
-	return ItemStyle.Render(s.String() + title)
+	return style.Width(l.width - 4).Render(s.String() + title)
+}
+
+// menuIconGutter returns the width of the icon column: the widest icon plus
+// a space, or zero when no item has an icon
+func (l *List) menuIconGutter() int {
+	widest := 0
+	for _, item := range l.MenuItems {
+		if w := lipgloss.Width(item.Icon); w > widest {
+			widest = w
+		}
+	}
+	if widest == 0 {
+		return 0
+	}
+	return widest + 1
 }
 
 // renderListItem renders a single list item
@@ -550,7 +589,7 @@ func (l *List) renderListItem(item ListItem, index int, selected bool) string {
 
 	// Description
 	if item.Description != "" {
-		s.WriteString("\n    " + lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Render(item.Description))
+		s.WriteString("\n    " + lipgloss.NewStyle().Foreground(currentTheme.Muted).Render(item.Description))
 	}
 
 	// Details
@@ -582,6 +621,30 @@ func (l *List) GetCursor() int {
 	return l.cursor
 }
 
+// SetCursor moves the cursor to an item, clamped to the list, and scrolls it
+// into view
+func (l *List) SetCursor(index int) {
+	maxItems := len(l.Items)
+	if l.MenuItems != nil {
+		maxItems = len(l.MenuItems)
+	}
+
+	if index >= maxItems {
+		index = maxItems - 1
+	}
+	if index < 0 {
+		index = 0
+	}
+	l.cursor = index
+
+	if l.cursor < l.offset {
+		l.offset = l.cursor
+	}
+	if l.height > 0 && l.cursor >= l.offset+l.height {
+		l.offset = l.cursor - l.height + 1
+	}
+}
+
 // SetSize sets the display size of the list
 func (l *List) SetSize(width, height int) {
 	l.width = width
@@ -621,7 +684,7 @@ func RenderTitle(title string, subtitle ...string) string {
 	if len(subtitle) > 0 && subtitle[0] != "" {
 		s.WriteString("\n")
 		s.WriteString(lipgloss.NewStyle().
-			Foreground(lipgloss.Color("241")).
+			Foreground(currentTheme.Muted).
 			Width(80).
 			Align(lipgloss.Center).
 			Render(subtitle[0]))
@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var updateGolden = flag.Bool("update", false, "update golden files")
+
+func TestMenuViewGolden(t *testing.T) {
+	menu := NewMenu([]MenuItem{
+		{Title: "Pods", Description: "List and manage pods", Icon: "📦", Number: 1},
+		{Title: "Logs", Description: "Stream container logs", Icon: "📜", Number: 2},
+		{Title: "Quit", Number: 3},
+	})
+	menu.SetSize(60, 10)
+	menu.MoveDown()
+
+	view := menu.View()
+
+	golden := filepath.Join("testdata", "menu_width60.golden")
+	if *updateGolden {
+		require.NoError(t, os.WriteFile(golden, []byte(view), 0644))
+	}
+	want, err := os.ReadFile(golden)
+	require.NoError(t, err)
+	assert.Equal(t, string(want), view)
+}
+
+func TestMenuItemsShareWidthAndGutter(t *testing.T) {
+	menu := NewMenu([]MenuItem{
+		{Title: "Pods", Icon: "📦"},
+		{Title: "Quit"},
+	})
+	menu.SetSize(60, 10)
+
+	selected := menu.renderMenuItem(menu.MenuItems[0], 0, true)
+	unselected := menu.renderMenuItem(menu.MenuItems[0], 0, false)
+	noIcon := menu.renderMenuItem(menu.MenuItems[1], 1, false)
+
+	assert.Equal(t, 56, lipgloss.Width(selected))
+	assert.Equal(t, 56, lipgloss.Width(unselected))
+	assert.Equal(t, 56, lipgloss.Width(noIcon))
+
+	// Titles start in the same column whether or not the item is selected
+	// or has an icon
+	column := func(line, title string) int {
+		return lipgloss.Width(line[:strings.Index(line, title)])
+	}
+	assert.Equal(t, column(unselected, "Pods"), column(selected, "Pods"))
+	assert.Equal(t, column(unselected, "Pods"), column(noIcon, "Quit"))
+}
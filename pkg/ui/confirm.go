@@ -0,0 +1,100 @@
+package ui
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/karthickk/k8s-manager/pkg/config"
+	"github.com/pterm/pterm"
+)
+
+// confirmIn and confirmOut are where ConfirmDestructive reads the answer and
+// writes the prompt
+var (
+	confirmIn  io.Reader = os.Stdin
+	confirmOut io.Writer = os.Stdout
+)
+
+// ConfirmDestructive asks before an action that removes or disrupts a
+// resource, such as a delete or restart, and reports whether to go ahead.
+// force skips the prompt. In a namespace that the config marks as high-risk
+// the resource name must be typed out; elsewhere y or yes confirms. A kind
+// without a name, such as "3 pods", is confirmed by typing the namespace.
+func ConfirmDestructive(action, kind, namespace, name string, force bool) (bool, error) {
+	return ConfirmDestructiveOn(confirmIn, confirmOut, action, kind, namespace, name, force)
+}
+
+// ConfirmDestructiveOn is ConfirmDestructive on the given input and output,
+// such as the terminal tea.Exec hands over while a program is running
+func ConfirmDestructiveOn(in io.Reader, out io.Writer, action, kind, namespace, name string, force bool) (bool, error) {
+	if force {
+		return true, nil
+	}
+
+	target := kind
+	if name != "" {
+		target = fmt.Sprintf("%s '%s'", kind, name)
+	}
+	if namespace != "" {
+		target += fmt.Sprintf(" in namespace '%s'", namespace)
+	}
+
+	reader := bufio.NewReader(in)
+	if cfg := config.Get(); cfg != nil && cfg.IsHighRiskNamespace(namespace) {
+		expected, what := name, kind+" name"
+		if expected == "" {
+			expected, what = namespace, "namespace name"
+		}
+		fmt.Fprintln(out, pterm.FgRed.Sprintf("⚠️  Namespace '%s' is high-risk. You are about to %s %s.", namespace, action, target))
+		return confirmTyped(reader, out, what, expected)
+	}
+
+	fmt.Fprint(out, pterm.FgRed.Sprintf("Are you sure you want to %s %s? (y/N): ", action, target))
+	answer, err := readAnswer(reader)
+	if err != nil {
+		return false, err
+	}
+	answer = strings.ToLower(answer)
+	return answer == "y" || answer == "yes", nil
+}
+
+// ConfirmByName asks before an action so destructive, such as deleting a
+// namespace, that the name of the resource must be typed out wherever it
+// lives. force skips the prompt.
+func ConfirmByName(action, kind, name string, force bool) (bool, error) {
+	if force {
+		return true, nil
+	}
+
+	fmt.Fprintln(confirmOut, pterm.FgRed.Sprintf("⚠️  You are about to %s %s '%s'. This cannot be undone.", action, kind, name))
+	return confirmTyped(bufio.NewReader(confirmIn), confirmOut, kind+" name", name)
+}
+
+// confirmTyped confirms when the expected name is typed back
+func confirmTyped(reader *bufio.Reader, out io.Writer, what, expected string) (bool, error) {
+	fmt.Fprint(out, pterm.FgRed.Sprintf("Type the %s '%s' to confirm: ", what, expected))
+
+	answer, err := readAnswer(reader)
+	if err != nil {
+		return false, err
+	}
+	if answer != expected {
+		fmt.Fprintln(out, "The name did not match")
+		return false, nil
+	}
+	return true, nil
+}
+
+// readAnswer reads one line of input without its line ending. Running out
+// of input answers with an empty line, which declines.
+func readAnswer(reader *bufio.Reader) (string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("failed to read confirmation: %w", err)
+	}
+	return strings.TrimSpace(line), nil
+}
@@ -0,0 +1,91 @@
+package ui
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/karthickk/k8s-manager/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// withConfirmInput answers the next confirmations with input and returns
+// what was written as the prompt
+func withConfirmInput(t *testing.T, input string) *bytes.Buffer {
+	t.Helper()
+
+	out := new(bytes.Buffer)
+	oldIn, oldOut := confirmIn, confirmOut
+	confirmIn, confirmOut = strings.NewReader(input), out
+	t.Cleanup(func() { confirmIn, confirmOut = oldIn, oldOut })
+	return out
+}
+
+func TestConfirmDestructive(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "k8s-manager.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("k8s:\n  high_risk_namespace_pattern: prod\n"), 0644))
+	t.Setenv(config.PathEnvVar, path)
+	_, err := config.Load()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		namespace string
+		resource  string
+		input     string
+		force     bool
+		confirmed bool
+		prompt    string
+	}{
+		{name: "force skips the prompt", namespace: "prod", resource: "web", force: true, confirmed: true},
+		{name: "yes confirms", namespace: "staging", resource: "web", input: "yes\n", confirmed: true, prompt: "Are you sure you want to delete pod 'web' in namespace 'staging'? (y/N)"},
+		{name: "default declines", namespace: "staging", resource: "web", input: "\n", prompt: "(y/N)"},
+		{name: "no input declines", namespace: "staging", resource: "web", prompt: "(y/N)"},
+		{name: "high-risk namespace wants the name", namespace: "prod-eu", resource: "web", input: "web\n", confirmed: true, prompt: "Type the pod name 'web' to confirm"},
+		{name: "high-risk namespace rejects y", namespace: "prod-eu", resource: "web", input: "y\n", prompt: "Type the pod name 'web' to confirm"},
+		{name: "high-risk bulk action wants the namespace", namespace: "prod-eu", input: "prod-eu\n", confirmed: true, prompt: "Type the namespace name 'prod-eu' to confirm"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out := withConfirmInput(t, tc.input)
+
+			kind := "pod"
+			if tc.resource == "" {
+				kind = "3 pods"
+			}
+			confirmed, err := ConfirmDestructive("delete", kind, tc.namespace, tc.resource, tc.force)
+			require.NoError(t, err)
+			assert.Equal(t, tc.confirmed, confirmed)
+			if tc.prompt == "" {
+				assert.Empty(t, out.String())
+			} else {
+				assert.Contains(t, out.String(), tc.prompt)
+			}
+		})
+	}
+}
+
+func TestConfirmByName(t *testing.T) {
+	out := withConfirmInput(t, "team-a\n")
+	confirmed, err := ConfirmByName("delete", "namespace", "team-a", false)
+	require.NoError(t, err)
+	assert.True(t, confirmed)
+	assert.Contains(t, out.String(), "Type the namespace name 'team-a' to confirm")
+
+	out = withConfirmInput(t, "yes\n")
+	confirmed, err = ConfirmByName("delete", "namespace", "team-a", false)
+	require.NoError(t, err)
+	assert.False(t, confirmed, "y is not enough")
+	assert.Contains(t, out.String(), "The name did not match")
+
+	out = withConfirmInput(t, "")
+	confirmed, err = ConfirmByName("delete", "namespace", "team-a", true)
+	require.NoError(t, err)
+	assert.True(t, confirmed)
+	assert.Empty(t, out.String())
+}
@@ -0,0 +1,22 @@
+package ui
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// newModelContext returns the context a model uses for its API calls. It is
+// cancelled when the model quits so that slow requests are abandoned as soon
+// as the user backs out.
+func newModelContext() (context.Context, context.CancelFunc) {
+	return context.WithCancel(context.Background())
+}
+
+// quitModel cancels a model's in-flight requests and quits the program
+func quitModel(cancel context.CancelFunc) tea.Cmd {
+	if cancel != nil {
+		cancel()
+	}
+	return tea.Quit
+}
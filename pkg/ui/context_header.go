@@ -0,0 +1,98 @@
+package ui
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/karthickk/k8s-manager/pkg/config"
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+)
+
+var (
+	contextHeaderStyle     lipgloss.Style
+	contextHeaderProdStyle lipgloss.Style
+)
+
+// buildContextHeaderStyles sets the context header styles from the theme
+func buildContextHeaderStyles(t Theme) {
+	contextHeaderStyle = lipgloss.NewStyle().
+		Foreground(t.Muted)
+
+	contextHeaderProdStyle = lipgloss.NewStyle().
+		Foreground(t.Inverse).
+		Background(t.Error).
+		Bold(true).
+		Padding(0, 1)
+}
+
+// currentContextName caches the context read from the kubeconfig, since
+// views render many times a second. resetCurrentContext clears it after the
+// context is switched.
+var (
+	currentContextMu   sync.Mutex
+	currentContextName *string
+)
+
+// currentContext returns the context clients connect to, or "" if it cannot
+// be read
+func currentContext() string {
+	currentContextMu.Lock()
+	defer currentContextMu.Unlock()
+
+	if currentContextName == nil {
+		name, err := k8s.GetCurrentContext()
+		if err != nil {
+			name = ""
+		}
+		currentContextName = &name
+	}
+	return *currentContextName
+}
+
+// resetCurrentContext makes the next render read the context again
+func resetCurrentContext() {
+	currentContextMu.Lock()
+	defer currentContextMu.Unlock()
+	currentContextName = nil
+}
+
+// renderContextHeader renders the status line shown at the top of every view
+// naming the active context and namespace, so actions are never taken against
+// the wrong cluster by mistake. An empty namespace shows the configured one.
+func renderContextHeader(namespace string) string {
+	cfg := config.Get()
+	if namespace == "" && cfg != nil {
+		namespace = cfg.Namespace()
+	}
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	context := currentContext()
+	prod := cfg != nil && cfg.IsProdContext(context)
+	return formatContextHeader(context, namespace, prod) + "\n"
+}
+
+// formatContextHeader styles the context status line, highlighting contexts
+// that match the production pattern
+func formatContextHeader(context, namespace string, prod bool) string {
+	if context == "" {
+		context = "unknown"
+	}
+
+	line := fmt.Sprintf("⎈ %s │ ns: %s", context, namespace)
+	if prod {
+		return contextHeaderProdStyle.Render("⚠ PRODUCTION " + line)
+	}
+	return contextHeaderStyle.Render(line)
+}
+
+// headerNamespace is the namespace named in the context header of views that
+// can list every namespace
+func headerNamespace(namespace string, allNamespaces bool) string {
+	if allNamespaces {
+		return "all"
+	}
+	return namespace
+}
@@ -0,0 +1,57 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatContextHeader(t *testing.T) {
+	testCases := []struct {
+		name      string
+		context   string
+		namespace string
+		prod      bool
+		contains  []string
+		excludes  []string
+	}{
+		{
+			name:      "regular context",
+			context:   "gke_acme_staging",
+			namespace: "web",
+			contains:  []string{"gke_acme_staging", "ns: web"},
+			excludes:  []string{"PRODUCTION"},
+		},
+		{
+			name:      "production context",
+			context:   "gke_acme_prod",
+			namespace: "payments",
+			prod:      true,
+			contains:  []string{"PRODUCTION", "gke_acme_prod", "ns: payments"},
+		},
+		{
+			name:      "unknown context",
+			context:   "",
+			namespace: "default",
+			contains:  []string{"unknown", "ns: default"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			header := formatContextHeader(tc.context, tc.namespace, tc.prod)
+			for _, want := range tc.contains {
+				assert.Contains(t, header, want)
+			}
+			for _, unwanted := range tc.excludes {
+				assert.NotContains(t, header, unwanted)
+			}
+		})
+	}
+}
+
+func TestHeaderNamespace(t *testing.T) {
+	assert.Equal(t, "web", headerNamespace("web", false))
+	assert.Equal(t, "all", headerNamespace("web", true))
+	assert.Equal(t, "", headerNamespace("", false))
+}
@@ -0,0 +1,114 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/karthickk/k8s-manager/pkg/config"
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/karthickk/k8s-manager/pkg/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// customActionIcon marks the pod actions defined in the configuration
+const customActionIcon = "🧩"
+
+// customActionEnvVars are the variables of the environment passed on to
+// custom pod action commands. Everything else, such as cloud credentials
+// exported in the shell, is withheld.
+var customActionEnvVars = []string{
+	"PATH", "HOME", "USER", "LOGNAME", "SHELL", "TERM", "LANG", "LC_ALL", "TMPDIR", "KUBECONFIG",
+}
+
+// customPodActions returns the valid pod actions of the configuration.
+// Invalid ones are reported when the program starts.
+func customPodActions() []config.CustomPodAction {
+	cfg := config.Get()
+	if cfg == nil {
+		return nil
+	}
+	actions, _ := cfg.CustomPodActions()
+	return actions
+}
+
+// customActionEnv builds the environment of a custom action command: the
+// allowed variables of the current environment and the pod it runs for
+func customActionEnv(environ []string, data config.PodActionData, kubeContext string) []string {
+	allowed := map[string]bool{}
+	for _, name := range customActionEnvVars {
+		allowed[name] = true
+	}
+
+	var env []string
+	for _, entry := range environ {
+		if name, _, _ := strings.Cut(entry, "="); allowed[name] {
+			env = append(env, entry)
+		}
+	}
+
+	return append(env,
+		"K8S_MANAGER_NAMESPACE="+data.Namespace,
+		"K8S_MANAGER_POD="+data.Name,
+		"K8S_MANAGER_CONTAINER="+data.Container,
+		"K8S_MANAGER_CONTEXT="+kubeContext,
+	)
+}
+
+// customActionExec runs a custom pod action through tea.Exec, which hands
+// it the terminal while a program is running
+type customActionExec struct {
+	action config.CustomPodAction
+	pod    PodInfo
+	client *k8s.Client
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+}
+
+func newCustomActionExec(action config.CustomPodAction, pod PodInfo, client *k8s.Client) *customActionExec {
+	return &customActionExec{action: action, pod: pod, client: client, stdin: os.Stdin, stdout: os.Stdout, stderr: os.Stderr}
+}
+
+// Run fills in the command for the pod and its primary container and runs
+// it with the shell
+func (e *customActionExec) Run() error {
+	data := config.PodActionData{Namespace: e.pod.Namespace, Name: e.pod.Name}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	pod, err := e.client.Clientset.CoreV1().Pods(e.pod.Namespace).Get(ctx, e.pod.Name, metav1.GetOptions{})
+	cancel()
+	if err != nil {
+		return fmt.Errorf("failed to get pod %s: %w", e.pod.Name, err)
+	}
+	data.Container = utils.PrimaryContainer(pod)
+
+	command, err := e.action.Render(data)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(e.stdout, "\033[H\033[2J")
+	fmt.Fprintf(e.stdout, "%s %s\n$ %s\n\n", customActionIcon, e.action.Name, command)
+
+	cmd := exec.Command("sh", "-c", command)
+	cmd.Env = customActionEnv(os.Environ(), data, currentContext())
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = e.stdin, e.stdout, e.stderr
+	err = cmd.Run()
+
+	fmt.Fprintln(e.stdout, "\nPress Enter to continue...")
+	fmt.Fscanln(e.stdin)
+
+	if err != nil {
+		return fmt.Errorf("%s failed: %w", e.action.Name, err)
+	}
+	return nil
+}
+
+func (e *customActionExec) SetStdin(r io.Reader)  { e.stdin = r }
+func (e *customActionExec) SetStdout(w io.Writer) { e.stdout = w }
+func (e *customActionExec) SetStderr(w io.Writer) { e.stderr = w }
@@ -0,0 +1,58 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/karthickk/k8s-manager/pkg/config"
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomActionEnv(t *testing.T) {
+	environ := []string{
+		"PATH=/usr/bin",
+		"HOME=/home/dev",
+		"KUBECONFIG=/home/dev/.kube/config",
+		"AWS_SECRET_ACCESS_KEY=hunter2",
+		"GITHUB_TOKEN=ghp_x",
+		"PATHEXT=.exe",
+	}
+	data := config.PodActionData{Namespace: "shop", Name: "web-0", Container: "app"}
+
+	assert.Equal(t, []string{
+		"PATH=/usr/bin",
+		"HOME=/home/dev",
+		"KUBECONFIG=/home/dev/.kube/config",
+		"K8S_MANAGER_NAMESPACE=shop",
+		"K8S_MANAGER_POD=web-0",
+		"K8S_MANAGER_CONTAINER=app",
+		"K8S_MANAGER_CONTEXT=staging",
+	}, customActionEnv(environ, data, "staging"))
+}
+
+func TestEnhancedPodActionsListsCustomActions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "k8s-manager.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`pod_actions:
+  - name: Open in Lens
+    description: Show the pod in Lens
+    command: lens --namespace {{.Namespace}} {{.Name}}
+  - name: Invalid
+    command: echo {{.Unknown}}
+`), 0644))
+	t.Setenv(config.PathEnvVar, path)
+	_, err := config.Load()
+	require.NoError(t, err)
+
+	m := NewEnhancedPodActionsModel(PodInfo{Name: "web-0", Namespace: "shop"}, &k8s.Client{})
+	require.Len(t, m.custom, 1)
+
+	items := m.menu.MenuItems
+	last := items[len(items)-1]
+	assert.Equal(t, "Open in Lens", last.Title)
+	assert.Equal(t, customActionIcon, last.Icon)
+	assert.Equal(t, "Delete Pod", items[len(items)-2].Title, "custom actions follow the built-in ones")
+	assert.Contains(t, m.View(), "Open in Lens")
+}
@@ -0,0 +1,289 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+)
+
+// deploymentScaleState tracks which step of the scale flow is shown
+type deploymentScaleState int
+
+const (
+	scaleStateLoading deploymentScaleState = iota
+	scaleStateInput
+	scaleStateConfirmZero
+	scaleStateScaling
+	scaleStateProgress
+	scaleStateDone
+)
+
+// DeploymentScaleModel asks for a new replica count for a deployment, scales
+// it and follows the rollout until the replicas are ready
+type DeploymentScaleModel struct {
+	client    *k8s.Client
+	namespace string
+	name      string
+	state     deploymentScaleState
+	input     textinput.Model
+	current   k8s.DeploymentProgress
+	replicas  int32
+	progress  k8s.DeploymentProgress
+	scaled    bool
+	message   string
+	err       error
+	ctx       context.Context
+	cancel    context.CancelFunc
+}
+
+type deploymentScaleLoadedMsg struct {
+	progress k8s.DeploymentProgress
+}
+
+type deploymentScaledMsg struct{}
+
+type deploymentScaleProgressMsg struct {
+	progress k8s.DeploymentProgress
+}
+
+type deploymentScaleErrorMsg struct {
+	err error
+}
+
+type deploymentScaleTickMsg struct{}
+
+// NewDeploymentScaleModel creates the scale model for a deployment
+func NewDeploymentScaleModel(client *k8s.Client, namespace, name string) *DeploymentScaleModel {
+	ctx, cancel := newModelContext()
+
+	input := textinput.New()
+	input.Placeholder = "replicas"
+	input.CharLimit = 10
+	input.Focus()
+
+	return &DeploymentScaleModel{
+		client:    client,
+		namespace: namespace,
+		name:      name,
+		state:     scaleStateLoading,
+		input:     input,
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+}
+
+// quit cancels in-flight requests and exits the program
+func (m *DeploymentScaleModel) quit() tea.Cmd {
+	return quitModel(m.cancel)
+}
+
+func (m *DeploymentScaleModel) Init() tea.Cmd {
+	return tea.Batch(textinput.Blink, m.loadDeployment)
+}
+
+func (m *DeploymentScaleModel) loadDeployment() tea.Msg {
+	ctx, cancel := context.WithTimeout(m.ctx, 10*time.Second)
+	defer cancel()
+
+	progress, err := k8s.GetDeploymentProgress(ctx, m.client.Clientset, m.namespace, m.name)
+	if err != nil {
+		return deploymentScaleErrorMsg{err}
+	}
+	return deploymentScaleLoadedMsg{progress: progress}
+}
+
+func (m *DeploymentScaleModel) scale() tea.Msg {
+	ctx, cancel := context.WithTimeout(m.ctx, 10*time.Second)
+	defer cancel()
+
+	if err := k8s.ScaleDeployment(ctx, m.client.Clientset, m.namespace, m.name, m.replicas); err != nil {
+		return deploymentScaleErrorMsg{err}
+	}
+	return deploymentScaledMsg{}
+}
+
+func (m *DeploymentScaleModel) pollProgress() tea.Msg {
+	ctx, cancel := context.WithTimeout(m.ctx, 10*time.Second)
+	defer cancel()
+
+	progress, err := k8s.GetDeploymentProgress(ctx, m.client.Clientset, m.namespace, m.name)
+	if err != nil {
+		return deploymentScaleErrorMsg{err}
+	}
+	return deploymentScaleProgressMsg{progress: progress}
+}
+
+func (m *DeploymentScaleModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case deploymentScaleLoadedMsg:
+		m.current = msg.progress
+		m.state = scaleStateInput
+		m.input.SetValue(fmt.Sprintf("%d", msg.progress.Desired))
+		m.input.CursorEnd()
+		return m, nil
+
+	case deploymentScaledMsg:
+		m.scaled = true
+		m.state = scaleStateProgress
+		return m, m.pollProgress
+
+	case deploymentScaleProgressMsg:
+		m.progress = msg.progress
+		if msg.progress.Done() {
+			m.state = scaleStateDone
+			return m, nil
+		}
+		return m, tea.Tick(2*time.Second, func(time.Time) tea.Msg { return deploymentScaleTickMsg{} })
+
+	case deploymentScaleTickMsg:
+		if m.state != scaleStateProgress {
+			return m, nil
+		}
+		return m, m.pollProgress
+
+	case deploymentScaleErrorMsg:
+		m.err = msg.err
+		return m, nil
+
+	case tea.KeyMsg:
+		return m.handleKey(msg)
+	}
+
+	return m, nil
+}
+
+func (m *DeploymentScaleModel) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.String() == "ctrl+c" {
+		return m, m.quit()
+	}
+
+	if m.err != nil {
+		return m, m.quit()
+	}
+
+	switch m.state {
+	case scaleStateInput:
+		switch msg.String() {
+		case "esc":
+			return m, m.quit()
+		case "enter":
+			replicas, err := k8s.ParseReplicas(m.input.Value())
+			if err != nil {
+				m.message = err.Error()
+				return m, nil
+			}
+			m.message = ""
+			m.replicas = replicas
+			if replicas == 0 && m.current.Desired > 0 {
+				m.state = scaleStateConfirmZero
+				return m, nil
+			}
+			m.state = scaleStateScaling
+			return m, m.scale
+		}
+
+		var cmd tea.Cmd
+		m.input, cmd = m.input.Update(msg)
+		return m, cmd
+
+	case scaleStateConfirmZero:
+		switch strings.ToLower(msg.String()) {
+		case "y":
+			m.state = scaleStateScaling
+			return m, m.scale
+		case "n", "esc":
+			m.state = scaleStateInput
+		}
+		return m, nil
+
+	case scaleStateProgress, scaleStateDone:
+		switch msg.String() {
+		case "q", "esc", "enter":
+			return m, m.quit()
+		}
+	}
+
+	return m, nil
+}
+
+func (m *DeploymentScaleModel) View() string {
+	var s strings.Builder
+
+	s.WriteString(renderContextHeader(m.namespace))
+	s.WriteString(devToolsTitleStyle.Render(fmt.Sprintf("📏 Scale Deployment: %s", m.name)))
+	s.WriteString("\n\n")
+	s.WriteString(devToolsItemStyle.Render(fmt.Sprintf("Namespace: %s", m.namespace)))
+	s.WriteString("\n\n")
+
+	if m.err != nil {
+		s.WriteString(devToolsErrorStyle.Render(fmt.Sprintf("❌ Error: %v", m.err)))
+		s.WriteString("\n\n")
+		s.WriteString(devToolsHelpStyle.Render("Press any key to exit"))
+		return devToolsContainerStyle.Render(s.String())
+	}
+
+	switch m.state {
+	case scaleStateLoading:
+		s.WriteString(devToolsItemStyle.Render("Loading deployment..."))
+
+	case scaleStateInput:
+		s.WriteString(devToolsItemStyle.Render(fmt.Sprintf("Current replicas: %s", m.current)))
+		s.WriteString("\n\n")
+		s.WriteString(devToolsItemStyle.Render("New replica count: "))
+		s.WriteString(m.input.View())
+		if m.message != "" {
+			s.WriteString("\n\n")
+			s.WriteString(devToolsErrorStyle.Render("⚠️  " + m.message))
+		}
+		s.WriteString("\n\n")
+		s.WriteString(devToolsHelpStyle.Render("enter: scale • esc: cancel"))
+
+	case scaleStateConfirmZero:
+		s.WriteString(devToolsWarningStyle.Render(fmt.Sprintf(
+			"⚠️  Scaling to 0 will stop all %d pods of this deployment.", m.current.Desired)))
+		s.WriteString("\n\n")
+		s.WriteString(devToolsHelpStyle.Render("Continue? (y/N)"))
+
+	case scaleStateScaling:
+		s.WriteString(devToolsItemStyle.Render(fmt.Sprintf("Scaling to %d replicas...", m.replicas)))
+
+	case scaleStateProgress:
+		s.WriteString(devToolsInfoStyle.Render(fmt.Sprintf("⏳ Waiting for %d replicas: %s", m.replicas, m.progress)))
+		s.WriteString("\n\n")
+		s.WriteString(devToolsHelpStyle.Render("q: stop watching (scaling continues in the cluster)"))
+
+	case scaleStateDone:
+		s.WriteString(devToolsSuccessStyle.Render(fmt.Sprintf("✅ Scaled to %d replicas: %s", m.replicas, m.progress)))
+		s.WriteString("\n\n")
+		s.WriteString(devToolsHelpStyle.Render("Press enter or q to exit"))
+	}
+
+	return devToolsContainerStyle.Render(s.String())
+}
+
+// ShowDeploymentScale shows the interactive scale interface for a deployment
+func ShowDeploymentScale(client *k8s.Client, namespace, name string) error {
+	model := NewDeploymentScaleModel(client, namespace, name)
+	p := tea.NewProgram(model, tea.WithAltScreen())
+
+	result, err := p.Run()
+	if err != nil {
+		return err
+	}
+
+	if m, ok := result.(*DeploymentScaleModel); ok {
+		if m.err != nil {
+			return m.err
+		}
+		if m.scaled {
+			fmt.Printf("✅ Deployment '%s' scaled to %d replicas in namespace '%s'\n", m.name, m.replicas, m.namespace)
+		}
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package ui
 
 import (
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/charmbracelet/bubbles/spinner"
@@ -40,51 +41,68 @@ func ShowDevToolsInterface() error {
 
 			// Handle the selection
 			switch menu.selected {
-			case 0: // Pods Manager
+			case 0: // Favorites
+				if err := showDevToolsFavorites(); err != nil {
+					fmt.Printf("Error: %v\n", err)
+					fmt.Println("\nPress Enter to continue...")
+					fmt.Scanln()
+				}
+
+			case 1: // Pods Manager
 				if err := showDevToolsPods(); err != nil {
 					fmt.Printf("Error: %v\n", err)
 					fmt.Println("\nPress Enter to continue...")
 					fmt.Scanln()
 				}
 
-			case 1: // Deployments
-				fmt.Println("\n📦 Deployments feature coming soon!")
-				fmt.Println("\nPress Enter to continue...")
-				fmt.Scanln()
+			case 2: // Deployments
+				if err := showDevToolsDeployments(); err != nil {
+					fmt.Printf("Error: %v\n", err)
+					fmt.Println("\nPress Enter to continue...")
+					fmt.Scanln()
+				}
 
-			case 2: // Services
-				fmt.Println("\n🌐 Services feature coming soon!")
-				fmt.Println("\nPress Enter to continue...")
-				fmt.Scanln()
+			case 3: // Services
+				if err := showDevToolsServices(); err != nil {
+					fmt.Printf("Error: %v\n", err)
+					fmt.Println("\nPress Enter to continue...")
+					fmt.Scanln()
+				}
 
-			case 3: // ConfigMaps & Secrets
+			case 4: // ConfigMaps & Secrets
 				if err := showDevToolsSecrets(); err != nil {
 					fmt.Printf("Error: %v\n", err)
 					fmt.Println("\nPress Enter to continue...")
 					fmt.Scanln()
 				}
 
-			case 4: // Namespaces
-				fmt.Println("\n🏷️ Namespaces feature coming soon!")
-				fmt.Println("\nPress Enter to continue...")
-				fmt.Scanln()
+			case 5: // Namespaces
+				if err := showDevToolsNamespaces(); err != nil {
+					fmt.Printf("Error: %v\n", err)
+					fmt.Println("\nPress Enter to continue...")
+					fmt.Scanln()
+				}
 
-			case 5: // Nodes & Cluster
-				fmt.Println("\n🖥️ Nodes & Cluster feature coming soon!")
-				fmt.Println("\nPress Enter to continue...")
-				fmt.Scanln()
+			case 6: // Cluster Info
+				if _, err := tea.NewProgram(NewDevToolsClusterInfoModel(), tea.WithAltScreen()).Run(); err != nil {
+					fmt.Printf("Error: %v\n", err)
+					fmt.Println("\nPress Enter to continue...")
+					fmt.Scanln()
+				}
 
-			case 6: // Logs & Events
+			case 7: // Logs & Events
 				fmt.Println("\n📊 Logs & Events feature coming soon!")
 				fmt.Println("\nPress Enter to continue...")
 				fmt.Scanln()
 
-			case 7: // Configuration
-				fmt.Println("\n⚙️ Configuration feature coming soon!")
-				fmt.Println("\nPress Enter to continue...")
-				fmt.Scanln()
+			case 8: // Switch Context
+				if err := showDevToolsContexts(); err != nil {
+					fmt.Printf("Error: %v\n", err)
+					fmt.Println("\nPress Enter to continue...")
+					fmt.Scanln()
+				}
 
-			case 8: // Exit
+			case 9: // Exit
 				fmt.Println("👋 Goodbye!")
 				return nil
 
@@ -98,79 +116,13 @@ func ShowDevToolsInterface() error {
 }
 
 func showDevToolsPods() error {
-	// Show namespace selection menu in DevTools style
 	fmt.Print("\033[H\033[2J") // Clear screen before namespace menu
 
-	namespaceMenu := NewDevToolsMenu("📦 Namespace Selection", []DevToolsMenuItem{
-		{
-			Number:      "1",
-			Title:       "Current Namespace",
-			Description: "Use the current context's namespace",
-		},
-		{
-			Number:      "2",
-			Title:       "All Namespaces",
-			Description: "Show pods from all namespaces",
-		},
-		{
-			Number:      "3",
-			Title:       "Specific Namespace",
-			Description: "Enter a specific namespace name",
-		},
-		{
-			Number:      "0",
-			Title:       "Back to Main Menu",
-			Description: "Return to the main menu",
-		},
-	})
-
-	p := tea.NewProgram(namespaceMenu, tea.WithAltScreen())
-	model, err := p.Run()
-	if err != nil {
+	namespace, allNamespaces, ok, err := selectDevToolsNamespaceScope("📦 Namespace Selection", "pods")
+	if err != nil || !ok {
 		return err
 	}
 
-	menu, ok := model.(*DevToolsMenu)
-	if !ok {
-		return nil
-	}
-
-	if menu.quitting || menu.selected < 0 {
-		return nil
-	}
-
-	namespace := ""
-	allNamespaces := false
-
-	switch menu.selected {
-	case 0: // Current namespace
-		// Use default
-	case 1: // All namespaces
-		allNamespaces = true
-	case 2: // Specific namespace
-		// Show namespace selector with instant number selection
-		nsModel := NewDevToolsNamespaceModel()
-		nsProgram := tea.NewProgram(nsModel, tea.WithAltScreen())
-
-		nsResult, err := nsProgram.Run()
-		if err != nil {
-			return err
-		}
-
-		if nsM, ok := nsResult.(*DevToolsNamespaceModel); ok {
-			namespace = nsM.GetSelectedNamespace()
-			if namespace == "" {
-				return nil // User cancelled
-			}
-		} else {
-			return nil
-		}
-	case 3: // Back
-		return nil
-	default:
-		return nil
-	}
-
 	// Show pods interface
 	for {
 		podsModel := NewDevToolsPodsModel(namespace, allNamespaces)
@@ -291,7 +243,7 @@ func showDevToolsPodActions(pod PodInfo) error {
 		{
 			Number:      "9",
 			Title:       "Resource Usage",
-			Description: "Show CPU and memory metrics",
+			Description: "CPU and memory usage against requests and limits",
 		},
 		{
 			Number:      "0",
@@ -340,11 +292,7 @@ func showDevToolsPodActions(pod PodInfo) error {
 				}
 				return nil
 			case 4: // Port Forward
-				msg := enhancedModel.portForward()
-				if errMsg, ok := msg.(actionResultMsg); ok && errMsg.err != nil {
-					return errMsg.err
-				}
-				return nil
+				return runPortForward(pod, client, os.Stdin, os.Stdout, os.Stderr)
 			case 5: // Restart Pod
 				msg := enhancedModel.restartPod()
 				if errMsg, ok := msg.(actionResultMsg); ok && errMsg.err != nil {
@@ -421,7 +369,7 @@ type loadingCompleteMsg struct{}
 func NewPodActionsLoadingModel(podName string) PodActionsLoadingModel {
 	s := spinner.New()
 	s.Spinner = spinner.Dot
-	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	s.Style = lipgloss.NewStyle().Foreground(currentTheme.Title)
 	return PodActionsLoadingModel{
 		spinner: s,
 		podName: podName,
@@ -465,15 +413,15 @@ func (m PodActionsLoadingModel) View() string {
 		Height(12).
 		Align(lipgloss.Center, lipgloss.Center).
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("62")).
+		BorderForeground(currentTheme.Border).
 		Padding(1, 2)
 
 	titleStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("220")).
+		Foreground(currentTheme.Warning).
 		Bold(true)
 
 	spinnerStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("205"))
+		Foreground(currentTheme.Title)
 
 	content := fmt.Sprintf("\n\n%s Loading actions for pod\n\n%s\n\n",
 		spinnerStyle.Render(m.spinner.View()),
@@ -481,7 +429,7 @@ func (m PodActionsLoadingModel) View() string {
 
 	// Add a subtle message
 	msgStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("245")).
+		Foreground(currentTheme.Muted).
 		Italic(true)
 	content += msgStyle.Render("Please wait...")
 
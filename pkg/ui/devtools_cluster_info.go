@@ -0,0 +1,187 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+)
+
+// DevToolsClusterInfoModel shows the server version, size and health of the
+// current cluster
+type DevToolsClusterInfoModel struct {
+	info    *k8s.ClusterInfo
+	loading bool
+	err     error
+	ctx     context.Context
+	cancel  context.CancelFunc
+}
+
+type clusterInfoLoadedMsg struct {
+	info *k8s.ClusterInfo
+}
+
+type clusterInfoErrorMsg struct {
+	err error
+}
+
+// NewDevToolsClusterInfoModel creates a new cluster info view
+func NewDevToolsClusterInfoModel() *DevToolsClusterInfoModel {
+	ctx, cancel := newModelContext()
+
+	return &DevToolsClusterInfoModel{
+		loading: true,
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+}
+
+// quit cancels in-flight requests and exits the program
+func (m *DevToolsClusterInfoModel) quit() tea.Cmd {
+	return quitModel(m.cancel)
+}
+
+func (m *DevToolsClusterInfoModel) Init() tea.Cmd {
+	return m.loadClusterInfo
+}
+
+func (m *DevToolsClusterInfoModel) loadClusterInfo() tea.Msg {
+	client, err := k8s.NewClient()
+	if err != nil {
+		return clusterInfoErrorMsg{err}
+	}
+
+	ctx, cancel := context.WithTimeout(m.ctx, 15*time.Second)
+	defer cancel()
+
+	info, err := client.GetClusterInfo(ctx)
+	if err != nil {
+		return clusterInfoErrorMsg{err}
+	}
+	return clusterInfoLoadedMsg{info: info}
+}
+
+func (m *DevToolsClusterInfoModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case clusterInfoLoadedMsg:
+		m.loading = false
+		m.info = msg.info
+		m.err = nil
+		return m, nil
+
+	case clusterInfoErrorMsg:
+		m.loading = false
+		m.err = msg.err
+		return m, nil
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "0", "b", "q", "ctrl+c", "esc":
+			return m, m.quit()
+
+		case "r":
+			if !m.loading {
+				m.loading = true
+				return m, m.loadClusterInfo
+			}
+		}
+	}
+
+	return m, nil
+}
+
+func (m *DevToolsClusterInfoModel) View() string {
+	var s strings.Builder
+
+	s.WriteString(renderContextHeader(""))
+
+	s.WriteString(devToolsTitleStyle.Render("🖥️ Cluster Info"))
+	s.WriteString("\n\n")
+
+	switch {
+	case m.loading:
+		s.WriteString(devToolsItemStyle.Render("Checking the cluster..."))
+	case m.err != nil:
+		s.WriteString(devToolsErrorStyle.Render("❌ Cannot reach the cluster: " + m.err.Error()))
+	default:
+		m.renderInfo(&s)
+	}
+
+	s.WriteString("\n\n")
+	s.WriteString(devToolsHelpStyle.Render("r refresh • 0/b back • q quit"))
+
+	return devToolsContainerStyle.Render(s.String())
+}
+
+// renderInfo writes the loaded cluster info
+func (m *DevToolsClusterInfoModel) renderInfo(s *strings.Builder) {
+	info := m.info
+
+	version := info.Version
+	if info.Platform != "" {
+		version += " (" + info.Platform + ")"
+	}
+	s.WriteString(clusterInfoRow("Server version", version))
+
+	nodes := fmt.Sprintf("%d (%d ready)", info.Nodes, info.ReadyNodes)
+	if info.ReadyNodes < info.Nodes {
+		nodes = devToolsWarningStyle.Render(nodes)
+	}
+	s.WriteString(clusterInfoRow("Nodes", nodes))
+	s.WriteString(clusterInfoRow("Namespaces", fmt.Sprint(info.Namespaces)))
+
+	s.WriteString("\n")
+	s.WriteString(devToolsItemStyle.Render("API Server Health"))
+	s.WriteString("\n")
+	for _, check := range info.Checks {
+		s.WriteString(healthRow(check.Endpoint, check.Healthy, check.Detail))
+	}
+
+	s.WriteString("\n")
+	s.WriteString(devToolsItemStyle.Render("Components"))
+	s.WriteString("\n")
+	if len(info.Components) == 0 {
+		s.WriteString(devToolsDescriptionStyle.Render("   Not reported by this cluster"))
+		s.WriteString("\n")
+	}
+	for _, component := range info.Components {
+		detail := ""
+		if !component.Healthy {
+			detail = component.Message
+		}
+		s.WriteString(healthRow(component.Name, component.Healthy, detail))
+	}
+}
+
+func clusterInfoRow(label, value string) string {
+	return devToolsDescriptionStyle.Render(fmt.Sprintf("   %-16s", label)) + value + "\n"
+}
+
+// healthRow renders a named check as healthy or failing, with the reason it
+// failed
+func healthRow(name string, healthy bool, detail string) string {
+	if healthy {
+		return "   " + devToolsSuccessStyle.Render("✅ "+name) + "\n"
+	}
+
+	row := "   " + devToolsErrorStyle.Render("❌ "+name)
+	if reason := healthFailure(detail); reason != "" {
+		row += devToolsDescriptionStyle.Render("  " + reason)
+	}
+	return row + "\n"
+}
+
+// healthFailure picks the line explaining a failed check. Health endpoints
+// list every check they ran, marking the failing ones with [-].
+func healthFailure(detail string) string {
+	lines := strings.Split(detail, "\n")
+	for _, line := range lines {
+		if strings.HasPrefix(line, "[-]") {
+			return line
+		}
+	}
+	return lines[0]
+}
@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDevToolsClusterInfoView(t *testing.T) {
+	m := NewDevToolsClusterInfoModel()
+	t.Cleanup(m.cancel)
+	assert.Contains(t, m.View(), "Checking the cluster...")
+
+	m.Update(clusterInfoLoadedMsg{info: &k8s.ClusterInfo{
+		Version:    "v1.30.2",
+		Platform:   "linux/amd64",
+		Nodes:      3,
+		ReadyNodes: 2,
+		Namespaces: 12,
+		Checks: []k8s.HealthCheck{
+			{Endpoint: "/healthz", Healthy: true},
+			{Endpoint: "/readyz", Detail: "[+]ping ok\n[-]etcd failed: reason withheld\nreadyz check failed"},
+		},
+	}})
+
+	view := m.View()
+	assert.Contains(t, view, "v1.30.2 (linux/amd64)")
+	assert.Contains(t, view, "3 (2 ready)")
+	assert.Contains(t, view, "✅ /healthz")
+	assert.Contains(t, view, "❌ /readyz")
+	assert.Contains(t, view, "[-]etcd failed: reason withheld")
+	assert.NotContains(t, view, "readyz check failed")
+	assert.Contains(t, view, "Not reported by this cluster")
+}
+
+func TestHealthFailure(t *testing.T) {
+	testCases := []struct {
+		name   string
+		detail string
+		want   string
+	}{
+		{name: "failing check", detail: "[+]ping ok\n[-]etcd failed: reason withheld\nhealthz check failed", want: "[-]etcd failed: reason withheld"},
+		{name: "plain error", detail: "connection refused", want: "connection refused"},
+		{name: "empty", detail: "", want: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, healthFailure(tc.detail))
+		})
+	}
+}
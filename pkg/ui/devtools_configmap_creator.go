@@ -0,0 +1,407 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/karthickk/k8s-manager/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConfigMapCreatorModel manages the creation of new config maps, in the same
+// steps as SecretCreatorModel without the type
+type ConfigMapCreatorModel struct {
+	client         *k8s.Client
+	step           int // 0: name, 1: namespace, 2: add data, 3: review
+	name           string
+	namespace      string
+	data           map[string]string
+	nameInput      textinput.Model
+	namespaceInput textinput.Model
+	keyInput       textinput.Model
+	valueInput     textinput.Model
+	currentKey     string
+	created        bool
+	message        string
+	messageType    string
+	err            error
+	ctx            context.Context
+	cancel         context.CancelFunc
+}
+
+// NewConfigMapCreatorModel creates a new config map creator model
+func NewConfigMapCreatorModel(namespace string) *ConfigMapCreatorModel {
+	nameInput := textinput.New()
+	nameInput.Placeholder = "my-config"
+	nameInput.CharLimit = 253
+	nameInput.Focus()
+
+	namespaceInput := textinput.New()
+	namespaceInput.Placeholder = "default"
+	namespaceInput.CharLimit = 63
+	if namespace != "" {
+		namespaceInput.SetValue(namespace)
+	}
+
+	keyInput := textinput.New()
+	keyInput.Placeholder = "key"
+	keyInput.CharLimit = 253
+
+	valueInput := textinput.New()
+	valueInput.Placeholder = "value"
+	valueInput.CharLimit = 4096
+
+	ctx, cancel := newModelContext()
+
+	return &ConfigMapCreatorModel{
+		namespace:      namespace,
+		data:           make(map[string]string),
+		nameInput:      nameInput,
+		namespaceInput: namespaceInput,
+		keyInput:       keyInput,
+		valueInput:     valueInput,
+		ctx:            ctx,
+		cancel:         cancel,
+	}
+}
+
+// quit cancels in-flight requests and exits the program
+func (m *ConfigMapCreatorModel) quit() tea.Cmd {
+	return quitModel(m.cancel)
+}
+
+func (m *ConfigMapCreatorModel) Init() tea.Cmd {
+	return m.loadClient
+}
+
+func (m *ConfigMapCreatorModel) loadClient() tea.Msg {
+	if m.client != nil {
+		return configMapCreatorClientMsg{m.client}
+	}
+	client, err := k8s.NewClient()
+	if err != nil {
+		return configMapCreatorErrorMsg{err}
+	}
+	return configMapCreatorClientMsg{client}
+}
+
+type configMapCreatorErrorMsg struct{ err error }
+type configMapCreatorClientMsg struct{ client *k8s.Client }
+type configMapCreatedMsg struct{}
+
+func (m *ConfigMapCreatorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case configMapCreatorClientMsg:
+		m.client = msg.client
+		if m.namespace == "" {
+			m.namespace = m.client.GetNamespace()
+			m.namespaceInput.SetValue(m.namespace)
+		}
+		return m, nil
+
+	case configMapCreatorErrorMsg:
+		// A failed create leaves the review open so it can be retried
+		if m.step == 3 {
+			m.message = msg.err.Error()
+			m.messageType = "error"
+			return m, nil
+		}
+		m.err = msg.err
+		return m, nil
+
+	case configMapCreatedMsg:
+		m.created = true
+		m.message = "ConfigMap created successfully!"
+		m.messageType = "success"
+		return m, m.quit()
+
+	case tea.KeyMsg:
+		keyStr := msg.String()
+
+		switch m.step {
+		case 0: // Enter name
+			switch keyStr {
+			case "enter":
+				m.name = m.nameInput.Value()
+				if err := utils.ValidateResourceName(m.name); err != nil {
+					m.message = err.Error()
+					m.messageType = "error"
+				} else {
+					m.message = ""
+					m.step = 1
+					m.namespaceInput.Focus()
+					m.nameInput.Blur()
+				}
+				return m, nil
+
+			case "esc", "ctrl+c":
+				return m, m.quit()
+
+			default:
+				var cmd tea.Cmd
+				m.nameInput, cmd = m.nameInput.Update(msg)
+				return m, cmd
+			}
+
+		case 1: // Enter namespace
+			switch keyStr {
+			case "enter":
+				m.namespace = m.namespaceInput.Value()
+				if m.namespace == "" {
+					m.namespace = "default"
+				}
+				m.step = 2
+				m.namespaceInput.Blur()
+				m.keyInput.Focus()
+				return m, nil
+
+			case "esc":
+				m.step = 0
+				m.nameInput.Focus()
+				m.namespaceInput.Blur()
+				return m, nil
+
+			case "ctrl+c":
+				return m, m.quit()
+
+			default:
+				var cmd tea.Cmd
+				m.namespaceInput, cmd = m.namespaceInput.Update(msg)
+				return m, cmd
+			}
+
+		case 2: // Add data
+			if m.keyInput.Focused() {
+				switch keyStr {
+				case "enter":
+					key := m.keyInput.Value()
+					if err := utils.ValidateDataKey(key); err != nil {
+						m.message = err.Error()
+						m.messageType = "error"
+						return m, nil
+					}
+					m.currentKey = key
+					m.message = ""
+					m.keyInput.Blur()
+					m.valueInput.Focus()
+					return m, nil
+
+				case "esc":
+					m.keyInput.Blur()
+					if len(m.data) > 0 {
+						m.step = 3
+					} else {
+						m.step = 1
+						m.namespaceInput.Focus()
+					}
+					return m, nil
+
+				case "ctrl+c":
+					return m, m.quit()
+
+				default:
+					var cmd tea.Cmd
+					m.keyInput, cmd = m.keyInput.Update(msg)
+					return m, cmd
+				}
+			} else if m.valueInput.Focused() {
+				switch keyStr {
+				case "enter":
+					m.data[m.currentKey] = m.valueInput.Value()
+					m.message = fmt.Sprintf("Added key: %s", m.currentKey)
+					m.messageType = "success"
+					m.keyInput.SetValue("")
+					m.valueInput.SetValue("")
+					m.currentKey = ""
+					m.valueInput.Blur()
+					m.keyInput.Focus()
+					return m, nil
+
+				case "esc":
+					m.valueInput.Blur()
+					m.keyInput.Focus()
+					return m, nil
+
+				case "ctrl+c":
+					return m, m.quit()
+
+				default:
+					var cmd tea.Cmd
+					m.valueInput, cmd = m.valueInput.Update(msg)
+					return m, cmd
+				}
+			}
+
+		case 3: // Review and create
+			switch keyStr {
+			case "c": // Create
+				return m, m.createConfigMap()
+
+			case "b": // Back
+				m.step = 2
+				m.keyInput.Focus()
+				return m, nil
+
+			case "esc", "ctrl+c":
+				return m, m.quit()
+			}
+		}
+	}
+
+	return m, nil
+}
+
+func (m *ConfigMapCreatorModel) View() string {
+	var s strings.Builder
+
+	s.WriteString(renderContextHeader(m.namespace))
+
+	s.WriteString(devToolsTitleStyle.Render("📋 Create New ConfigMap"))
+	s.WriteString("\n\n")
+
+	if m.err != nil {
+		s.WriteString(devToolsErrorStyle.Render("Error: " + m.err.Error()))
+		s.WriteString("\n\n")
+		s.WriteString(devToolsHelpStyle.Render("Press ctrl+c to exit"))
+		return devToolsContainerStyle.Render(s.String())
+	}
+
+	switch m.step {
+	case 0: // Name input
+		s.WriteString(devToolsNumberStyle.Render("Step 1: Enter ConfigMap Name"))
+		s.WriteString("\n\n")
+		s.WriteString("Name: ")
+		s.WriteString(m.nameInput.View())
+		s.WriteString("\n\n")
+		s.WriteString(devToolsHelpStyle.Render("enter to continue • esc to cancel"))
+
+	case 1: // Namespace input
+		s.WriteString(devToolsNumberStyle.Render("Step 2: Enter Namespace"))
+		s.WriteString("\n\n")
+		s.WriteString("Namespace: ")
+		s.WriteString(m.namespaceInput.View())
+		s.WriteString("\n\n")
+		s.WriteString(devToolsHelpStyle.Render("enter to continue • esc to go back"))
+
+	case 2: // Add data
+		s.WriteString(devToolsNumberStyle.Render("Step 3: Add Data"))
+		s.WriteString("\n\n")
+
+		if len(m.data) > 0 {
+			s.WriteString(devToolsInfoStyle.Render("Current data:"))
+			s.WriteString("\n")
+			m.writeData(&s, 30)
+			s.WriteString("\n")
+		}
+
+		if m.valueInput.Focused() {
+			s.WriteString("Key: " + devToolsInfoStyle.Render(m.currentKey))
+			s.WriteString("\nValue: ")
+			s.WriteString(m.valueInput.View())
+			s.WriteString("\n\n")
+			s.WriteString(devToolsHelpStyle.Render("enter to save • esc to cancel"))
+		} else {
+			s.WriteString("Key: ")
+			s.WriteString(m.keyInput.View())
+			s.WriteString("\n\n")
+			s.WriteString(devToolsHelpStyle.Render("enter to add value • esc to finish"))
+		}
+
+	case 3: // Review
+		s.WriteString(devToolsNumberStyle.Render("Review and Create"))
+		s.WriteString("\n\n")
+
+		s.WriteString(fmt.Sprintf("Name: %s\n", devToolsInfoStyle.Render(m.name)))
+		s.WriteString(fmt.Sprintf("Namespace: %s\n", devToolsInfoStyle.Render(m.namespace)))
+		s.WriteString(fmt.Sprintf("Data Keys: %s\n", devToolsInfoStyle.Render(fmt.Sprintf("%d", len(m.data)))))
+
+		s.WriteString("\nData:\n")
+		m.writeData(&s, 50)
+
+		s.WriteString("\n")
+		s.WriteString(devToolsHelpStyle.Render("c create config map • b back • esc cancel"))
+	}
+
+	if m.message != "" {
+		s.WriteString("\n\n")
+		switch m.messageType {
+		case "success":
+			s.WriteString(devToolsSuccessStyle.Render("✓ " + m.message))
+		case "error":
+			s.WriteString(devToolsErrorStyle.Render("✗ " + m.message))
+		default:
+			s.WriteString(devToolsInfoStyle.Render("• " + m.message))
+		}
+	}
+
+	return devToolsContainerStyle.Render(s.String())
+}
+
+// writeData lists the keys added so far in order, cutting values longer
+// than width
+func (m *ConfigMapCreatorModel) writeData(s *strings.Builder, width int) {
+	keys := make([]string, 0, len(m.data))
+	for key := range m.data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value := m.data[key]
+		if len(value) > width {
+			value = value[:width-3] + "..."
+		}
+		s.WriteString(fmt.Sprintf("  %s = %s\n",
+			devToolsNumberStyle.Render(key),
+			devToolsDescriptionStyle.Render(value)))
+	}
+}
+
+func (m *ConfigMapCreatorModel) createConfigMap() tea.Cmd {
+	return func() tea.Msg {
+		if m.client == nil {
+			return configMapCreatorErrorMsg{fmt.Errorf("not connected to the cluster yet")}
+		}
+
+		ctx, cancel := context.WithTimeout(m.ctx, 30*time.Second)
+		defer cancel()
+
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      m.name,
+				Namespace: m.namespace,
+			},
+			Data: m.data,
+		}
+
+		_, err := m.client.Clientset.CoreV1().ConfigMaps(m.namespace).Create(ctx, configMap, metav1.CreateOptions{FieldManager: k8s.FieldManager()})
+		if err != nil {
+			return configMapCreatorErrorMsg{fmt.Errorf("failed to create config map %s: %w", m.name, err)}
+		}
+
+		return configMapCreatedMsg{}
+	}
+}
+
+// ShowConfigMapCreator shows the config map creation interface and reports
+// whether a config map was created. A nil client connects with the
+// configured context.
+func ShowConfigMapCreator(client *k8s.Client, namespace string) (bool, error) {
+	model := NewConfigMapCreatorModel(namespace)
+	model.client = client
+
+	result, err := tea.NewProgram(model, tea.WithAltScreen()).Run()
+	if err != nil {
+		return false, err
+	}
+
+	m, ok := result.(*ConfigMapCreatorModel)
+	return ok && m.created, nil
+}
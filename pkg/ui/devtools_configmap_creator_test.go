@@ -0,0 +1,73 @@
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigMapCreatorSteps(t *testing.T) {
+	m := NewConfigMapCreatorModel("default")
+	t.Cleanup(m.cancel)
+
+	typeInto(m, "My_Config")
+	assert.Equal(t, 0, m.step)
+	assert.Equal(t, "error", m.messageType)
+
+	m.nameInput.SetValue("")
+	typeInto(m, "app-config")
+	assert.Equal(t, 1, m.step)
+
+	typeInto(m, "")
+	assert.Equal(t, 2, m.step)
+	assert.Equal(t, "default", m.namespace)
+	assert.True(t, m.keyInput.Focused())
+
+	typeInto(m, "db host")
+	assert.Equal(t, "error", m.messageType)
+	assert.True(t, m.keyInput.Focused(), "invalid keys should keep the key input focused")
+
+	m.keyInput.SetValue("")
+	typeInto(m, "DB_HOST")
+	typeInto(m, "postgres:5432")
+	assert.Equal(t, map[string]string{"DB_HOST": "postgres:5432"}, m.data)
+	assert.True(t, m.keyInput.Focused())
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, 3, m.step)
+	assert.Contains(t, m.View(), "DB_HOST")
+
+	// A failed create keeps the review open
+	m.Update(configMapCreatorErrorMsg{assert.AnError})
+	assert.Equal(t, 3, m.step)
+	assert.Nil(t, m.err)
+	assert.Equal(t, "error", m.messageType)
+
+	_, cmd := m.Update(configMapCreatedMsg{})
+	assert.NotNil(t, cmd)
+	assert.True(t, m.created)
+}
+
+func TestConfigMapCreatorEscWithoutDataGoesBack(t *testing.T) {
+	m := NewConfigMapCreatorModel("default")
+	t.Cleanup(m.cancel)
+	m.step = 2
+	m.keyInput.Focus()
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, 1, m.step)
+	assert.True(t, m.namespaceInput.Focused())
+}
+
+func TestConfigMapCreatorUsesTheGivenClient(t *testing.T) {
+	m := NewConfigMapCreatorModel("shop")
+	t.Cleanup(m.cancel)
+	client := &k8s.Client{}
+	m.client = client
+
+	msg, ok := m.loadClient().(configMapCreatorClientMsg)
+	assert.True(t, ok)
+	assert.Same(t, client, msg.client, "the caller's cluster is kept")
+}
@@ -0,0 +1,78 @@
+package ui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+)
+
+// contextMenuItems lists the kubeconfig contexts, marking the active one,
+// followed by Back
+func contextMenuItems(contexts []k8s.KubeContext, current string) []DevToolsMenuItem {
+	items := make([]DevToolsMenuItem, 0, len(contexts)+1)
+	for i, context := range contexts {
+		item := DevToolsMenuItem{
+			Title:       context.Name,
+			Description: fmt.Sprintf("Cluster: %s, User: %s", context.Cluster, context.User),
+		}
+		if context.Namespace != "" {
+			item.Description += ", Namespace: " + context.Namespace
+		}
+		if i < 9 {
+			item.Number = fmt.Sprintf("%d", i+1)
+		}
+		if context.Name == current {
+			item.Badge = "(current)"
+		}
+		items = append(items, item)
+	}
+
+	return append(items, DevToolsMenuItem{
+		Number:      "0",
+		Title:       "Back",
+		Description: "Return to the main menu",
+	})
+}
+
+// showDevToolsContexts lets the user pick a kubeconfig context, saves it and
+// connects to confirm the new cluster is reachable
+func showDevToolsContexts() error {
+	contexts, current, err := k8s.ListContexts()
+	if err != nil {
+		return err
+	}
+	if len(contexts) == 0 {
+		return fmt.Errorf("the kubeconfig has no contexts")
+	}
+
+	items := contextMenuItems(contexts, current)
+	result, err := tea.NewProgram(NewDevToolsMenu("⎈ Switch Context", items), tea.WithAltScreen()).Run()
+	if err != nil {
+		return err
+	}
+
+	menu, ok := result.(*DevToolsMenu)
+	if !ok || menu.quitting || menu.selected < 0 || menu.selected >= len(contexts) {
+		return nil
+	}
+
+	name := contexts[menu.selected].Name
+	if err := k8s.SetContext(name); err != nil {
+		return err
+	}
+	resetCurrentContext()
+
+	fmt.Print("\033[H\033[2J")
+	fmt.Printf("\n✅ Switched to context '%s'\n", name)
+	if client, err := k8s.NewClient(); err != nil {
+		fmt.Printf("⚠️  Could not connect: %v\n", err)
+	} else if version, err := client.Clientset.Discovery().ServerVersion(); err != nil {
+		fmt.Printf("⚠️  Could not reach the API server: %v\n", err)
+	} else {
+		fmt.Printf("   Server version: %s\n", version.GitVersion)
+	}
+	fmt.Println("\nPress Enter to continue...")
+	fmt.Scanln()
+	return nil
+}
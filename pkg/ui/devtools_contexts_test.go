@@ -0,0 +1,25 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContextMenuItems(t *testing.T) {
+	contexts := []k8s.KubeContext{
+		{Name: "gke_acme_prod", Cluster: "prod", User: "admin"},
+		{Name: "staging", Cluster: "staging", User: "dev", Namespace: "web"},
+	}
+
+	items := contextMenuItems(contexts, "staging")
+	require.Len(t, items, 3)
+	assert.Equal(t, "1", items[0].Number)
+	assert.Empty(t, items[0].Badge)
+	assert.Equal(t, "Cluster: staging, User: dev, Namespace: web", items[1].Description)
+	assert.Equal(t, "(current)", items[1].Badge)
+	assert.Equal(t, "0", items[2].Number)
+	assert.Equal(t, "Back", items[2].Title)
+}
@@ -0,0 +1,501 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/karthickk/k8s-manager/pkg/utils"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DeploymentInfo is a deployment as listed in the DevTools deployments view
+type DeploymentInfo struct {
+	Name      string
+	Namespace string
+	Progress  k8s.DeploymentProgress
+	Age       string
+}
+
+// DevToolsDeploymentsModel lists deployments in DevTools style, with their
+// ready, up-to-date and available replicas
+type DevToolsDeploymentsModel struct {
+	deployments   []DeploymentInfo
+	filtered      []DeploymentInfo
+	selected      int
+	window        listWindow // Part of the list on screen
+	filterInput   textinput.Model
+	filtering     bool
+	loading       bool
+	loadingAction bool
+	spinner       AnimatedSpinner
+	client        *k8s.Client
+	namespace     string
+	allNamespaces bool
+	message       string
+	err           error
+	chosen        bool // Whether a deployment was picked for its actions
+	ctx           context.Context
+	cancel        context.CancelFunc
+}
+
+// deploymentsLoadedMsg carries the listed deployments
+type deploymentsLoadedMsg struct {
+	deployments []DeploymentInfo
+	client      *k8s.Client
+}
+
+// NewDevToolsDeploymentsModel creates the DevTools deployments view
+func NewDevToolsDeploymentsModel(namespace string, allNamespaces bool) *DevToolsDeploymentsModel {
+	ti := textinput.New()
+	ti.Placeholder = "Type to filter..."
+	ti.CharLimit = 50
+
+	ctx, cancel := newModelContext()
+
+	return &DevToolsDeploymentsModel{
+		filterInput:   ti,
+		loading:       true,
+		spinner:       NewAnimatedSpinner("spinner", "Loading deployments"),
+		namespace:     namespace,
+		allNamespaces: allNamespaces,
+		selected:      -1,
+		window:        newListWindow(devToolsListHeight),
+		ctx:           ctx,
+		cancel:        cancel,
+	}
+}
+
+// quit cancels in-flight requests and exits the program
+func (m *DevToolsDeploymentsModel) quit() tea.Cmd {
+	return quitModel(m.cancel)
+}
+
+func (m *DevToolsDeploymentsModel) Init() tea.Cmd {
+	return tea.Batch(
+		m.loadDeployments,
+		m.spinner.Init(),
+	)
+}
+
+func (m *DevToolsDeploymentsModel) loadDeployments() tea.Msg {
+	client, err := k8s.NewClient()
+	if err != nil {
+		return errMsg{err}
+	}
+
+	namespace := m.namespace
+	if m.allNamespaces {
+		namespace = ""
+	} else if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	ctx, cancel := context.WithTimeout(m.ctx, 30*time.Second)
+	defer cancel()
+
+	list, err := k8s.ListAll(ctx, metav1.ListOptions{}, 0, client.Clientset.AppsV1().Deployments(namespace).List)
+	if err != nil {
+		return errMsg{fmt.Errorf("failed to list deployments: %w", err)}
+	}
+
+	return deploymentsLoadedMsg{deployments: newDeploymentInfos(list.Items), client: client}
+}
+
+// newDeploymentInfos converts deployments into list entries
+func newDeploymentInfos(deployments []appsv1.Deployment) []DeploymentInfo {
+	infos := make([]DeploymentInfo, 0, len(deployments))
+	for i := range deployments {
+		deployment := &deployments[i]
+		infos = append(infos, DeploymentInfo{
+			Name:      deployment.Name,
+			Namespace: deployment.Namespace,
+			Progress:  k8s.NewDeploymentProgress(deployment),
+			Age:       utils.FormatAge(deployment.CreationTimestamp.Time),
+		})
+	}
+	return infos
+}
+
+func (m *DevToolsDeploymentsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	if m.loading || m.loadingAction {
+		spinner, cmd := m.spinner.Update(msg)
+		m.spinner = spinner
+		if cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+
+	switch msg := msg.(type) {
+	case deploymentsLoadedMsg:
+		m.loading = false
+		m.deployments = msg.deployments
+		m.client = msg.client
+		m.applyFilter()
+		return m, nil
+
+	case errMsg:
+		m.loading = false
+		m.err = msg.err
+		return m, nil
+
+	case tea.KeyMsg:
+		if m.filtering {
+			switch msg.String() {
+			case "esc":
+				m.filtering = false
+				m.filterInput.Blur()
+				m.filterInput.SetValue("")
+				m.applyFilter()
+				return m, nil
+
+			case "enter":
+				m.filtering = false
+				m.filterInput.Blur()
+				m.applyFilter()
+				// A filter that leaves a single deployment opens its actions
+				if len(m.filtered) == 1 {
+					return m, m.choose(0)
+				}
+				return m, nil
+
+			default:
+				var cmd tea.Cmd
+				m.filterInput, cmd = m.filterInput.Update(msg)
+				m.applyFilter()
+				return m, cmd
+			}
+		}
+
+		keyStr := msg.String()
+
+		// Number keys for quick selection
+		if len(keyStr) == 1 && keyStr[0] >= '1' && keyStr[0] <= '8' {
+			num := int(keyStr[0] - '0')
+			if index := m.window.index(num, len(m.filtered)); index >= 0 {
+				return m, m.choose(index)
+			}
+		}
+
+		if selected, ok := m.window.jump(keyStr, m.selected, len(m.filtered)); ok {
+			m.selected = selected
+			m.window.follow(m.selected, len(m.filtered))
+			return m, nil
+		}
+
+		switch keyStr {
+		case "9", "r": // Refresh
+			m.loading = true
+			m.spinner = NewAnimatedSpinner("spinner", "Refreshing deployments")
+			return m, tea.Batch(
+				m.loadDeployments,
+				m.spinner.Init(),
+			)
+
+		case "0", "b", "q", "esc", "ctrl+c": // Back to main menu
+			return m, m.quit()
+
+		case "/":
+			m.filtering = true
+			m.filterInput.Focus()
+			return m, textinput.Blink
+
+		case "up", "k":
+			if m.selected > 0 {
+				m.selected--
+			} else if m.selected == -1 && len(m.filtered) > 0 {
+				m.selected = len(m.filtered) - 1
+			}
+			m.window.follow(m.selected, len(m.filtered))
+
+		case "down", "j":
+			if m.selected < len(m.filtered)-1 {
+				m.selected++
+			} else if m.selected == -1 && len(m.filtered) > 0 {
+				m.selected = 0
+			}
+			m.window.follow(m.selected, len(m.filtered))
+
+		case "enter", " ":
+			if m.selected >= 0 && m.selected < len(m.filtered) {
+				return m, m.choose(m.selected)
+			}
+		}
+	}
+
+	if len(cmds) > 0 {
+		return m, tea.Batch(cmds...)
+	}
+	return m, nil
+}
+
+func (m *DevToolsDeploymentsModel) View() string {
+	var s strings.Builder
+
+	if m.err != nil {
+		return devToolsContainerStyle.Render(devToolsErrorStyle.Render("Error: " + m.err.Error()))
+	}
+
+	s.WriteString(renderContextHeader(headerNamespace(m.namespace, m.allNamespaces)))
+
+	title := "🚀 Kubernetes Deployments"
+	if m.allNamespaces {
+		title += " - all namespaces"
+	} else if m.namespace != "" {
+		title += fmt.Sprintf(" - %s namespace", m.namespace)
+	}
+	s.WriteString(devToolsTitleStyle.Render(title))
+	s.WriteString("\n\n")
+
+	if m.loading || m.loadingAction {
+		s.WriteString("\n")
+		s.WriteString(m.spinner.View())
+		s.WriteString("\n")
+		return devToolsContainerStyle.Render(s.String())
+	}
+
+	if m.filtering {
+		s.WriteString("Filter: ")
+		s.WriteString(m.filterInput.View())
+		s.WriteString("\n\n")
+	}
+
+	if len(m.filtered) == 0 {
+		s.WriteString(devToolsDescriptionStyle.Render("No deployments found"))
+		if m.filterInput.Value() != "" {
+			s.WriteString(devToolsDescriptionStyle.Render(fmt.Sprintf(" matching '%s'", m.filterInput.Value())))
+		}
+		s.WriteString("\n\n")
+	} else {
+		// Show the window of deployments around the selection, numbered
+		// from 1 (leaving 9 for refresh and 0 for back)
+		start, end := m.window.bounds(len(m.filtered))
+		if above := m.window.aboveIndicator(len(m.filtered), "deployments"); above != "" {
+			s.WriteString(devToolsDescriptionStyle.Render(above))
+			s.WriteString("\n")
+		}
+
+		for i := start; i < end; i++ {
+			deployment := m.filtered[i]
+
+			name := deployment.Name
+			if i == m.selected {
+				name = devToolsSelectedStyle.Render("▸ " + name)
+			} else {
+				name = "  " + devToolsItemStyle.Render(name)
+			}
+
+			s.WriteString(devToolsNumberStyle.Render(fmt.Sprintf("%d.", i-start+1)) + name + " " + deploymentStatusString(deployment.Progress))
+			s.WriteString("\n")
+
+			progress := deployment.Progress
+			details := fmt.Sprintf("Ready: %d/%d, Up-to-date: %d, Available: %d, Age: %s",
+				progress.Ready, progress.Desired, progress.Updated, progress.Available, deployment.Age)
+			if m.allNamespaces {
+				details = fmt.Sprintf("Namespace: %s, %s", deployment.Namespace, details)
+			}
+			s.WriteString(devToolsDescriptionStyle.Render("   " + details))
+			s.WriteString("\n")
+		}
+
+		if below := m.window.belowIndicator(len(m.filtered), "deployments"); below != "" {
+			s.WriteString(devToolsDescriptionStyle.Render(below))
+			s.WriteString("\n")
+		}
+
+		s.WriteString("\n")
+		s.WriteString(devToolsNumberStyle.Render("9.") + "  " + devToolsItemStyle.Render("Refresh"))
+		s.WriteString("\n")
+		s.WriteString(devToolsDescriptionStyle.Render("   Reload the deployments list"))
+		s.WriteString("\n")
+	}
+
+	s.WriteString(devToolsNumberStyle.Render("0.") + "  " + devToolsItemStyle.Render("Back to Main Menu"))
+	s.WriteString("\n")
+	s.WriteString(devToolsDescriptionStyle.Render("   Return to the main menu"))
+
+	if m.message != "" {
+		s.WriteString("\n")
+		s.WriteString(devToolsInfoStyle.Render(m.message))
+	}
+
+	s.WriteString("\n\n")
+	s.WriteString(devToolsHelpStyle.Render("↑/k up • ↓/j down • pgup/pgdn page • g/G first/last • 1-8 select deployment • 9 refresh • 0 back • / filter • q quit"))
+
+	return devToolsContainerStyle.Render(s.String())
+}
+
+// deploymentStatusString tags a deployment with the state of its rollout
+func deploymentStatusString(progress k8s.DeploymentProgress) string {
+	switch {
+	case progress.Stalled != "":
+		return devToolsErrorStyle.Render("[Stalled]")
+	case progress.Desired == 0:
+		return devToolsDescriptionStyle.Render("[Scaled to 0]")
+	case progress.Done():
+		return devToolsSuccessStyle.Render("[Available]")
+	default:
+		return devToolsWarningStyle.Render("[Progressing]")
+	}
+}
+
+// applyFilter filters the deployments by name and namespace, keeping the
+// selected deployment selected if it is still listed
+func (m *DevToolsDeploymentsModel) applyFilter() {
+	var current *DeploymentInfo
+	if m.selected >= 0 && m.selected < len(m.filtered) {
+		deployment := m.filtered[m.selected]
+		current = &deployment
+	}
+
+	filter := strings.ToLower(m.filterInput.Value())
+	if filter == "" {
+		m.filtered = m.deployments
+	} else {
+		filtered := []DeploymentInfo{}
+		for _, deployment := range m.deployments {
+			if strings.Contains(strings.ToLower(deployment.Name), filter) ||
+				strings.Contains(strings.ToLower(deployment.Namespace), filter) {
+				filtered = append(filtered, deployment)
+			}
+		}
+		m.filtered = filtered
+	}
+
+	m.selected = -1
+	if current != nil {
+		for i, deployment := range m.filtered {
+			if deployment.Namespace == current.Namespace && deployment.Name == current.Name {
+				m.selected = i
+				break
+			}
+		}
+	}
+	m.window.follow(m.selected, len(m.filtered))
+}
+
+// choose selects the deployment at index and quits to show its actions
+func (m *DevToolsDeploymentsModel) choose(index int) tea.Cmd {
+	m.selected = index
+	m.chosen = true
+	m.loadingAction = true
+	m.spinner = NewAnimatedSpinner("spinner", fmt.Sprintf("Loading actions for deployment %s", m.filtered[index].Name))
+	return tea.Batch(
+		m.spinner.Init(),
+		tea.Tick(time.Millisecond*300, func(t time.Time) tea.Msg {
+			return m.quit()()
+		}),
+	)
+}
+
+// GetSelectedDeployment returns the deployment picked for its actions
+func (m *DevToolsDeploymentsModel) GetSelectedDeployment() *DeploymentInfo {
+	if m.chosen && m.selected >= 0 && m.selected < len(m.filtered) {
+		return &m.filtered[m.selected]
+	}
+	return nil
+}
+
+func showDevToolsDeployments() error {
+	fmt.Print("\033[H\033[2J") // Clear screen before namespace menu
+
+	namespace, allNamespaces, ok, err := selectDevToolsNamespaceScope("🚀 Namespace Selection", "deployments")
+	if err != nil || !ok {
+		return err
+	}
+
+	for {
+		result, err := tea.NewProgram(NewDevToolsDeploymentsModel(namespace, allNamespaces), tea.WithAltScreen()).Run()
+		if err != nil {
+			return err
+		}
+
+		model, ok := result.(*DevToolsDeploymentsModel)
+		if !ok {
+			return nil
+		}
+		deployment := model.GetSelectedDeployment()
+		if deployment == nil {
+			return nil
+		}
+
+		if err := showDevToolsDeploymentActions(model.client, *deployment); err != nil {
+			fmt.Printf("Error: %v\n", err)
+		}
+		fmt.Println("\nPress Enter to continue...")
+		fmt.Scanln()
+	}
+}
+
+// showDevToolsDeploymentActions offers scaling, restarting and deleting a
+// deployment
+func showDevToolsDeploymentActions(client *k8s.Client, deployment DeploymentInfo) error {
+	actions := []DevToolsMenuItem{
+		{
+			Number:      "1",
+			Title:       "Scale",
+			Description: fmt.Sprintf("Change the number of replicas (now %d)", deployment.Progress.Desired),
+		},
+		{
+			Number:      "2",
+			Title:       "Restart",
+			Description: "Roll all pods with a new restart annotation",
+		},
+		{
+			Number:      "3",
+			Title:       "Delete",
+			Description: "Delete the deployment and its pods",
+		},
+		{
+			Number:      "0",
+			Title:       "Back to Deployments",
+			Description: "Return to the deployments list",
+		},
+	}
+
+	result, err := tea.NewProgram(NewDevToolsMenu(fmt.Sprintf("🚀 Deployment Actions: %s", deployment.Name), actions), tea.WithAltScreen()).Run()
+	if err != nil {
+		return err
+	}
+	menu, ok := result.(*DevToolsMenu)
+	if !ok || menu.quitting {
+		return nil
+	}
+
+	switch menu.selected {
+	case 0: // Scale
+		return ShowDeploymentScale(client, deployment.Namespace, deployment.Name)
+
+	case 1: // Restart
+		confirmed, err := ConfirmDestructive("restart", "deployment", deployment.Namespace, deployment.Name, false)
+		if err != nil || !confirmed {
+			return err
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		if err := k8s.RestartWorkload(ctx, client.Clientset, k8s.KindDeployment, deployment.Namespace, deployment.Name); err != nil {
+			return err
+		}
+		fmt.Printf("✅ Deployment '%s' restarted in namespace '%s'\n", deployment.Name, deployment.Namespace)
+
+	case 2: // Delete
+		confirmed, err := ConfirmDestructive("delete", "deployment", deployment.Namespace, deployment.Name, false)
+		if err != nil || !confirmed {
+			return err
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		if err := client.Clientset.AppsV1().Deployments(deployment.Namespace).Delete(ctx, deployment.Name, metav1.DeleteOptions{}); err != nil {
+			return fmt.Errorf("failed to delete deployment %s: %w", deployment.Name, err)
+		}
+		fmt.Printf("✅ Deployment '%s' deleted from namespace '%s'\n", deployment.Name, deployment.Namespace)
+	}
+	return nil
+}
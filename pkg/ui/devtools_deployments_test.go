@@ -0,0 +1,69 @@
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func deploymentInfos(names ...string) []DeploymentInfo {
+	deployments := make([]appsv1.Deployment, 0, len(names))
+	for _, name := range names {
+		replicas := int32(2)
+		deployments = append(deployments, appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Generation: 1},
+			Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+			Status: appsv1.DeploymentStatus{
+				ObservedGeneration: 1,
+				Replicas:           2,
+				UpdatedReplicas:    2,
+				ReadyReplicas:      1,
+				AvailableReplicas:  1,
+			},
+		})
+	}
+	return newDeploymentInfos(deployments)
+}
+
+func TestDevToolsDeploymentsListAndSelect(t *testing.T) {
+	m := NewDevToolsDeploymentsModel("default", false)
+	t.Cleanup(m.cancel)
+
+	m.Update(deploymentsLoadedMsg{deployments: deploymentInfos("api", "cache", "web")})
+	view := m.View()
+	assert.Contains(t, view, "Ready: 1/2, Up-to-date: 2, Available: 1")
+	assert.Contains(t, view, "[Progressing]")
+
+	// Filtering keeps the deployment selected while it still matches
+	m.selected = 2
+	m.filterInput.SetValue("we")
+	m.applyFilter()
+	assert.Equal(t, 0, m.selected)
+	assert.Equal(t, "web", m.filtered[m.selected].Name)
+
+	m.filterInput.SetValue("")
+	m.applyFilter()
+	assert.Nil(t, m.GetSelectedDeployment(), "nothing is chosen until a deployment is picked")
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	assert.NotNil(t, cmd)
+	if assert.NotNil(t, m.GetSelectedDeployment()) {
+		assert.Equal(t, "cache", m.GetSelectedDeployment().Name)
+	}
+}
+
+func TestDeploymentStatusString(t *testing.T) {
+	infos := deploymentInfos("web")
+	assert.Contains(t, deploymentStatusString(infos[0].Progress), "Progressing")
+
+	replicas := int32(0)
+	scaled := newDeploymentInfos([]appsv1.Deployment{{
+		ObjectMeta: metav1.ObjectMeta{Name: "idle", Generation: 1},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		Status:     appsv1.DeploymentStatus{ObservedGeneration: 1},
+	}})
+	assert.Contains(t, deploymentStatusString(scaled[0].Progress), "Scaled to 0")
+}
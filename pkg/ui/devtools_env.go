@@ -147,29 +147,48 @@ func (m *PodEnvManager) GetEnvTemplate() (string, error) {
 	return string(data), nil
 }
 
-// CopyEnvToNewPod copies environment configuration to a new pod spec
+// CopyEnvToNewPod copies environment configuration to a new pod spec. Each
+// target container gets the env of the source container with the same name;
+// the first target container falls back to the first source container.
 func (m *PodEnvManager) CopyEnvToNewPod(targetPod *corev1.Pod) error {
 	if len(targetPod.Spec.Containers) == 0 {
 		return fmt.Errorf("target pod has no containers")
 	}
 
-	// Copy to first container (could be enhanced to handle multiple)
-	container := &targetPod.Spec.Containers[0]
+	for i := range targetPod.Spec.Containers {
+		container := &targetPod.Spec.Containers[i]
 
-	// Clear existing env
-	container.Env = []corev1.EnvVar{}
-	container.EnvFrom = []corev1.EnvFromSource{}
+		source := findContainer(m.pod.Spec.Containers, container.Name)
+		if source == nil && i == 0 && len(m.pod.Spec.Containers) > 0 {
+			source = &m.pod.Spec.Containers[0]
+		}
+		if source == nil && i > 0 {
+			continue
+		}
+
+		// Clear existing env
+		container.Env = []corev1.EnvVar{}
+		container.EnvFrom = []corev1.EnvFromSource{}
 
-	// Copy env vars from source pod
-	if len(m.pod.Spec.Containers) > 0 {
-		sourceContainer := m.pod.Spec.Containers[0]
-		container.Env = append(container.Env, sourceContainer.Env...)
-		container.EnvFrom = append(container.EnvFrom, sourceContainer.EnvFrom...)
+		// Copy env vars from source pod
+		if source != nil {
+			container.Env = append(container.Env, source.Env...)
+			container.EnvFrom = append(container.EnvFrom, source.EnvFrom...)
+		}
 	}
 
 	return nil
 }
 
+func findContainer(containers []corev1.Container, name string) *corev1.Container {
+	for i := range containers {
+		if containers[i].Name == name {
+			return &containers[i]
+		}
+	}
+	return nil
+}
+
 // EnvTemplate represents a reusable environment configuration
 type EnvTemplate struct {
 	Name        string            `yaml:"name"`
@@ -420,7 +439,7 @@ func ApplyEnvTemplate(client *k8s.Client, namespace string, deploymentName strin
 	}
 
 	// Update the deployment
-	_, err = client.Clientset.AppsV1().Deployments(namespace).Update(ctx, deployment, metav1.UpdateOptions{})
+	_, err = client.Clientset.AppsV1().Deployments(namespace).Update(ctx, deployment, metav1.UpdateOptions{FieldManager: k8s.FieldManager()})
 	return err
 }
 
@@ -0,0 +1,233 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/karthickk/k8s-manager/pkg/config"
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// favoriteMarker is shown next to pinned resources in the lists
+const favoriteMarker = "★"
+
+// pinnedFavorite is a pinned resource as found in the cluster. Stale pins
+// point at resources that no longer exist.
+type pinnedFavorite struct {
+	config.Favorite
+	stale  bool
+	pod    *corev1.Pod
+	secret *corev1.Secret
+}
+
+// pinnedFavorites returns the resources pinned in the loaded configuration
+func pinnedFavorites() []config.Favorite {
+	if cfg := config.Get(); cfg != nil {
+		return cfg.PinnedFavorites()
+	}
+	return nil
+}
+
+// isPinned reports whether a resource is pinned
+func isPinned(favorite config.Favorite) bool {
+	cfg := config.Get()
+	return cfg != nil && cfg.IsFavorite(favorite)
+}
+
+// renderFavoriteMarker returns the marker to append to a list entry, or ""
+// when the resource is not pinned
+func renderFavoriteMarker(favorite config.Favorite) string {
+	if !isPinned(favorite) {
+		return ""
+	}
+	return " " + devToolsWarningStyle.Render(favoriteMarker)
+}
+
+// toggleFavorite pins or unpins a resource from a list and returns the
+// message to show
+func toggleFavorite(favorite config.Favorite) string {
+	pinned, err := config.ToggleFavorite(favorite)
+	switch {
+	case err != nil:
+		return fmt.Sprintf("Failed to update favorites: %v", err)
+	case pinned:
+		return fmt.Sprintf("Pinned %s to favorites", favorite)
+	default:
+		return fmt.Sprintf("Unpinned %s from favorites", favorite)
+	}
+}
+
+// checkFavorites looks up each pinned resource, marking the ones that are
+// not found as stale. Any other error stops the check.
+func checkFavorites(ctx context.Context, client *k8s.Client, favorites []config.Favorite) ([]pinnedFavorite, error) {
+	core := client.Clientset.CoreV1()
+	checked := make([]pinnedFavorite, 0, len(favorites))
+	for _, favorite := range favorites {
+		entry := pinnedFavorite{Favorite: favorite}
+
+		var err error
+		switch favorite.Kind {
+		case config.FavoritePod:
+			entry.pod, err = core.Pods(favorite.Namespace).Get(ctx, favorite.Name, metav1.GetOptions{})
+		case config.FavoriteSecret:
+			entry.secret, err = core.Secrets(favorite.Namespace).Get(ctx, favorite.Name, metav1.GetOptions{})
+		case config.FavoriteNamespace:
+			_, err = core.Namespaces().Get(ctx, favorite.Name, metav1.GetOptions{})
+		}
+
+		if apierrors.IsNotFound(err) {
+			entry.stale = true
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to check %s: %w", favorite, err)
+		}
+		checked = append(checked, entry)
+	}
+	return checked, nil
+}
+
+// favoritesMenuItems lists the pins, then an entry to clean up the stale
+// ones if there are any, then Back
+func favoritesMenuItems(favorites []pinnedFavorite) []DevToolsMenuItem {
+	items := make([]DevToolsMenuItem, 0, len(favorites)+2)
+	stale := 0
+	for _, favorite := range favorites {
+		item := DevToolsMenuItem{
+			Title:       favorite.String(),
+			Description: favoriteDescription(favorite),
+		}
+		if favorite.stale {
+			stale++
+			item.Badge = "(stale)"
+			item.BadgeAlert = true
+		}
+		items = append(items, item)
+	}
+
+	if stale > 0 {
+		items = append(items, DevToolsMenuItem{
+			Title:       fmt.Sprintf("Clean Up %d Stale Pins", stale),
+			Description: "Unpin the resources that no longer exist",
+		})
+	}
+	items = append(items, DevToolsMenuItem{
+		Title:       "Back",
+		Description: "Return to the main menu",
+	})
+
+	for i := range items {
+		if i < 9 {
+			items[i].Number = fmt.Sprintf("%d", i+1)
+		}
+	}
+	items[len(items)-1].Number = "0"
+	return items
+}
+
+// favoriteDescription describes what selecting a pin does
+func favoriteDescription(favorite pinnedFavorite) string {
+	if favorite.stale {
+		return "No longer exists in the cluster"
+	}
+	switch favorite.Kind {
+	case config.FavoritePod:
+		return fmt.Sprintf("Pod actions (%s)", k8s.PodStatus(favorite.pod))
+	case config.FavoriteSecret:
+		return "Secret actions"
+	default:
+		return "Switch to this namespace"
+	}
+}
+
+// showDevToolsFavorites lists the pinned resources for quick access and
+// offers to unpin the ones that have been deleted
+func showDevToolsFavorites() error {
+	favorites := pinnedFavorites()
+	if len(favorites) == 0 {
+		fmt.Println("\n★ No favorites yet")
+		fmt.Println("\nPin a pod, secret or namespace with 'p' in its list, or run:")
+		fmt.Println("  k8s-manager config pin pod/<namespace>/<name>")
+		fmt.Println("\nPress Enter to continue...")
+		fmt.Scanln()
+		return nil
+	}
+
+	client, err := k8s.NewClient()
+	if err != nil {
+		return err
+	}
+
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), menuBadgeTimeout)
+		checked, err := checkFavorites(ctx, client, pinnedFavorites())
+		cancel()
+		if err != nil {
+			return err
+		}
+		if len(checked) == 0 {
+			return nil
+		}
+
+		items := favoritesMenuItems(checked)
+		result, err := tea.NewProgram(NewDevToolsMenu("★ Favorites", items), tea.WithAltScreen()).Run()
+		if err != nil {
+			return err
+		}
+
+		menu, ok := result.(*DevToolsMenu)
+		if !ok || menu.quitting || menu.selected < 0 || menu.selected >= len(items)-1 {
+			return nil
+		}
+
+		if menu.selected >= len(checked) {
+			// Clean up the stale pins
+			var stale []config.Favorite
+			for _, favorite := range checked {
+				if favorite.stale {
+					stale = append(stale, favorite.Favorite)
+				}
+			}
+			if err := config.UnpinFavorites(stale...); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := openFavorite(checked[menu.selected]); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			fmt.Println("\nPress Enter to continue...")
+			fmt.Scanln()
+		}
+	}
+}
+
+// openFavorite shows the actions of a pinned pod or secret, or switches to a
+// pinned namespace
+func openFavorite(favorite pinnedFavorite) error {
+	fmt.Print("\033[H\033[2J")
+
+	if favorite.stale {
+		fmt.Printf("\n%s no longer exists. Use the clean up entry to unpin it.\n", favorite)
+		fmt.Println("\nPress Enter to continue...")
+		fmt.Scanln()
+		return nil
+	}
+
+	switch favorite.Kind {
+	case config.FavoritePod:
+		return showDevToolsPodActions(newPodInfos([]corev1.Pod{*favorite.pod})[0])
+	case config.FavoriteSecret:
+		return showDevToolsSecretActions(newSecretInfos([]corev1.Secret{*favorite.secret})[0])
+	default:
+		if err := config.SetNamespace(favorite.Name); err != nil {
+			return err
+		}
+		fmt.Printf("\n✅ Switched to namespace '%s'\n", favorite.Name)
+		fmt.Println("\nPress Enter to continue...")
+		fmt.Scanln()
+		return nil
+	}
+}
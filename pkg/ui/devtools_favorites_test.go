@@ -0,0 +1,90 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/karthickk/k8s-manager/pkg/config"
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// loadFavoritesConfig loads an empty configuration from a temporary file so
+// pins can be saved
+func loadFavoritesConfig(t *testing.T) {
+	t.Helper()
+	t.Setenv(config.PathEnvVar, filepath.Join(t.TempDir(), "k8s-manager.yaml"))
+	_, err := config.Load()
+	require.NoError(t, err)
+}
+
+func TestCheckFavoritesMarksMissingPinsStale(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/namespaces/shop/pods/web-0":
+			json.NewEncoder(w).Encode(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-0", Namespace: "shop"}})
+		case "/api/v1/namespaces/payments":
+			json.NewEncoder(w).Encode(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "payments"}})
+		default:
+			status := apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "old").Status()
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(&status)
+		}
+	}))
+	defer server.Close()
+
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+
+	web := config.Favorite{Kind: config.FavoritePod, Namespace: "shop", Name: "web-0"}
+	old := config.Favorite{Kind: config.FavoriteSecret, Namespace: "shop", Name: "old"}
+	payments := config.Favorite{Kind: config.FavoriteNamespace, Name: "payments"}
+
+	checked, err := checkFavorites(t.Context(), &k8s.Client{Clientset: clientset}, []config.Favorite{web, old, payments})
+	require.NoError(t, err)
+	require.Len(t, checked, 3)
+	assert.False(t, checked[0].stale)
+	assert.Equal(t, "web-0", checked[0].pod.Name)
+	assert.True(t, checked[1].stale)
+	assert.False(t, checked[2].stale)
+
+	items := favoritesMenuItems(checked)
+	require.Len(t, items, 5)
+	assert.Equal(t, "pod/shop/web-0", items[0].Title)
+	assert.Equal(t, "(stale)", items[1].Badge)
+	assert.Equal(t, "Clean Up 1 Stale Pins", items[3].Title)
+	assert.Equal(t, "4", items[3].Number)
+	assert.Equal(t, "0", items[4].Number)
+}
+
+func TestDevToolsPodsPinToggle(t *testing.T) {
+	loadFavoritesConfig(t)
+
+	m := NewDevToolsPodsModel("default", false)
+	t.Cleanup(m.cancel)
+	m.Update(podsLoadedMsg{pods: podInfos("api", "web")})
+	m.selected = 1
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	assert.Contains(t, m.message, "Pinned pod/default/web")
+	assert.Equal(t, []config.Favorite{{Kind: config.FavoritePod, Namespace: "default", Name: "web"}}, pinnedFavorites())
+	assert.Contains(t, m.View(), favoriteMarker)
+	assert.Equal(t, "(1)", K8sManagerMenu().items[mainMenuFavoritesIndex].Badge)
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	assert.Contains(t, m.message, "Unpinned pod/default/web")
+	assert.Empty(t, pinnedFavorites())
+	assert.Empty(t, K8sManagerMenu().items[mainMenuFavoritesIndex].Badge)
+}
@@ -69,11 +69,11 @@ func (s AnimatedSpinner) View() string {
 	frame := frames[s.frame%len(frames)]
 
 	spinnerStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("86")). // Cyan color
+		Foreground(currentTheme.Title).
 		Bold(true)
 
 	messageStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("243")) // Gray
+		Foreground(currentTheme.Muted)
 
 	if s.message != "" {
 		return spinnerStyle.Render(frame) + " " + messageStyle.Render(s.message)
@@ -100,7 +100,7 @@ func InlineLoadingText(message string, frame int) string {
 	spaces := strings.Repeat(" ", 3-len(dots))
 
 	loadingStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("243"))
+		Foreground(currentTheme.Muted)
 
 	return loadingStyle.Render(message + dots + spaces)
 }
\ No newline at end of file
@@ -1,47 +1,79 @@
 package ui
 
 import (
+	"context"
+	"fmt"
 	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// DevTools style - clean and minimalist
 var (
-	// DevTools style - clean and minimalist
+	devToolsContainerStyle = lipgloss.NewStyle().
+		Padding(1, 2)
+
+	devToolsTitleStyle       lipgloss.Style
+	devToolsNumberStyle      lipgloss.Style
+	devToolsItemStyle        lipgloss.Style
+	devToolsDescriptionStyle lipgloss.Style
+	devToolsSelectedStyle    lipgloss.Style
+	devToolsHelpStyle        lipgloss.Style
+	devToolsBadgeStyle       lipgloss.Style
+	devToolsBadgeAlertStyle  lipgloss.Style
+)
+
+// buildDevToolsStyles sets the DevTools styles from the theme
+func buildDevToolsStyles(t Theme) {
 	devToolsTitleStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("99")).
+		Foreground(t.Title).
 		Bold(true).
 		MarginBottom(1)
 
 	devToolsNumberStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("86")).
+		Foreground(t.Title).
 		Bold(true)
 
 	devToolsItemStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("252"))
+		Foreground(t.Text)
 
 	devToolsDescriptionStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("244")).
+		Foreground(t.Muted).
 		MarginLeft(4)
 
 	devToolsSelectedStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("86")).
+		Foreground(t.Selected).
 		Bold(true)
 
 	devToolsHelpStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("240")).
+		Foreground(t.Muted).
 		MarginTop(2)
 
-	devToolsContainerStyle = lipgloss.NewStyle().
-		Padding(1, 2)
-)
+	devToolsBadgeStyle = lipgloss.NewStyle().
+		Foreground(t.Muted)
+
+	devToolsBadgeAlertStyle = lipgloss.NewStyle().
+		Foreground(t.Error).
+		Bold(true)
+}
+
+// menuBadgeTimeout bounds the list calls behind the main menu badges so a slow
+// cluster never holds them up for long
+const menuBadgeTimeout = 3 * time.Second
 
 // DevToolsMenuItem represents a menu item in DevTools style
 type DevToolsMenuItem struct {
 	Number      string
 	Title       string
 	Description string
+	// Badge is shown after the title, e.g. a resource count
+	Badge string
+	// BadgeAlert highlights the badge when something needs attention
+	BadgeAlert bool
 }
 
 // DevToolsMenu represents the DevTools-style menu
@@ -52,19 +84,49 @@ type DevToolsMenu struct {
 	width    int
 	height   int
 	quitting bool
+	// loadBadges fetches the item badges in the background, if set
+	loadBadges func(ctx context.Context) tea.Msg
+	ctx        context.Context
+	cancel     context.CancelFunc
+}
+
+// menuClientMsg carries the client used to fetch the badges once it is ready
+type menuClientMsg struct {
+	client *k8s.Client
+}
+
+// menuBadgeMsg sets the badge of the item at index
+type menuBadgeMsg struct {
+	index int
+	badge string
+	alert bool
 }
 
 // NewDevToolsMenu creates a new DevTools-style menu
 func NewDevToolsMenu(title string, items []DevToolsMenuItem) *DevToolsMenu {
+	ctx, cancel := newModelContext()
+
 	return &DevToolsMenu{
 		title:    title,
 		items:    items,
 		selected: -1,
+		ctx:      ctx,
+		cancel:   cancel,
 	}
 }
 
+// quit cancels in-flight badge requests and exits the program
+func (m *DevToolsMenu) quit() tea.Cmd {
+	return quitModel(m.cancel)
+}
+
 func (m *DevToolsMenu) Init() tea.Cmd {
-	return nil
+	if m.loadBadges == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		return m.loadBadges(m.ctx)
+	}
 }
 
 func (m *DevToolsMenu) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -74,6 +136,16 @@ func (m *DevToolsMenu) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.height = msg.Height
 		return m, nil
 
+	case menuClientMsg:
+		return m, tea.Batch(m.loadPodsBadge(msg.client), m.loadConfigBadge(msg.client))
+
+	case menuBadgeMsg:
+		if msg.index >= 0 && msg.index < len(m.items) {
+			m.items[msg.index].Badge = msg.badge
+			m.items[msg.index].BadgeAlert = msg.alert
+		}
+		return m, nil
+
 	case tea.KeyMsg:
 		keyStr := msg.String()
 
@@ -86,14 +158,14 @@ func (m *DevToolsMenu) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				} else {
 					m.selected = len(m.items) - 1 // Select last item
 				}
-				return m, tea.Quit
+				return m, m.quit()
 			}
 
 			num := int(keyStr[0] - '0')
 			if num <= len(m.items) {
 				m.selected = num - 1
 				// Immediately quit with selection
-				return m, tea.Quit
+				return m, m.quit()
 			}
 		}
 
@@ -116,19 +188,19 @@ func (m *DevToolsMenu) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "enter", " ":
 			if m.selected >= 0 && m.selected < len(m.items) {
 				// Quit with selection
-				return m, tea.Quit
+				return m, m.quit()
 			} else if len(m.items) > 0 {
 				// If nothing selected, select first item
 				m.selected = 0
-				return m, tea.Quit
+				return m, m.quit()
 			}
 
 		case "q", "ctrl+c":
 			m.quitting = true
-			return m, tea.Quit
+			return m, m.quit()
 
 		case "esc":
-			return m, tea.Quit
+			return m, m.quit()
 		}
 	}
 
@@ -142,6 +214,8 @@ func (m *DevToolsMenu) View() string {
 
 	var s strings.Builder
 
+	s.WriteString(renderContextHeader(""))
+
 	// Title
 	s.WriteString(devToolsTitleStyle.Render(m.title))
 	s.WriteString("\n\n")
@@ -159,6 +233,14 @@ func (m *DevToolsMenu) View() string {
 			titleStr = "  " + devToolsItemStyle.Render(titleStr)
 		}
 
+		if item.Badge != "" {
+			if item.BadgeAlert {
+				titleStr += " " + devToolsBadgeAlertStyle.Render(item.Badge)
+			} else {
+				titleStr += " " + devToolsBadgeStyle.Render(item.Badge)
+			}
+		}
+
 		// Combine number and title
 		s.WriteString(numberStr + titleStr)
 		s.WriteString("\n")
@@ -183,50 +265,125 @@ func K8sManagerMenu() *DevToolsMenu {
 	items := []DevToolsMenuItem{
 		{
 			Number:      "1",
+			Title:       "Favorites",
+			Description: "Pinned pods, secrets and namespaces",
+		},
+		{
+			Number:      "2",
 			Title:       "Pods Manager",
 			Description: "List, manage, and interact with Kubernetes pods",
 		},
 		{
-			Number:      "2",
+			Number:      "3",
 			Title:       "Deployments",
 			Description: "Manage Kubernetes deployments and rollouts",
 		},
 		{
-			Number:      "3",
+			Number:      "4",
 			Title:       "Services",
 			Description: "View and manage Kubernetes services",
 		},
 		{
-			Number:      "4",
+			Number:      "5",
 			Title:       "ConfigMaps & Secrets",
 			Description: "Manage configuration and secret resources",
 		},
 		{
-			Number:      "5",
+			Number:      "6",
 			Title:       "Namespaces",
 			Description: "Switch and manage Kubernetes namespaces",
 		},
 		{
-			Number:      "6",
-			Title:       "Nodes & Cluster",
-			Description: "View cluster nodes and resource usage",
+			Number:      "7",
+			Title:       "Cluster Info",
+			Description: "Server version, nodes and control plane health",
 		},
 		{
-			Number:      "7",
+			Number:      "8",
 			Title:       "Logs & Events",
 			Description: "View pod logs and cluster events",
 		},
 		{
-			Number:      "8",
-			Title:       "Configuration",
-			Description: "Manage K8s Manager settings and contexts",
+			Number:      "9",
+			Title:       "Switch Context",
+			Description: "Choose the kubeconfig context to connect to",
 		},
 		{
-			Number:      "9",
+			Number:      "0",
 			Title:       "Exit",
 			Description: "Quit the application",
 		},
 	}
 
-	return NewDevToolsMenu("🚀 K8s Manager by Karthick", items)
+	if favorites := len(pinnedFavorites()); favorites > 0 {
+		items[mainMenuFavoritesIndex].Badge = fmt.Sprintf("(%d)", favorites)
+	}
+
+	menu := NewDevToolsMenu("🚀 K8s Manager by Karthick", items)
+	menu.loadBadges = loadMainMenuClient
+	return menu
+}
+
+// Indexes of the main menu items that show counts
+const (
+	mainMenuFavoritesIndex = 0
+	mainMenuPodsIndex      = 1
+	mainMenuConfigIndex    = 4
+)
+
+// loadMainMenuClient connects to the cluster for the main menu badges. On
+// error the menu simply keeps its items without badges.
+func loadMainMenuClient(ctx context.Context) tea.Msg {
+	client, err := k8s.NewClient()
+	if err != nil || ctx.Err() != nil {
+		return nil
+	}
+	return menuClientMsg{client: client}
+}
+
+// loadPodsBadge counts the pods in the current namespace, flagging the badge
+// when any of them is failing
+func (m *DevToolsMenu) loadPodsBadge(client *k8s.Client) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.ctx, menuBadgeTimeout)
+		defer cancel()
+
+		pods, err := client.Clientset.CoreV1().Pods(client.GetNamespace()).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil
+		}
+
+		alert := false
+		for i := range pods.Items {
+			if k8s.PodFailing(&pods.Items[i]) {
+				alert = true
+				break
+			}
+		}
+
+		return menuBadgeMsg{index: mainMenuPodsIndex, badge: fmt.Sprintf("(%d)", len(pods.Items)), alert: alert}
+	}
+}
+
+// loadConfigBadge counts the config maps and secrets in the current namespace
+func (m *DevToolsMenu) loadConfigBadge(client *k8s.Client) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.ctx, menuBadgeTimeout)
+		defer cancel()
+
+		namespace := client.GetNamespace()
+		configMaps, err := client.Clientset.CoreV1().ConfigMaps(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil
+		}
+		secrets, err := client.Clientset.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil
+		}
+
+		return menuBadgeMsg{
+			index: mainMenuConfigIndex,
+			badge: fmt.Sprintf("(%d configmaps, %d secrets)", len(configMaps.Items), len(secrets.Items)),
+		}
+	}
 }
\ No newline at end of file
@@ -0,0 +1,33 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDevToolsMenuBadges(t *testing.T) {
+	menu := K8sManagerMenu()
+	assert.NotContains(t, menu.View(), "(23)", "badges must not block the first render")
+
+	menu.Update(menuBadgeMsg{index: mainMenuPodsIndex, badge: "(23)", alert: true})
+	menu.Update(menuBadgeMsg{index: mainMenuConfigIndex, badge: "(4 configmaps, 7 secrets)"})
+
+	assert.Equal(t, "(23)", menu.items[mainMenuPodsIndex].Badge)
+	assert.True(t, menu.items[mainMenuPodsIndex].BadgeAlert)
+	assert.Equal(t, "(4 configmaps, 7 secrets)", menu.items[mainMenuConfigIndex].Badge)
+	assert.False(t, menu.items[mainMenuConfigIndex].BadgeAlert)
+
+	view := menu.View()
+	assert.Contains(t, view, "(23)")
+	assert.Contains(t, view, "(4 configmaps, 7 secrets)")
+}
+
+func TestDevToolsMenuIgnoresUnknownBadge(t *testing.T) {
+	menu := NewDevToolsMenu("Menu", []DevToolsMenuItem{{Number: "1", Title: "Only"}})
+
+	menu.Update(menuBadgeMsg{index: 5, badge: "(1)"})
+
+	assert.Empty(t, menu.items[0].Badge)
+	assert.Nil(t, menu.Init())
+}
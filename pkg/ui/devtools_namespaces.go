@@ -6,28 +6,197 @@ import (
 	"strings"
 	"time"
 
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/karthickk/k8s-manager/pkg/config"
 	"github.com/karthickk/k8s-manager/pkg/k8s"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-// DevToolsNamespaceModel represents the namespace selector
+// DevToolsNamespaceModel is the namespace picker shared by every interactive
+// view that needs a namespace. It loads the live namespaces, supports number
+// quick-select and a / filter, and returns the chosen namespace or "" when
+// the user cancels.
 type DevToolsNamespaceModel struct {
-	namespaces []string
-	selected   int
-	loading    bool
-	err        error
-	client     *k8s.Client
+	title       string
+	namespaces  []string
+	filtered    []string
+	selected    int
+	chosen      bool
+	filterInput textinput.Model
+	filtering   bool
+	loading     bool
+	message     string
+	err         error
+	client      *k8s.Client
+	ctx         context.Context
+	cancel      context.CancelFunc
 }
 
-// NewDevToolsNamespaceModel creates a new namespace selector
-func NewDevToolsNamespaceModel() *DevToolsNamespaceModel {
+// NewDevToolsNamespaceModel creates a namespace picker with the given title;
+// an empty title uses the default one
+func NewDevToolsNamespaceModel(title string) *DevToolsNamespaceModel {
+	if title == "" {
+		title = "🏷️ Select Namespace"
+	}
+
+	ti := textinput.New()
+	ti.Placeholder = "Type to filter..."
+	ti.CharLimit = 63
+
+	ctx, cancel := newModelContext()
+
 	return &DevToolsNamespaceModel{
-		loading:  true,
-		selected: -1,
+		title:       title,
+		filterInput: ti,
+		loading:     true,
+		selected:    -1,
+		ctx:         ctx,
+		cancel:      cancel,
+	}
+}
+
+// PickNamespace runs the namespace picker and returns the chosen namespace,
+// or "" if the user cancelled
+func PickNamespace(title string) (string, error) {
+	result, err := tea.NewProgram(NewDevToolsNamespaceModel(title), tea.WithAltScreen()).Run()
+	if err != nil {
+		return "", err
+	}
+	if m, ok := result.(*DevToolsNamespaceModel); ok {
+		return m.GetSelectedNamespace(), nil
+	}
+	return "", nil
+}
+
+// selectDevToolsNamespaceScope asks whether to use the current namespace,
+// all namespaces or a specific one picked from the cluster. ok is false when
+// the user backed out at any step.
+func selectDevToolsNamespaceScope(title, resource string) (namespace string, allNamespaces, ok bool, err error) {
+	scopeMenu := NewDevToolsMenu(title, []DevToolsMenuItem{
+		{
+			Number:      "1",
+			Title:       "Current Namespace",
+			Description: "Use the current context's namespace",
+		},
+		{
+			Number:      "2",
+			Title:       "All Namespaces",
+			Description: fmt.Sprintf("Show %s from all namespaces", resource),
+		},
+		{
+			Number:      "3",
+			Title:       "Specific Namespace",
+			Description: "Pick a namespace from the cluster",
+		},
+		{
+			Number:      "0",
+			Title:       "Back to Main Menu",
+			Description: "Return to the main menu",
+		},
+	})
+
+	model, err := tea.NewProgram(scopeMenu, tea.WithAltScreen()).Run()
+	if err != nil {
+		return "", false, false, err
+	}
+
+	menu, isMenu := model.(*DevToolsMenu)
+	if !isMenu || menu.quitting || menu.selected < 0 {
+		return "", false, false, nil
+	}
+
+	switch menu.selected {
+	case 0: // Current namespace
+		return "", false, true, nil
+	case 1: // All namespaces
+		return "", true, true, nil
+	case 2: // Specific namespace
+		namespace, err := PickNamespace("")
+		if err != nil || namespace == "" {
+			return "", false, false, err
+		}
+		return namespace, false, true, nil
+	default:
+		return "", false, false, nil
 	}
 }
 
+// showDevToolsNamespaces picks a namespace and offers switching to it or
+// deleting it
+func showDevToolsNamespaces() error {
+	namespace, err := PickNamespace("🏷️ Namespaces")
+	if err != nil || namespace == "" {
+		return err
+	}
+
+	actions := []DevToolsMenuItem{
+		{
+			Number:      "1",
+			Title:       "Switch to Namespace",
+			Description: "Use it as the namespace of the current context",
+		},
+		{
+			Number:      "2",
+			Title:       "Delete",
+			Description: "Delete the namespace and everything in it",
+		},
+		{
+			Number:      "0",
+			Title:       "Back to Main Menu",
+			Description: "Return to the main menu",
+		},
+	}
+
+	result, err := tea.NewProgram(NewDevToolsMenu(fmt.Sprintf("🏷️ Namespace Actions: %s", namespace), actions), tea.WithAltScreen()).Run()
+	if err != nil {
+		return err
+	}
+	menu, ok := result.(*DevToolsMenu)
+	if !ok || menu.quitting {
+		return nil
+	}
+
+	switch menu.selected {
+	case 0: // Switch
+		if err := config.SetNamespace(namespace); err != nil {
+			return err
+		}
+		fmt.Printf("\n✅ Switched to namespace '%s'\n", namespace)
+
+	case 1: // Delete
+		if k8s.IsSystemNamespace(namespace) {
+			return fmt.Errorf("namespace '%s' is a system namespace and cannot be deleted", namespace)
+		}
+		confirmed, err := ConfirmByName("delete", "namespace", namespace, false)
+		if err != nil || !confirmed {
+			return err
+		}
+		client, err := k8s.NewClient()
+		if err != nil {
+			return err
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		if err := client.DeleteNamespace(ctx, namespace); err != nil {
+			return err
+		}
+		fmt.Printf("✅ Namespace '%s' is being deleted\n", namespace)
+
+	default:
+		return nil
+	}
+
+	fmt.Println("\nPress Enter to continue...")
+	fmt.Scanln()
+	return nil
+}
+
+// quit cancels in-flight requests and exits the program
+func (m *DevToolsNamespaceModel) quit() tea.Cmd {
+	return quitModel(m.cancel)
+}
+
 func (m *DevToolsNamespaceModel) Init() tea.Cmd {
 	return m.loadNamespaces
 }
@@ -38,7 +207,7 @@ func (m *DevToolsNamespaceModel) loadNamespaces() tea.Msg {
 		return namespaceErrorMsg{err}
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(m.ctx, 10*time.Second)
 	defer cancel()
 
 	namespaceList, err := client.Clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
@@ -72,6 +241,7 @@ func (m *DevToolsNamespaceModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.loading = false
 		m.namespaces = msg.namespaces
 		m.client = msg.client
+		m.applyFilter()
 		return m, nil
 
 	case namespaceErrorMsg:
@@ -80,43 +250,79 @@ func (m *DevToolsNamespaceModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case tea.KeyMsg:
+		if m.filtering {
+			switch msg.String() {
+			case "esc":
+				m.filtering = false
+				m.filterInput.Blur()
+				m.filterInput.SetValue("")
+				m.applyFilter()
+				return m, nil
+
+			case "enter":
+				m.filtering = false
+				m.filterInput.Blur()
+				// A filter that leaves a single namespace picks it
+				if len(m.filtered) == 1 {
+					m.selected = 0
+					m.chosen = true
+					return m, m.quit()
+				}
+				return m, nil
+
+			default:
+				var cmd tea.Cmd
+				m.filterInput, cmd = m.filterInput.Update(msg)
+				m.applyFilter()
+				return m, cmd
+			}
+		}
+
 		keyStr := msg.String()
 
 		// Number keys for quick selection
 		if len(keyStr) == 1 && keyStr[0] >= '1' && keyStr[0] <= '9' {
 			num := int(keyStr[0] - '0')
-			if num <= len(m.namespaces) {
+			if num <= len(m.filtered) {
 				m.selected = num - 1
-				return m, tea.Quit
+				m.chosen = true
+				return m, m.quit()
 			}
 		}
 
 		switch keyStr {
-		case "0", "b": // Back
+		case "0", "b", "q", "ctrl+c", "esc": // Cancel
 			m.selected = -1
-			return m, tea.Quit
+			return m, m.quit()
 
-		case "q", "ctrl+c", "esc":
-			m.selected = -1
-			return m, tea.Quit
+		case "/":
+			m.filtering = true
+			m.filterInput.Focus()
+			return m, textinput.Blink
+
+		case "p": // Pin or unpin
+			if m.selected >= 0 && m.selected < len(m.filtered) {
+				m.message = toggleFavorite(config.Favorite{Kind: config.FavoriteNamespace, Name: m.filtered[m.selected]})
+			}
 
 		case "up", "k":
 			if m.selected > 0 {
 				m.selected--
-			} else if m.selected == -1 && len(m.namespaces) > 0 {
-				m.selected = len(m.namespaces) - 1
+			} else if m.selected == -1 && len(m.filtered) > 0 {
+				m.selected = len(m.filtered) - 1
 			}
 
 		case "down", "j":
-			if m.selected < len(m.namespaces)-1 {
+			if m.selected < len(m.filtered)-1 {
 				m.selected++
-			} else if m.selected == -1 && len(m.namespaces) > 0 {
+			} else if m.selected == -1 && len(m.filtered) > 0 {
 				m.selected = 0
 			}
 
 		case "enter", " ":
-			if m.selected >= 0 && m.selected < len(m.namespaces) {
-				return m, tea.Quit
+			if m.selected >= 0 && m.selected < len(m.filtered) {
+				m.chosen = true
+				return m, m.quit()
 			}
 		}
 	}
@@ -124,11 +330,43 @@ func (m *DevToolsNamespaceModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// applyFilter narrows the namespaces to those containing the filter text.
+// The selected namespace stays selected if it is still listed.
+func (m *DevToolsNamespaceModel) applyFilter() {
+	current := ""
+	if m.selected >= 0 && m.selected < len(m.filtered) {
+		current = m.filtered[m.selected]
+	}
+
+	filter := strings.ToLower(m.filterInput.Value())
+	if filter == "" {
+		m.filtered = m.namespaces
+	} else {
+		filtered := []string{}
+		for _, ns := range m.namespaces {
+			if strings.Contains(strings.ToLower(ns), filter) {
+				filtered = append(filtered, ns)
+			}
+		}
+		m.filtered = filtered
+	}
+
+	m.selected = -1
+	for i, ns := range m.filtered {
+		if ns == current {
+			m.selected = i
+			break
+		}
+	}
+}
+
 func (m *DevToolsNamespaceModel) View() string {
 	var s strings.Builder
 
+	s.WriteString(renderContextHeader(""))
+
 	// Title
-	s.WriteString(devToolsTitleStyle.Render("🏷️ Select Namespace"))
+	s.WriteString(devToolsTitleStyle.Render(m.title))
 	s.WriteString("\n\n")
 
 	if m.loading {
@@ -141,14 +379,29 @@ func (m *DevToolsNamespaceModel) View() string {
 		return devToolsContainerStyle.Render(s.String())
 	}
 
+	// Filter
+	if m.filtering || m.filterInput.Value() != "" {
+		s.WriteString("Filter: ")
+		s.WriteString(m.filterInput.View())
+		s.WriteString("\n\n")
+	}
+
+	if len(m.filtered) == 0 {
+		s.WriteString(devToolsDescriptionStyle.Render("No namespaces found"))
+		if m.filterInput.Value() != "" {
+			s.WriteString(devToolsDescriptionStyle.Render(fmt.Sprintf(" matching '%s'", m.filterInput.Value())))
+		}
+		s.WriteString("\n")
+	}
+
 	// Show first 9 namespaces with numbers
 	maxItems := 9
-	if len(m.namespaces) < maxItems {
-		maxItems = len(m.namespaces)
+	if len(m.filtered) < maxItems {
+		maxItems = len(m.filtered)
 	}
 
 	for i := 0; i < maxItems; i++ {
-		ns := m.namespaces[i]
+		ns := m.filtered[i]
 
 		// Number
 		numberStr := devToolsNumberStyle.Render(fmt.Sprintf("%d.", i+1))
@@ -162,35 +415,21 @@ func (m *DevToolsNamespaceModel) View() string {
 		} else {
 			nsStr = "  " + devToolsItemStyle.Render(nsStr)
 		}
+		nsStr += renderFavoriteMarker(config.Favorite{Kind: config.FavoriteNamespace, Name: ns})
 
 		s.WriteString(numberStr + nsStr)
 		s.WriteString("\n")
-
-		// Add description for special namespaces
-		var desc string
-		switch ns {
-		case "default":
-			desc = "The default namespace"
-		case "kube-system":
-			desc = "Kubernetes system components"
-		case "kube-public":
-			desc = "Public resources"
-		case "kube-node-lease":
-			desc = "Node heartbeat data"
-		default:
-			if strings.HasPrefix(ns, "kube-") {
-				desc = "System namespace"
-			} else {
-				desc = "User namespace"
-			}
-		}
-		s.WriteString(devToolsDescriptionStyle.Render("   " + desc))
+		s.WriteString(devToolsDescriptionStyle.Render("   " + namespaceDescription(ns)))
 		s.WriteString("\n")
 	}
 
-	if len(m.namespaces) > maxItems {
+	if len(m.filtered) > maxItems {
 		s.WriteString("\n")
-		s.WriteString(devToolsDescriptionStyle.Render(fmt.Sprintf("   ... and %d more namespaces (use arrows to navigate)", len(m.namespaces)-maxItems)))
+		s.WriteString(devToolsDescriptionStyle.Render(fmt.Sprintf("   ... and %d more namespaces (use arrows to navigate or / to filter)", len(m.filtered)-maxItems)))
+	}
+	if m.selected >= maxItems {
+		s.WriteString("\n")
+		s.WriteString(devToolsSelectedStyle.Render("▸ " + m.filtered[m.selected]))
 	}
 
 	// Back option
@@ -199,18 +438,44 @@ func (m *DevToolsNamespaceModel) View() string {
 	s.WriteString("\n")
 	s.WriteString(devToolsDescriptionStyle.Render("   Return without selecting"))
 
+	// Message
+	if m.message != "" {
+		s.WriteString("\n")
+		s.WriteString(devToolsInfoStyle.Render(m.message))
+	}
+
 	// Help
 	s.WriteString("\n\n")
-	helpText := "↑/k up • ↓/j down • 1-9 quick select • enter select • 0 cancel • q quit"
+	helpText := "↑/k up • ↓/j down • 1-9 quick select • / filter • enter select • p pin • 0 cancel • q quit"
 	s.WriteString(devToolsHelpStyle.Render(helpText))
 
 	return devToolsContainerStyle.Render(s.String())
 }
 
-// GetSelectedNamespace returns the selected namespace
+// namespaceDescription describes the well-known namespaces
+func namespaceDescription(ns string) string {
+	switch ns {
+	case "default":
+		return "The default namespace"
+	case "kube-system":
+		return "Kubernetes system components"
+	case "kube-public":
+		return "Public resources"
+	case "kube-node-lease":
+		return "Node heartbeat data"
+	default:
+		if strings.HasPrefix(ns, "kube-") {
+			return "System namespace"
+		}
+		return "User namespace"
+	}
+}
+
+// GetSelectedNamespace returns the chosen namespace, or "" if the picker was
+// cancelled
 func (m *DevToolsNamespaceModel) GetSelectedNamespace() string {
-	if m.selected >= 0 && m.selected < len(m.namespaces) {
-		return m.namespaces[m.selected]
+	if m.chosen && m.selected >= 0 && m.selected < len(m.filtered) {
+		return m.filtered[m.selected]
 	}
 	return ""
-}
\ No newline at end of file
+}
@@ -0,0 +1,58 @@
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+)
+
+func loadedNamespacePicker(t *testing.T, namespaces ...string) *DevToolsNamespaceModel {
+	t.Helper()
+	m := NewDevToolsNamespaceModel("")
+	t.Cleanup(m.cancel)
+	m.Update(namespacesLoadedMsg{namespaces: namespaces})
+	return m
+}
+
+func TestNamespacePickerQuickSelectUsesFilteredList(t *testing.T) {
+	m := loadedNamespacePicker(t, "default", "kube-system", "payments", "payments-staging")
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+	assert.True(t, m.filtering)
+	for _, r := range "pay" {
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	assert.Equal(t, []string{"payments", "payments-staging"}, m.filtered)
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.False(t, m.filtering)
+	assert.Empty(t, m.GetSelectedNamespace(), "two matches need an explicit choice")
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	assert.NotNil(t, cmd)
+	assert.Equal(t, "payments-staging", m.GetSelectedNamespace())
+}
+
+func TestNamespacePickerSingleFilterMatchIsChosen(t *testing.T) {
+	m := loadedNamespacePicker(t, "default", "kube-system", "payments")
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+	for _, r := range "system" {
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, "kube-system", m.GetSelectedNamespace())
+}
+
+func TestNamespacePickerCancelReturnsEmpty(t *testing.T) {
+	m := loadedNamespacePicker(t, "default", "payments")
+
+	m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	assert.Equal(t, 1, m.selected)
+	assert.Empty(t, m.GetSelectedNamespace(), "highlighting is not choosing")
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Empty(t, m.GetSelectedNamespace())
+}
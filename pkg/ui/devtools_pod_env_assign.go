@@ -30,6 +30,9 @@ type PodEnvAssignModel struct {
 	directEnvName   string
 	directEnvValue  string
 	inputMode       int // 0: name, 1: value
+	applied         string // kind/name of the workload updated
+	ctx            context.Context
+	cancel         context.CancelFunc
 }
 
 // ConfigMapInfo holds configmap information
@@ -49,8 +52,32 @@ type EnvVarAssignment struct {
 	Key        string
 }
 
+// EnvVar returns the container variable of the assignment, referencing the
+// secret or config map key unless the value is direct
+func (e EnvVarAssignment) EnvVar() corev1.EnvVar {
+	switch e.SourceType {
+	case "secret":
+		return corev1.EnvVar{Name: e.Name, ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: e.SourceName},
+				Key:                  e.Key,
+			},
+		}}
+	case "configmap":
+		return corev1.EnvVar{Name: e.Name, ValueFrom: &corev1.EnvVarSource{
+			ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: e.SourceName},
+				Key:                  e.Key,
+			},
+		}}
+	}
+	return corev1.EnvVar{Name: e.Name, Value: e.Value}
+}
+
 // NewPodEnvAssignModel creates a new pod environment assignment model
 func NewPodEnvAssignModel(pod *corev1.Pod, client *k8s.Client) *PodEnvAssignModel {
+	ctx, cancel := newModelContext()
+
 	return &PodEnvAssignModel{
 		pod:          pod,
 		client:       client,
@@ -58,15 +85,22 @@ func NewPodEnvAssignModel(pod *corev1.Pod, client *k8s.Client) *PodEnvAssignMode
 		selectedKeys: make(map[string]bool),
 		selected:     0,
 		step:         0,
+		ctx:          ctx,
+		cancel:       cancel,
 	}
 }
 
+// quit cancels in-flight requests and exits the program
+func (m *PodEnvAssignModel) quit() tea.Cmd {
+	return quitModel(m.cancel)
+}
+
 func (m *PodEnvAssignModel) Init() tea.Cmd {
 	return m.loadResources
 }
 
 func (m *PodEnvAssignModel) loadResources() tea.Msg {
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(m.ctx, 30*time.Second)
 	defer cancel()
 
 	// Load secrets
@@ -76,7 +110,8 @@ func (m *PodEnvAssignModel) loadResources() tea.Msg {
 	}
 
 	secretInfos := make([]SecretInfo, 0, len(secrets.Items))
-	for _, secret := range secrets.Items {
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
 		// Skip service account tokens
 		if secret.Type == corev1.SecretTypeServiceAccountToken {
 			continue
@@ -86,7 +121,7 @@ func (m *PodEnvAssignModel) loadResources() tea.Msg {
 			Namespace: secret.Namespace,
 			Type:      string(secret.Type),
 			DataCount: len(secret.Data),
-			Secret:    &secret,
+			Secret:    secret,
 		}
 		secretInfos = append(secretInfos, info)
 	}
@@ -98,12 +133,13 @@ func (m *PodEnvAssignModel) loadResources() tea.Msg {
 	}
 
 	configMapInfos := make([]ConfigMapInfo, 0, len(configMaps.Items))
-	for _, cm := range configMaps.Items {
+	for i := range configMaps.Items {
+		cm := &configMaps.Items[i]
 		info := ConfigMapInfo{
 			Name:      cm.Name,
 			Namespace: cm.Namespace,
 			DataCount: len(cm.Data),
-			ConfigMap: &cm,
+			ConfigMap: cm,
 		}
 		configMapInfos = append(configMapInfos, info)
 	}
@@ -132,6 +168,15 @@ func (m *PodEnvAssignModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case envApplyMsg:
+		if msg.err != nil {
+			m.message = msg.err.Error()
+			m.messageType = "error"
+			return m, nil
+		}
+		m.applied = msg.workload
+		return m, m.quit()
+
 	case tea.KeyMsg:
 		keyStr := msg.String()
 
@@ -155,7 +200,7 @@ func (m *PodEnvAssignModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, nil
 
 			case "0", "q", "esc":
-				return m, tea.Quit
+				return m, m.quit()
 			}
 
 		case 1: // Select secret or configmap
@@ -179,7 +224,7 @@ func (m *PodEnvAssignModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, nil
 
 			case "q", "esc":
-				return m, tea.Quit
+				return m, m.quit()
 			}
 
 		case 2: // Select keys
@@ -222,7 +267,7 @@ func (m *PodEnvAssignModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, nil
 
 			case "q", "esc":
-				return m, tea.Quit
+				return m, m.quit()
 			}
 
 		case 3: // Review and apply
@@ -248,7 +293,7 @@ func (m *PodEnvAssignModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, nil
 
 			case "q", "esc":
-				return m, tea.Quit
+				return m, m.quit()
 			}
 
 		case 4: // Direct input
@@ -301,7 +346,7 @@ func (m *PodEnvAssignModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, nil
 
 			case "q", "esc":
-				return m, tea.Quit
+				return m, m.quit()
 			}
 		}
 
@@ -330,6 +375,7 @@ func (m *PodEnvAssignModel) View() string {
 	var s strings.Builder
 
 	s.WriteString("\033[H\033[2J") // Clear screen
+	s.WriteString(renderContextHeader(m.pod.Namespace))
 
 	// Title
 	s.WriteString(devToolsTitleStyle.Render(fmt.Sprintf("🔧 Assign Environment to Pod: %s", m.pod.Name)))
@@ -461,7 +507,7 @@ func (m *PodEnvAssignModel) View() string {
 		}
 
 		s.WriteString("\n")
-		s.WriteString(devToolsSuccessStyle.Render(fmt.Sprintf("Will add %d environment variables to pod", len(m.envVars))))
+		s.WriteString(devToolsSuccessStyle.Render(fmt.Sprintf("Will add %d environment variables to the pod's workload", len(m.envVars))))
 		s.WriteString("\n\n")
 		if m.sourceType == 2 {
 			s.WriteString(devToolsHelpStyle.Render("a apply • n add another • b back • q quit"))
@@ -607,21 +653,31 @@ func (m *PodEnvAssignModel) createEnvVars() {
 
 func (m *PodEnvAssignModel) applyEnvVars() tea.Cmd {
 	return func() tea.Msg {
-		// This would typically update the pod's deployment or create a patch
-		// For now, we'll just show what would be applied
+		ctx, cancel := context.WithTimeout(m.ctx, 30*time.Second)
+		defer cancel()
+
+		// The pod's own spec cannot change, so the variables go on the
+		// template of the workload that manages it and survive restarts
+		kind, name, err := k8s.PodWorkload(ctx, m.client.Clientset, m.pod)
+		if err != nil {
+			return envApplyMsg{err: err}
+		}
 
-		// In a real implementation, you would:
-		// 1. Find the deployment/statefulset that manages this pod
-		// 2. Update its pod template with the new environment variables
-		// 3. Trigger a rolling update
+		env := make([]corev1.EnvVar, len(m.envVars))
+		for i, assignment := range m.envVars {
+			env[i] = assignment.EnvVar()
+		}
+		if err := k8s.SetWorkloadEnv(ctx, m.client.Clientset, kind, m.pod.Namespace, name, env); err != nil {
+			return envApplyMsg{err: err}
+		}
 
-		return envApplyMsg{success: true}
+		return envApplyMsg{workload: strings.ToLower(string(kind)) + "/" + name}
 	}
 }
 
 type envApplyMsg struct {
-	success bool
-	err     error
+	workload string
+	err      error
 }
 
 func (m *PodEnvAssignModel) getMaxSelection() int {
@@ -706,12 +762,16 @@ func ShowPodEnvAssignment(pod *corev1.Pod, client *k8s.Client) error {
 		return err
 	}
 
-	if m, ok := result.(*PodEnvAssignModel); ok && len(m.envVars) > 0 {
-		fmt.Println("\n✅ Environment variables prepared for assignment:")
+	if m, ok := result.(*PodEnvAssignModel); ok && m.applied != "" {
+		fmt.Printf("\n✅ Environment variables set on %s:\n", m.applied)
 		for _, env := range m.envVars {
-			fmt.Printf("  %s from %s:%s/%s\n", env.Name, env.SourceType, env.SourceName, env.Key)
+			if env.SourceType == "direct" {
+				fmt.Printf("  %s\n", env.Name)
+			} else {
+				fmt.Printf("  %s from %s:%s/%s\n", env.Name, env.SourceType, env.SourceName, env.Key)
+			}
 		}
-		fmt.Println("\nNote: Update the deployment to apply these changes permanently.")
+		fmt.Println("\nIts pods are restarted to pick them up.")
 	}
 
 	return nil
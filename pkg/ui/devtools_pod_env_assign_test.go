@@ -0,0 +1,92 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestPodEnvAssignLoadResourcesPointsAtEachResource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/namespaces/shop/secrets":
+			json.NewEncoder(w).Encode(&corev1.SecretList{Items: []corev1.Secret{
+				{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "shop"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "token", Namespace: "shop"}, Type: corev1.SecretTypeServiceAccountToken},
+				{ObjectMeta: metav1.ObjectMeta{Name: "api-key", Namespace: "shop"}},
+			}})
+		case "/api/v1/namespaces/shop/configmaps":
+			json.NewEncoder(w).Encode(&corev1.ConfigMapList{Items: []corev1.ConfigMap{
+				{ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: "shop"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "features", Namespace: "shop"}},
+			}})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "shop"}}
+	m := NewPodEnvAssignModel(pod, &k8s.Client{Clientset: clientset})
+	t.Cleanup(m.cancel)
+
+	msg, ok := m.loadResources().(resourceLoadMsg)
+	require.True(t, ok)
+	require.NoError(t, msg.err)
+
+	require.Len(t, msg.secrets, 2, "service account tokens are skipped")
+	for _, info := range msg.secrets {
+		require.NotNil(t, info.Secret)
+		assert.Equal(t, info.Name, info.Secret.Name)
+	}
+
+	require.Len(t, msg.configMaps, 2)
+	for _, info := range msg.configMaps {
+		require.NotNil(t, info.ConfigMap)
+		assert.Equal(t, info.Name, info.ConfigMap.Name)
+	}
+}
+
+func TestEnvVarAssignmentEnvVar(t *testing.T) {
+	secret := EnvVarAssignment{Name: "DB_PASSWORD", SourceType: "secret", SourceName: "db", Key: "password"}.EnvVar()
+	require.NotNil(t, secret.ValueFrom)
+	require.NotNil(t, secret.ValueFrom.SecretKeyRef)
+	assert.Equal(t, "db", secret.ValueFrom.SecretKeyRef.Name)
+	assert.Equal(t, "password", secret.ValueFrom.SecretKeyRef.Key)
+
+	configMap := EnvVarAssignment{Name: "LOG_LEVEL", SourceType: "configmap", SourceName: "settings", Key: "log-level"}.EnvVar()
+	require.NotNil(t, configMap.ValueFrom)
+	require.NotNil(t, configMap.ValueFrom.ConfigMapKeyRef)
+	assert.Equal(t, "settings", configMap.ValueFrom.ConfigMapKeyRef.Name)
+
+	direct := EnvVarAssignment{Name: "DEBUG", Value: "true", SourceType: "direct"}.EnvVar()
+	assert.Equal(t, corev1.EnvVar{Name: "DEBUG", Value: "true"}, direct)
+}
+
+func TestPodEnvAssignApplyUnmanagedPod(t *testing.T) {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "debug", Namespace: "shop"}}
+	m := NewPodEnvAssignModel(pod, &k8s.Client{})
+	t.Cleanup(m.cancel)
+	m.envVars = []EnvVarAssignment{{Name: "DEBUG", Value: "true", SourceType: "direct"}}
+
+	msg, ok := m.applyEnvVars()().(envApplyMsg)
+	require.True(t, ok)
+	require.ErrorIs(t, msg.err, k8s.ErrUnmanagedPod)
+
+	m.Update(msg)
+	assert.Empty(t, m.applied)
+	assert.Contains(t, m.View(), "not managed by a deployment")
+}
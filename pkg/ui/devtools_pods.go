@@ -9,6 +9,7 @@ import (
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/karthickk/k8s-manager/pkg/config"
 	"github.com/karthickk/k8s-manager/pkg/k8s"
 	"github.com/karthickk/k8s-manager/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
@@ -20,6 +21,7 @@ type DevToolsPodsModel struct {
 	pods          []PodInfo
 	filteredPods  []PodInfo
 	selected      int
+	window        listWindow // Part of the list on screen
 	filterInput   textinput.Model
 	filtering     bool
 	loading       bool
@@ -32,7 +34,10 @@ type DevToolsPodsModel struct {
 	allNamespaces bool
 	message       string
 	err           error
-	podSelected   bool // Track if a pod was selected
+	podSelected   bool   // Track if a pod was selected
+	more          string // Continue token of the next page while pages are still loading
+	ctx           context.Context
+	cancel        context.CancelFunc
 }
 
 // NewDevToolsPodsModel creates a new DevTools-style pods model
@@ -41,6 +46,8 @@ func NewDevToolsPodsModel(namespace string, allNamespaces bool) *DevToolsPodsMod
 	ti.Placeholder = "Type to filter..."
 	ti.CharLimit = 50
 
+	ctx, cancel := newModelContext()
+
 	return &DevToolsPodsModel{
 		filterInput:   ti,
 		loading:       true,
@@ -48,9 +55,17 @@ func NewDevToolsPodsModel(namespace string, allNamespaces bool) *DevToolsPodsMod
 		namespace:     namespace,
 		allNamespaces: allNamespaces,
 		selected:      -1,
+		window:        newListWindow(devToolsListHeight),
+		ctx:           ctx,
+		cancel:        cancel,
 	}
 }
 
+// quit cancels in-flight requests and exits the program
+func (m *DevToolsPodsModel) quit() tea.Cmd {
+	return quitModel(m.cancel)
+}
+
 func (m *DevToolsPodsModel) Init() tea.Cmd {
 	return tea.Batch(
 		m.loadPods,
@@ -69,36 +84,75 @@ func (m *DevToolsPodsModel) loadPods() tea.Msg {
 		namespace = client.GetNamespace()
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
-
-	var pods *corev1.PodList
 	if m.allNamespaces {
-		pods, err = client.Clientset.CoreV1().Pods("").List(ctx, metav1.ListOptions{})
-	} else {
-		pods, err = client.Clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+		namespace = ""
 	}
 
+	// Only the first page is loaded here so the list shows up quickly; the
+	// rest follows in the background
+	pods, err := m.listPodsPage(client, namespace, "")
 	if err != nil {
 		return errMsg{err}
 	}
 
-	podInfos := make([]PodInfo, 0, len(pods.Items))
-	for _, pod := range pods.Items {
+	return podsLoadedMsg{pods: newPodInfos(pods.Items), client: client, more: pods.Continue}
+}
+
+// listPodsPage fetches the page of pods that starts at the continue token
+func (m *DevToolsPodsModel) listPodsPage(client *k8s.Client, namespace, continueToken string) (*corev1.PodList, error) {
+	ctx, cancel := context.WithTimeout(m.ctx, 30*time.Second)
+	defer cancel()
+
+	return client.Clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		Limit:    k8s.DefaultListPageSize,
+		Continue: continueToken,
+	})
+}
+
+// loadMorePods fetches the next page of pods in the background
+func (m *DevToolsPodsModel) loadMorePods(client *k8s.Client, continueToken string) tea.Cmd {
+	namespace := m.namespace
+	if m.allNamespaces {
+		namespace = ""
+	} else if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	return func() tea.Msg {
+		pods, err := m.listPodsPage(client, namespace, continueToken)
+		if err != nil {
+			return podsPageMsg{token: continueToken, err: err}
+		}
+		return podsPageMsg{token: continueToken, pods: newPodInfos(pods.Items), more: pods.Continue}
+	}
+}
+
+// podsPageMsg carries a page of pods loaded after the first one
+type podsPageMsg struct {
+	token string // Continue token the page was requested with
+	pods  []PodInfo
+	more  string
+	err   error
+}
+
+// newPodInfos converts pods into list entries
+func newPodInfos(pods []corev1.Pod) []PodInfo {
+	podInfos := make([]PodInfo, 0, len(pods))
+	for i := range pods {
+		pod := &pods[i]
 		info := PodInfo{
 			Name:      pod.Name,
 			Namespace: pod.Namespace,
-			Ready:     getPodReadyStatus(&pod),
-			Status:    string(pod.Status.Phase),
-			Restarts:  getPodRestartCount(&pod),
+			Ready:     getPodReadyStatus(pod),
+			Status:    k8s.PodStatus(pod),
+			Restarts:  getPodRestartCount(pod),
 			Age:       utils.FormatAge(pod.CreationTimestamp.Time),
 			Node:      pod.Spec.NodeName,
-			Pod:       &pod,
+			Pod:       pod,
 		}
 		podInfos = append(podInfos, info)
 	}
-
-	return podsLoadedMsg{pods: podInfos, client: client}
+	return podInfos
 }
 
 func (m *DevToolsPodsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -122,8 +176,30 @@ func (m *DevToolsPodsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case podsLoadedMsg:
 		m.loading = false
 		m.pods = msg.pods
-		m.filteredPods = msg.pods
 		m.client = msg.client
+		m.more = msg.more
+		m.applyFilter()
+		if m.more != "" {
+			return m, m.loadMorePods(m.client, m.more)
+		}
+		return m, nil
+
+	case podsPageMsg:
+		// Pages of a list that has since been reloaded are dropped
+		if msg.token != m.more {
+			return m, nil
+		}
+		if msg.err != nil {
+			m.more = ""
+			m.message = fmt.Sprintf("Showing the first %d pods; loading more failed: %v", len(m.pods), msg.err)
+			return m, nil
+		}
+		m.pods = append(m.pods, msg.pods...)
+		m.more = msg.more
+		m.applyFilter()
+		if m.more != "" {
+			return m, m.loadMorePods(m.client, m.more)
+		}
 		return m, nil
 
 	case errMsg:
@@ -138,7 +214,7 @@ func (m *DevToolsPodsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.filtering = false
 				m.filterInput.Blur()
 				m.filterInput.SetValue("")
-				m.filteredPods = m.pods
+				m.applyFilter()
 				return m, nil
 
 			case "enter":
@@ -160,20 +236,17 @@ func (m *DevToolsPodsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Number keys for quick selection
 		if len(keyStr) == 1 && keyStr[0] >= '1' && keyStr[0] <= '8' {
 			num := int(keyStr[0] - '0')
-			if num <= len(m.filteredPods) {
-				m.selected = num - 1
-				m.podSelected = true
-				m.loadingAction = true
-				m.spinner = NewAnimatedSpinner("spinner", fmt.Sprintf("Loading actions for pod %s", m.filteredPods[m.selected].Name))
-				return m, tea.Batch(
-					m.spinner.Init(),
-					tea.Tick(time.Millisecond*300, func(t time.Time) tea.Msg {
-						return tea.Quit()
-					}),
-				)
+			if index := m.window.index(num, len(m.filteredPods)); index >= 0 {
+				return m, m.choosePod(index)
 			}
 		}
 
+		if selected, ok := m.window.jump(keyStr, m.selected, len(m.filteredPods)); ok {
+			m.selected = selected
+			m.window.follow(m.selected, len(m.filteredPods))
+			return m, nil
+		}
+
 		// Special keys
 		switch keyStr {
 		case "9": // Refresh
@@ -185,13 +258,13 @@ func (m *DevToolsPodsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			)
 
 		case "0", "b": // Back to main menu
-			return m, tea.Quit
+			return m, m.quit()
 
 		case "q", "ctrl+c":
-			return m, tea.Quit
+			return m, m.quit()
 
 		case "esc":
-			return m, tea.Quit
+			return m, m.quit()
 
 		case "/":
 			m.filtering = true
@@ -204,6 +277,7 @@ func (m *DevToolsPodsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			} else if m.selected == -1 && len(m.filteredPods) > 0 {
 				m.selected = len(m.filteredPods) - 1
 			}
+			m.window.follow(m.selected, len(m.filteredPods))
 
 		case "down", "j":
 			if m.selected < len(m.filteredPods)-1 {
@@ -211,18 +285,11 @@ func (m *DevToolsPodsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			} else if m.selected == -1 && len(m.filteredPods) > 0 {
 				m.selected = 0
 			}
+			m.window.follow(m.selected, len(m.filteredPods))
 
 		case "enter", " ":
 			if m.selected >= 0 && m.selected < len(m.filteredPods) {
-				m.podSelected = true
-				m.loadingAction = true
-				m.spinner = NewAnimatedSpinner("spinner", fmt.Sprintf("Loading actions for pod %s", m.filteredPods[m.selected].Name))
-				return m, tea.Batch(
-					m.spinner.Init(),
-					tea.Tick(time.Millisecond*300, func(t time.Time) tea.Msg {
-						return tea.Quit()
-					}),
-				)
+				return m, m.choosePod(m.selected)
 			}
 
 		case "r":
@@ -242,6 +309,12 @@ func (m *DevToolsPodsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.selected >= 0 && m.selected < len(m.filteredPods) {
 				return m, m.viewLogs()
 			}
+
+		case "p": // Pin or unpin
+			if m.selected >= 0 && m.selected < len(m.filteredPods) {
+				pod := m.filteredPods[m.selected]
+				m.message = toggleFavorite(config.Favorite{Kind: config.FavoritePod, Namespace: pod.Namespace, Name: pod.Name})
+			}
 		}
 	}
 
@@ -261,6 +334,8 @@ func (m *DevToolsPodsModel) View() string {
 		return s.String()
 	}
 
+	s.WriteString(renderContextHeader(headerNamespace(m.namespace, m.allNamespaces)))
+
 	// Title - same style as main menu
 	title := "📦 Kubernetes Pods"
 	if m.namespace != "" && !m.allNamespaces {
@@ -285,6 +360,11 @@ func (m *DevToolsPodsModel) View() string {
 		s.WriteString("\n\n")
 	}
 
+	if m.more != "" {
+		s.WriteString(devToolsDescriptionStyle.Render(fmt.Sprintf("Loading more pods... (%d so far)", len(m.pods))))
+		s.WriteString("\n\n")
+	}
+
 	// Pods list with numbers - same format as main menu
 	if len(m.filteredPods) == 0 {
 		s.WriteString(devToolsDescriptionStyle.Render("No pods found"))
@@ -298,17 +378,19 @@ func (m *DevToolsPodsModel) View() string {
 		s.WriteString("\n")
 		s.WriteString(devToolsDescriptionStyle.Render("   Return to the main menu"))
 	} else {
-		// Show first 8 pods with numbers (leaving 9 for refresh and 0 for back)
-		maxItems := 8
-		if len(m.filteredPods) < maxItems {
-			maxItems = len(m.filteredPods)
+		// Show the window of pods around the selection, numbered from 1
+		// (leaving 9 for refresh and 0 for back)
+		start, end := m.window.bounds(len(m.filteredPods))
+		if above := m.window.aboveIndicator(len(m.filteredPods), "pods"); above != "" {
+			s.WriteString(devToolsDescriptionStyle.Render(above))
+			s.WriteString("\n")
 		}
 
-		for i := 0; i < maxItems; i++ {
+		for i := start; i < end; i++ {
 			pod := m.filteredPods[i]
 
 			// Number
-			numberStr := devToolsNumberStyle.Render(fmt.Sprintf("%d.", i+1))
+			numberStr := devToolsNumberStyle.Render(fmt.Sprintf("%d.", i-start+1))
 
 			// Pod name - same style as menu items
 			podStr := pod.Name
@@ -320,6 +402,9 @@ func (m *DevToolsPodsModel) View() string {
 				podStr = "  " + devToolsItemStyle.Render(podStr)
 			}
 
+			// Pin marker
+			podStr += renderFavoriteMarker(config.Favorite{Kind: config.FavoritePod, Namespace: pod.Namespace, Name: pod.Name})
+
 			// Status
 			statusStr := m.getStatusString(pod.Status)
 
@@ -335,9 +420,8 @@ func (m *DevToolsPodsModel) View() string {
 			s.WriteString("\n")
 		}
 
-		if len(m.filteredPods) > maxItems {
-			s.WriteString("\n")
-			s.WriteString(devToolsDescriptionStyle.Render(fmt.Sprintf("   ... and %d more pods (use arrows to navigate)", len(m.filteredPods)-maxItems)))
+		if below := m.window.belowIndicator(len(m.filteredPods), "pods"); below != "" {
+			s.WriteString(devToolsDescriptionStyle.Render(below))
 			s.WriteString("\n")
 		}
 
@@ -362,7 +446,7 @@ func (m *DevToolsPodsModel) View() string {
 
 	// Help - same style as main menu
 	s.WriteString("\n\n")
-	helpText := "↑/k up • ↓/j down • 1-8 select pod • 9 refresh • 0 back • / filter • q quit"
+	helpText := "↑/k up • ↓/j down • pgup/pgdn page • g/G first/last • 1-8 select pod • 9 refresh • 0 back • / filter • p pin • q quit"
 	s.WriteString(devToolsHelpStyle.Render(helpText))
 
 	return devToolsContainerStyle.Render(s.String())
@@ -374,7 +458,7 @@ func (m *DevToolsPodsModel) getStatusString(status string) string {
 		return devToolsSuccessStyle.Render("[Running]")
 	case "pending":
 		return devToolsWarningStyle.Render("[Pending]")
-	case "failed", "error", "crashloopbackoff":
+	case "failed", "error", "crashloopbackoff", "imagepullbackoff", "errimagepull":
 		return devToolsErrorStyle.Render("[" + status + "]")
 	case "terminating":
 		return devToolsWarningStyle.Render("[Terminating]")
@@ -383,7 +467,15 @@ func (m *DevToolsPodsModel) getStatusString(status string) string {
 	}
 }
 
+// applyFilter filters the pods by name, namespace and status, keeping the
+// selected pod selected if it is still listed
 func (m *DevToolsPodsModel) applyFilter() {
+	var current *PodInfo
+	if m.selected >= 0 && m.selected < len(m.filteredPods) {
+		pod := m.filteredPods[m.selected]
+		current = &pod
+	}
+
 	filter := strings.ToLower(m.filterInput.Value())
 	if filter == "" {
 		m.filteredPods = m.pods
@@ -398,7 +490,12 @@ func (m *DevToolsPodsModel) applyFilter() {
 		}
 		m.filteredPods = filtered
 	}
-	m.selected = -1 // Reset selection
+
+	m.selected = -1
+	if current != nil {
+		m.selected = podIndex(m.filteredPods, current.Namespace, current.Name)
+	}
+	m.window.follow(m.selected, len(m.filteredPods))
 }
 
 
@@ -409,7 +506,7 @@ func (m *DevToolsPodsModel) deletePod() tea.Cmd {
 
 	pod := m.filteredPods[m.selected]
 	return func() tea.Msg {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(m.ctx, 30*time.Second)
 		defer cancel()
 
 		gracePeriod := int64(30)
@@ -430,6 +527,20 @@ func (m *DevToolsPodsModel) viewLogs() tea.Cmd {
 	}
 }
 
+// choosePod selects the pod at index and quits to show its actions
+func (m *DevToolsPodsModel) choosePod(index int) tea.Cmd {
+	m.selected = index
+	m.podSelected = true
+	m.loadingAction = true
+	m.spinner = NewAnimatedSpinner("spinner", fmt.Sprintf("Loading actions for pod %s", m.filteredPods[index].Name))
+	return tea.Batch(
+		m.spinner.Init(),
+		tea.Tick(time.Millisecond*300, func(t time.Time) tea.Msg {
+			return m.quit()()
+		}),
+	)
+}
+
 // GetSelectedPod returns the currently selected pod
 func (m *DevToolsPodsModel) GetSelectedPod() *PodInfo {
 	if m.podSelected && m.selected >= 0 && m.selected < len(m.filteredPods) {
@@ -440,15 +551,23 @@ func (m *DevToolsPodsModel) GetSelectedPod() *PodInfo {
 
 // Additional DevTools styles
 var (
+	devToolsErrorStyle   lipgloss.Style
+	devToolsSuccessStyle lipgloss.Style
+	devToolsWarningStyle lipgloss.Style
+	devToolsInfoStyle    lipgloss.Style
+)
+
+// buildDevToolsStatusStyles sets the DevTools message styles from the theme
+func buildDevToolsStatusStyles(t Theme) {
 	devToolsErrorStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("196"))
+		Foreground(t.Error)
 
 	devToolsSuccessStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("42"))
+		Foreground(t.Success)
 
 	devToolsWarningStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("214"))
+		Foreground(t.Warning)
 
 	devToolsInfoStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("86"))
-)
\ No newline at end of file
+		Foreground(t.Title)
+}
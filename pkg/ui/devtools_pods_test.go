@@ -0,0 +1,99 @@
+package ui
+
+import (
+	"context"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDevToolsPodsKeepSelectionAcrossReload(t *testing.T) {
+	m := NewDevToolsPodsModel("default", false)
+	t.Cleanup(m.cancel)
+
+	m.Update(podsLoadedMsg{pods: podInfos("api", "cache", "web")})
+	m.selected = 2
+
+	m.Update(podsLoadedMsg{pods: podInfos("api", "auth", "cache", "web")})
+	assert.Equal(t, 3, m.selected)
+	assert.Equal(t, "web", m.filteredPods[m.selected].Name)
+
+	// Filtering keeps the pod selected while it still matches
+	m.filterInput.SetValue("we")
+	m.applyFilter()
+	assert.Equal(t, 0, m.selected)
+	assert.Equal(t, "web", m.filteredPods[m.selected].Name)
+
+	m.filterInput.SetValue("")
+	m.applyFilter()
+	assert.Equal(t, "web", m.filteredPods[m.selected].Name)
+
+	m.Update(podsLoadedMsg{pods: podInfos("api", "auth")})
+	assert.Equal(t, -1, m.selected, "a pod that is gone is no longer selected")
+}
+
+func TestPodsModelKeepsSelectionAcrossReload(t *testing.T) {
+	m := NewPodsModel("default", false)
+	t.Cleanup(m.cancel)
+
+	updated, _ := m.Update(podsLoadedMsg{pods: podInfos("api", "cache", "web")})
+	m = updated.(PodsModel)
+	m.table.SetCursor(1)
+
+	updated, _ = m.Update(podsLoadedMsg{pods: podInfos("api", "auth", "cache", "web")})
+	m = updated.(PodsModel)
+	assert.Equal(t, 2, m.table.Cursor())
+	assert.Equal(t, "cache", m.filteredPods[m.table.Cursor()].Name)
+}
+
+func TestDevToolsPodsLoadsRemainingPagesInBackground(t *testing.T) {
+	m := NewDevToolsPodsModel("default", false)
+	t.Cleanup(m.cancel)
+
+	_, cmd := m.Update(podsLoadedMsg{pods: podInfos("api", "cache"), more: "page-2"})
+	assert.NotNil(t, cmd, "the next page is requested")
+	assert.Equal(t, "page-2", m.more)
+	assert.Contains(t, m.View(), "Loading more pods... (2 so far)")
+
+	m.selected = 1
+	_, cmd = m.Update(podsPageMsg{token: "page-2", pods: podInfos("web"), more: "page-3"})
+	assert.NotNil(t, cmd)
+	assert.Len(t, m.filteredPods, 3)
+	assert.Equal(t, "cache", m.filteredPods[m.selected].Name, "the selection survives new pages")
+
+	// A page of a list that has been reloaded since is dropped
+	m.Update(podsLoadedMsg{pods: podInfos("api"), more: ""})
+	_, cmd = m.Update(podsPageMsg{token: "page-3", pods: podInfos("worker")})
+	assert.Nil(t, cmd)
+	assert.Len(t, m.pods, 1)
+	assert.NotContains(t, m.View(), "Loading more pods")
+}
+
+func TestDevToolsPodsKeepsFirstPagesWhenLaterPageFails(t *testing.T) {
+	m := NewDevToolsPodsModel("default", false)
+	t.Cleanup(m.cancel)
+
+	m.Update(podsLoadedMsg{pods: podInfos("api", "cache"), more: "page-2"})
+	_, cmd := m.Update(podsPageMsg{token: "page-2", err: assert.AnError})
+	assert.Nil(t, cmd)
+	assert.Empty(t, m.more)
+	assert.Len(t, m.pods, 2)
+	assert.Contains(t, m.message, "Showing the first 2 pods")
+}
+
+func TestDevToolsPodsChoosingPodCancelsRequests(t *testing.T) {
+	m := NewDevToolsPodsModel("default", false)
+	t.Cleanup(m.cancel)
+	m.Update(podsLoadedMsg{pods: podInfos("api", "web")})
+
+	cmd := m.choosePod(1)
+	for _, c := range cmd().(tea.BatchMsg) {
+		if c != nil {
+			c()
+		}
+	}
+
+	assert.ErrorIs(t, m.ctx.Err(), context.Canceled)
+	assert.Equal(t, "web", m.GetSelectedPod().Name)
+}
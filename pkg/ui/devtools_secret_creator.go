@@ -3,16 +3,21 @@ package ui
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/karthickk/k8s-manager/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// secretCreatorRandomBytes is how many random bytes ctrl+g generates
+const secretCreatorRandomBytes = 32
+
 // SecretCreatorModel manages the creation of new secrets
 type SecretCreatorModel struct {
 	client         *k8s.Client
@@ -27,9 +32,13 @@ type SecretCreatorModel struct {
 	valueInput     textinput.Model
 	currentKey     string
 	currentValue   string
+	generated      map[string]bool // keys whose values were generated
+	valueGenerated bool            // the value input holds an unedited generated value
 	message        string
 	messageType    string
 	err            error
+	ctx            context.Context
+	cancel         context.CancelFunc
 }
 
 // NewSecretCreatorModel creates a new secret creator model
@@ -54,18 +63,28 @@ func NewSecretCreatorModel(namespace string) *SecretCreatorModel {
 	valueInput.Placeholder = "value"
 	valueInput.CharLimit = 1024
 
+	ctx, cancel := newModelContext()
+
 	return &SecretCreatorModel{
 		step:           0,
 		namespace:      namespace,
 		secretType:     corev1.SecretTypeOpaque,
 		data:           make(map[string]string),
+		generated:      make(map[string]bool),
 		nameInput:      nameInput,
 		namespaceInput: namespaceInput,
 		keyInput:       keyInput,
 		valueInput:     valueInput,
+		ctx:            ctx,
+		cancel:         cancel,
 	}
 }
 
+// quit cancels in-flight requests and exits the program
+func (m *SecretCreatorModel) quit() tea.Cmd {
+	return quitModel(m.cancel)
+}
+
 func (m *SecretCreatorModel) Init() tea.Cmd {
 	return m.loadClient
 }
@@ -101,7 +120,7 @@ func (m *SecretCreatorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.message = "Secret created successfully!"
 			m.messageType = "success"
 		}
-		return m, tea.Quit
+		return m, m.quit()
 
 	case tea.KeyMsg:
 		keyStr := msg.String()
@@ -111,10 +130,11 @@ func (m *SecretCreatorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			switch keyStr {
 			case "enter":
 				m.name = m.nameInput.Value()
-				if m.name == "" {
-					m.message = "Name cannot be empty"
+				if err := utils.ValidateResourceName(m.name); err != nil {
+					m.message = err.Error()
 					m.messageType = "error"
 				} else {
+					m.message = ""
 					m.step = 1
 					m.namespaceInput.Focus()
 					m.nameInput.Blur()
@@ -122,7 +142,7 @@ func (m *SecretCreatorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, nil
 
 			case "esc", "ctrl+c":
-				return m, tea.Quit
+				return m, m.quit()
 
 			default:
 				var cmd tea.Cmd
@@ -148,7 +168,7 @@ func (m *SecretCreatorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, nil
 
 			case "ctrl+c":
-				return m, tea.Quit
+				return m, m.quit()
 
 			default:
 				var cmd tea.Cmd
@@ -182,7 +202,7 @@ func (m *SecretCreatorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, nil
 
 			case "ctrl+c":
-				return m, tea.Quit
+				return m, m.quit()
 			}
 
 		case 3: // Add data
@@ -190,7 +210,14 @@ func (m *SecretCreatorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				switch keyStr {
 				case "enter":
 					m.currentKey = m.keyInput.Value()
+					if err := utils.ValidateDataKey(m.currentKey); err != nil {
+						m.message = err.Error()
+						m.messageType = "error"
+						m.currentKey = ""
+						return m, nil
+					}
 					if m.currentKey != "" {
+						m.message = ""
 						m.keyInput.Blur()
 						m.valueInput.Focus()
 					}
@@ -207,7 +234,7 @@ func (m *SecretCreatorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					return m, nil
 
 				case "ctrl+c":
-					return m, tea.Quit
+					return m, m.quit()
 
 				default:
 					var cmd tea.Cmd
@@ -220,26 +247,47 @@ func (m *SecretCreatorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.currentValue = m.valueInput.Value()
 					if m.currentKey != "" {
 						m.data[m.currentKey] = m.currentValue
+						if m.valueGenerated {
+							m.generated[m.currentKey] = true
+						} else {
+							delete(m.generated, m.currentKey)
+						}
+						m.valueGenerated = false
+						m.message = fmt.Sprintf("Added key: %s", m.currentKey)
+						m.messageType = "success"
 						m.keyInput.SetValue("")
 						m.valueInput.SetValue("")
 						m.currentKey = ""
 						m.currentValue = ""
 						m.valueInput.Blur()
 						m.keyInput.Focus()
-						m.message = fmt.Sprintf("Added key: %s", m.currentKey)
-						m.messageType = "success"
 					}
 					return m, nil
 
+				case "ctrl+g": // Generate a random value
+					value, err := utils.RandomValue(secretCreatorRandomBytes, utils.CharsetBase64)
+					if err != nil {
+						m.message = err.Error()
+						m.messageType = "error"
+						return m, nil
+					}
+					m.valueInput.SetValue(value)
+					m.valueGenerated = true
+					m.message = "Generated a random value; it is shown only once, so copy it before creating"
+					m.messageType = "warning"
+					return m, nil
+
 				case "esc":
+					m.valueGenerated = false
 					m.valueInput.Blur()
 					m.keyInput.Focus()
 					return m, nil
 
 				case "ctrl+c":
-					return m, tea.Quit
+					return m, m.quit()
 
 				default:
+					m.valueGenerated = false
 					var cmd tea.Cmd
 					m.valueInput, cmd = m.valueInput.Update(msg)
 					return m, cmd
@@ -264,7 +312,7 @@ func (m *SecretCreatorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					return m, nil
 
 				case "ctrl+c":
-					return m, tea.Quit
+					return m, m.quit()
 				}
 			}
 
@@ -279,7 +327,7 @@ func (m *SecretCreatorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, nil
 
 			case "esc", "ctrl+c":
-				return m, tea.Quit
+				return m, m.quit()
 			}
 		}
 	}
@@ -291,6 +339,7 @@ func (m *SecretCreatorModel) View() string {
 	var s strings.Builder
 
 	s.WriteString("\033[H\033[2J") // Clear screen
+	s.WriteString(renderContextHeader(m.namespace))
 
 	// Title
 	s.WriteString(devToolsTitleStyle.Render("🔒 Create New Secret"))
@@ -372,7 +421,7 @@ func (m *SecretCreatorModel) View() string {
 			s.WriteString("\nValue: ")
 			s.WriteString(m.valueInput.View())
 			s.WriteString("\n\n")
-			s.WriteString(devToolsHelpStyle.Render("enter to save • esc to cancel"))
+			s.WriteString(devToolsHelpStyle.Render("enter to save • ctrl+g generate random value • esc to cancel"))
 		} else {
 			s.WriteString("\n")
 			s.WriteString(devToolsHelpStyle.Render("a add more • c continue • esc to go back"))
@@ -422,7 +471,7 @@ func (m *SecretCreatorModel) View() string {
 
 func (m *SecretCreatorModel) createSecret() tea.Cmd {
 	return func() tea.Msg {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(m.ctx, 30*time.Second)
 		defer cancel()
 
 		// Convert string data to []byte
@@ -440,7 +489,7 @@ func (m *SecretCreatorModel) createSecret() tea.Cmd {
 			Data: byteData,
 		}
 
-		_, err := m.client.Clientset.CoreV1().Secrets(m.namespace).Create(ctx, secret, metav1.CreateOptions{})
+		_, err := m.client.Clientset.CoreV1().Secrets(m.namespace).Create(ctx, secret, metav1.CreateOptions{FieldManager: k8s.FieldManager()})
 		if err != nil {
 			return secretCreatorErrorMsg{err}
 		}
@@ -461,6 +510,17 @@ func ShowSecretCreator(namespace string) error {
 
 	if m, ok := result.(*SecretCreatorModel); ok && m.message == "Secret created successfully!" {
 		fmt.Printf("\n✅ Secret '%s' created in namespace '%s'\n", m.name, m.namespace)
+		if len(m.generated) > 0 {
+			fmt.Println("⚠️  Generated values are shown only once; store them now:")
+			keys := make([]string, 0, len(m.generated))
+			for key := range m.generated {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				fmt.Printf("%s=%s\n", key, m.data[key])
+			}
+		}
 	}
 
 	return nil
@@ -0,0 +1,71 @@
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+)
+
+// typeInto sends text to a model one rune at a time, then presses enter
+func typeInto(model tea.Model, text string) tea.Model {
+	for _, r := range text {
+		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	return model
+}
+
+func TestSecretCreatorRejectsInvalidName(t *testing.T) {
+	m := NewSecretCreatorModel("default")
+
+	typeInto(m, "My_Secret")
+
+	assert.Equal(t, 0, m.step)
+	assert.Equal(t, "error", m.messageType)
+	assert.Contains(t, m.message, `invalid name "My_Secret"`)
+}
+
+func TestSecretCreatorRejectsInvalidKey(t *testing.T) {
+	m := NewSecretCreatorModel("default")
+	m.step = 3
+	m.keyInput.Focus()
+
+	typeInto(m, "db password")
+
+	assert.Equal(t, "error", m.messageType)
+	assert.Contains(t, m.message, `invalid key "db password"`)
+	assert.True(t, m.keyInput.Focused(), "invalid keys should keep the key input focused")
+	assert.Empty(t, m.currentKey)
+
+	m.keyInput.SetValue("")
+	typeInto(m, "db_password")
+
+	assert.Empty(t, m.message)
+	assert.Equal(t, "db_password", m.currentKey)
+	assert.True(t, m.valueInput.Focused())
+}
+
+func TestSecretCreatorGeneratesRandomValue(t *testing.T) {
+	m := NewSecretCreatorModel("default")
+	m.step = 3
+	m.keyInput.Focus()
+	typeInto(m, "password")
+
+	m.Update(tea.KeyMsg{Type: tea.KeyCtrlG})
+	generated := m.valueInput.Value()
+	assert.Len(t, generated, 44, "32 random bytes in base64")
+	assert.Equal(t, "warning", m.messageType)
+	assert.Contains(t, m.message, "shown only once")
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, generated, m.data["password"])
+	assert.True(t, m.generated["password"])
+
+	// A generated value that is edited is no longer reported as generated
+	typeInto(m, "token")
+	m.Update(tea.KeyMsg{Type: tea.KeyCtrlG})
+	typeInto(m, "x")
+	assert.Equal(t, 45, len(m.data["token"]))
+	assert.False(t, m.generated["token"])
+}
@@ -3,13 +3,16 @@ package ui
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/karthickk/k8s-manager/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -19,6 +22,7 @@ type SecretEditorModel struct {
 	client       *k8s.Client
 	keys         []string
 	values       map[string]string
+	binary       map[string][]byte // Binary values, kept byte for byte and read-only
 	selected     int
 	editing      bool
 	adding       bool
@@ -28,6 +32,11 @@ type SecretEditorModel struct {
 	message      string
 	messageType  string
 	quitting     bool
+	dirty        bool // Edits not yet saved to the cluster
+	confirmQuit  bool // Asking whether to discard unsaved edits
+	conflict     *corev1.Secret // Newer version found on save, awaiting a choice
+	ctx          context.Context
+	cancel       context.CancelFunc
 }
 
 // NewSecretEditorModel creates a new secret editor
@@ -40,24 +49,44 @@ func NewSecretEditorModel(secret *corev1.Secret, client *k8s.Client) *SecretEdit
 	valueInput.Placeholder = "Enter value..."
 	valueInput.CharLimit = 500
 
-	// Decode secret data
-	values := make(map[string]string)
-	keys := make([]string, 0, len(secret.Data))
+	ctx, cancel := newModelContext()
 
-	for k, v := range secret.Data {
-		keys = append(keys, k)
-		values[k] = string(v) // Already decoded from base64
-	}
-
-	return &SecretEditorModel{
+	m := &SecretEditorModel{
 		secret:     secret,
 		client:     client,
-		keys:       keys,
-		values:     values,
 		keyInput:   keyInput,
 		valueInput: valueInput,
 		selected:   -1,
+		ctx:        ctx,
+		cancel:     cancel,
+	}
+	m.loadData()
+	return m
+}
+
+// loadData replaces the edited data with the data of the secret as last
+// loaded or saved. Binary values cannot round-trip through a text input, so
+// they are kept aside untouched.
+func (m *SecretEditorModel) loadData() {
+	m.values = make(map[string]string)
+	m.binary = make(map[string][]byte)
+	m.keys = make([]string, 0, len(m.secret.Data))
+
+	for k, v := range m.secret.Data {
+		m.keys = append(m.keys, k)
+		if utils.IsBinary(v) {
+			m.binary[k] = v
+		} else {
+			m.values[k] = string(v) // Already decoded from base64
+		}
 	}
+	sort.Strings(m.keys)
+	m.dirty = false
+}
+
+// quit cancels in-flight requests and exits the program
+func (m *SecretEditorModel) quit() tea.Cmd {
+	return quitModel(m.cancel)
 }
 
 func (m *SecretEditorModel) Init() tea.Cmd {
@@ -66,7 +95,65 @@ func (m *SecretEditorModel) Init() tea.Cmd {
 
 func (m *SecretEditorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case secretUpdateMsg:
+		if msg.conflict != nil {
+			m.conflict = msg.conflict
+			m.message = "The secret was changed by someone else since it was loaded. r reload theirs (discard your edits) • o overwrite with yours • esc cancel"
+			m.messageType = "error"
+			return m, nil
+		}
+		if msg.err != nil {
+			m.message = fmt.Sprintf("Failed to save: %v", msg.err)
+			m.messageType = "error"
+			return m, nil
+		}
+		m.secret = msg.saved
+		m.dirty = false
+		m.message = fmt.Sprintf("Saved %s", m.secret.Name)
+		m.messageType = "success"
+		return m, nil
+
 	case tea.KeyMsg:
+		// Unsaved edits are only discarded once confirmed
+		if m.confirmQuit {
+			switch msg.String() {
+			case "y", "Y":
+				m.quitting = true
+				return m, m.quit()
+			default:
+				m.confirmQuit = false
+				m.message = ""
+				return m, nil
+			}
+		}
+
+		// A save that lost a race with another writer needs a decision
+		if m.conflict != nil {
+			switch msg.String() {
+			case "r":
+				m.secret = m.conflict
+				m.conflict = nil
+				m.loadData()
+				m.selected = -1
+				m.message = "Reloaded the latest version of the secret"
+				m.messageType = "success"
+				return m, nil
+			case "o":
+				// Saving on top of the latest version keeps the resource
+				// version check for any further concurrent change
+				m.secret = m.conflict
+				m.conflict = nil
+				m.message = "Saving..."
+				m.messageType = "info"
+				return m, m.saveSecret()
+			case "esc", "n", "N":
+				m.conflict = nil
+				m.message = ""
+				return m, nil
+			}
+			return m, nil
+		}
+
 		// Handle input mode
 		if m.editing || m.adding {
 			switch msg.String() {
@@ -97,11 +184,21 @@ func (m *SecretEditorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					key := m.keyInput.Value()
 					value := m.valueInput.Value()
 
+					if key != "" {
+						if err := utils.ValidateDataKey(key); err != nil {
+							m.message = err.Error()
+							m.messageType = "error"
+							return m, nil
+						}
+					}
+
 					if key != "" && value != "" {
 						m.values[key] = value
+						delete(m.binary, key)
 						if !contains(m.keys, key) {
 							m.keys = append(m.keys, key)
 						}
+						m.dirty = true
 						m.message = fmt.Sprintf("Added %s", key)
 						m.messageType = "success"
 
@@ -117,6 +214,7 @@ func (m *SecretEditorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					value := m.valueInput.Value()
 					if m.currentKey != "" && value != "" {
 						m.values[m.currentKey] = value
+						m.dirty = true
 						m.message = fmt.Sprintf("Updated %s", m.currentKey)
 						m.messageType = "success"
 
@@ -148,11 +246,7 @@ func (m *SecretEditorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if num <= len(m.keys) {
 				m.selected = num - 1
 				// Start editing
-				m.currentKey = m.keys[m.selected]
-				m.valueInput.SetValue(m.values[m.currentKey])
-				m.valueInput.Focus()
-				m.editing = true
-				return m, textinput.Blink
+				return m, m.startEditing(m.keys[m.selected])
 			}
 		}
 
@@ -166,18 +260,16 @@ func (m *SecretEditorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		case "e": // Edit selected
 			if m.selected >= 0 && m.selected < len(m.keys) {
-				m.currentKey = m.keys[m.selected]
-				m.valueInput.SetValue(m.values[m.currentKey])
-				m.valueInput.Focus()
-				m.editing = true
-				return m, textinput.Blink
+				return m, m.startEditing(m.keys[m.selected])
 			}
 
 		case "d": // Delete selected
 			if m.selected >= 0 && m.selected < len(m.keys) {
 				key := m.keys[m.selected]
 				delete(m.values, key)
+				delete(m.binary, key)
 				m.keys = append(m.keys[:m.selected], m.keys[m.selected+1:]...)
+				m.dirty = true
 				m.message = fmt.Sprintf("Deleted %s", key)
 				m.messageType = "info"
 				if m.selected >= len(m.keys) && m.selected > 0 {
@@ -188,6 +280,14 @@ func (m *SecretEditorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "s": // Save changes
 			return m, m.saveSecret()
 
+		case "u": // Revert to the last saved data
+			if m.dirty {
+				m.loadData()
+				m.selected = -1
+				m.message = "Reverted unsaved changes"
+				m.messageType = "info"
+			}
+
 		case "up", "k":
 			if m.selected > 0 {
 				m.selected--
@@ -203,50 +303,93 @@ func (m *SecretEditorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 
 		case "q", "ctrl+c", "esc":
+			if m.dirty {
+				m.confirmQuit = true
+				m.message = "Discard unsaved changes? (y/N)"
+				m.messageType = "error"
+				return m, nil
+			}
 			m.quitting = true
-			return m, tea.Quit
+			return m, m.quit()
 		}
 	}
 
 	return m, nil
 }
 
-func (m *SecretEditorModel) saveSecret() tea.Cmd {
-	return func() tea.Msg {
-		// Encode values to base64
-		newData := make(map[string][]byte)
-		for k, v := range m.values {
-			newData[k] = []byte(v)
-		}
+// startEditing opens the value editor for key. Binary values are read-only.
+func (m *SecretEditorModel) startEditing(key string) tea.Cmd {
+	if value, ok := m.binary[key]; ok {
+		m.message = fmt.Sprintf("%s holds binary data (%d bytes) and is read-only", key, len(value))
+		m.messageType = "error"
+		return nil
+	}
+
+	m.currentKey = key
+	m.valueInput.SetValue(m.values[key])
+	m.valueInput.Focus()
+	m.editing = true
+	return textinput.Blink
+}
+
+// secretData returns the data to save: the edited text values plus the
+// binary values exactly as they were loaded
+func (m *SecretEditorModel) secretData() map[string][]byte {
+	data := make(map[string][]byte, len(m.values)+len(m.binary))
+	for k, v := range m.values {
+		data[k] = []byte(v)
+	}
+	for k, v := range m.binary {
+		data[k] = v
+	}
+	return data
+}
 
-		m.secret.Data = newData
+// saveSecret updates the secret with the edited data. The secret the editor
+// reverts to is only replaced once the update succeeds.
+func (m *SecretEditorModel) saveSecret() tea.Cmd {
+	updated := m.secret.DeepCopy()
+	updated.Data = m.secretData()
 
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.ctx, 30*time.Second)
 		defer cancel()
 
-		_, err := m.client.Clientset.CoreV1().Secrets(m.secret.Namespace).Update(
-			ctx, m.secret, metav1.UpdateOptions{})
+		saved, err := m.client.Clientset.CoreV1().Secrets(updated.Namespace).Update(
+			ctx, updated, metav1.UpdateOptions{FieldManager: k8s.FieldManager()})
 
+		if apierrors.IsConflict(err) {
+			latest, getErr := m.client.Clientset.CoreV1().Secrets(updated.Namespace).Get(ctx, updated.Name, metav1.GetOptions{})
+			if getErr == nil {
+				return secretUpdateMsg{err: err, conflict: latest}
+			}
+		}
 		if err != nil {
 			return secretUpdateMsg{err: err}
 		}
 
-		return secretUpdateMsg{success: true}
+		return secretUpdateMsg{success: true, saved: saved}
 	}
 }
 
 type secretUpdateMsg struct {
-	success bool
-	err     error
+	success  bool
+	saved    *corev1.Secret
+	conflict *corev1.Secret // Latest version when the update hit a conflict
+	err      error
 }
 
 func (m *SecretEditorModel) View() string {
 	var s strings.Builder
 
 	s.WriteString("\033[H\033[2J") // Clear screen
+	s.WriteString(renderContextHeader(m.secret.Namespace))
 
 	// Title
 	s.WriteString(devToolsTitleStyle.Render(fmt.Sprintf("🔐 Edit Secret: %s", m.secret.Name)))
+	if m.dirty {
+		s.WriteString(" " + devToolsWarningStyle.Render("● unsaved changes"))
+	}
 	s.WriteString("\n\n")
 
 	// Show input fields when adding
@@ -300,10 +443,14 @@ func (m *SecretEditorModel) View() string {
 			s.WriteString("\n")
 
 			// Value (masked)
-			value := m.values[key]
-			displayValue := value
-			if len(displayValue) > 40 {
-				displayValue = displayValue[:15] + "..." + displayValue[len(displayValue)-15:]
+			var displayValue string
+			if data, ok := m.binary[key]; ok {
+				displayValue = fmt.Sprintf("%s %s (read-only)", binarySummary(data), hexPreview(data, 16))
+			} else {
+				displayValue = m.values[key]
+				if len(displayValue) > 40 {
+					displayValue = displayValue[:15] + "..." + displayValue[len(displayValue)-15:]
+				}
 			}
 			s.WriteString(devToolsDescriptionStyle.Render("   " + displayValue))
 			s.WriteString("\n")
@@ -326,7 +473,7 @@ func (m *SecretEditorModel) View() string {
 	// Help
 	s.WriteString("\n\n")
 	s.WriteString(devToolsHelpStyle.Render(
-		"↑/k up • ↓/j down • 1-8 quick edit • a add • e edit • d delete • s save • q quit",
+		"↑/k up • ↓/j down • 1-8 quick edit • a add • e edit • d delete • s save • u revert • q quit",
 	))
 
 	return devToolsContainerStyle.Render(s.String())
@@ -341,6 +488,8 @@ type CreateSecretModel struct {
 	data         map[string]string
 	step         int // 0: name/type, 1: add data, 2: review
 	message      string
+	ctx          context.Context
+	cancel       context.CancelFunc
 }
 
 // NewCreateSecretModel creates a new secret creation model
@@ -350,15 +499,24 @@ func NewCreateSecretModel(namespace string, client *k8s.Client) *CreateSecretMod
 	nameInput.CharLimit = 63
 	nameInput.Focus()
 
+	ctx, cancel := newModelContext()
+
 	return &CreateSecretModel{
 		namespace:    namespace,
 		client:       client,
 		nameInput:    nameInput,
 		data:         make(map[string]string),
 		typeSelector: 0,
+		ctx:          ctx,
+		cancel:       cancel,
 	}
 }
 
+// quit cancels in-flight requests and exits the program
+func (m *CreateSecretModel) quit() tea.Cmd {
+	return quitModel(m.cancel)
+}
+
 func (m *CreateSecretModel) Init() tea.Cmd {
 	return textinput.Blink
 }
@@ -371,6 +529,11 @@ func (m *CreateSecretModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			switch msg.String() {
 			case "enter":
 				if m.nameInput.Value() != "" {
+					if err := utils.ValidateResourceName(m.nameInput.Value()); err != nil {
+						m.message = err.Error()
+						return m, nil
+					}
+					m.message = ""
 					m.step = 1
 					m.nameInput.Blur()
 				}
@@ -395,7 +558,7 @@ func (m *CreateSecretModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 
 			case "esc", "q":
-				return m, tea.Quit
+				return m, m.quit()
 
 			default:
 				if m.nameInput.Focused() {
@@ -415,7 +578,7 @@ func (m *CreateSecretModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 
 			case "esc", "q":
-				return m, tea.Quit
+				return m, m.quit()
 			}
 
 		case 2: // Review and create
@@ -427,7 +590,7 @@ func (m *CreateSecretModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.step = 1
 
 			case "esc", "q":
-				return m, tea.Quit
+				return m, m.quit()
 			}
 		}
 	}
@@ -462,11 +625,11 @@ func (m *CreateSecretModel) createSecret() tea.Cmd {
 			Data: secretData,
 		}
 
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(m.ctx, 30*time.Second)
 		defer cancel()
 
 		_, err := m.client.Clientset.CoreV1().Secrets(m.namespace).Create(
-			ctx, secret, metav1.CreateOptions{})
+			ctx, secret, metav1.CreateOptions{FieldManager: k8s.FieldManager()})
 
 		if err != nil {
 			return secretCreateMsg{err: err}
@@ -485,6 +648,7 @@ func (m *CreateSecretModel) View() string {
 	var s strings.Builder
 
 	s.WriteString("\033[H\033[2J") // Clear screen
+	s.WriteString(renderContextHeader(m.namespace))
 
 	// Title
 	s.WriteString(devToolsTitleStyle.Render("🔐 Create New Secret"))
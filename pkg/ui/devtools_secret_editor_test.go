@@ -0,0 +1,211 @@
+package ui
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// newSecretUpdateServer returns a client whose API server records the body of
+// the secret update it receives
+func newSecretUpdateServer(t *testing.T, saved *corev1.Secret) *k8s.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(saved))
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(saved)
+	}))
+	t.Cleanup(server.Close)
+
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+	return &k8s.Client{Clientset: clientset}
+}
+
+func TestSecretEditorPreservesBinaryData(t *testing.T) {
+	keystore := make([]byte, 256)
+	_, err := rand.Read(keystore)
+	require.NoError(t, err)
+	keystore[0] = 0xfe // never valid UTF-8, whatever the random bytes are
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "tls", Namespace: "default"},
+		Data: map[string][]byte{
+			"keystore.jks": keystore,
+			"password":     []byte("changeit"),
+		},
+	}
+
+	var saved corev1.Secret
+	m := NewSecretEditorModel(secret, newSecretUpdateServer(t, &saved))
+	m.keys = []string{"keystore.jks", "password"}
+
+	// The binary value cannot be opened for editing
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+	assert.False(t, m.editing)
+	assert.Contains(t, m.message, "read-only")
+	assert.Contains(t, m.View(), "<binary, 256 bytes>")
+
+	// Edit the unrelated text value and save
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	require.True(t, m.editing)
+	m.valueInput.SetValue("s3cr3t")
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	msg := m.saveSecret()()
+	update, ok := msg.(secretUpdateMsg)
+	require.True(t, ok)
+	require.NoError(t, update.err)
+	require.True(t, update.success)
+
+	assert.Equal(t, keystore, saved.Data["keystore.jks"], "binary value must survive byte for byte")
+	assert.Equal(t, []byte("s3cr3t"), saved.Data["password"])
+}
+
+func TestViewSecretDataSummarisesBinaryValues(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "tls"},
+		Data: map[string][]byte{
+			"cert.der": {0x30, 0x82, 0x01, 0x0a, 0x02},
+			"user":     []byte("admin"),
+		},
+	}
+
+	view := ViewSecretData(secret)
+
+	assert.Contains(t, view, "<binary, 5 bytes>")
+	assert.Contains(t, view, "admin")
+}
+
+func TestSecretEditorTracksUnsavedChanges(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default"},
+		Data:       map[string][]byte{"password": []byte("changeit"), "user": []byte("admin")},
+	}
+
+	var saved corev1.Secret
+	m := NewSecretEditorModel(secret, newSecretUpdateServer(t, &saved))
+	assert.Equal(t, []string{"password", "user"}, m.keys)
+	assert.NotContains(t, m.View(), "unsaved changes")
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+	m.valueInput.SetValue("s3cr3t")
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.True(t, m.dirty)
+	assert.Contains(t, m.View(), "● unsaved changes")
+
+	// Quitting asks first, and anything but y keeps the editor open
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+	assert.Nil(t, cmd)
+	assert.True(t, m.confirmQuit)
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	assert.False(t, m.confirmQuit)
+	assert.False(t, m.quitting)
+
+	// u reverts to the data last loaded
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("u")})
+	assert.False(t, m.dirty)
+	assert.Equal(t, "changeit", m.values["password"])
+
+	// Once saved, the saved data is what u reverts to
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	m.valueInput.SetValue("root")
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m.Update(m.saveSecret()())
+	assert.False(t, m.dirty)
+	assert.Equal(t, []byte("root"), saved.Data["user"])
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	assert.True(t, m.dirty)
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("u")})
+	assert.Equal(t, "root", m.values["user"])
+	assert.Equal(t, []byte("changeit"), secret.Data["password"], "the loaded secret is never modified")
+
+	// Discarding is confirmed with y
+	m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	_, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	assert.NotNil(t, cmd)
+	assert.True(t, m.quitting)
+}
+
+func TestSecretEditorConflict(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default", ResourceVersion: "1"},
+		Data:       map[string][]byte{"password": []byte("changeit")},
+	}
+	latest := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default", ResourceVersion: "2"},
+		Data:       map[string][]byte{"password": []byte("rotated")},
+	}
+
+	var updates []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			json.NewEncoder(w).Encode(latest)
+		case http.MethodPut:
+			var sent corev1.Secret
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&sent))
+			assert.Equal(t, "k8s-manager", r.URL.Query().Get("fieldManager"))
+			updates = append(updates, sent.ResourceVersion)
+			if sent.ResourceVersion != latest.ResourceVersion {
+				w.WriteHeader(http.StatusConflict)
+				json.NewEncoder(w).Encode(metav1.Status{Status: metav1.StatusFailure, Reason: metav1.StatusReasonConflict, Code: http.StatusConflict})
+				return
+			}
+			json.NewEncoder(w).Encode(sent)
+		}
+	}))
+	t.Cleanup(server.Close)
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+
+	m := NewSecretEditorModel(secret, &k8s.Client{Clientset: clientset})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+	m.valueInput.SetValue("mine")
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	// A stale save is not overwritten silently; the user chooses what to keep
+	m.Update(m.saveSecret()())
+	require.NotNil(t, m.conflict)
+	assert.Contains(t, m.View(), "changed by someone else")
+	assert.True(t, m.dirty)
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("o")})
+	require.NotNil(t, cmd)
+	m.Update(cmd())
+	assert.Nil(t, m.conflict)
+	assert.False(t, m.dirty)
+	assert.Equal(t, []string{"1", "2"}, updates)
+	assert.Equal(t, []byte("mine"), m.secret.Data["password"])
+
+	// Reloading discards the edits in favour of the latest version
+	m.secret = secret
+	m.loadData()
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+	m.valueInput.SetValue("mine")
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m.Update(m.saveSecret()())
+	require.NotNil(t, m.conflict)
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	assert.Nil(t, m.conflict)
+	assert.False(t, m.dirty)
+	assert.Equal(t, "rotated", m.values["password"])
+}
@@ -3,6 +3,7 @@ package ui
 import (
 	"context"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"sort"
 	"strings"
@@ -10,9 +11,12 @@ import (
 
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/karthickk/k8s-manager/pkg/config"
 	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/karthickk/k8s-manager/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
 )
 
 // DevToolsSecretsModel represents the secrets view in DevTools style
@@ -20,6 +24,7 @@ type DevToolsSecretsModel struct {
 	secrets       []SecretInfo
 	filtered      []SecretInfo
 	selected      int
+	window        listWindow // Part of the list on screen
 	filterInput   textinput.Model
 	filtering     bool
 	loading       bool
@@ -31,6 +36,11 @@ type DevToolsSecretsModel struct {
 	message       string
 	err           error
 	secretSelected bool
+	deepSearch     bool            // Also match keys and decoded values
+	deepMatches    map[string]bool // Secrets matched only by their data
+	more           string          // Continue token of the next page while pages are still loading
+	ctx            context.Context
+	cancel         context.CancelFunc
 }
 
 // SecretInfo holds secret information
@@ -49,6 +59,8 @@ func NewDevToolsSecretsModel(namespace string, allNamespaces bool) *DevToolsSecr
 	ti.Placeholder = "Type to filter..."
 	ti.CharLimit = 50
 
+	ctx, cancel := newModelContext()
+
 	return &DevToolsSecretsModel{
 		filterInput:   ti,
 		loading:       true,
@@ -56,9 +68,17 @@ func NewDevToolsSecretsModel(namespace string, allNamespaces bool) *DevToolsSecr
 		namespace:     namespace,
 		allNamespaces: allNamespaces,
 		selected:      -1,
+		window:        newListWindow(devToolsListHeight),
+		ctx:           ctx,
+		cancel:        cancel,
 	}
 }
 
+// quit cancels in-flight requests and exits the program
+func (m *DevToolsSecretsModel) quit() tea.Cmd {
+	return quitModel(m.cancel)
+}
+
 func (m *DevToolsSecretsModel) Init() tea.Cmd {
 	return tea.Batch(
 		m.loadSecrets,
@@ -77,40 +97,81 @@ func (m *DevToolsSecretsModel) loadSecrets() tea.Msg {
 		namespace = client.GetNamespace()
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
-
-	var secrets *corev1.SecretList
 	if m.allNamespaces {
-		secrets, err = client.Clientset.CoreV1().Secrets("").List(ctx, metav1.ListOptions{})
-	} else {
-		secrets, err = client.Clientset.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{})
+		namespace = ""
 	}
 
+	// Only the first page is loaded here so the list shows up quickly; the
+	// rest follows in the background
+	secrets, err := m.listSecretsPage(client, namespace, "")
 	if err != nil {
 		return secretErrorMsg{err}
 	}
 
-	secretInfos := make([]SecretInfo, 0, len(secrets.Items))
-	for _, secret := range secrets.Items {
+	return secretsLoadedMsg{secrets: newSecretInfos(secrets.Items), client: client, more: secrets.Continue}
+}
+
+// listSecretsPage fetches the page of secrets that starts at the continue
+// token
+func (m *DevToolsSecretsModel) listSecretsPage(client *k8s.Client, namespace, continueToken string) (*corev1.SecretList, error) {
+	ctx, cancel := context.WithTimeout(m.ctx, 30*time.Second)
+	defer cancel()
+
+	return client.Clientset.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{
+		Limit:    k8s.DefaultListPageSize,
+		Continue: continueToken,
+	})
+}
+
+// loadMoreSecrets fetches the next page of secrets in the background
+func (m *DevToolsSecretsModel) loadMoreSecrets(client *k8s.Client, continueToken string) tea.Cmd {
+	namespace := m.namespace
+	if m.allNamespaces {
+		namespace = ""
+	} else if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	return func() tea.Msg {
+		secrets, err := m.listSecretsPage(client, namespace, continueToken)
+		if err != nil {
+			return secretsPageMsg{token: continueToken, err: err}
+		}
+		return secretsPageMsg{token: continueToken, secrets: newSecretInfos(secrets.Items), more: secrets.Continue}
+	}
+}
+
+// newSecretInfos converts secrets into list entries
+func newSecretInfos(secrets []corev1.Secret) []SecretInfo {
+	secretInfos := make([]SecretInfo, 0, len(secrets))
+	for i := range secrets {
+		secret := &secrets[i]
 		info := SecretInfo{
 			Name:      secret.Name,
 			Namespace: secret.Namespace,
 			Type:      string(secret.Type),
 			DataCount: len(secret.Data),
 			Age:       formatAge(secret.CreationTimestamp.Time),
-			Secret:    &secret,
+			Secret:    secret,
 		}
 		secretInfos = append(secretInfos, info)
 	}
-
-	return secretsLoadedMsg{secrets: secretInfos, client: client}
+	return secretInfos
 }
 
 type secretErrorMsg struct{ err error }
 type secretsLoadedMsg struct {
 	secrets []SecretInfo
 	client  *k8s.Client
+	more    string // Continue token when only the first page was loaded
+}
+
+// secretsPageMsg carries a page of secrets loaded after the first one
+type secretsPageMsg struct {
+	token   string // Continue token the page was requested with
+	secrets []SecretInfo
+	more    string
+	err     error
 }
 
 func (m *DevToolsSecretsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -132,8 +193,30 @@ func (m *DevToolsSecretsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case secretsLoadedMsg:
 		m.loading = false
 		m.secrets = msg.secrets
-		m.filtered = msg.secrets
 		m.client = msg.client
+		m.more = msg.more
+		m.applyFilter(m.deepSearch)
+		if m.more != "" {
+			return m, m.loadMoreSecrets(m.client, m.more)
+		}
+		return m, nil
+
+	case secretsPageMsg:
+		// Pages of a list that has since been reloaded are dropped
+		if msg.token != m.more {
+			return m, nil
+		}
+		if msg.err != nil {
+			m.more = ""
+			m.message = fmt.Sprintf("Showing the first %d secrets; loading more failed: %v", len(m.secrets), msg.err)
+			return m, nil
+		}
+		m.secrets = append(m.secrets, msg.secrets...)
+		m.more = msg.more
+		m.applyFilter(m.deepSearch)
+		if m.more != "" {
+			return m, m.loadMoreSecrets(m.client, m.more)
+		}
 		return m, nil
 
 	case secretErrorMsg:
@@ -148,19 +231,20 @@ func (m *DevToolsSecretsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.filtering = false
 				m.filterInput.Blur()
 				m.filterInput.SetValue("")
-				m.filtered = m.secrets
+				m.applyFilter(false)
 				return m, nil
 
 			case "enter":
 				m.filtering = false
 				m.filterInput.Blur()
-				m.applyFilter()
+				m.applyFilter(m.deepSearch)
 				return m, nil
 
 			default:
+				// Secret data is only searched once the filter is submitted
 				var cmd tea.Cmd
 				m.filterInput, cmd = m.filterInput.Update(msg)
-				m.applyFilter()
+				m.applyFilter(false)
 				return m, cmd
 			}
 		}
@@ -170,20 +254,26 @@ func (m *DevToolsSecretsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Number keys for quick selection
 		if len(keyStr) == 1 && keyStr[0] >= '1' && keyStr[0] <= '8' {
 			num := int(keyStr[0] - '0')
-			if num <= len(m.filtered) {
-				m.selected = num - 1
+			if index := m.window.index(num, len(m.filtered)); index >= 0 {
+				m.selected = index
 				m.secretSelected = true
 				m.loadingAction = true
 				m.spinner = NewAnimatedSpinner("spinner", fmt.Sprintf("Loading secret %s", m.filtered[m.selected].Name))
 				return m, tea.Batch(
 					m.spinner.Init(),
 					tea.Tick(time.Millisecond*300, func(t time.Time) tea.Msg {
-						return tea.Quit()
+						return m.quit()()
 					}),
 				)
 			}
 		}
 
+		if selected, ok := m.window.jump(keyStr, m.selected, len(m.filtered)); ok {
+			m.selected = selected
+			m.window.follow(m.selected, len(m.filtered))
+			return m, nil
+		}
+
 		// Special keys
 		switch keyStr {
 		case "9": // Create new secret
@@ -194,27 +284,38 @@ func (m *DevToolsSecretsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, tea.Batch(
 				m.spinner.Init(),
 				tea.Tick(time.Millisecond*300, func(t time.Time) tea.Msg {
-					return tea.Quit()
+					return m.quit()()
 				}),
 			)
 
 		case "0", "b": // Back to main menu
-			return m, tea.Quit
+			return m, m.quit()
 
 		case "q", "ctrl+c", "esc":
-			return m, tea.Quit
+			return m, m.quit()
 
 		case "/":
 			m.filtering = true
 			m.filterInput.Focus()
 			return m, textinput.Blink
 
+		case "D": // Toggle deep search
+			m.deepSearch = !m.deepSearch
+			if m.deepSearch {
+				m.message = "Deep search on: keys and values are searched when the filter is submitted"
+			} else {
+				m.message = "Deep search off"
+			}
+			m.applyFilter(m.deepSearch)
+			return m, nil
+
 		case "up", "k":
 			if m.selected > 0 {
 				m.selected--
 			} else if m.selected == -1 && len(m.filtered) > 0 {
 				m.selected = len(m.filtered) - 1
 			}
+			m.window.follow(m.selected, len(m.filtered))
 
 		case "down", "j":
 			if m.selected < len(m.filtered)-1 {
@@ -222,6 +323,7 @@ func (m *DevToolsSecretsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			} else if m.selected == -1 && len(m.filtered) > 0 {
 				m.selected = 0
 			}
+			m.window.follow(m.selected, len(m.filtered))
 
 		case "enter", " ":
 			if m.selected >= 0 && m.selected < len(m.filtered) {
@@ -231,7 +333,7 @@ func (m *DevToolsSecretsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, tea.Batch(
 					m.spinner.Init(),
 					tea.Tick(time.Millisecond*300, func(t time.Time) tea.Msg {
-						return tea.Quit()
+						return m.quit()()
 					}),
 				)
 			}
@@ -248,6 +350,12 @@ func (m *DevToolsSecretsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.selected >= 0 && m.selected < len(m.filtered) {
 				return m, m.deleteSecret()
 			}
+
+		case "p": // Pin or unpin
+			if m.selected >= 0 && m.selected < len(m.filtered) {
+				secret := m.filtered[m.selected]
+				m.message = toggleFavorite(config.Favorite{Kind: config.FavoriteSecret, Namespace: secret.Namespace, Name: secret.Name})
+			}
 		}
 	}
 
@@ -267,6 +375,8 @@ func (m *DevToolsSecretsModel) View() string {
 		return s.String()
 	}
 
+	s.WriteString(renderContextHeader(headerNamespace(m.namespace, m.allNamespaces)))
+
 	// Title
 	title := "🔒 Kubernetes Secrets"
 	if m.namespace != "" && !m.allNamespaces {
@@ -286,11 +396,20 @@ func (m *DevToolsSecretsModel) View() string {
 
 	// Filter
 	if m.filtering {
-		s.WriteString("Filter: ")
+		if m.deepSearch {
+			s.WriteString("Deep filter: ")
+		} else {
+			s.WriteString("Filter: ")
+		}
 		s.WriteString(m.filterInput.View())
 		s.WriteString("\n\n")
 	}
 
+	if m.more != "" {
+		s.WriteString(devToolsDescriptionStyle.Render(fmt.Sprintf("Loading more secrets... (%d so far)", len(m.secrets))))
+		s.WriteString("\n\n")
+	}
+
 	// Secrets list
 	if len(m.filtered) == 0 {
 		s.WriteString(devToolsDescriptionStyle.Render("No secrets found"))
@@ -299,17 +418,18 @@ func (m *DevToolsSecretsModel) View() string {
 		}
 		s.WriteString("\n\n")
 	} else {
-		// Show first 8 secrets with numbers
-		maxItems := 8
-		if len(m.filtered) < maxItems {
-			maxItems = len(m.filtered)
+		// Show the window of secrets around the selection, numbered from 1
+		start, end := m.window.bounds(len(m.filtered))
+		if above := m.window.aboveIndicator(len(m.filtered), "secrets"); above != "" {
+			s.WriteString(devToolsDescriptionStyle.Render(above))
+			s.WriteString("\n")
 		}
 
-		for i := 0; i < maxItems; i++ {
+		for i := start; i < end; i++ {
 			secret := m.filtered[i]
 
 			// Number
-			numberStr := devToolsNumberStyle.Render(fmt.Sprintf("%d.", i+1))
+			numberStr := devToolsNumberStyle.Render(fmt.Sprintf("%d.", i-start+1))
 
 			// Secret name
 			secretStr := secret.Name
@@ -321,8 +441,14 @@ func (m *DevToolsSecretsModel) View() string {
 				secretStr = "  " + devToolsItemStyle.Render(secretStr)
 			}
 
+			// Pin marker
+			secretStr += renderFavoriteMarker(config.Favorite{Kind: config.FavoriteSecret, Namespace: secret.Namespace, Name: secret.Name})
+
 			// Type indicator
 			typeStr := m.getTypeString(secret.Type)
+			if m.deepMatches[secret.Namespace+"/"+secret.Name] {
+				typeStr += " " + devToolsWarningStyle.Render("[data match]")
+			}
 
 			s.WriteString(numberStr + secretStr + " " + typeStr)
 			s.WriteString("\n")
@@ -337,10 +463,8 @@ func (m *DevToolsSecretsModel) View() string {
 			s.WriteString("\n")
 		}
 
-		if len(m.filtered) > maxItems {
-			s.WriteString("\n")
-			s.WriteString(devToolsDescriptionStyle.Render(
-				fmt.Sprintf("   ... and %d more secrets (use arrows to navigate)", len(m.filtered)-maxItems)))
+		if below := m.window.belowIndicator(len(m.filtered), "secrets"); below != "" {
+			s.WriteString(devToolsDescriptionStyle.Render(below))
 			s.WriteString("\n")
 		}
 	}
@@ -365,7 +489,7 @@ func (m *DevToolsSecretsModel) View() string {
 
 	// Help
 	s.WriteString("\n\n")
-	helpText := "↑/k up • ↓/j down • 1-8 select • 9 create • 0 back • / filter • d delete • r refresh • q quit"
+	helpText := "↑/k up • ↓/j down • pgup/pgdn page • g/G first/last • 1-8 select • 9 create • 0 back • / filter • D deep search • d delete • p pin • r refresh • q quit"
 	s.WriteString(devToolsHelpStyle.Render(helpText))
 
 	return devToolsContainerStyle.Render(s.String())
@@ -386,7 +510,18 @@ func (m *DevToolsSecretsModel) getTypeString(secretType string) string {
 	}
 }
 
-func (m *DevToolsSecretsModel) applyFilter() {
+// applyFilter filters secrets by name, namespace and type. With deep set,
+// secrets whose keys or decoded values contain the filter are included too;
+// they are flagged in the list but the matching value is never shown. The
+// selected secret stays selected if it is still listed.
+func (m *DevToolsSecretsModel) applyFilter(deep bool) {
+	var current *SecretInfo
+	if m.selected >= 0 && m.selected < len(m.filtered) {
+		secret := m.filtered[m.selected]
+		current = &secret
+	}
+
+	m.deepMatches = map[string]bool{}
 	filter := strings.ToLower(m.filterInput.Value())
 	if filter == "" {
 		m.filtered = m.secrets
@@ -397,11 +532,39 @@ func (m *DevToolsSecretsModel) applyFilter() {
 				strings.Contains(strings.ToLower(secret.Namespace), filter) ||
 				strings.Contains(strings.ToLower(secret.Type), filter) {
 				filtered = append(filtered, secret)
+			} else if deep && secretDataContains(secret.Secret, filter) {
+				m.deepMatches[secret.Namespace+"/"+secret.Name] = true
+				filtered = append(filtered, secret)
 			}
 		}
 		m.filtered = filtered
 	}
+
 	m.selected = -1
+	if current != nil {
+		for i, secret := range m.filtered {
+			if secret.Name == current.Name && secret.Namespace == current.Namespace {
+				m.selected = i
+				break
+			}
+		}
+	}
+	m.window.follow(m.selected, len(m.filtered))
+}
+
+// secretDataContains reports whether any key or value of the secret contains
+// the lowercase needle
+func secretDataContains(secret *corev1.Secret, needle string) bool {
+	if secret == nil {
+		return false
+	}
+	for key, value := range secret.Data {
+		if strings.Contains(strings.ToLower(key), needle) ||
+			strings.Contains(strings.ToLower(string(value)), needle) {
+			return true
+		}
+	}
+	return false
 }
 
 func (m *DevToolsSecretsModel) deleteSecret() tea.Cmd {
@@ -411,7 +574,7 @@ func (m *DevToolsSecretsModel) deleteSecret() tea.Cmd {
 
 	secret := m.filtered[m.selected]
 	return func() tea.Msg {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(m.ctx, 30*time.Second)
 		defer cancel()
 
 		err := m.client.Clientset.CoreV1().Secrets(secret.Namespace).Delete(
@@ -513,14 +676,15 @@ func ViewSecretData(secret *corev1.Secret) string {
 	// Display each key-value pair
 	for _, key := range keys {
 		value := secret.Data[key]
-		decodedValue := string(value) // Already decoded from base64
 
 		s.WriteString(devToolsNumberStyle.Render(key + ":"))
 		s.WriteString("\n")
 
 		// Mask sensitive data partially
-		displayValue := decodedValue
-		if len(displayValue) > 20 {
+		displayValue := string(value) // Already decoded from base64
+		if utils.IsBinary(value) {
+			displayValue = binarySummary(value)
+		} else if len(displayValue) > 20 {
 			displayValue = displayValue[:8] + "..." + displayValue[len(displayValue)-8:]
 		}
 
@@ -553,4 +717,72 @@ func ExportSecretAsEnv(secret *corev1.Secret) string {
 	}
 
 	return s.String()
-}
\ No newline at end of file
+}
+
+// ExportSecretAsYAML renders a secret as a manifest that can be applied to
+// another cluster: server-populated metadata is dropped and only the name,
+// namespace, labels, annotations, type and data are kept. With decode, text
+// values are written in plain form under stringData; binary values always
+// stay base64 encoded under data.
+func ExportSecretAsYAML(secret *corev1.Secret, decode bool) ([]byte, error) {
+	metadata := map[string]interface{}{
+		"name":      secret.Name,
+		"namespace": secret.Namespace,
+	}
+	if len(secret.Labels) > 0 {
+		metadata["labels"] = secret.Labels
+	}
+	annotations := map[string]string{}
+	for k, v := range secret.Annotations {
+		if k != "kubectl.kubernetes.io/last-applied-configuration" {
+			annotations[k] = v
+		}
+	}
+	if len(annotations) > 0 {
+		metadata["annotations"] = annotations
+	}
+
+	manifest := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   metadata,
+	}
+	if secret.Type != "" {
+		manifest["type"] = string(secret.Type)
+	}
+
+	data := map[string][]byte{}
+	stringData := map[string]string{}
+	for key, value := range secret.Data {
+		if decode && !utils.IsBinary(value) {
+			stringData[key] = string(value)
+		} else {
+			data[key] = value
+		}
+	}
+	if len(data) > 0 {
+		manifest["data"] = data
+	}
+	if len(stringData) > 0 {
+		manifest["stringData"] = stringData
+	}
+
+	out, err := yaml.Marshal(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode secret %s: %w", secret.Name, err)
+	}
+	return out, nil
+}
+
+// binarySummary describes a binary secret value without printing it
+func binarySummary(data []byte) string {
+	return fmt.Sprintf("<binary, %d bytes>", len(data))
+}
+
+// hexPreview renders the first n bytes of data as hex
+func hexPreview(data []byte, n int) string {
+	if len(data) <= n {
+		return hex.EncodeToString(data)
+	}
+	return hex.EncodeToString(data[:n]) + "..."
+}
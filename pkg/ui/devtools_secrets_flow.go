@@ -9,73 +9,11 @@ import (
 
 // showDevToolsSecrets shows the secrets management interface
 func showDevToolsSecrets() error {
-	// Show namespace selection menu
-	namespaceMenu := NewDevToolsMenu("🔒 Select Namespace for Secrets", []DevToolsMenuItem{
-		{
-			Number:      "1",
-			Title:       "Current Namespace",
-			Description: "Use the current context's namespace",
-		},
-		{
-			Number:      "2",
-			Title:       "All Namespaces",
-			Description: "Show secrets from all namespaces",
-		},
-		{
-			Number:      "3",
-			Title:       "Specific Namespace",
-			Description: "Select a specific namespace",
-		},
-		{
-			Number:      "0",
-			Title:       "Back to Main Menu",
-			Description: "Return to the main menu",
-		},
-	})
-
-	p := tea.NewProgram(namespaceMenu, tea.WithAltScreen())
-	model, err := p.Run()
-	if err != nil {
+	namespace, allNamespaces, ok, err := selectDevToolsNamespaceScope("🔒 Select Namespace for Secrets", "secrets")
+	if err != nil || !ok {
 		return err
 	}
 
-	menu, ok := model.(*DevToolsMenu)
-	if !ok || menu.quitting || menu.selected < 0 {
-		return nil
-	}
-
-	namespace := ""
-	allNamespaces := false
-
-	switch menu.selected {
-	case 0: // Current namespace
-		// Use default
-	case 1: // All namespaces
-		allNamespaces = true
-	case 2: // Specific namespace
-		// Show namespace selector
-		nsModel := NewDevToolsNamespaceModel()
-		nsProgram := tea.NewProgram(nsModel, tea.WithAltScreen())
-
-		nsResult, err := nsProgram.Run()
-		if err != nil {
-			return err
-		}
-
-		if nsM, ok := nsResult.(*DevToolsNamespaceModel); ok {
-			namespace = nsM.GetSelectedNamespace()
-			if namespace == "" {
-				return nil // User cancelled
-			}
-		} else {
-			return nil
-		}
-	case 3: // Back
-		return nil
-	default:
-		return nil
-	}
-
 	// Show secrets interface
 	for {
 		secretsModel := NewDevToolsSecretsModel(namespace, allNamespaces)
@@ -171,9 +109,13 @@ func showDevToolsSecretActions(secret SecretInfo) error {
 		case 1: // Edit Secret
 			// Use the new secret editor
 			if secret.Secret != nil {
-				editor := NewSecretEditorModel(secret.Secret, nil)
+				client, err := k8s.NewClient()
+				if err != nil {
+					return err
+				}
+				editor := NewSecretEditorModel(secret.Secret, client)
 				p := tea.NewProgram(editor, tea.WithAltScreen())
-				_, err := p.Run()
+				_, err = p.Run()
 				if err != nil {
 					fmt.Printf("Error editing secret: %v\n", err)
 					fmt.Println("\nPress Enter to continue...")
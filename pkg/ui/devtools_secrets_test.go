@@ -0,0 +1,148 @@
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+)
+
+func TestExportSecretAsYAML(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "db",
+			Namespace:       "payments",
+			UID:             types.UID("1234"),
+			ResourceVersion: "42",
+			Labels:          map[string]string{"app": "db"},
+			Annotations: map[string]string{
+				"kubectl.kubernetes.io/last-applied-configuration": "{}",
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			"password": []byte("hunter2"),
+			"keystore": {0x00, 0xff, 0x10},
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		decode         bool
+		wantData       map[string][]byte
+		wantStringData map[string]string
+	}{
+		{
+			name:     "encoded",
+			wantData: map[string][]byte{"password": []byte("hunter2"), "keystore": {0x00, 0xff, 0x10}},
+		},
+		{
+			name:           "decoded keeps binary values encoded",
+			decode:         true,
+			wantData:       map[string][]byte{"keystore": {0x00, 0xff, 0x10}},
+			wantStringData: map[string]string{"password": "hunter2"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := ExportSecretAsYAML(secret, tc.decode)
+			require.NoError(t, err)
+
+			var exported corev1.Secret
+			require.NoError(t, yaml.Unmarshal(out, &exported))
+
+			assert.Equal(t, "Secret", exported.Kind)
+			assert.Equal(t, "db", exported.Name)
+			assert.Equal(t, "payments", exported.Namespace)
+			assert.Equal(t, map[string]string{"app": "db"}, exported.Labels)
+			assert.Empty(t, exported.Annotations)
+			assert.Empty(t, exported.UID)
+			assert.Empty(t, exported.ResourceVersion)
+			assert.NotContains(t, string(out), "creationTimestamp")
+			assert.Equal(t, corev1.SecretTypeOpaque, exported.Type)
+			assert.Equal(t, tc.wantData, exported.Data)
+			assert.Equal(t, tc.wantStringData, exported.StringData)
+		})
+	}
+}
+
+func TestDevToolsSecretsKeepSelectionAcrossReload(t *testing.T) {
+	m := NewDevToolsSecretsModel("default", false)
+	t.Cleanup(m.cancel)
+
+	secrets := func(names ...string) []SecretInfo {
+		infos := make([]SecretInfo, 0, len(names))
+		for _, name := range names {
+			infos = append(infos, SecretInfo{Name: name, Namespace: "default", Type: "Opaque"})
+		}
+		return infos
+	}
+
+	m.Update(secretsLoadedMsg{secrets: secrets("api-key", "db", "tls")})
+	m.selected = 1
+
+	m.Update(secretsLoadedMsg{secrets: secrets("api-key", "cache", "db", "tls")})
+	assert.Equal(t, 2, m.selected)
+	assert.Equal(t, "db", m.filtered[m.selected].Name)
+
+	m.Update(secretsLoadedMsg{secrets: secrets("api-key", "tls")})
+	assert.Equal(t, -1, m.selected, "a secret that is gone is no longer selected")
+}
+
+func TestNewSecretInfosPointAtEachSecret(t *testing.T) {
+	infos := newSecretInfos([]corev1.Secret{
+		{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "payments"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "api-key", Namespace: "payments"}},
+	})
+
+	require.Len(t, infos, 2)
+	for _, info := range infos {
+		require.NotNil(t, info.Secret)
+		assert.Equal(t, info.Name, info.Secret.Name)
+	}
+}
+
+func TestDevToolsSecretsDeepSearchToggle(t *testing.T) {
+	m := NewDevToolsSecretsModel("default", false)
+	t.Cleanup(m.cancel)
+
+	m.Update(secretsLoadedMsg{secrets: newSecretInfos([]corev1.Secret{
+		{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default"}, Type: corev1.SecretTypeOpaque, Data: map[string][]byte{"password": []byte("hunter2")}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "hunter-config", Namespace: "default"}, Type: corev1.SecretTypeOpaque},
+		{ObjectMeta: metav1.ObjectMeta{Name: "tls", Namespace: "default"}, Type: corev1.SecretTypeTLS, Data: map[string][]byte{"tls.key": []byte("key")}},
+	})})
+
+	filterBy := func(filter string) []string {
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+		m.filterInput.SetValue("")
+		for _, r := range filter {
+			m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		}
+		m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+		names := []string{}
+		for _, secret := range m.filtered {
+			names = append(names, secret.Name)
+		}
+		return names
+	}
+
+	assert.Equal(t, []string{"hunter-config"}, filterBy("hunter"), "values are not searched by default")
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("D")})
+	assert.True(t, m.deepSearch)
+	assert.Equal(t, []string{"db", "hunter-config"}, filterBy("hunter"), "deep search matches values")
+	assert.True(t, m.deepMatches["default/db"])
+	assert.False(t, m.deepMatches["default/hunter-config"], "name matches are not deep matches")
+	assert.Equal(t, []string{"tls"}, filterBy("tls.key"), "deep search matches keys")
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("D")})
+	assert.False(t, m.deepSearch)
+	assert.Equal(t, []string{"hunter-config"}, filterBy("hunter"), "turning deep search off drops value matches")
+}
@@ -0,0 +1,505 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/karthickk/k8s-manager/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ServiceInfo is a service as listed in the DevTools services view
+type ServiceInfo struct {
+	Name       string
+	Namespace  string
+	Type       corev1.ServiceType
+	ClusterIP  string
+	ExternalIP string
+	Ports      string
+	Age        string
+}
+
+// DevToolsServicesModel lists services in DevTools style, with their type,
+// addresses and port mappings
+type DevToolsServicesModel struct {
+	services      []ServiceInfo
+	filtered      []ServiceInfo
+	selected      int
+	window        listWindow // Part of the list on screen
+	filterInput   textinput.Model
+	filtering     bool
+	loading       bool
+	loadingAction bool
+	spinner       AnimatedSpinner
+	client        *k8s.Client
+	namespace     string
+	allNamespaces bool
+	err           error
+	chosen        bool // Whether a service was picked for its actions
+	ctx           context.Context
+	cancel        context.CancelFunc
+}
+
+// servicesLoadedMsg carries the listed services
+type servicesLoadedMsg struct {
+	services []ServiceInfo
+	client   *k8s.Client
+}
+
+// NewDevToolsServicesModel creates the DevTools services view
+func NewDevToolsServicesModel(namespace string, allNamespaces bool) *DevToolsServicesModel {
+	ti := textinput.New()
+	ti.Placeholder = "Type to filter..."
+	ti.CharLimit = 50
+
+	ctx, cancel := newModelContext()
+
+	return &DevToolsServicesModel{
+		filterInput:   ti,
+		loading:       true,
+		spinner:       NewAnimatedSpinner("spinner", "Loading services"),
+		namespace:     namespace,
+		allNamespaces: allNamespaces,
+		selected:      -1,
+		window:        newListWindow(devToolsListHeight),
+		ctx:           ctx,
+		cancel:        cancel,
+	}
+}
+
+// quit cancels in-flight requests and exits the program
+func (m *DevToolsServicesModel) quit() tea.Cmd {
+	return quitModel(m.cancel)
+}
+
+func (m *DevToolsServicesModel) Init() tea.Cmd {
+	return tea.Batch(
+		m.loadServices,
+		m.spinner.Init(),
+	)
+}
+
+func (m *DevToolsServicesModel) loadServices() tea.Msg {
+	client, err := k8s.NewClient()
+	if err != nil {
+		return errMsg{err}
+	}
+
+	namespace := m.namespace
+	if m.allNamespaces {
+		namespace = ""
+	} else if namespace == "" {
+		namespace = client.GetNamespace()
+	}
+
+	ctx, cancel := context.WithTimeout(m.ctx, 30*time.Second)
+	defer cancel()
+
+	list, err := k8s.ListAll(ctx, metav1.ListOptions{}, 0, client.Clientset.CoreV1().Services(namespace).List)
+	if err != nil {
+		return errMsg{fmt.Errorf("failed to list services: %w", err)}
+	}
+
+	return servicesLoadedMsg{services: newServiceInfos(list.Items), client: client}
+}
+
+// newServiceInfos converts services into list entries
+func newServiceInfos(services []corev1.Service) []ServiceInfo {
+	infos := make([]ServiceInfo, 0, len(services))
+	for i := range services {
+		service := &services[i]
+		infos = append(infos, ServiceInfo{
+			Name:       service.Name,
+			Namespace:  service.Namespace,
+			Type:       service.Spec.Type,
+			ClusterIP:  service.Spec.ClusterIP,
+			ExternalIP: k8s.ServiceExternalAddress(service),
+			Ports:      k8s.ServicePorts(service),
+			Age:        utils.FormatAge(service.CreationTimestamp.Time),
+		})
+	}
+	return infos
+}
+
+func (m *DevToolsServicesModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	if m.loading || m.loadingAction {
+		spinner, cmd := m.spinner.Update(msg)
+		m.spinner = spinner
+		if cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+
+	switch msg := msg.(type) {
+	case servicesLoadedMsg:
+		m.loading = false
+		m.services = msg.services
+		m.client = msg.client
+		m.applyFilter()
+		return m, nil
+
+	case errMsg:
+		m.loading = false
+		m.err = msg.err
+		return m, nil
+
+	case tea.KeyMsg:
+		if m.filtering {
+			switch msg.String() {
+			case "esc":
+				m.filtering = false
+				m.filterInput.Blur()
+				m.filterInput.SetValue("")
+				m.applyFilter()
+				return m, nil
+
+			case "enter":
+				m.filtering = false
+				m.filterInput.Blur()
+				m.applyFilter()
+				// A filter that leaves a single service opens its actions
+				if len(m.filtered) == 1 {
+					return m, m.choose(0)
+				}
+				return m, nil
+
+			default:
+				var cmd tea.Cmd
+				m.filterInput, cmd = m.filterInput.Update(msg)
+				m.applyFilter()
+				return m, cmd
+			}
+		}
+
+		keyStr := msg.String()
+
+		// Number keys for quick selection
+		if len(keyStr) == 1 && keyStr[0] >= '1' && keyStr[0] <= '8' {
+			num := int(keyStr[0] - '0')
+			if index := m.window.index(num, len(m.filtered)); index >= 0 {
+				return m, m.choose(index)
+			}
+		}
+
+		if selected, ok := m.window.jump(keyStr, m.selected, len(m.filtered)); ok {
+			m.selected = selected
+			m.window.follow(m.selected, len(m.filtered))
+			return m, nil
+		}
+
+		switch keyStr {
+		case "9", "r": // Refresh
+			m.loading = true
+			m.spinner = NewAnimatedSpinner("spinner", "Refreshing services")
+			return m, tea.Batch(
+				m.loadServices,
+				m.spinner.Init(),
+			)
+
+		case "0", "b", "q", "esc", "ctrl+c": // Back to main menu
+			return m, m.quit()
+
+		case "/":
+			m.filtering = true
+			m.filterInput.Focus()
+			return m, textinput.Blink
+
+		case "up", "k":
+			if m.selected > 0 {
+				m.selected--
+			} else if m.selected == -1 && len(m.filtered) > 0 {
+				m.selected = len(m.filtered) - 1
+			}
+			m.window.follow(m.selected, len(m.filtered))
+
+		case "down", "j":
+			if m.selected < len(m.filtered)-1 {
+				m.selected++
+			} else if m.selected == -1 && len(m.filtered) > 0 {
+				m.selected = 0
+			}
+			m.window.follow(m.selected, len(m.filtered))
+
+		case "enter", " ":
+			if m.selected >= 0 && m.selected < len(m.filtered) {
+				return m, m.choose(m.selected)
+			}
+		}
+	}
+
+	if len(cmds) > 0 {
+		return m, tea.Batch(cmds...)
+	}
+	return m, nil
+}
+
+func (m *DevToolsServicesModel) View() string {
+	var s strings.Builder
+
+	if m.err != nil {
+		return devToolsContainerStyle.Render(devToolsErrorStyle.Render("Error: " + m.err.Error()))
+	}
+
+	s.WriteString(renderContextHeader(headerNamespace(m.namespace, m.allNamespaces)))
+
+	title := "🌐 Kubernetes Services"
+	if m.allNamespaces {
+		title += " - all namespaces"
+	} else if m.namespace != "" {
+		title += fmt.Sprintf(" - %s namespace", m.namespace)
+	}
+	s.WriteString(devToolsTitleStyle.Render(title))
+	s.WriteString("\n\n")
+
+	if m.loading || m.loadingAction {
+		s.WriteString("\n")
+		s.WriteString(m.spinner.View())
+		s.WriteString("\n")
+		return devToolsContainerStyle.Render(s.String())
+	}
+
+	if m.filtering {
+		s.WriteString("Filter: ")
+		s.WriteString(m.filterInput.View())
+		s.WriteString("\n\n")
+	}
+
+	if len(m.filtered) == 0 {
+		s.WriteString(devToolsDescriptionStyle.Render("No services found"))
+		if m.filterInput.Value() != "" {
+			s.WriteString(devToolsDescriptionStyle.Render(fmt.Sprintf(" matching '%s'", m.filterInput.Value())))
+		}
+		s.WriteString("\n\n")
+	} else {
+		// Show the window of services around the selection, numbered from 1
+		// (leaving 9 for refresh and 0 for back)
+		start, end := m.window.bounds(len(m.filtered))
+		if above := m.window.aboveIndicator(len(m.filtered), "services"); above != "" {
+			s.WriteString(devToolsDescriptionStyle.Render(above))
+			s.WriteString("\n")
+		}
+
+		for i := start; i < end; i++ {
+			service := m.filtered[i]
+
+			name := service.Name
+			if i == m.selected {
+				name = devToolsSelectedStyle.Render("▸ " + name)
+			} else {
+				name = "  " + devToolsItemStyle.Render(name)
+			}
+
+			s.WriteString(devToolsNumberStyle.Render(fmt.Sprintf("%d.", i-start+1)) + name + " " + serviceTypeString(service))
+			s.WriteString("\n")
+
+			details := fmt.Sprintf("Cluster IP: %s, External: %s, Ports: %s, Age: %s",
+				service.ClusterIP, service.ExternalIP, service.Ports, service.Age)
+			if m.allNamespaces {
+				details = fmt.Sprintf("Namespace: %s, %s", service.Namespace, details)
+			}
+			s.WriteString(devToolsDescriptionStyle.Render("   " + details))
+			s.WriteString("\n")
+		}
+
+		if below := m.window.belowIndicator(len(m.filtered), "services"); below != "" {
+			s.WriteString(devToolsDescriptionStyle.Render(below))
+			s.WriteString("\n")
+		}
+
+		s.WriteString("\n")
+		s.WriteString(devToolsNumberStyle.Render("9.") + "  " + devToolsItemStyle.Render("Refresh"))
+		s.WriteString("\n")
+		s.WriteString(devToolsDescriptionStyle.Render("   Reload the services list"))
+		s.WriteString("\n")
+	}
+
+	s.WriteString(devToolsNumberStyle.Render("0.") + "  " + devToolsItemStyle.Render("Back to Main Menu"))
+	s.WriteString("\n")
+	s.WriteString(devToolsDescriptionStyle.Render("   Return to the main menu"))
+
+	s.WriteString("\n\n")
+	s.WriteString(devToolsHelpStyle.Render("↑/k up • ↓/j down • pgup/pgdn page • g/G first/last • 1-8 select service • 9 refresh • 0 back • / filter • q quit"))
+
+	return devToolsContainerStyle.Render(s.String())
+}
+
+// serviceTypeString tags a service with its type, flagging a load balancer
+// that has not been provisioned yet
+func serviceTypeString(service ServiceInfo) string {
+	tag := fmt.Sprintf("[%s]", service.Type)
+	switch {
+	case service.Type == corev1.ServiceTypeLoadBalancer && service.ExternalIP == "<pending>":
+		return devToolsWarningStyle.Render("[LoadBalancer pending]")
+	case service.Type == corev1.ServiceTypeLoadBalancer:
+		return devToolsSuccessStyle.Render(tag)
+	default:
+		return devToolsDescriptionStyle.Render(tag)
+	}
+}
+
+// applyFilter filters the services by name, namespace and type, keeping the
+// selected service selected if it is still listed
+func (m *DevToolsServicesModel) applyFilter() {
+	var current *ServiceInfo
+	if m.selected >= 0 && m.selected < len(m.filtered) {
+		service := m.filtered[m.selected]
+		current = &service
+	}
+
+	filter := strings.ToLower(m.filterInput.Value())
+	if filter == "" {
+		m.filtered = m.services
+	} else {
+		filtered := []ServiceInfo{}
+		for _, service := range m.services {
+			if strings.Contains(strings.ToLower(service.Name), filter) ||
+				strings.Contains(strings.ToLower(service.Namespace), filter) ||
+				strings.Contains(strings.ToLower(string(service.Type)), filter) {
+				filtered = append(filtered, service)
+			}
+		}
+		m.filtered = filtered
+	}
+
+	m.selected = -1
+	if current != nil {
+		for i, service := range m.filtered {
+			if service.Namespace == current.Namespace && service.Name == current.Name {
+				m.selected = i
+				break
+			}
+		}
+	}
+	m.window.follow(m.selected, len(m.filtered))
+}
+
+// choose selects the service at index and quits to show its actions
+func (m *DevToolsServicesModel) choose(index int) tea.Cmd {
+	m.selected = index
+	m.chosen = true
+	m.loadingAction = true
+	m.spinner = NewAnimatedSpinner("spinner", fmt.Sprintf("Loading actions for service %s", m.filtered[index].Name))
+	return tea.Batch(
+		m.spinner.Init(),
+		tea.Tick(time.Millisecond*300, func(t time.Time) tea.Msg {
+			return m.quit()()
+		}),
+	)
+}
+
+// GetSelectedService returns the service picked for its actions
+func (m *DevToolsServicesModel) GetSelectedService() *ServiceInfo {
+	if m.chosen && m.selected >= 0 && m.selected < len(m.filtered) {
+		return &m.filtered[m.selected]
+	}
+	return nil
+}
+
+func showDevToolsServices() error {
+	fmt.Print("\033[H\033[2J") // Clear screen before namespace menu
+
+	namespace, allNamespaces, ok, err := selectDevToolsNamespaceScope("🌐 Namespace Selection", "services")
+	if err != nil || !ok {
+		return err
+	}
+
+	for {
+		result, err := tea.NewProgram(NewDevToolsServicesModel(namespace, allNamespaces), tea.WithAltScreen()).Run()
+		if err != nil {
+			return err
+		}
+
+		model, ok := result.(*DevToolsServicesModel)
+		if !ok {
+			return nil
+		}
+		service := model.GetSelectedService()
+		if service == nil {
+			return nil
+		}
+
+		if err := showDevToolsServiceActions(model.client, *service); err != nil {
+			fmt.Printf("Error: %v\n", err)
+		}
+		fmt.Println("\nPress Enter to continue...")
+		fmt.Scanln()
+	}
+}
+
+// showDevToolsServiceActions offers listing the endpoints of a service and
+// deleting it
+func showDevToolsServiceActions(client *k8s.Client, service ServiceInfo) error {
+	actions := []DevToolsMenuItem{
+		{
+			Number:      "1",
+			Title:       "Endpoints",
+			Description: "Show the pods behind the service and whether they are ready",
+		},
+		{
+			Number:      "2",
+			Title:       "Delete",
+			Description: "Delete the service; its pods keep running",
+		},
+		{
+			Number:      "0",
+			Title:       "Back to Services",
+			Description: "Return to the services list",
+		},
+	}
+
+	result, err := tea.NewProgram(NewDevToolsMenu(fmt.Sprintf("🌐 Service Actions: %s", service.Name), actions), tea.WithAltScreen()).Run()
+	if err != nil {
+		return err
+	}
+	menu, ok := result.(*DevToolsMenu)
+	if !ok || menu.quitting {
+		return nil
+	}
+
+	switch menu.selected {
+	case 0: // Endpoints
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		endpoints, err := client.ServiceEndpoints(ctx, service.Namespace, service.Name)
+		if err != nil {
+			return err
+		}
+		if len(endpoints) == 0 {
+			fmt.Printf("Service '%s' has no endpoints\n", service.Name)
+			return nil
+		}
+		fmt.Printf("Endpoints of service '%s':\n\n", service.Name)
+		for _, endpoint := range endpoints {
+			ready := "✅"
+			if !endpoint.Ready {
+				ready = "⏳"
+			}
+			pod := endpoint.Pod
+			if pod == "" {
+				pod = "<none>"
+			}
+			fmt.Printf("  %s %-21s %s\n", ready, endpoint.Address(), pod)
+		}
+
+	case 1: // Delete
+		confirmed, err := ConfirmDestructive("delete", "service", service.Namespace, service.Name, false)
+		if err != nil || !confirmed {
+			return err
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		if err := client.Clientset.CoreV1().Services(service.Namespace).Delete(ctx, service.Name, metav1.DeleteOptions{}); err != nil {
+			return fmt.Errorf("failed to delete service %s: %w", service.Name, err)
+		}
+		fmt.Printf("✅ Service '%s' deleted from namespace '%s'\n", service.Name, service.Namespace)
+	}
+	return nil
+}
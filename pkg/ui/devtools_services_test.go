@@ -0,0 +1,49 @@
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDevToolsServicesListAndSelect(t *testing.T) {
+	m := NewDevToolsServicesModel("default", false)
+	t.Cleanup(m.cancel)
+
+	services := newServiceInfos([]corev1.Service{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "default"},
+			Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP, ClusterIP: "10.0.0.10", Ports: []corev1.ServicePort{{Port: 8080}}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer, ClusterIP: "10.0.0.11", Ports: []corev1.ServicePort{{Port: 80, NodePort: 30080}}},
+			Status:     corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{Ingress: []corev1.LoadBalancerIngress{{IP: "34.1.2.3"}}}},
+		},
+	})
+	m.Update(servicesLoadedMsg{services: services})
+
+	view := m.View()
+	assert.Contains(t, view, "Cluster IP: 10.0.0.10, External: <none>, Ports: 8080/TCP")
+	assert.Contains(t, view, "External: 34.1.2.3, Ports: 80:30080/TCP")
+
+	// Filtering matches the service type too
+	m.filterInput.SetValue("loadbalancer")
+	m.applyFilter()
+	if assert.Len(t, m.filtered, 1) {
+		assert.Equal(t, "web", m.filtered[0].Name)
+	}
+
+	m.filterInput.SetValue("")
+	m.applyFilter()
+	assert.Nil(t, m.GetSelectedService(), "nothing is chosen until a service is picked")
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+	assert.NotNil(t, cmd)
+	if assert.NotNil(t, m.GetSelectedService()) {
+		assert.Equal(t, "api", m.GetSelectedService().Name)
+	}
+}
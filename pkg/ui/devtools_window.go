@@ -0,0 +1,110 @@
+package ui
+
+import "fmt"
+
+// devToolsListHeight is how many items the DevTools lists show at once; the
+// number keys 1-8 pick from the visible items, leaving 9 and 0 for actions
+const devToolsListHeight = 8
+
+// listWindow is the part of a DevTools list that is on screen. It scrolls so
+// the selected item is always visible.
+type listWindow struct {
+	offset int
+	height int
+}
+
+// newListWindow returns a window showing the first height items
+func newListWindow(height int) listWindow {
+	return listWindow{height: height}
+}
+
+// follow scrolls the window just far enough to show the selected item and
+// keeps it within a list of total items. A negative selection only clamps.
+func (w *listWindow) follow(selected, total int) {
+	if selected >= 0 && selected < total {
+		if selected < w.offset {
+			w.offset = selected
+		}
+		if selected >= w.offset+w.height {
+			w.offset = selected - w.height + 1
+		}
+	}
+
+	if maxOffset := total - w.height; w.offset > maxOffset {
+		w.offset = maxOffset
+	}
+	if w.offset < 0 {
+		w.offset = 0
+	}
+}
+
+// bounds returns the range [start, end) of the items on screen
+func (w listWindow) bounds(total int) (int, int) {
+	w.follow(-1, total)
+	end := w.offset + w.height
+	if end > total {
+		end = total
+	}
+	return w.offset, end
+}
+
+// index maps a quick-select number, 1 for the first visible item, to the
+// item it picks, or -1 when no item is shown under that number
+func (w listWindow) index(number, total int) int {
+	start, end := w.bounds(total)
+	if number < 1 || start+number-1 >= end {
+		return -1
+	}
+	return start + number - 1
+}
+
+// aboveIndicator describes the items scrolled off the top, or "" if none
+func (w listWindow) aboveIndicator(total int, noun string) string {
+	start, _ := w.bounds(total)
+	if start == 0 {
+		return ""
+	}
+	return fmt.Sprintf("   ▲ %d more %s", start, noun)
+}
+
+// belowIndicator describes the items below the window, or "" if none
+func (w listWindow) belowIndicator(total int, noun string) string {
+	_, end := w.bounds(total)
+	if end >= total {
+		return ""
+	}
+	return fmt.Sprintf("   ▼ %d more %s (use arrows to navigate)", total-end, noun)
+}
+
+// jump returns the selection after a paging key: pgup and pgdown move by a
+// window, g or home go to the first item and G or end to the last. The bool
+// is false for any other key.
+func (w listWindow) jump(key string, selected, total int) (int, bool) {
+	switch key {
+	case "pgup", "pgdown", "g", "home", "G", "end":
+	default:
+		return selected, false
+	}
+	if total == 0 {
+		return selected, true
+	}
+
+	switch key {
+	case "pgup":
+		selected -= w.height
+	case "pgdown":
+		selected += w.height
+	case "g", "home":
+		selected = 0
+	case "G", "end":
+		selected = total - 1
+	}
+
+	if selected < 0 {
+		selected = 0
+	}
+	if selected >= total {
+		selected = total - 1
+	}
+	return selected, true
+}
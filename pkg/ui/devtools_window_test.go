@@ -0,0 +1,124 @@
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListWindowFollowsSelection(t *testing.T) {
+	w := newListWindow(3)
+
+	start, end := w.bounds(10)
+	assert.Equal(t, []int{0, 3}, []int{start, end})
+	assert.Empty(t, w.aboveIndicator(10, "pods"))
+	assert.Equal(t, "   ▼ 7 more pods (use arrows to navigate)", w.belowIndicator(10, "pods"))
+
+	// Moving past the bottom scrolls just far enough to show the selection
+	w.follow(4, 10)
+	start, end = w.bounds(10)
+	assert.Equal(t, []int{2, 5}, []int{start, end})
+	assert.Equal(t, "   ▲ 2 more pods", w.aboveIndicator(10, "pods"))
+	assert.Equal(t, 3, w.index(2, 10), "numbers pick from the visible items")
+	assert.Equal(t, -1, w.index(4, 10))
+
+	// Moving within the window does not scroll
+	w.follow(3, 10)
+	assert.Equal(t, 2, w.offset)
+
+	// Moving above the top scrolls up to the selection
+	w.follow(0, 10)
+	assert.Equal(t, 0, w.offset)
+
+	// A list that shrinks below the window pulls it back into range
+	w.follow(9, 10)
+	w.follow(-1, 4)
+	start, end = w.bounds(4)
+	assert.Equal(t, []int{1, 4}, []int{start, end})
+	assert.Empty(t, w.belowIndicator(4, "pods"))
+}
+
+func TestDevToolsPodsWindowFollowsCursor(t *testing.T) {
+	m := NewDevToolsPodsModel("default", false)
+	t.Cleanup(m.cancel)
+
+	m.Update(podsLoadedMsg{pods: podInfos("p00", "p01", "p02", "p03", "p04", "p05", "p06", "p07", "p08", "p09", "p10", "p11")})
+	view := m.View()
+	assert.Contains(t, view, "p07")
+	assert.NotContains(t, view, "p08")
+	assert.NotContains(t, view, "▲", "nothing above yet")
+	assert.Contains(t, view, "▼ 4 more pods")
+
+	for i := 0; i < 10; i++ {
+		m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	}
+	assert.Equal(t, 9, m.selected)
+
+	view = m.View()
+	assert.Contains(t, view, "▸ p09", "the selected pod is on screen")
+	assert.NotContains(t, view, "p01")
+	assert.Contains(t, view, "▲ 2 more pods")
+	assert.Contains(t, view, "▼ 2 more pods")
+
+	// Wrapping to the end from no selection shows the last pod
+	m.selected = -1
+	m.Update(tea.KeyMsg{Type: tea.KeyUp})
+	assert.Contains(t, m.View(), "▸ p11")
+}
+
+func TestListWindowJump(t *testing.T) {
+	w := newListWindow(8)
+
+	testCases := []struct {
+		key      string
+		selected int
+		want     int
+	}{
+		{key: "pgdown", selected: 2, want: 10},
+		{key: "pgdown", selected: 15, want: 19},
+		{key: "pgdown", selected: -1, want: 7},
+		{key: "pgup", selected: 12, want: 4},
+		{key: "pgup", selected: 3, want: 0},
+		{key: "g", selected: 12, want: 0},
+		{key: "home", selected: 12, want: 0},
+		{key: "G", selected: 3, want: 19},
+		{key: "end", selected: -1, want: 19},
+	}
+
+	for _, tc := range testCases {
+		selected, ok := w.jump(tc.key, tc.selected, 20)
+		assert.True(t, ok, tc.key)
+		assert.Equal(t, tc.want, selected, "%s from %d", tc.key, tc.selected)
+	}
+
+	selected, ok := w.jump("G", -1, 0)
+	assert.True(t, ok)
+	assert.Equal(t, -1, selected, "an empty list keeps no selection")
+
+	_, ok = w.jump("x", 3, 20)
+	assert.False(t, ok)
+}
+
+func TestDevToolsPodsPagingRenumbers(t *testing.T) {
+	m := NewDevToolsPodsModel("default", false)
+	t.Cleanup(m.cancel)
+
+	m.Update(podsLoadedMsg{pods: podInfos("p00", "p01", "p02", "p03", "p04", "p05", "p06", "p07", "p08", "p09", "p10", "p11")})
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("G")})
+	assert.Equal(t, 11, m.selected)
+	view := m.View()
+	assert.Contains(t, view, "▸ p11")
+	assert.Contains(t, view, "▲ 4 more pods")
+	assert.NotContains(t, view, "▼")
+	assert.Equal(t, 4, m.window.index(1, len(m.filteredPods)), "1 picks the first pod on screen")
+
+	m.Update(tea.KeyMsg{Type: tea.KeyPgUp})
+	assert.Equal(t, 3, m.selected)
+	assert.Contains(t, m.View(), "▸ p03")
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("g")})
+	assert.Equal(t, 0, m.selected)
+	assert.NotContains(t, m.View(), "▲")
+}
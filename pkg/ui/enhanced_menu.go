@@ -14,41 +14,53 @@ import (
 var (
 	appStyle = lipgloss.NewStyle().Padding(0, 1)
 
+	titleBarStyle           lipgloss.Style
+	selectedTitleStyle      lipgloss.Style
+	selectedDescStyle       lipgloss.Style
+	normalTitleStyle        lipgloss.Style
+	normalDescStyle         lipgloss.Style
+	enhancedPaginationStyle lipgloss.Style
+	activePageDotStyle      lipgloss.Style
+	inactivePageDotStyle    lipgloss.Style
+)
+
+// buildEnhancedMenuStyles sets the enhanced menu styles from the theme
+func buildEnhancedMenuStyles(t Theme) {
 	titleBarStyle = lipgloss.NewStyle().
-			Background(lipgloss.Color("62")).
-			Foreground(lipgloss.Color("230")).
-			Padding(0, 1).
-			MarginBottom(1)
+		Background(t.Border).
+		Foreground(t.Inverse).
+		Padding(0, 1).
+		MarginBottom(1)
 
 	selectedTitleStyle = lipgloss.NewStyle().
-				Border(lipgloss.NormalBorder(), false, false, false, true).
-				BorderForeground(lipgloss.Color("170")).
-				Foreground(lipgloss.Color("170")).
-				Bold(true).
-				PaddingLeft(1)
+		Border(lipgloss.NormalBorder(), false, false, false, true).
+		BorderForeground(t.Selected).
+		Foreground(t.Selected).
+		Bold(true).
+		PaddingLeft(1)
 
 	selectedDescStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.Color("245")).
-				PaddingLeft(3)
+		Foreground(t.Muted).
+		PaddingLeft(3)
 
 	normalTitleStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.Color("252")).
-				PaddingLeft(2)
+		Foreground(t.Text).
+		PaddingLeft(2)
 
 	normalDescStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("246")).
-			PaddingLeft(3)
+		Foreground(t.Muted).
+		PaddingLeft(3)
 
 	enhancedPaginationStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("240"))
+		Foreground(t.Muted)
 
 	activePageDotStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.Color("170")).
-				Bold(true)
+		Foreground(t.Selected).
+		Bold(true)
 
 	inactivePageDotStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.Color("240"))
-)
+		Foreground(t.Muted)
+}
 
 // CompactDelegate is a custom delegate for compact menu items
 type CompactDelegate struct {
@@ -139,8 +151,8 @@ func (m EnhancedMenuModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			contentWidth := min(100, msg.Width-2)
 
 			// Calculate available height for list items
-			// Reserve space for: title (3 lines) + help (2 lines) + padding (2 lines)
-			reservedHeight := 7
+			// Reserve space for: context header (1 line) + title (3 lines) + help (2 lines) + padding (2 lines)
+			reservedHeight := 8
 			availableHeight := msg.Height - reservedHeight
 
 			// Ensure minimum and maximum bounds
@@ -157,7 +169,7 @@ func (m EnhancedMenuModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.list.SetWidth(min(100, msg.Width-2))
 
 			// Recalculate height
-			reservedHeight := 7
+			reservedHeight := 8
 			availableHeight := msg.Height - reservedHeight
 			if availableHeight < 10 {
 				availableHeight = 10
@@ -194,11 +206,11 @@ func (m EnhancedMenuModel) View() string {
 		return ""
 	}
 	if m.quitting {
-		return "\n" + lipgloss.NewStyle().Foreground(lipgloss.Color("170")).Render("👋 Goodbye! Thank you for using K8s Manager.") + "\n\n"
+		return "\n" + lipgloss.NewStyle().Foreground(currentTheme.Selected).Render("👋 Goodbye! Thank you for using K8s Manager.") + "\n\n"
 	}
 
 	// Add padding and styling
-	return appStyle.Render(m.list.View())
+	return appStyle.Render(renderContextHeader("") + m.list.View())
 }
 
 // Custom pagination to show dots
@@ -305,7 +317,7 @@ func ShowEnhancedMainMenu() string {
 
 	// Help styles
 	l.Styles.HelpStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("241")).
+		Foreground(currentTheme.Muted).
 		MarginTop(1)
 
 	m := EnhancedMenuModel{
@@ -10,19 +10,9 @@ import (
 )
 
 var (
-	titleStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("230")).
-			Background(lipgloss.Color("62")).
-			Padding(0, 1).
-			MarginBottom(1)
-
 	itemStyle = lipgloss.NewStyle().
 			PaddingLeft(4)
 
-	selectedItemStyle = lipgloss.NewStyle().
-				PaddingLeft(2).
-				Foreground(lipgloss.Color("170"))
-
 	paginationStyle = list.DefaultStyles().PaginationStyle.
 			PaddingLeft(4)
 
@@ -32,8 +22,24 @@ var (
 
 	quitTextStyle = lipgloss.NewStyle().
 			Margin(1, 0, 2, 4)
+
+	titleStyle        lipgloss.Style
+	selectedItemStyle lipgloss.Style
 )
 
+// buildMenuStyles sets the menu styles from the theme
+func buildMenuStyles(t Theme) {
+	titleStyle = lipgloss.NewStyle().
+		Foreground(t.Inverse).
+		Background(t.Border).
+		Padding(0, 1).
+		MarginBottom(1)
+
+	selectedItemStyle = lipgloss.NewStyle().
+		PaddingLeft(2).
+		Foreground(t.Selected)
+}
+
 type menuItem struct {
 	title       string
 	description string
@@ -60,7 +66,7 @@ func (m MainMenuModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Set the list dimensions based on the terminal size
 		m.list.SetWidth(msg.Width)
 		// Use most of the terminal height, leaving some space for title and help
-		availableHeight := msg.Height - 4 // Reserve 4 lines for title and help text
+		availableHeight := msg.Height - 5 // Reserve 5 lines for context header, title and help text
 		if availableHeight > 20 {
 			availableHeight = 20 // Cap at 20 lines for better UX
 		}
@@ -94,7 +100,7 @@ func (m MainMenuModel) View() string {
 	if m.quitting {
 		return quitTextStyle.Render("👋 Goodbye! Thank you for using K8s Manager.\n")
 	}
-	return "\n" + m.list.View()
+	return "\n" + renderContextHeader("") + m.list.View()
 }
 
 // ShowMainMenu displays the interactive main menu and returns the selected command
@@ -145,10 +151,10 @@ func ShowMainMenu() string {
 
 	// Style the delegate for better visibility
 	delegate.Styles.SelectedTitle = delegate.Styles.SelectedTitle.
-		Foreground(lipgloss.Color("170")).
-		BorderForeground(lipgloss.Color("170"))
+		Foreground(currentTheme.Selected).
+		BorderForeground(currentTheme.Selected)
 	delegate.Styles.SelectedDesc = delegate.Styles.SelectedDesc.
-		Foreground(lipgloss.Color("244"))
+		Foreground(currentTheme.Muted)
 
 	l := list.New(items, delegate, 0, 0) // Size will be set by WindowSizeMsg
 	l.Title = "🚀 K8s Manager - Main Menu"
@@ -214,7 +220,7 @@ func (m SubMenuModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 }
 
 func (m SubMenuModel) View() string {
-	s := fmt.Sprintf("\n%s\n\n", titleStyle.Render(m.title))
+	s := fmt.Sprintf("\n%s%s\n\n", renderContextHeader(""), titleStyle.Render(m.title))
 
 	for i, choice := range m.choices {
 		cursor := " "
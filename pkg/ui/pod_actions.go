@@ -12,66 +12,80 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/karthickk/k8s-manager/pkg/utils"
 	"github.com/pterm/pterm"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var (
+	actionItemStyle = lipgloss.NewStyle().
+			PaddingLeft(4).
+			PaddingTop(0).
+			PaddingBottom(0)
+
+	actionMenuStyle     lipgloss.Style
+	actionTitleStyle    lipgloss.Style
+	podInfoStyle        lipgloss.Style
+	actionSectionStyle  lipgloss.Style
+	actionSelectedStyle lipgloss.Style
+	actionDescStyle     lipgloss.Style
+	statusGoodStyle     lipgloss.Style
+	statusBadStyle      lipgloss.Style
+	statusWarningStyle  lipgloss.Style
+)
+
+// buildPodActionStyles sets the pod action styles from the theme
+func buildPodActionStyles(t Theme) {
 	actionMenuStyle = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(lipgloss.Color("62")).
-			Padding(1, 3).
-			Margin(1, 2)
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Border).
+		Padding(1, 3).
+		Margin(1, 2)
 
 	actionTitleStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(lipgloss.Color("39")).
-			Background(lipgloss.Color("235")).
-			Padding(0, 2).
-			MarginBottom(1)
+		Bold(true).
+		Foreground(t.Title).
+		Background(t.Background).
+		Padding(0, 2).
+		MarginBottom(1)
 
 	podInfoStyle = lipgloss.NewStyle().
-			Border(lipgloss.NormalBorder()).
-			BorderForeground(lipgloss.Color("240")).
-			Padding(0, 2).
-			MarginBottom(1)
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(t.Muted).
+		Padding(0, 2).
+		MarginBottom(1)
 
 	actionSectionStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("86")).
-			Bold(true).
-			MarginTop(1).
-			MarginBottom(1)
-
-	actionItemStyle = lipgloss.NewStyle().
-			PaddingLeft(4).
-			PaddingTop(0).
-			PaddingBottom(0)
+		Foreground(t.Title).
+		Bold(true).
+		MarginTop(1).
+		MarginBottom(1)
 
 	actionSelectedStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("170")).
-			Background(lipgloss.Color("236")).
-			Bold(true).
-			PaddingLeft(2).
-			PaddingRight(2)
+		Foreground(t.Selected).
+		Background(t.Background).
+		Bold(true).
+		PaddingLeft(2).
+		PaddingRight(2)
 
 	actionDescStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("244")).
-			PaddingLeft(6).
-			PaddingTop(0)
+		Foreground(t.Muted).
+		PaddingLeft(6).
+		PaddingTop(0)
 
 	statusGoodStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("42")).
-			Bold(true)
+		Foreground(t.Success).
+		Bold(true)
 
 	statusBadStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("196")).
-			Bold(true)
+		Foreground(t.Error).
+		Bold(true)
 
 	statusWarningStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("214")).
-			Bold(true)
-)
+		Foreground(t.Warning).
+		Bold(true)
+}
 
 type PodActionsModel struct {
 	pod          PodInfo
@@ -87,6 +101,9 @@ type PodActionsModel struct {
 	client       *k8s.Client
 	spinner      spinner.Model
 	initialized  bool
+	// pendingAction is the key of an action that takes over the terminal and
+	// therefore runs after the TUI has quit
+	pendingAction string
 }
 
 type PodAction struct {
@@ -140,9 +157,15 @@ func NewPodActionsModel(pod PodInfo, client *k8s.Client) PodActionsModel {
 			Key:         "port-forward",
 			Handler:     portForwardPod,
 		},
+		{
+			Name:        "🧪  Clone as Debug Pod",
+			Description: "Create a standalone copy without probes or controller",
+			Key:         "clone-debug",
+			Handler:     cloneDebugPod,
+		},
 		{
 			Name:        "📈  Resource Usage",
-			Description: "Show CPU and memory usage",
+			Description: "CPU and memory usage against requests and limits",
 			Key:         "resources",
 			Handler:     showResourceUsage,
 		},
@@ -157,7 +180,7 @@ func NewPodActionsModel(pod PodInfo, client *k8s.Client) PodActionsModel {
 	// Create spinner
 	s := spinner.New()
 	s.Spinner = spinner.Dot
-	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	s.Style = lipgloss.NewStyle().Foreground(currentTheme.Title)
 
 	return PodActionsModel{
 		pod:     pod,
@@ -249,6 +272,12 @@ func (m PodActionsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, tea.Quit
 			}
 
+			if action.Handler != nil && runsAfterQuit(action.Key) {
+				m.pendingAction = action.Key
+				m.quitting = true
+				return m, tea.Quit
+			}
+
 			if action.Handler != nil {
 				m.executing = true
 				return m, m.executeAction(action)
@@ -261,12 +290,6 @@ func (m PodActionsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 func (m PodActionsModel) executeAction(action PodAction) tea.Cmd {
 	return func() tea.Msg {
-		// For actions that need to exit the TUI
-		if action.Key == "exec" || action.Key == "logs" || action.Key == "follow" {
-			// We'll handle these specially
-			return tea.Quit
-		}
-
 		err := action.Handler(m.pod, m.client)
 		if err != nil {
 			m.message = fmt.Sprintf("Error: %v", err)
@@ -296,6 +319,8 @@ func (m PodActionsModel) View() string {
 
 	var content strings.Builder
 
+	content.WriteString(renderContextHeader(m.pod.Namespace))
+
 	// Header with pod name
 	header := fmt.Sprintf("🔧 Pod Actions: %s", m.pod.Name)
 	content.WriteString(actionTitleStyle.Width(60).Align(lipgloss.Center).Render(header))
@@ -314,7 +339,7 @@ func (m PodActionsModel) View() string {
 		styledStatus = statusGoodStyle.Render(status)
 	case "pending", "terminating":
 		styledStatus = statusWarningStyle.Render(status)
-	case "failed", "error", "crashloopbackoff":
+	case "failed", "error", "crashloopbackoff", "imagepullbackoff", "errimagepull":
 		styledStatus = statusBadStyle.Render(status)
 	default:
 		styledStatus = status
@@ -377,7 +402,7 @@ func (m PodActionsModel) View() string {
 	if m.message != "" {
 		content.WriteString("\n\n")
 		content.WriteString(lipgloss.NewStyle().
-			Foreground(lipgloss.Color("220")).
+			Foreground(currentTheme.Warning).
 			Bold(true).
 			Render(m.message))
 	}
@@ -386,7 +411,7 @@ func (m PodActionsModel) View() string {
 	content.WriteString("\n\n")
 	helpText := "↑/k up • ↓/j down • enter select • q/esc back"
 	content.WriteString(lipgloss.NewStyle().
-		Foreground(lipgloss.Color("241")).
+		Foreground(currentTheme.Muted).
 		Width(60).
 		Align(lipgloss.Center).
 		Render(helpText))
@@ -398,10 +423,19 @@ func (m PodActionsModel) View() string {
 // Action handlers
 
 func describePod(pod PodInfo, client *k8s.Client) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+	var p *corev1.Pod
+	var owners []k8s.Owner
+	err := RunWithSpinner(context.Background(), "Loading pod details...", func(ctx context.Context) error {
+		var err error
+		p, err = client.Clientset.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
 
-	p, err := client.Clientset.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+		// A partial chain is still worth showing
+		owners, _ = k8s.OwnerChain(ctx, client.Clientset, p.Namespace, p.OwnerReferences)
+		return nil
+	})
 	if err != nil {
 		return err
 	}
@@ -416,6 +450,7 @@ func describePod(pod PodInfo, client *k8s.Client) error {
 		{"Node", p.Spec.NodeName},
 		{"IP", p.Status.PodIP},
 		{"Created", p.CreationTimestamp.Format(time.RFC3339)},
+		{"Controlled By", k8s.FormatOwnerChain(owners)},
 	}
 
 	pterm.DefaultTable.WithData(data).Render()
@@ -430,8 +465,7 @@ func describePod(pod PodInfo, client *k8s.Client) error {
 		pterm.DefaultTable.WithData(containerData).Render()
 	}
 
-	fmt.Println("\nPress Enter to continue...")
-	fmt.Scanln()
+	waitForEnter()
 
 	return nil
 }
@@ -469,14 +503,18 @@ func execIntoPod(pod PodInfo, client *k8s.Client) error {
 		for i, c := range p.Spec.Containers {
 			fmt.Printf("  %d. %s\n", i+1, c.Name)
 		}
-		fmt.Print("Select container (1-", len(p.Spec.Containers), "): ")
-
-		var choice int
-		fmt.Scanln(&choice)
-		if choice < 1 || choice > len(p.Spec.Containers) {
-			return fmt.Errorf("invalid container selection")
+		containerName = utils.PrimaryContainer(p)
+		fmt.Printf("Select container (1-%d) or press Enter for %s: ", len(p.Spec.Containers), containerName)
+
+		var input string
+		fmt.Scanln(&input)
+		if input != "" {
+			var choice int
+			if _, err := fmt.Sscanf(input, "%d", &choice); err != nil || choice < 1 || choice > len(p.Spec.Containers) {
+				return fmt.Errorf("invalid container selection")
+			}
+			containerName = p.Spec.Containers[choice-1].Name
 		}
-		containerName = p.Spec.Containers[choice-1].Name
 	} else if len(p.Spec.Containers) == 1 {
 		containerName = p.Spec.Containers[0].Name
 	}
@@ -508,14 +546,11 @@ func execIntoPod(pod PodInfo, client *k8s.Client) error {
 
 func restartPod(pod PodInfo, client *k8s.Client) error {
 	// Confirm before restarting
-	confirm := false
-	prompt := pterm.InteractiveConfirmPrinter{
-		DefaultText: fmt.Sprintf("Are you sure you want to restart pod '%s'?", pod.Name),
-		TextStyle:   pterm.NewStyle(pterm.FgYellow),
+	confirmed, err := ConfirmDestructive("restart", "pod", pod.Namespace, pod.Name, false)
+	if err != nil {
+		return err
 	}
-	confirm, _ = prompt.Show()
-
-	if !confirm {
+	if !confirmed {
 		return fmt.Errorf("restart cancelled")
 	}
 
@@ -530,14 +565,11 @@ func restartPod(pod PodInfo, client *k8s.Client) error {
 
 func deletePod(pod PodInfo, client *k8s.Client) error {
 	// Confirm before deleting
-	confirm := false
-	prompt := pterm.InteractiveConfirmPrinter{
-		DefaultText: fmt.Sprintf("Are you sure you want to delete pod '%s'?", pod.Name),
-		TextStyle:   pterm.NewStyle(pterm.FgRed),
+	confirmed, err := ConfirmDestructive("delete", "pod", pod.Namespace, pod.Name, false)
+	if err != nil {
+		return err
 	}
-	confirm, _ = prompt.Show()
-
-	if !confirm {
+	if !confirmed {
 		return fmt.Errorf("deletion cancelled")
 	}
 
@@ -551,41 +583,16 @@ func deletePod(pod PodInfo, client *k8s.Client) error {
 }
 
 func portForwardPod(pod PodInfo, client *k8s.Client) error {
-	// Get port from user
-	fmt.Print("Enter local port: ")
-	var localPort int
-	fmt.Scanln(&localPort)
-
-	fmt.Print("Enter pod port: ")
-	var podPort int
-	fmt.Scanln(&podPort)
-
-	fmt.Printf("Port forwarding %d:%d for pod %s...\n", localPort, podPort, pod.Name)
-	fmt.Println("Press Ctrl+C to stop port forwarding")
-
-	// Use kubectl port-forward
-	cmd := exec.Command("kubectl", "port-forward",
-		fmt.Sprintf("pod/%s", pod.Name),
-		fmt.Sprintf("%d:%d", localPort, podPort),
-		"-n", pod.Namespace)
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-	return cmd.Run()
+	return runPortForward(pod, client, os.Stdin, os.Stdout, os.Stderr)
 }
 
 func showResourceUsage(pod PodInfo, client *k8s.Client) error {
-	// Use kubectl top
-	cmd := exec.Command("kubectl", "top", "pod", pod.Name, "-n", pod.Namespace, "--containers")
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-
-	if err := cmd.Run(); err != nil {
+	if err := printResourceUsage(pod, client); err != nil {
 		fmt.Println("Note: Metrics server might not be installed in the cluster")
 		return err
 	}
 
-	fmt.Println("\nPress Enter to continue...")
-	fmt.Scanln()
+	waitForEnter()
 	return nil
 }
 
@@ -599,15 +606,33 @@ func ShowPodActions(pod PodInfo) error {
 	m := NewPodActionsModel(pod, client)
 	p := tea.NewProgram(m)
 
-	_, err = p.Run()
+	result, err := p.Run()
+	if err != nil {
+		return err
+	}
+
+	return runPendingPodAction(result, pod, client)
+}
+
+// runsAfterQuit reports whether an action needs the terminal to itself and
+// must run once the TUI has quit
+func runsAfterQuit(key string) bool {
+	return key == "exec" || key == "logs" || key == "follow" || key == "port-forward" || key == "clone-debug"
+}
 
-	// Check if we need to execute special actions after quitting
-	if m.cursor < len(m.actions) {
-		action := m.actions[m.cursor]
-		if action.Handler != nil && (action.Key == "exec" || action.Key == "logs" || action.Key == "follow") {
+// runPendingPodAction runs the action chosen in the final model returned by
+// the program, if the user picked one that runs after quitting
+func runPendingPodAction(result tea.Model, pod PodInfo, client *k8s.Client) error {
+	final, ok := result.(PodActionsModel)
+	if !ok || final.pendingAction == "" {
+		return nil
+	}
+
+	for _, action := range final.actions {
+		if action.Key == final.pendingAction && action.Handler != nil {
 			return action.Handler(pod, client)
 		}
 	}
 
-	return err
+	return nil
 }
\ No newline at end of file
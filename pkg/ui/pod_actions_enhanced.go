@@ -3,6 +3,7 @@ package ui
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"strings"
@@ -11,7 +12,9 @@ import (
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/karthickk/k8s-manager/pkg/config"
 	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/karthickk/k8s-manager/pkg/utils"
 	"github.com/pterm/pterm"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -32,6 +35,9 @@ type EnhancedPodActionsModel struct {
 	keys           NavigationKeys
 	spinner        spinner.Model
 	currentAction  string
+	// custom are the pod actions from the configuration, listed after the
+	// built-in ones
+	custom []config.CustomPodAction
 }
 
 // NewEnhancedPodActionsModel creates a new enhanced pod actions model
@@ -75,7 +81,7 @@ func NewEnhancedPodActionsModel(pod PodInfo, client *k8s.Client) EnhancedPodActi
 		},
 		{
 			Title:       "Resource Usage",
-			Description: "Show CPU and memory usage metrics",
+			Description: "CPU and memory usage against requests and limits",
 			Icon:        "📈",
 			Number:      6,
 			Handler:     nil,
@@ -103,13 +109,22 @@ func NewEnhancedPodActionsModel(pod PodInfo, client *k8s.Client) EnhancedPodActi
 		},
 	}
 
+	custom := customPodActions()
+	for _, action := range custom {
+		menuItems = append(menuItems, MenuItem{
+			Title:       action.Name,
+			Description: action.Description,
+			Icon:        customActionIcon,
+		})
+	}
+
 	menu := NewMenu(menuItems)
 	keys := DefaultNavigationKeys()
 
 	// Create spinner
 	s := spinner.New()
 	s.Spinner = spinner.Dot
-	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	s.Style = lipgloss.NewStyle().Foreground(currentTheme.Title)
 
 	return EnhancedPodActionsModel{
 		pod:     pod,
@@ -118,6 +133,7 @@ func NewEnhancedPodActionsModel(pod PodInfo, client *k8s.Client) EnhancedPodActi
 		loading: false,
 		keys:    keys,
 		spinner: s,
+		custom:  custom,
 	}
 }
 
@@ -153,7 +169,14 @@ func (m EnhancedPodActionsModel) executeAction(actionIndex int) tea.Cmd {
 		case 3: // Execute Shell
 			return m.execShell()
 		case 4: // Port Forward
-			return m.portForward()
+			// The prompt and kubectl need the terminal, so the program
+			// hands it over until forwarding stops
+			return tea.Exec(newPortForwardExec(m.pod, m.client), func(err error) tea.Msg {
+				if err != nil {
+					return actionResultMsg{err: err}
+				}
+				return actionResultMsg{message: "Port forwarding stopped"}
+			})()
 		case 5: // Resource Usage
 			return m.resourceUsage()
 		case 6: // Edit Pod
@@ -164,6 +187,17 @@ func (m EnhancedPodActionsModel) executeAction(actionIndex int) tea.Cmd {
 			return m.deletePod()
 		}
 
+		// Custom actions follow the built-in ones
+		if custom := actionIndex - (len(m.menu.MenuItems) - len(m.custom)); custom >= 0 {
+			action := m.custom[custom]
+			return tea.Exec(newCustomActionExec(action, m.pod, m.client), func(err error) tea.Msg {
+				if err != nil {
+					return actionResultMsg{err: err}
+				}
+				return actionResultMsg{message: action.Name + " completed"}
+			})()
+		}
+
 		return nil
 	}
 }
@@ -239,6 +273,8 @@ type actionResultMsg struct {
 func (m EnhancedPodActionsModel) View() string {
 	var s strings.Builder
 
+	s.WriteString(renderContextHeader(m.pod.Namespace))
+
 	// Title with pod info
 	title := fmt.Sprintf("🔧 Pod Actions: %s", m.pod.Name)
 	subtitle := fmt.Sprintf("Namespace: %s | Status: %s | Ready: %s | Age: %s",
@@ -258,7 +294,7 @@ func (m EnhancedPodActionsModel) View() string {
 		statusStr = StatusRunningStyle.Render(statusStr)
 	case "pending":
 		statusStr = StatusPendingStyle.Render(statusStr)
-	case "failed", "error", "crashloopbackoff":
+	case "failed", "error", "crashloopbackoff", "imagepullbackoff", "errimagepull":
 		statusStr = StatusErrorStyle.Render(statusStr)
 	}
 	details.WriteString(fmt.Sprintf("  Status:   %s\n", statusStr))
@@ -323,6 +359,7 @@ func (m EnhancedPodActionsModel) View() string {
   7 - Edit        Edit pod configuration
   8 - Restart     Delete and recreate pod
   9 - Delete      Permanently remove pod
+  🧩 actions      Custom actions from pod_actions in the config
 
 📝 Navigation:
   ?/h             Toggle this help
@@ -342,21 +379,29 @@ func (m EnhancedPodActionsModel) View() string {
 
 // Action implementations
 func (m EnhancedPodActionsModel) describePod() tea.Msg {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+	fmt.Print("\033[H\033[2J") // Clear screen
 
-	pod, err := m.client.Clientset.CoreV1().Pods(m.pod.Namespace).Get(ctx, m.pod.Name, metav1.GetOptions{})
+	var pod *corev1.Pod
+	var events []corev1.Event
+	var owners []k8s.Owner
+	err := RunWithSpinner(context.Background(), "Loading pod details...", func(ctx context.Context) error {
+		var err error
+		pod, err = m.client.Clientset.CoreV1().Pods(m.pod.Namespace).Get(ctx, m.pod.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		// Get events for the pod
+		events, _ = m.client.GetEventsForObject(ctx, m.pod.Namespace, "Pod", m.pod.Name)
+		// A partial chain is still worth showing
+		owners, _ = k8s.OwnerChain(ctx, m.client.Clientset, pod.Namespace, pod.OwnerReferences)
+		return nil
+	})
 	if err != nil {
 		return actionResultMsg{err: err}
 	}
 
-	// Get events for the pod
-	events, _ := m.client.Clientset.CoreV1().Events(m.pod.Namespace).List(ctx, metav1.ListOptions{
-		FieldSelector: fmt.Sprintf("involvedObject.name=%s", m.pod.Name),
-	})
-
 	// Display pod details
-	fmt.Print("\033[H\033[2J") // Clear screen
 	pterm.DefaultHeader.Println("Pod Details")
 
 	data := [][]string{
@@ -366,6 +411,7 @@ func (m EnhancedPodActionsModel) describePod() tea.Msg {
 		{"Node", pod.Spec.NodeName},
 		{"IP", pod.Status.PodIP},
 		{"Created", pod.CreationTimestamp.Format(time.RFC3339)},
+		{"Controlled By", k8s.FormatOwnerChain(owners)},
 	}
 
 	pterm.DefaultTable.WithData(data).Render()
@@ -382,16 +428,15 @@ func (m EnhancedPodActionsModel) describePod() tea.Msg {
 	}
 
 	// Show recent events
-	if len(events.Items) > 0 {
+	if len(events) > 0 {
 		pterm.DefaultSection.Println("Recent Events")
-		for _, event := range events.Items {
-			eventTime := event.LastTimestamp.Format("15:04:05")
+		for _, event := range events {
+			eventTime := k8s.EventTime(event).Format("15:04:05")
 			fmt.Printf("[%s] %s: %s\n", eventTime, event.Reason, event.Message)
 		}
 	}
 
-	fmt.Println("\nPress Enter to continue...")
-	fmt.Scanln()
+	waitForEnter()
 
 	return actionResultMsg{message: "Pod description viewed"}
 }
@@ -405,8 +450,7 @@ func (m EnhancedPodActionsModel) viewLogs() tea.Msg {
 	cmd.Stderr = os.Stderr
 	err := cmd.Run()
 
-	fmt.Println("\nPress Enter to continue...")
-	fmt.Scanln()
+	waitForEnter()
 
 	if err != nil {
 		return actionResultMsg{err: err}
@@ -452,6 +496,7 @@ func (m EnhancedPodActionsModel) execShell() tea.Msg {
 
 		selectedOption, _ := pterm.DefaultInteractiveSelect.
 			WithOptions(options).
+			WithDefaultOption(utils.PrimaryContainer(pod)).
 			WithDefaultText("Select container").
 			Show()
 
@@ -491,130 +536,90 @@ func (m EnhancedPodActionsModel) execShell() tea.Msg {
 	return actionResultMsg{message: "Shell session ended"}
 }
 
-func (m EnhancedPodActionsModel) portForward() tea.Msg {
-	fmt.Print("\033[H\033[2J") // Clear screen
-	pterm.DefaultHeader.Printf("Port Forwarding: %s\n", m.pod.Name)
-
-	// Get port information
-	localPort := ""
-	podPort := ""
-
-	localPortInput := pterm.DefaultInteractiveTextInput.
-		WithDefaultText("Enter local port (e.g., 8080)")
-	localPort, _ = localPortInput.Show()
-
-	podPortInput := pterm.DefaultInteractiveTextInput.
-		WithDefaultText("Enter pod port (e.g., 80)")
-	podPort, _ = podPortInput.Show()
-
-	if localPort == "" || podPort == "" {
-		return actionResultMsg{err: fmt.Errorf("invalid port configuration")}
-	}
-
-	pterm.Info.Printf("Port forwarding %s:%s -> %s:%s\n", "localhost", localPort, m.pod.Name, podPort)
-	pterm.Info.Println("Press Ctrl+C to stop port forwarding")
-
-	// Use kubectl port-forward
-	cmd := exec.Command("kubectl", "port-forward",
-		fmt.Sprintf("pod/%s", m.pod.Name),
-		fmt.Sprintf("%s:%s", localPort, podPort),
-		"-n", m.pod.Namespace)
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-	err := cmd.Run()
-
-	if err != nil {
-		return actionResultMsg{err: err}
-	}
-	return actionResultMsg{message: "Port forwarding stopped"}
-}
-
 func (m EnhancedPodActionsModel) resourceUsage() tea.Msg {
 	fmt.Print("\033[H\033[2J") // Clear screen
 	pterm.DefaultHeader.Printf("Resource Usage: %s\n", m.pod.Name)
 
-	cmd := exec.Command("kubectl", "top", "pod", m.pod.Name, "-n", m.pod.Namespace, "--containers")
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-
-	if err := cmd.Run(); err != nil {
+	if err := printResourceUsage(m.pod, m.client); err != nil {
 		pterm.Warning.Println("Note: Metrics server might not be installed in the cluster")
-		fmt.Println("\nPress Enter to continue...")
-		fmt.Scanln()
+		waitForEnter()
 		return actionResultMsg{err: err}
 	}
 
-	fmt.Println("\nPress Enter to continue...")
-	fmt.Scanln()
+	waitForEnter()
 	return actionResultMsg{message: "Resource usage displayed"}
 }
 
+// editPod edits the pod in the user's editor. The editor and its prompts
+// need the terminal, so the program hands it over until the edit is done.
 func (m EnhancedPodActionsModel) editPod() tea.Msg {
-	fmt.Print("\033[H\033[2J") // Clear screen
-	pterm.DefaultHeader.Printf("Editing Pod: %s\n", m.pod.Name)
-
-	cmd := exec.Command("kubectl", "edit", "pod", m.pod.Name, "-n", m.pod.Namespace)
-	cmd.Stdin = os.Stdin
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-
-	err := cmd.Run()
-	if err != nil {
-		return actionResultMsg{err: err}
-	}
-	return actionResultMsg{message: "Pod edit completed"}
+	return tea.Exec(newResourceEditExec(m.client, k8s.PodsResource, m.pod.Namespace, m.pod.Name), func(err error) tea.Msg {
+		if err != nil {
+			return actionResultMsg{err: err}
+		}
+		return actionResultMsg{message: "Pod edit completed"}
+	})()
 }
 
 func (m EnhancedPodActionsModel) restartPod() tea.Msg {
-	// Confirm before restarting
-	result, _ := pterm.DefaultInteractiveConfirm.
-		WithDefaultText(fmt.Sprintf("Are you sure you want to restart pod '%s'?", m.pod.Name)).
-		WithTextStyle(pterm.NewStyle(pterm.FgYellow)).
-		Show()
-
-	if !result {
-		return actionResultMsg{message: "Restart cancelled"}
-	}
+	return m.removePod("restart", "restarted", "Restart cancelled")
+}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+func (m EnhancedPodActionsModel) deletePod() tea.Msg {
+	return m.removePod("delete", "deleted", "Deletion cancelled")
+}
 
-	gracePeriod := int64(30)
-	err := m.client.Clientset.CoreV1().Pods(m.pod.Namespace).Delete(ctx, m.pod.Name, metav1.DeleteOptions{
-		GracePeriodSeconds: &gracePeriod,
-	})
+// removePod deletes the pod after confirmation, which restarts it when a
+// controller manages it. The prompt needs the terminal, so the program hands
+// it over until the pod is gone.
+func (m EnhancedPodActionsModel) removePod(action, done, cancelled string) tea.Msg {
+	removal := newPodRemovalExec(action, m.pod, m.client)
+	return tea.Exec(removal, func(err error) tea.Msg {
+		if err != nil {
+			return actionResultMsg{err: err}
+		}
+		if !removal.confirmed {
+			return actionResultMsg{message: cancelled}
+		}
+		return actionResultMsg{message: fmt.Sprintf("Pod %s %s successfully", m.pod.Name, done)}
+	})()
+}
 
-	if err != nil {
-		return actionResultMsg{err: err}
-	}
-	return actionResultMsg{message: fmt.Sprintf("Pod %s restarted successfully", m.pod.Name)}
+// podRemovalExec confirms and deletes a pod through tea.Exec
+type podRemovalExec struct {
+	action    string
+	pod       PodInfo
+	client    *k8s.Client
+	confirmed bool
+	stdin     io.Reader
+	stdout    io.Writer
 }
 
-func (m EnhancedPodActionsModel) deletePod() tea.Msg {
-	// Confirm before deleting
-	result, _ := pterm.DefaultInteractiveConfirm.
-		WithDefaultText(fmt.Sprintf("Are you sure you want to DELETE pod '%s'?", m.pod.Name)).
-		WithTextStyle(pterm.NewStyle(pterm.FgRed)).
-		Show()
-
-	if !result {
-		return actionResultMsg{message: "Deletion cancelled"}
+func newPodRemovalExec(action string, pod PodInfo, client *k8s.Client) *podRemovalExec {
+	return &podRemovalExec{action: action, pod: pod, client: client, stdin: os.Stdin, stdout: os.Stdout}
+}
+
+// Run asks for confirmation and deletes the pod with a 30s grace period
+func (e *podRemovalExec) Run() error {
+	confirmed, err := ConfirmDestructiveOn(e.stdin, e.stdout, e.action, "pod", e.pod.Namespace, e.pod.Name, false)
+	if err != nil || !confirmed {
+		return err
 	}
+	e.confirmed = true
 
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
 	gracePeriod := int64(30)
-	err := m.client.Clientset.CoreV1().Pods(m.pod.Namespace).Delete(ctx, m.pod.Name, metav1.DeleteOptions{
+	return e.client.Clientset.CoreV1().Pods(e.pod.Namespace).Delete(ctx, e.pod.Name, metav1.DeleteOptions{
 		GracePeriodSeconds: &gracePeriod,
 	})
-
-	if err != nil {
-		return actionResultMsg{err: err}
-	}
-	return actionResultMsg{message: fmt.Sprintf("Pod %s deleted successfully", m.pod.Name)}
 }
 
+func (e *podRemovalExec) SetStdin(r io.Reader)  { e.stdin = r }
+func (e *podRemovalExec) SetStdout(w io.Writer) { e.stdout = w }
+func (e *podRemovalExec) SetStderr(io.Writer)   {}
+
 // Helper function to format ports
 func formatPorts(ports []corev1.ContainerPort) string {
 	if len(ports) == 0 {
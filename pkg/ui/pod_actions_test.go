@@ -0,0 +1,98 @@
+package ui
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// newRecordingPodActionsModel returns a loaded model whose handlers record
+// the key of the action they belong to instead of touching the cluster
+func newRecordingPodActionsModel(invoked *[]string) tea.Model {
+	m := NewPodActionsModel(PodInfo{Name: "web", Namespace: "default"}, nil)
+	for i := range m.actions {
+		if m.actions[i].Handler == nil {
+			continue
+		}
+		key := m.actions[i].Key
+		m.actions[i].Handler = func(PodInfo, *k8s.Client) error {
+			*invoked = append(*invoked, key)
+			return nil
+		}
+	}
+
+	model, _ := m.Update(podDetailsLoadedMsg{pod: &corev1.Pod{}})
+	return model
+}
+
+func TestShowPodActionsRunsSelectedActionAfterQuit(t *testing.T) {
+	var invoked []string
+	model := newRecordingPodActionsModel(&invoked)
+
+	// Describe -> View Logs -> Follow Logs
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	require.NotNil(t, cmd)
+	assert.Equal(t, tea.QuitMsg{}, cmd())
+	assert.Empty(t, invoked, "follow must not run while the TUI owns the terminal")
+
+	require.NoError(t, runPendingPodAction(model, PodInfo{Name: "web", Namespace: "default"}, nil))
+	assert.Equal(t, []string{"follow"}, invoked)
+}
+
+func TestShowPodActionsQuitWithoutSelection(t *testing.T) {
+	var invoked []string
+	model := newRecordingPodActionsModel(&invoked)
+
+	// Moving onto an action and quitting must not run it
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+
+	require.NoError(t, runPendingPodAction(model, PodInfo{Name: "web", Namespace: "default"}, nil))
+	assert.Empty(t, invoked)
+}
+
+func TestPodRemovalExecConfirmsOnItsOwnTerminal(t *testing.T) {
+	var deletes []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodDelete {
+			deletes = append(deletes, r.URL.Path)
+		}
+		w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Success"}`))
+	}))
+	t.Cleanup(server.Close)
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+	client := &k8s.Client{Clientset: clientset}
+	pod := PodInfo{Name: "web-0", Namespace: "shop"}
+
+	// The answer comes from the terminal tea.Exec hands over, not os.Stdin
+	declined := newPodRemovalExec("delete", pod, client)
+	out := new(bytes.Buffer)
+	declined.SetStdin(strings.NewReader("n\n"))
+	declined.SetStdout(out)
+	require.NoError(t, declined.Run())
+	assert.False(t, declined.confirmed)
+	assert.Contains(t, out.String(), "delete pod 'web-0' in namespace 'shop'")
+	assert.Empty(t, deletes)
+
+	confirmed := newPodRemovalExec("restart", pod, client)
+	confirmed.SetStdin(strings.NewReader("y\r\n"))
+	confirmed.SetStdout(new(bytes.Buffer))
+	require.NoError(t, confirmed.Run())
+	assert.True(t, confirmed.confirmed)
+	assert.Equal(t, []string{"/api/v1/namespaces/shop/pods/web-0"}, deletes)
+}
@@ -0,0 +1,98 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/pterm/pterm"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DebugCloneOfAnnotation records the pod a debug clone was made from
+const DebugCloneOfAnnotation = "k8s-manager/debug-clone-of"
+
+// NewDebugPodClone builds a standalone copy of a pod named <name>-debug that
+// runs the same images, env and volumes. It is not owned by any controller,
+// carries none of the pod's labels so services and controllers leave it
+// alone, and has no probes so it is not restarted while being inspected.
+// With sleep set, its containers run "sleep infinity" instead of their
+// command.
+func NewDebugPodClone(pod *corev1.Pod, sleep bool) (*corev1.Pod, error) {
+	spec := pod.Spec.DeepCopy()
+	spec.NodeName = ""
+	spec.EphemeralContainers = nil
+	spec.RestartPolicy = corev1.RestartPolicyNever
+
+	for i := range spec.Containers {
+		container := &spec.Containers[i]
+		container.LivenessProbe = nil
+		container.ReadinessProbe = nil
+		container.StartupProbe = nil
+		container.Env = nil
+		container.EnvFrom = nil
+		if sleep {
+			container.Command = []string{"sleep", "infinity"}
+			container.Args = nil
+		}
+	}
+
+	clone := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        pod.Name + "-debug",
+			Namespace:   pod.Namespace,
+			Labels:      map[string]string{k8s.ManagedByLabel: "k8s-manager"},
+			Annotations: map[string]string{DebugCloneOfAnnotation: pod.Name},
+		},
+		Spec: *spec,
+	}
+
+	if err := NewPodEnvManager(pod, nil).CopyEnvToNewPod(clone); err != nil {
+		return nil, err
+	}
+
+	return clone, nil
+}
+
+// cloneDebugPod creates a standalone debug copy of a pod after confirmation
+func cloneDebugPod(pod PodInfo, client *k8s.Client) error {
+	getCtx, cancelGet := context.WithTimeout(context.Background(), 30*time.Second)
+	source, err := client.Clientset.CoreV1().Pods(pod.Namespace).Get(getCtx, pod.Name, metav1.GetOptions{})
+	cancelGet()
+	if err != nil {
+		return err
+	}
+
+	sleep, _ := pterm.DefaultInteractiveConfirm.
+		WithDefaultText("Replace the container commands with 'sleep infinity'?").
+		WithDefaultValue(true).
+		Show()
+
+	clone, err := NewDebugPodClone(source, sleep)
+	if err != nil {
+		return err
+	}
+
+	confirm, _ := pterm.DefaultInteractiveConfirm.
+		WithDefaultText(fmt.Sprintf("Create debug pod '%s' in namespace '%s'?", clone.Name, clone.Namespace)).
+		Show()
+	if !confirm {
+		return fmt.Errorf("clone cancelled")
+	}
+
+	// The deadline starts once the user has answered
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	created, err := client.Clientset.CoreV1().Pods(pod.Namespace).Create(ctx, clone, metav1.CreateOptions{FieldManager: k8s.FieldManager()})
+	if err != nil {
+		return fmt.Errorf("failed to create debug pod %s: %w", clone.Name, err)
+	}
+
+	fmt.Printf("✅ Created debug pod '%s' in namespace '%s'\n", created.Name, created.Namespace)
+	fmt.Println("Delete it when you are done; nothing else will.")
+	waitForEnter()
+	return nil
+}
@@ -0,0 +1,108 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func debugCloneTestPod() *corev1.Pod {
+	controller := true
+	probe := &corev1.Probe{ProbeHandler: corev1.ProbeHandler{Exec: &corev1.ExecAction{Command: []string{"true"}}}}
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "web-7d4b9",
+			Namespace:       "shop",
+			Labels:          map[string]string{"app": "web"},
+			OwnerReferences: []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "web-7d", Controller: &controller}},
+			ResourceVersion: "42",
+		},
+		Spec: corev1.PodSpec{
+			NodeName:      "node-1",
+			RestartPolicy: corev1.RestartPolicyAlways,
+			Volumes:       []corev1.Volume{{Name: "data"}},
+			Containers: []corev1.Container{
+				{
+					Name:           "app",
+					Image:          "shop/web:1.2",
+					Command:        []string{"/server"},
+					Args:           []string{"--port", "8080"},
+					Env:            []corev1.EnvVar{{Name: "LOG_LEVEL", Value: "debug"}},
+					LivenessProbe:  probe,
+					ReadinessProbe: probe,
+					StartupProbe:   probe,
+				},
+				{
+					Name:    "proxy",
+					Image:   "envoy:1.30",
+					EnvFrom: []corev1.EnvFromSource{{ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "proxy-config"}}}},
+				},
+			},
+			EphemeralContainers: []corev1.EphemeralContainer{{EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "debugger-abcde"}}},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning, PodIP: "10.0.0.5"},
+	}
+}
+
+func TestNewDebugPodClone(t *testing.T) {
+	pod := debugCloneTestPod()
+
+	clone, err := NewDebugPodClone(pod, true)
+	require.NoError(t, err)
+
+	assert.Equal(t, "web-7d4b9-debug", clone.Name)
+	assert.Equal(t, "shop", clone.Namespace)
+	assert.Empty(t, clone.OwnerReferences)
+	assert.Empty(t, clone.ResourceVersion)
+	assert.Equal(t, map[string]string{k8s.ManagedByLabel: "k8s-manager"}, clone.Labels, "the app labels would let services and controllers pick the clone up")
+	assert.Equal(t, "web-7d4b9", clone.Annotations[DebugCloneOfAnnotation])
+	assert.Empty(t, clone.Status.PodIP)
+
+	assert.Empty(t, clone.Spec.NodeName)
+	assert.Empty(t, clone.Spec.EphemeralContainers)
+	assert.Equal(t, corev1.RestartPolicyNever, clone.Spec.RestartPolicy)
+	assert.Equal(t, pod.Spec.Volumes, clone.Spec.Volumes)
+
+	app := clone.Spec.Containers[0]
+	assert.Equal(t, "shop/web:1.2", app.Image)
+	assert.Equal(t, []string{"sleep", "infinity"}, app.Command)
+	assert.Nil(t, app.Args)
+	assert.Nil(t, app.LivenessProbe)
+	assert.Nil(t, app.ReadinessProbe)
+	assert.Nil(t, app.StartupProbe)
+	assert.Equal(t, pod.Spec.Containers[0].Env, app.Env)
+	assert.Equal(t, pod.Spec.Containers[1].EnvFrom, clone.Spec.Containers[1].EnvFrom)
+
+	// The source pod is left untouched
+	assert.Equal(t, "node-1", pod.Spec.NodeName)
+	assert.NotNil(t, pod.Spec.Containers[0].LivenessProbe)
+}
+
+func TestNewDebugPodCloneKeepsCommand(t *testing.T) {
+	clone, err := NewDebugPodClone(debugCloneTestPod(), false)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"/server"}, clone.Spec.Containers[0].Command)
+	assert.Equal(t, []string{"--port", "8080"}, clone.Spec.Containers[0].Args)
+}
+
+func TestCopyEnvToNewPodMatchesContainersByName(t *testing.T) {
+	source := debugCloneTestPod()
+	target := &corev1.Pod{Spec: corev1.PodSpec{Containers: []corev1.Container{
+		{Name: "proxy"},
+		{Name: "sidecar", Env: []corev1.EnvVar{{Name: "KEEP", Value: "1"}}},
+	}}}
+
+	require.NoError(t, NewPodEnvManager(source, nil).CopyEnvToNewPod(target))
+
+	assert.Empty(t, target.Spec.Containers[0].Env)
+	assert.Equal(t, source.Spec.Containers[1].EnvFrom, target.Spec.Containers[0].EnvFrom)
+	assert.Equal(t, []corev1.EnvVar{{Name: "KEEP", Value: "1"}}, target.Spec.Containers[1].Env, "containers without a source are left alone")
+
+	err := NewPodEnvManager(source, nil).CopyEnvToNewPod(&corev1.Pod{})
+	assert.ErrorContains(t, err, "target pod has no containers")
+}
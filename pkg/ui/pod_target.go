@@ -0,0 +1,49 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+// PodTarget narrows an interactive pods view to the pods matching a label
+// selector and, if Name is set, to the pod of that name. When exactly one
+// pod matches, the view opens its actions instead of a one-item list.
+type PodTarget struct {
+	Selector string
+	Name     string
+}
+
+// IsZero reports whether the target matches every pod
+func (t PodTarget) IsZero() bool {
+	return t.Selector == "" && t.Name == ""
+}
+
+// listOptions returns the list options that fetch the targeted pods
+func (t PodTarget) listOptions() metav1.ListOptions {
+	opts := metav1.ListOptions{LabelSelector: t.Selector}
+	if t.Name != "" {
+		opts.FieldSelector = fields.OneTermEqualSelector("metadata.name", t.Name).String()
+	}
+	return opts
+}
+
+// findUniquePod returns the only pod matching target, or nil when none or
+// several match
+func findUniquePod(ctx context.Context, client *k8s.Client, namespace string, target PodTarget) (*corev1.Pod, error) {
+	opts := target.listOptions()
+	opts.Limit = 2
+
+	pods, err := client.Clientset.CoreV1().Pods(namespace).List(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+	if len(pods.Items) != 1 || pods.Continue != "" {
+		return nil, nil
+	}
+	return &pods.Items[0], nil
+}
@@ -0,0 +1,79 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestFindUniquePod(t *testing.T) {
+	pods := map[string][]string{
+		"app=web":   {"web-0"},
+		"app=cache": {"cache-0", "cache-1"},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/namespaces/shop/pods", r.URL.Path)
+		assert.Equal(t, "2", r.URL.Query().Get("limit"))
+
+		list := &corev1.PodList{}
+		for _, name := range pods[r.URL.Query().Get("labelSelector")] {
+			if field := r.URL.Query().Get("fieldSelector"); field == "" || field == "metadata.name="+name {
+				list.Items = append(list.Items, corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "shop"}})
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(list)
+	}))
+	defer server.Close()
+
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+	client := &k8s.Client{Clientset: clientset}
+
+	pod, err := findUniquePod(t.Context(), client, "shop", PodTarget{Selector: "app=web"})
+	require.NoError(t, err)
+	require.NotNil(t, pod)
+	assert.Equal(t, "web-0", pod.Name)
+
+	pod, err = findUniquePod(t.Context(), client, "shop", PodTarget{Selector: "app=cache"})
+	require.NoError(t, err)
+	assert.Nil(t, pod, "several pods match")
+
+	pod, err = findUniquePod(t.Context(), client, "shop", PodTarget{Selector: "app=cache", Name: "cache-1"})
+	require.NoError(t, err)
+	require.NotNil(t, pod)
+	assert.Equal(t, "cache-1", pod.Name)
+
+	pod, err = findUniquePod(t.Context(), client, "shop", PodTarget{Selector: "app=db"})
+	require.NoError(t, err)
+	assert.Nil(t, pod, "no pod matches")
+}
+
+func TestDevToolsPodsFilterEnterOnlyAppliesFilter(t *testing.T) {
+	m := NewDevToolsPodsModel("default", false)
+	t.Cleanup(m.cancel)
+	m.Update(podsLoadedMsg{pods: podInfos("api", "web", "worker")})
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+	for _, r := range "web" {
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.False(t, m.filtering)
+	require.Len(t, m.filteredPods, 1)
+	assert.Nil(t, m.GetSelectedPod(), "a single match is not opened until chosen")
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+	require.NotNil(t, m.GetSelectedPod())
+	assert.Equal(t, "web", m.GetSelectedPod().Name)
+}
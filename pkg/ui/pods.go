@@ -19,35 +19,46 @@ import (
 )
 
 var (
+	podHeaderStyle   lipgloss.Style
+	podStatusRunning lipgloss.Style
+	podStatusPending lipgloss.Style
+	podStatusFailed  lipgloss.Style
+	podStatusUnknown lipgloss.Style
+	podSelectedStyle lipgloss.Style
+	podActionStyle   lipgloss.Style
+)
+
+// buildPodStyles sets the pod list styles from the theme
+func buildPodStyles(t Theme) {
 	podHeaderStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(lipgloss.Color("39")).
-			Background(lipgloss.Color("235")).
-			Padding(0, 1)
+		Bold(true).
+		Foreground(t.Title).
+		Background(t.Background).
+		Padding(0, 1)
 
 	podStatusRunning = lipgloss.NewStyle().
-				Foreground(lipgloss.Color("42")).
-				Bold(true)
+		Foreground(t.Success).
+		Bold(true)
 
 	podStatusPending = lipgloss.NewStyle().
-				Foreground(lipgloss.Color("214")).
-				Bold(true)
+		Foreground(t.Warning).
+		Bold(true)
 
 	podStatusFailed = lipgloss.NewStyle().
-				Foreground(lipgloss.Color("196")).
-				Bold(true)
+		Foreground(t.Error).
+		Bold(true)
 
 	podStatusUnknown = lipgloss.NewStyle().
-				Foreground(lipgloss.Color("244"))
+		Foreground(t.Muted)
 
 	podSelectedStyle = lipgloss.NewStyle().
-				Background(lipgloss.Color("236")).
-				Foreground(lipgloss.Color("170"))
+		Background(t.Background).
+		Foreground(t.Selected)
 
 	podActionStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("86")).
-			Bold(true)
-)
+		Foreground(t.Title).
+		Bold(true)
+}
 
 // PodInfo represents pod information for display
 type PodInfo struct {
@@ -61,6 +72,17 @@ type PodInfo struct {
 	Pod       *corev1.Pod
 }
 
+// podIndex returns the position of the named pod in pods, or -1 if it is not
+// there. Lists use it to keep the cursor on the same pod across reloads.
+func podIndex(pods []PodInfo, namespace, name string) int {
+	for i, pod := range pods {
+		if pod.Name == name && pod.Namespace == namespace {
+			return i
+		}
+	}
+	return -1
+}
+
 // PodsModel represents the pods view state
 type PodsModel struct {
 	pods         []PodInfo
@@ -79,6 +101,8 @@ type PodsModel struct {
 	client       *k8s.Client
 	namespace    string
 	allNamespaces bool
+	ctx          context.Context
+	cancel       context.CancelFunc
 }
 
 // PodActionModel represents the action menu for a pod
@@ -99,7 +123,7 @@ func NewPodsModel(namespace string, allNamespaces bool) PodsModel {
 	// Create spinner
 	s := spinner.New()
 	s.Spinner = spinner.Dot
-	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	s.Style = lipgloss.NewStyle().Foreground(currentTheme.Title)
 
 	// Create table
 	columns := []table.Column{
@@ -128,15 +152,17 @@ func NewPodsModel(namespace string, allNamespaces bool) PodsModel {
 	s1 := table.DefaultStyles()
 	s1.Header = s1.Header.
 		BorderStyle(lipgloss.NormalBorder()).
-		BorderForeground(lipgloss.Color("240")).
+		BorderForeground(currentTheme.Muted).
 		BorderBottom(true).
 		Bold(false)
 	s1.Selected = s1.Selected.
-		Foreground(lipgloss.Color("229")).
-		Background(lipgloss.Color("57")).
+		Foreground(currentTheme.Selected).
+		Background(currentTheme.Background).
 		Bold(false)
 	t.SetStyles(s1)
 
+	ctx, cancel := newModelContext()
+
 	return PodsModel{
 		table:         t,
 		filterInput:   ti,
@@ -144,9 +170,16 @@ func NewPodsModel(namespace string, allNamespaces bool) PodsModel {
 		loading:       true,
 		namespace:     namespace,
 		allNamespaces: allNamespaces,
+		ctx:           ctx,
+		cancel:        cancel,
 	}
 }
 
+// quit cancels in-flight requests and exits the program
+func (m *PodsModel) quit() tea.Cmd {
+	return quitModel(m.cancel)
+}
+
 func (m PodsModel) Init() tea.Cmd {
 	return tea.Batch(
 		m.spinner.Tick,
@@ -166,7 +199,7 @@ func (m PodsModel) loadPods() tea.Msg {
 		namespace = client.GetNamespace()
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(m.ctx, 30*time.Second)
 	defer cancel()
 
 	var pods *corev1.PodList
@@ -181,16 +214,17 @@ func (m PodsModel) loadPods() tea.Msg {
 	}
 
 	podInfos := make([]PodInfo, 0, len(pods.Items))
-	for _, pod := range pods.Items {
+	for i := range pods.Items {
+		pod := &pods.Items[i]
 		info := PodInfo{
 			Name:      pod.Name,
 			Namespace: pod.Namespace,
-			Ready:     getPodReadyStatus(&pod),
-			Status:    string(pod.Status.Phase),
-			Restarts:  getPodRestartCount(&pod),
+			Ready:     getPodReadyStatus(pod),
+			Status:    k8s.PodStatus(pod),
+			Restarts:  getPodRestartCount(pod),
 			Age:       utils.FormatAge(pod.CreationTimestamp.Time),
 			Node:      pod.Spec.NodeName,
-			Pod:       &pod,
+			Pod:       pod,
 		}
 		podInfos = append(podInfos, info)
 	}
@@ -202,6 +236,7 @@ type errMsg struct{ err error }
 type podsLoadedMsg struct {
 	pods   []PodInfo
 	client *k8s.Client
+	more   string // Continue token when only the first page was loaded
 }
 type refreshPodsMsg struct{}
 
@@ -219,9 +254,8 @@ func (m PodsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case podsLoadedMsg:
 		m.loading = false
 		m.pods = msg.pods
-		m.filteredPods = msg.pods
 		m.client = msg.client
-		m.updateTableData()
+		m.applyFilter()
 		return m, nil
 
 	case errMsg:
@@ -241,7 +275,7 @@ func (m PodsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		switch msg.String() {
 		case "ctrl+c", "q":
-			return m, tea.Quit
+			return m, m.quit()
 
 		case "/":
 			m.filtering = true
@@ -266,7 +300,7 @@ func (m PodsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			} else if len(m.filteredPods) > 0 {
 				// Get selected pod and quit to show action menu
 				m.selectedPod = m.table.Cursor()
-				return m, tea.Quit
+				return m, m.quit()
 			}
 			return m, nil
 
@@ -350,7 +384,19 @@ func (m *PodsModel) applyFilter() {
 	m.updateTableData()
 }
 
+// updateTableData fills the table from the filtered pods, keeping the cursor
+// on the pod it was on if that pod is still listed
 func (m *PodsModel) updateTableData() {
+	// The table still holds the rows of the previous pods
+	var namespace, name string
+	if row := m.table.SelectedRow(); row != nil {
+		if m.allNamespaces {
+			namespace, name = row[0], row[1]
+		} else {
+			name = row[0]
+		}
+	}
+
 	rows := []table.Row{}
 	for _, pod := range m.filteredPods {
 		var row table.Row
@@ -377,6 +423,15 @@ func (m *PodsModel) updateTableData() {
 		rows = append(rows, row)
 	}
 	m.table.SetRows(rows)
+
+	if name != "" {
+		for i, pod := range m.filteredPods {
+			if pod.Name == name && (!m.allNamespaces || pod.Namespace == namespace) {
+				m.table.SetCursor(i)
+				break
+			}
+		}
+	}
 }
 
 func (m PodsModel) colorStatus(status string) string {
@@ -385,7 +440,7 @@ func (m PodsModel) colorStatus(status string) string {
 		return podStatusRunning.Render(status)
 	case "pending":
 		return podStatusPending.Render(status)
-	case "failed", "error", "crashloopbackoff":
+	case "failed", "error", "crashloopbackoff", "imagepullbackoff", "errimagepull":
 		return podStatusFailed.Render(status)
 	default:
 		return podStatusUnknown.Render(status)
@@ -399,6 +454,8 @@ func (m PodsModel) View() string {
 
 	var s strings.Builder
 
+	s.WriteString(renderContextHeader(headerNamespace(m.namespace, m.allNamespaces)))
+
 	// Header
 	header := "📦 Kubernetes Pods"
 	if m.namespace != "" && !m.allNamespaces {
@@ -452,14 +509,14 @@ func (m PodsModel) View() string {
 		"l: logs",
 		"q: quit",
 	}
-	s.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Render(strings.Join(helpItems, " • ")))
+	s.WriteString(lipgloss.NewStyle().Foreground(currentTheme.Muted).Render(strings.Join(helpItems, " • ")))
 
 	return s.String()
 }
 
 func (m PodsModel) deletePod(pod PodInfo) tea.Cmd {
 	return func() tea.Msg {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(m.ctx, 30*time.Second)
 		defer cancel()
 
 		gracePeriod := int64(30)
@@ -15,6 +15,21 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// DefaultPodsRefreshInterval is how often the pods view reloads while
+// auto-refresh is on
+const DefaultPodsRefreshInterval = 3 * time.Second
+
+// autoRefreshTickMsg asks the pods view to reload. gen ties it to the
+// auto-refresh session that scheduled it, so ticks left over from a session
+// that was switched off are dropped.
+type autoRefreshTickMsg struct{ gen int }
+
+// autoRefreshedMsg carries the result of a reload started by auto-refresh
+type autoRefreshedMsg struct {
+	gen    int
+	result tea.Msg
+}
+
 // EnhancedPodsModel represents the enhanced pods view with common UI
 type EnhancedPodsModel struct {
 	list          *List
@@ -31,8 +46,15 @@ type EnhancedPodsModel struct {
 	client        *k8s.Client
 	namespace     string
 	allNamespaces bool
+	target        PodTarget
 	showHelp      bool
 	keys          NavigationKeys
+	ctx           context.Context
+	cancel        context.CancelFunc
+
+	autoRefresh     bool
+	refreshInterval time.Duration
+	refreshGen      int
 }
 
 // NewEnhancedPodsModel creates a new enhanced pods model
@@ -51,19 +73,78 @@ func NewEnhancedPodsModel(namespace string, allNamespaces bool) EnhancedPodsMode
 		key.WithHelp("?/h", "help"),
 	)
 
+	ctx, cancel := newModelContext()
+
 	return EnhancedPodsModel{
-		filterInput:   ti,
-		loading:       true,
-		namespace:     namespace,
-		allNamespaces: allNamespaces,
-		keys:          keys,
+		filterInput:     ti,
+		loading:         true,
+		namespace:       namespace,
+		allNamespaces:   allNamespaces,
+		keys:            keys,
+		ctx:             ctx,
+		cancel:          cancel,
+		refreshInterval: DefaultPodsRefreshInterval,
+	}
+}
+
+// WithAutoRefresh sets how often the view reloads while auto-refresh is on,
+// and whether it starts switched on. A non-positive interval keeps the default.
+func (m EnhancedPodsModel) WithAutoRefresh(interval time.Duration, enabled bool) EnhancedPodsModel {
+	if interval > 0 {
+		m.refreshInterval = interval
 	}
+	m.autoRefresh = enabled
+	return m
+}
+
+// WithTarget lists only the pods matching target
+func (m EnhancedPodsModel) WithTarget(target PodTarget) EnhancedPodsModel {
+	m.target = target
+	return m
+}
+
+// AutoRefresh reports whether auto-refresh is switched on
+func (m EnhancedPodsModel) AutoRefresh() bool {
+	return m.autoRefresh
+}
+
+// quit cancels in-flight requests and exits the program
+func (m *EnhancedPodsModel) quit() tea.Cmd {
+	return quitModel(m.cancel)
 }
 
 func (m EnhancedPodsModel) Init() tea.Cmd {
+	if m.autoRefresh {
+		return tea.Batch(m.loadPods, m.scheduleRefresh())
+	}
 	return m.loadPods
 }
 
+// scheduleRefresh waits one refresh interval and asks for a reload. The wait
+// ends early when the model quits, so no timer outlives the view.
+func (m EnhancedPodsModel) scheduleRefresh() tea.Cmd {
+	ctx, interval, gen := m.ctx, m.refreshInterval, m.refreshGen
+	return func() tea.Msg {
+		timer := time.NewTimer(interval)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-timer.C:
+			return autoRefreshTickMsg{gen: gen}
+		}
+	}
+}
+
+// refreshPods reloads the pods in the background for auto-refresh
+func (m EnhancedPodsModel) refreshPods() tea.Cmd {
+	gen := m.refreshGen
+	return func() tea.Msg {
+		return autoRefreshedMsg{gen: gen, result: m.loadPods()}
+	}
+}
+
 func (m EnhancedPodsModel) loadPods() tea.Msg {
 	// Create client
 	client, err := k8s.NewClient()
@@ -76,14 +157,14 @@ func (m EnhancedPodsModel) loadPods() tea.Msg {
 		namespace = client.GetNamespace()
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(m.ctx, 30*time.Second)
 	defer cancel()
 
 	var pods *corev1.PodList
 	if m.allNamespaces {
-		pods, err = client.Clientset.CoreV1().Pods("").List(ctx, metav1.ListOptions{})
+		pods, err = client.Clientset.CoreV1().Pods("").List(ctx, m.target.listOptions())
 	} else {
-		pods, err = client.Clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+		pods, err = client.Clientset.CoreV1().Pods(namespace).List(ctx, m.target.listOptions())
 	}
 
 	if err != nil {
@@ -91,16 +172,17 @@ func (m EnhancedPodsModel) loadPods() tea.Msg {
 	}
 
 	podInfos := make([]PodInfo, 0, len(pods.Items))
-	for _, pod := range pods.Items {
+	for i := range pods.Items {
+		pod := &pods.Items[i]
 		info := PodInfo{
 			Name:      pod.Name,
 			Namespace: pod.Namespace,
-			Ready:     getPodReadyStatus(&pod),
-			Status:    string(pod.Status.Phase),
-			Restarts:  getPodRestartCount(&pod),
+			Ready:     getPodReadyStatus(pod),
+			Status:    k8s.PodStatus(pod),
+			Restarts:  getPodRestartCount(pod),
 			Age:       utils.FormatAge(pod.CreationTimestamp.Time),
 			Node:      pod.Spec.NodeName,
-			Pod:       &pod,
+			Pod:       pod,
 		}
 		podInfos = append(podInfos, info)
 	}
@@ -128,6 +210,30 @@ func (m EnhancedPodsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.messageType = "success"
 		return m, nil
 
+	case autoRefreshTickMsg:
+		if !m.autoRefresh || msg.gen != m.refreshGen {
+			return m, nil
+		}
+		return m, m.refreshPods()
+
+	case autoRefreshedMsg:
+		if !m.autoRefresh || msg.gen != m.refreshGen {
+			return m, nil
+		}
+		switch result := msg.result.(type) {
+		case podsLoadedMsg:
+			m.loading = false
+			m.pods = result.pods
+			m.client = result.client
+			// Keep the current filter rather than resetting it on every tick
+			m.applyFilter()
+		case errMsg:
+			// A failed reload is reported but does not end the session
+			m.message = fmt.Sprintf("Auto-refresh failed: %v", result.err)
+			m.messageType = "error"
+		}
+		return m, m.scheduleRefresh()
+
 	case errMsg:
 		m.loading = false
 		m.err = msg.err
@@ -174,7 +280,7 @@ func (m EnhancedPodsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Handle other keys
 		switch {
 		case key.Matches(msg, m.keys.Quit):
-			return m, tea.Quit
+			return m, m.quit()
 
 		case key.Matches(msg, m.keys.Search):
 			m.filtering = true
@@ -191,6 +297,18 @@ func (m EnhancedPodsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, m.loadPods
 			}
 
+		case msg.String() == "w":
+			m.autoRefresh = !m.autoRefresh
+			m.refreshGen++
+			if m.autoRefresh {
+				m.message = fmt.Sprintf("Auto-refresh on, every %s", m.refreshInterval)
+				m.messageType = "info"
+				return m, m.scheduleRefresh()
+			}
+			m.message = "Auto-refresh off"
+			m.messageType = "info"
+			return m, nil
+
 		case key.Matches(msg, m.keys.Enter):
 			if m.list != nil && len(m.filteredPods) > 0 {
 				return m, m.showPodActions()
@@ -217,7 +335,7 @@ func (m EnhancedPodsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.list != nil && len(m.filteredPods) > 0 {
 				idx := m.list.GetCursor()
 				if idx < len(m.filteredPods) {
-					return m, tea.Quit // Exit to show logs
+					return m, m.quit() // Exit to show logs
 				}
 			}
 
@@ -226,7 +344,7 @@ func (m EnhancedPodsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.list != nil && len(m.filteredPods) > 0 {
 				idx := m.list.GetCursor()
 				if idx < len(m.filteredPods) {
-					return m, tea.Quit // Exit to exec into pod
+					return m, m.quit() // Exit to exec into pod
 				}
 			}
 
@@ -281,7 +399,18 @@ func (m *EnhancedPodsModel) applyFilter() {
 	m.updateList()
 }
 
+// updateList rebuilds the list from the filtered pods, keeping the cursor on
+// the pod it was on if that pod is still listed
 func (m *EnhancedPodsModel) updateList() {
+	// The old list still mirrors the pods it was built from, even when
+	// filteredPods has already been replaced
+	var current *ListItem
+	if m.list != nil {
+		if cursor := m.list.GetCursor(); cursor >= 0 && cursor < len(m.list.Items) {
+			current = &m.list.Items[cursor]
+		}
+	}
+
 	items := make([]ListItem, 0, len(m.filteredPods))
 	for _, pod := range m.filteredPods {
 		// Choose icon based on status
@@ -322,11 +451,20 @@ func (m *EnhancedPodsModel) updateList() {
 	if m.width > 0 && m.height > 0 {
 		m.list.SetSize(m.width-4, m.height-15)
 	}
+
+	if current != nil {
+		for i := range items {
+			if items[i].ID == current.ID && items[i].Details["Namespace"] == current.Details["Namespace"] {
+				m.list.SetCursor(i)
+				break
+			}
+		}
+	}
 }
 
 func (m EnhancedPodsModel) deletePod(pod PodInfo) tea.Cmd {
 	return func() tea.Msg {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(m.ctx, 30*time.Second)
 		defer cancel()
 
 		gracePeriod := int64(30)
@@ -344,7 +482,7 @@ func (m EnhancedPodsModel) deletePod(pod PodInfo) tea.Cmd {
 
 func (m EnhancedPodsModel) restartPod(pod PodInfo) tea.Cmd {
 	return func() tea.Msg {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(m.ctx, 30*time.Second)
 		defer cancel()
 
 		gracePeriod := int64(0) // Force delete for quick restart
@@ -368,6 +506,8 @@ func (m EnhancedPodsModel) View() string {
 
 	var s strings.Builder
 
+	s.WriteString(renderContextHeader(headerNamespace(m.namespace, m.allNamespaces)))
+
 	// Title
 	title := "🚀 Kubernetes Pods Manager"
 	subtitle := ""
@@ -376,6 +516,13 @@ func (m EnhancedPodsModel) View() string {
 	} else if m.allNamespaces {
 		subtitle = "All Namespaces"
 	}
+	if m.autoRefresh {
+		live := fmt.Sprintf("● LIVE %s", m.refreshInterval)
+		if subtitle != "" {
+			subtitle += "  "
+		}
+		subtitle += live
+	}
 	s.WriteString(RenderTitle(title, subtitle))
 	s.WriteString("\n\n")
 
@@ -432,6 +579,7 @@ func (m EnhancedPodsModel) View() string {
 🔍 Features:
   /               Search/filter pods
   R/F5            Refresh pod list
+  w               Toggle auto-refresh
   ?/h             Toggle this help
   q/Ctrl+C        Quit
 `
@@ -444,6 +592,7 @@ func (m EnhancedPodsModel) View() string {
 			"l: logs",
 			"x: exec",
 			"r: restart",
+			"w: auto-refresh",
 		}
 		s.WriteString("\n")
 		s.WriteString(RenderHelp(m.keys, additionalHelp...))
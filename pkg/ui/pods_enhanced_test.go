@@ -0,0 +1,116 @@
+package ui
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadedPodsModel(t *testing.T, names ...string) EnhancedPodsModel {
+	t.Helper()
+
+	m := NewEnhancedPodsModel("default", false)
+	t.Cleanup(m.cancel)
+
+	updated, _ := m.Update(podsLoadedMsg{pods: podInfos(names...)})
+	return updated.(EnhancedPodsModel)
+}
+
+func podInfos(names ...string) []PodInfo {
+	pods := make([]PodInfo, 0, len(names))
+	for _, name := range names {
+		pods = append(pods, PodInfo{Name: name, Namespace: "default", Status: "Running"})
+	}
+	return pods
+}
+
+func pressKey(m EnhancedPodsModel, k string) (EnhancedPodsModel, tea.Cmd) {
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)})
+	return updated.(EnhancedPodsModel), cmd
+}
+
+func TestEnhancedPodsAutoRefreshToggle(t *testing.T) {
+	m := loadedPodsModel(t, "api", "web")
+	assert.False(t, m.AutoRefresh())
+	assert.NotContains(t, m.View(), "● LIVE")
+
+	m, cmd := pressKey(m, "w")
+	assert.True(t, m.AutoRefresh())
+	assert.NotNil(t, cmd, "switching on schedules the first refresh")
+	assert.Contains(t, m.View(), "● LIVE 3s")
+
+	m, cmd = pressKey(m, "w")
+	assert.False(t, m.AutoRefresh())
+	assert.Nil(t, cmd)
+	assert.NotContains(t, m.View(), "● LIVE")
+}
+
+func TestEnhancedPodsAutoRefreshDropsStaleTicks(t *testing.T) {
+	m := loadedPodsModel(t, "api")
+
+	m, _ = pressKey(m, "w")
+	staleGen := m.refreshGen
+	m, _ = pressKey(m, "w")
+	m, _ = pressKey(m, "w")
+
+	updated, cmd := m.Update(autoRefreshTickMsg{gen: staleGen})
+	assert.Nil(t, cmd, "a tick from an earlier session is ignored")
+
+	_, cmd = updated.Update(autoRefreshTickMsg{gen: m.refreshGen})
+	assert.NotNil(t, cmd, "a tick from the current session reloads the pods")
+
+	m, _ = pressKey(m, "w")
+	_, cmd = m.Update(autoRefreshTickMsg{gen: m.refreshGen})
+	assert.Nil(t, cmd, "ticks are ignored once auto-refresh is off")
+}
+
+func TestEnhancedPodsAutoRefreshKeepsSelection(t *testing.T) {
+	m := loadedPodsModel(t, "api", "cache", "web")
+	m, _ = pressKey(m, "w")
+	m, _ = pressKey(m, "j")
+	m, _ = pressKey(m, "j")
+	require.Equal(t, "web", m.GetSelectedPod().Name)
+
+	// A new pod sorts ahead of the selected one
+	updated, cmd := m.Update(autoRefreshedMsg{gen: m.refreshGen, result: podsLoadedMsg{pods: podInfos("api", "auth", "cache", "web")}})
+	m = updated.(EnhancedPodsModel)
+	assert.NotNil(t, cmd, "the next refresh is scheduled")
+	require.NotNil(t, m.GetSelectedPod())
+	assert.Equal(t, "web", m.GetSelectedPod().Name)
+	assert.Len(t, m.pods, 4)
+
+	// A failed reload keeps the list and the session
+	updated, cmd = m.Update(autoRefreshedMsg{gen: m.refreshGen, result: errMsg{errors.New("connection refused")}})
+	m = updated.(EnhancedPodsModel)
+	assert.NotNil(t, cmd)
+	assert.NoError(t, m.err)
+	assert.Contains(t, m.message, "connection refused")
+	assert.Equal(t, "web", m.GetSelectedPod().Name)
+}
+
+func TestEnhancedPodsScheduleRefreshStopsOnQuit(t *testing.T) {
+	m := NewEnhancedPodsModel("default", false).WithAutoRefresh(time.Hour, true)
+	cmd := m.scheduleRefresh()
+
+	done := make(chan tea.Msg)
+	go func() { done <- cmd() }()
+
+	m.quit()
+	select {
+	case msg := <-done:
+		assert.Nil(t, msg)
+	case <-time.After(time.Second):
+		t.Fatal("refresh timer kept running after quit")
+	}
+}
+
+func TestEnhancedPodsScheduleRefreshTicks(t *testing.T) {
+	m := NewEnhancedPodsModel("default", false).WithAutoRefresh(time.Millisecond, true)
+	t.Cleanup(m.cancel)
+
+	assert.Equal(t, autoRefreshTickMsg{gen: m.refreshGen}, m.scheduleRefresh()())
+}
@@ -0,0 +1,371 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/karthickk/k8s-manager/internal/ui/components"
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// PortForwardPromptModel asks for the pod port and local port to forward
+type PortForwardPromptModel struct {
+	pod         PodInfo
+	fields      []*components.InputField
+	focus       int
+	submitted   bool
+	suggestions []portSuggestion
+	selected    int
+	// localAuto is set while the local port holds a suggestion rather than
+	// something the user typed, so it follows the pod port
+	localAuto bool
+	note      string
+	available func(port int32) bool
+}
+
+// portSuggestion is a port declared by one of the pod's containers
+type portSuggestion struct {
+	Port      int32
+	Name      string
+	Protocol  corev1.Protocol
+	Container string
+}
+
+// NewPortForwardPromptModel creates the port prompt for a pod. The ports its
+// containers declare are offered to choose from, pre-filled when there is
+// only one, and the pod port must be one of them when any are declared. The
+// local port follows the pod port unless that port cannot be bound locally.
+func NewPortForwardPromptModel(pod PodInfo) *PortForwardPromptModel {
+	suggestions := portSuggestions(pod.Pod)
+	ports := containerPorts(pod.Pod)
+
+	remote := components.NewPortInput("Pod port")
+	remote.Validator = func(value string) error {
+		port, err := components.ParsePort(value)
+		if err != nil {
+			return err
+		}
+		return checkContainerPort(ports, port)
+	}
+	remote.Focus()
+
+	m := &PortForwardPromptModel{
+		pod:         pod,
+		fields:      []*components.InputField{remote, components.NewPortInput("Local port")},
+		suggestions: suggestions,
+		selected:    -1,
+		available:   localPortAvailable,
+	}
+	if len(suggestions) == 1 {
+		m.selectSuggestion(0)
+	}
+	return m
+}
+
+func (m *PortForwardPromptModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m *PortForwardPromptModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch keyMsg.String() {
+	case "ctrl+c", "esc":
+		return m, tea.Quit
+
+	case "up", "down":
+		if m.focus == 0 && len(m.suggestions) > 0 {
+			step := 1
+			if keyMsg.String() == "up" {
+				step = len(m.suggestions) - 1
+			}
+			m.selectSuggestion((m.selected + step) % len(m.suggestions))
+		}
+		return m, nil
+
+	case "tab":
+		m.moveFocus(1)
+		return m, nil
+
+	case "shift+tab":
+		m.moveFocus(-1)
+		return m, nil
+
+	case "enter":
+		if m.focus < len(m.fields)-1 {
+			m.moveFocus(1)
+			return m, nil
+		}
+		// An invalid field already shows why; move to it
+		for i, field := range m.fields {
+			if field.Validator(field.Value) != nil {
+				m.setFocus(i)
+				return m, nil
+			}
+		}
+		m.submitted = true
+		return m, tea.Quit
+	}
+
+	cmd := m.fields[m.focus].Update(keyMsg)
+	if m.focus == 0 {
+		m.selected = -1
+	} else {
+		m.localAuto = false
+		m.note = ""
+	}
+	return m, cmd
+}
+
+// selectSuggestion puts a suggested port in the pod port field
+func (m *PortForwardPromptModel) selectSuggestion(index int) {
+	if index < 0 {
+		index = 0
+	}
+	m.selected = index
+	m.fields[0].SetValue(fmt.Sprint(m.suggestions[index].Port))
+	m.suggestLocalPort()
+}
+
+// suggestLocalPort fills the local port with the pod port, or the nearest
+// port that can be bound locally, unless the user typed one
+func (m *PortForwardPromptModel) suggestLocalPort() {
+	if !m.localAuto && m.fields[1].Value != "" {
+		return
+	}
+	remote, err := components.ParsePort(m.fields[0].Value)
+	if err != nil {
+		return
+	}
+
+	local := freeLocalPort(remote, m.available)
+	m.fields[1].SetValue(fmt.Sprint(local))
+	m.localAuto = true
+	m.note = ""
+	if local != remote {
+		m.note = fmt.Sprintf("Local port %d is not available; suggesting %d", remote, local)
+	}
+}
+
+func (m *PortForwardPromptModel) moveFocus(delta int) {
+	if m.focus == 0 {
+		m.suggestLocalPort()
+	}
+	m.setFocus((m.focus + delta + len(m.fields)) % len(m.fields))
+}
+
+func (m *PortForwardPromptModel) setFocus(index int) {
+	m.fields[m.focus].Blur()
+	m.focus = index
+	m.fields[m.focus].Focus()
+}
+
+func (m *PortForwardPromptModel) View() string {
+	var s strings.Builder
+
+	s.WriteString(devToolsTitleStyle.Render("📦 Port Forward: " + m.pod.Name))
+	s.WriteString("\n\n")
+
+	if len(m.suggestions) > 0 {
+		s.WriteString(devToolsItemStyle.Render("Container ports:"))
+		s.WriteString("\n")
+		for i, suggestion := range m.suggestions {
+			line := fmt.Sprintf("%d/%s", suggestion.Port, suggestion.Protocol)
+			if suggestion.Name != "" {
+				line += " " + suggestion.Name
+			}
+			line += " (" + suggestion.Container + ")"
+			if i == m.selected {
+				s.WriteString(devToolsSelectedStyle.Render("▸ " + line))
+			} else {
+				s.WriteString("  " + devToolsDescriptionStyle.Render(line))
+			}
+			s.WriteString("\n")
+		}
+		s.WriteString("\n")
+	}
+
+	for _, field := range m.fields {
+		s.WriteString(field.View())
+		s.WriteString("\n\n")
+	}
+
+	if m.note != "" {
+		s.WriteString(devToolsWarningStyle.Render("⚠️  " + m.note))
+		s.WriteString("\n\n")
+	}
+
+	help := "tab next field • enter forward • esc cancel"
+	if len(m.suggestions) > 0 {
+		help = "↑/↓ choose port • " + help
+	}
+	s.WriteString(devToolsHelpStyle.Render(help))
+
+	return devToolsContainerStyle.Render(s.String())
+}
+
+// Ports returns the pod port and local port entered, and whether the user
+// submitted them
+func (m *PortForwardPromptModel) Ports() (remote, local int32, ok bool) {
+	if !m.submitted {
+		return 0, 0, false
+	}
+	remote, _ = components.ParsePort(m.fields[0].Value)
+	local, _ = components.ParsePort(m.fields[1].Value)
+	return remote, local, true
+}
+
+// runPortForward asks for the ports and forwards them until interrupted,
+// reconnecting when the pod restarts or is replaced. It needs the terminal
+// to itself.
+func runPortForward(pod PodInfo, client *k8s.Client, stdin io.Reader, stdout, stderr io.Writer) error {
+	prompt := NewPortForwardPromptModel(pod)
+	if _, err := tea.NewProgram(prompt, tea.WithAltScreen(), tea.WithInput(stdin), tea.WithOutput(stdout)).Run(); err != nil {
+		return err
+	}
+
+	remote, local, ok := prompt.Ports()
+	if !ok {
+		return fmt.Errorf("port forwarding cancelled")
+	}
+
+	fmt.Fprintf(stdout, "Port forwarding localhost:%d -> %s:%d\n", local, pod.Name, remote)
+	fmt.Fprintln(stdout, "Press Ctrl+C to stop port forwarding")
+
+	// Ctrl+C stops the forward and returns to the menu
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	opts := k8s.DefaultReconnectOptions()
+	opts.Notify = func(message string) {
+		fmt.Fprintf(stderr, "🔄 %s\n", message)
+	}
+	ports := []string{fmt.Sprintf("%d:%d", local, remote)}
+	return client.ForwardWithReconnect(ctx, pod.Namespace, pod.Name, opts, client.Forwarder(pod.Namespace, ports, stdout, stderr))
+}
+
+// portForwardExec runs runPortForward through tea.Exec, which hands it the
+// terminal while a program is running
+type portForwardExec struct {
+	pod    PodInfo
+	client *k8s.Client
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+}
+
+func newPortForwardExec(pod PodInfo, client *k8s.Client) *portForwardExec {
+	return &portForwardExec{pod: pod, client: client, stdin: os.Stdin, stdout: os.Stdout, stderr: os.Stderr}
+}
+
+func (e *portForwardExec) Run() error {
+	return runPortForward(e.pod, e.client, e.stdin, e.stdout, e.stderr)
+}
+
+func (e *portForwardExec) SetStdin(r io.Reader)  { e.stdin = r }
+func (e *portForwardExec) SetStdout(w io.Writer) { e.stdout = w }
+func (e *portForwardExec) SetStderr(w io.Writer) { e.stderr = w }
+
+// Helper functions
+
+// containerPorts returns the distinct ports the pod's containers declare, in
+// the order they are declared
+func containerPorts(pod *corev1.Pod) []int32 {
+	var ports []int32
+	for _, suggestion := range portSuggestions(pod) {
+		ports = append(ports, suggestion.Port)
+	}
+	return ports
+}
+
+// portSuggestions lists the ports declared by the pod's containers, once each
+func portSuggestions(pod *corev1.Pod) []portSuggestion {
+	if pod == nil {
+		return nil
+	}
+
+	seen := map[int32]bool{}
+	var suggestions []portSuggestion
+	for _, container := range pod.Spec.Containers {
+		for _, port := range container.Ports {
+			if seen[port.ContainerPort] {
+				continue
+			}
+			seen[port.ContainerPort] = true
+
+			protocol := port.Protocol
+			if protocol == "" {
+				protocol = corev1.ProtocolTCP
+			}
+			suggestions = append(suggestions, portSuggestion{
+				Port:      port.ContainerPort,
+				Name:      port.Name,
+				Protocol:  protocol,
+				Container: container.Name,
+			})
+		}
+	}
+	return suggestions
+}
+
+// freeLocalPort returns port if it can be bound locally, or else the next
+// port that can. Privileged ports are moved up by 8000 first, so 80 becomes
+// 8080 and 443 becomes 8443.
+func freeLocalPort(port int32, available func(int32) bool) int32 {
+	if available(port) {
+		return port
+	}
+
+	start := port + 1
+	if port < 1024 {
+		start = port + 8000
+	}
+	for candidate := start; candidate <= 65535 && candidate < start+100; candidate++ {
+		if available(candidate) {
+			return candidate
+		}
+	}
+	return port
+}
+
+// localPortAvailable reports whether a port can be listened on locally
+func localPortAvailable(port int32) bool {
+	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+	if err != nil {
+		return false
+	}
+	listener.Close()
+	return true
+}
+
+// checkContainerPort accepts a port the pod declares. A pod that declares
+// no ports may still listen on any, so every port is accepted then.
+func checkContainerPort(ports []int32, port int32) error {
+	if len(ports) == 0 {
+		return nil
+	}
+	for _, declared := range ports {
+		if declared == port {
+			return nil
+		}
+	}
+	return fmt.Errorf("port %d is not a container port (%s)", port, joinPorts(ports))
+}
+
+func joinPorts(ports []int32) string {
+	names := make([]string, len(ports))
+	for i, port := range ports {
+		names[i] = fmt.Sprint(port)
+	}
+	return strings.Join(names, ", ")
+}
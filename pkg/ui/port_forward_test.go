@@ -0,0 +1,154 @@
+package ui
+
+import (
+	"net"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func podWithPorts(ports ...int32) PodInfo {
+	container := corev1.Container{Name: "app"}
+	for _, port := range ports {
+		container.Ports = append(container.Ports, corev1.ContainerPort{ContainerPort: port})
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-0", Namespace: "default"},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{container}},
+	}
+	return PodInfo{Name: pod.Name, Namespace: pod.Namespace, Pod: pod}
+}
+
+func typeKeys(m *PortForwardPromptModel, keys ...tea.KeyMsg) {
+	for _, k := range keys {
+		m.Update(k)
+	}
+}
+
+func runes(s string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+func TestPortForwardPromptPrefillsSingleContainerPort(t *testing.T) {
+	m := NewPortForwardPromptModel(podWithPorts(8080))
+	assert.Contains(t, m.View(), "8080/TCP (app)")
+
+	typeKeys(m, tea.KeyMsg{Type: tea.KeyEnter}, tea.KeyMsg{Type: tea.KeyEnter})
+
+	remote, _, ok := m.Ports()
+	assert.True(t, ok)
+	assert.Equal(t, int32(8080), remote)
+}
+
+func TestPortForwardPromptChoosesAmongContainerPorts(t *testing.T) {
+	m := NewPortForwardPromptModel(podWithPorts(8080, 9090))
+	m.available = func(int32) bool { return true }
+	assert.Empty(t, m.fields[0].Value, "nothing is pre-filled with several ports")
+
+	typeKeys(m, tea.KeyMsg{Type: tea.KeyDown}, tea.KeyMsg{Type: tea.KeyDown})
+	assert.Contains(t, m.View(), "▸ 9090/TCP (app)")
+
+	typeKeys(m, tea.KeyMsg{Type: tea.KeyEnter}, tea.KeyMsg{Type: tea.KeyEnter})
+
+	remote, local, ok := m.Ports()
+	assert.True(t, ok)
+	assert.Equal(t, int32(9090), remote)
+	assert.Equal(t, int32(9090), local)
+}
+
+func TestPortForwardPromptSuggestsFreeLocalPort(t *testing.T) {
+	m := NewPortForwardPromptModel(podWithPorts(80, 443))
+	m.available = func(port int32) bool { return port != 80 && port != 8080 }
+
+	typeKeys(m, tea.KeyMsg{Type: tea.KeyDown})
+	assert.Equal(t, "8081", m.fields[1].Value)
+	assert.Contains(t, m.View(), "Local port 80 is not available; suggesting 8081")
+
+	// The local port follows the pod port until the user edits it
+	typeKeys(m, tea.KeyMsg{Type: tea.KeyDown})
+	assert.Equal(t, "443", m.fields[1].Value)
+	assert.NotContains(t, m.View(), "is not available")
+
+	typeKeys(m, tea.KeyMsg{Type: tea.KeyTab}, tea.KeyMsg{Type: tea.KeyBackspace}, runes("4"))
+	typeKeys(m, tea.KeyMsg{Type: tea.KeyShiftTab}, tea.KeyMsg{Type: tea.KeyDown})
+	assert.Equal(t, "444", m.fields[1].Value)
+}
+
+func TestFreeLocalPort(t *testing.T) {
+	testCases := []struct {
+		name  string
+		port  int32
+		taken map[int32]bool
+		want  int32
+	}{
+		{name: "free", port: 8080, want: 8080},
+		{name: "taken", port: 8080, taken: map[int32]bool{8080: true, 8081: true}, want: 8082},
+		{name: "privileged", port: 443, taken: map[int32]bool{443: true}, want: 8443},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := freeLocalPort(tc.port, func(port int32) bool { return !tc.taken[port] })
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestLocalPortAvailable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	port := int32(listener.Addr().(*net.TCPAddr).Port)
+	assert.False(t, localPortAvailable(port))
+}
+
+func TestPortForwardPromptValidatesPorts(t *testing.T) {
+	testCases := []struct {
+		name       string
+		pod        PodInfo
+		remote     string
+		local      string
+		wantOK     bool
+		wantRemote int32
+		wantLocal  int32
+	}{
+		{name: "declared port", pod: podWithPorts(80, 443), remote: "443", local: "8443", wantOK: true, wantRemote: 443, wantLocal: 8443},
+		{name: "undeclared port", pod: podWithPorts(80), remote: "81", local: "8081"},
+		{name: "any port without declared ports", pod: podWithPorts(), remote: "5432", local: "15432", wantOK: true, wantRemote: 5432, wantLocal: 15432},
+		{name: "out of range", pod: podWithPorts(), remote: "70000", local: "8080"},
+		{name: "letters are ignored", pod: podWithPorts(), remote: "8a0", local: "x9", wantOK: true, wantRemote: 80, wantLocal: 9},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := NewPortForwardPromptModel(tc.pod)
+			m.fields[0].SetValue("")
+
+			typeKeys(m, runes(tc.remote), tea.KeyMsg{Type: tea.KeyTab})
+			m.fields[1].SetValue("")
+			typeKeys(m, runes(tc.local), tea.KeyMsg{Type: tea.KeyEnter})
+
+			remote, local, ok := m.Ports()
+			assert.Equal(t, tc.wantOK, ok)
+			assert.Equal(t, tc.wantRemote, remote)
+			assert.Equal(t, tc.wantLocal, local)
+			if !ok {
+				assert.Equal(t, 0, m.focus, "the invalid pod port is focused")
+			}
+		})
+	}
+}
+
+func TestPortForwardPromptCancel(t *testing.T) {
+	m := NewPortForwardPromptModel(podWithPorts(80))
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.NotNil(t, cmd)
+
+	_, _, ok := m.Ports()
+	assert.False(t, ok)
+}
@@ -0,0 +1,293 @@
+package ui
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/pterm/pterm"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/yaml"
+)
+
+// errEditCancelled is returned when the user leaves the edited file empty
+var errEditCancelled = errors.New("edit cancelled")
+
+// editorHeader explains the edit session at the top of the temporary file
+const editorHeader = `# Please edit the object below. Lines beginning with a '#' will be ignored,
+# and an empty file will cancel the edit. If an error occurs while saving,
+# this file will be reopened with the relevant failures.
+#
+`
+
+// EditResource opens an object in the user's editor as YAML and updates it
+// in the cluster when the file is saved. Invalid YAML and rejected updates
+// reopen the editor with the error shown at the top of the file.
+func EditResource(client *k8s.Client, gvr schema.GroupVersionResource, namespace, name string) error {
+	return editResource(client, gvr, namespace, name, os.Stdin, os.Stdout, os.Stderr)
+}
+
+// editResource is EditResource on the given terminal streams, such as the
+// ones tea.Exec hands over while a program is running
+func editResource(client *k8s.Client, gvr schema.GroupVersionResource, namespace, name string, stdin io.Reader, stdout, stderr io.Writer) error {
+	reader := bufio.NewReader(stdin)
+	dynamicClient, err := dynamic.NewForConfig(client.Config)
+	if err != nil {
+		return fmt.Errorf("failed to create dynamic client: %w", err)
+	}
+
+	var resource dynamic.ResourceInterface = dynamicClient.Resource(gvr)
+	if namespace != "" {
+		resource = dynamicClient.Resource(gvr).Namespace(namespace)
+	}
+
+	original, err := getEditableObject(resource, name)
+	if err != nil {
+		return err
+	}
+
+	content, err := yaml.Marshal(original.Object)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s %s: %w", gvr.Resource, name, err)
+	}
+
+	problem := ""
+	for {
+		edited, err := editInEditor(editorHeader+problemComment(problem), content, name, reader, stdout, stderr)
+		if err != nil {
+			return err
+		}
+
+		obj, err := parseEditedObject(edited)
+		if errors.Is(err, errEditCancelled) {
+			fmt.Fprintln(stdout, pterm.Info.Sprint("Edit cancelled, no changes made"))
+			return nil
+		}
+		if err == nil {
+			err = checkIdentityUnchanged(original, obj)
+		}
+		if err != nil {
+			problem = err.Error()
+			content = stripHeader(edited)
+			if !confirmReopen(reader, stdout, problem) {
+				return err
+			}
+			continue
+		}
+
+		if equality.Semantic.DeepEqual(original.Object, obj.Object) {
+			fmt.Fprintln(stdout, pterm.Info.Sprint("Edit cancelled, no changes made"))
+			return nil
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		_, err = resource.Update(ctx, obj, metav1.UpdateOptions{FieldManager: k8s.FieldManager()})
+		cancel()
+		if err == nil {
+			fmt.Fprintln(stdout, pterm.Success.Sprintf("%s '%s' updated", gvr.Resource, name))
+			return nil
+		}
+
+		if apierrors.IsConflict(err) {
+			// Someone else changed the object; start again from the latest
+			// version so the update cannot silently revert their changes
+			latest, getErr := getEditableObject(resource, name)
+			if getErr != nil {
+				return getErr
+			}
+			saved := saveRejectedEdit(edited, name)
+			problem = fmt.Sprintf("the object was modified on the server while you were editing it; "+
+				"the latest version is shown below, re-apply your changes (your edit was saved to %s)", saved)
+			original = latest
+			if content, err = yaml.Marshal(latest.Object); err != nil {
+				return fmt.Errorf("failed to encode %s %s: %w", gvr.Resource, name, err)
+			}
+		} else {
+			problem = err.Error()
+			content = stripHeader(edited)
+		}
+
+		if !confirmReopen(reader, stdout, problem) {
+			return err
+		}
+	}
+}
+
+// Helper functions
+
+func getEditableObject(resource dynamic.ResourceInterface, name string) (*unstructured.Unstructured, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	obj, err := resource.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	// Managed fields are noise when editing by hand
+	obj.SetManagedFields(nil)
+	return obj, nil
+}
+
+// editorCommand returns the editor to run, honouring KUBE_EDITOR and EDITOR
+// which may include arguments such as "code --wait"
+func editorCommand() []string {
+	for _, env := range []string{"KUBE_EDITOR", "EDITOR"} {
+		if fields := strings.Fields(os.Getenv(env)); len(fields) > 0 {
+			return fields
+		}
+	}
+	return []string{"vi"}
+}
+
+// editInEditor writes header and content to a temporary file, opens it in
+// the editor on the given terminal and returns what was saved
+func editInEditor(header string, content []byte, name string, stdin io.Reader, stdout, stderr io.Writer) ([]byte, error) {
+	file, err := os.CreateTemp("", fmt.Sprintf("k8s-manager-edit-%s-*.yaml", name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.WriteString(header + string(content)); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to write temporary file: %w", err)
+	}
+	file.Close()
+
+	editor := editorCommand()
+	cmd := exec.Command(editor[0], append(editor[1:], file.Name())...)
+	cmd.Stdin = stdin
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("editor %s failed: %w", editor[0], err)
+	}
+
+	edited, err := os.ReadFile(file.Name())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read edited file: %w", err)
+	}
+	return edited, nil
+}
+
+// parseEditedObject parses the saved file. A file left empty apart from the
+// header cancels the edit.
+func parseEditedObject(data []byte) (*unstructured.Unstructured, error) {
+	if strings.TrimSpace(string(stripHeader(data))) == "" {
+		return nil, errEditCancelled
+	}
+
+	obj := &unstructured.Unstructured{}
+	if err := yaml.Unmarshal(data, &obj.Object); err != nil {
+		return nil, fmt.Errorf("invalid YAML: %w", err)
+	}
+	if len(obj.Object) == 0 {
+		return nil, errEditCancelled
+	}
+
+	return obj, nil
+}
+
+// checkIdentityUnchanged rejects edits that would turn the update into a
+// request for a different object
+func checkIdentityUnchanged(original, edited *unstructured.Unstructured) error {
+	switch {
+	case edited.GetAPIVersion() != original.GetAPIVersion() || edited.GetKind() != original.GetKind():
+		return fmt.Errorf("apiVersion and kind cannot be changed (expected %s %s)", original.GetAPIVersion(), original.GetKind())
+	case edited.GetName() != original.GetName():
+		return fmt.Errorf("metadata.name cannot be changed (expected %s)", original.GetName())
+	case edited.GetNamespace() != original.GetNamespace():
+		return fmt.Errorf("metadata.namespace cannot be changed (expected %s)", original.GetNamespace())
+	}
+	return nil
+}
+
+// stripHeader drops the comment lines at the top of the file, which hold the
+// instructions and any previous error. Comments further down may be part of
+// a value, such as a script in a ConfigMap, and are kept.
+func stripHeader(data []byte) []byte {
+	lines := strings.Split(string(data), "\n")
+	for len(lines) > 0 && strings.HasPrefix(strings.TrimSpace(lines[0]), "#") {
+		lines = lines[1:]
+	}
+	return []byte(strings.Join(lines, "\n"))
+}
+
+// problemComment renders an error as comment lines for the editor header
+func problemComment(problem string) string {
+	if problem == "" {
+		return ""
+	}
+
+	var s strings.Builder
+	for _, line := range strings.Split(problem, "\n") {
+		s.WriteString("# error: " + line + "\n")
+	}
+	s.WriteString("#\n")
+	return s.String()
+}
+
+// saveRejectedEdit keeps an edit the server refused so the user's work is
+// not lost, returning where it was written
+func saveRejectedEdit(data []byte, name string) string {
+	file, err := os.CreateTemp("", fmt.Sprintf("k8s-manager-edit-%s-rejected-*.yaml", name))
+	if err != nil {
+		return "<unsaved>"
+	}
+	defer file.Close()
+
+	if _, err := file.Write(stripHeader(data)); err != nil {
+		return "<unsaved>"
+	}
+	return file.Name()
+}
+
+// confirmReopen shows why the edit failed and asks whether to fix it in the
+// editor; anything but n or no reopens it
+func confirmReopen(reader *bufio.Reader, out io.Writer, problem string) bool {
+	fmt.Fprintln(out, pterm.Error.Sprint(problem))
+	fmt.Fprint(out, "Reopen the editor to fix it? (Y/n): ")
+	answer, err := readAnswer(reader)
+	if err != nil {
+		return false
+	}
+	answer = strings.ToLower(answer)
+	return answer != "n" && answer != "no"
+}
+
+// resourceEditExec runs an edit session through tea.Exec, which hands it the
+// terminal for the editor and the prompts
+type resourceEditExec struct {
+	client    *k8s.Client
+	gvr       schema.GroupVersionResource
+	namespace string
+	name      string
+	stdin     io.Reader
+	stdout    io.Writer
+	stderr    io.Writer
+}
+
+func newResourceEditExec(client *k8s.Client, gvr schema.GroupVersionResource, namespace, name string) *resourceEditExec {
+	return &resourceEditExec{client: client, gvr: gvr, namespace: namespace, name: name, stdin: os.Stdin, stdout: os.Stdout, stderr: os.Stderr}
+}
+
+func (e *resourceEditExec) Run() error {
+	return editResource(e.client, e.gvr, e.namespace, e.name, e.stdin, e.stdout, e.stderr)
+}
+
+func (e *resourceEditExec) SetStdin(r io.Reader)  { e.stdin = r }
+func (e *resourceEditExec) SetStdout(w io.Writer) { e.stdout = w }
+func (e *resourceEditExec) SetStderr(w io.Writer) { e.stderr = w }
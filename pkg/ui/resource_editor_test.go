@@ -0,0 +1,133 @@
+package ui
+
+import (
+	"bufio"
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/rest"
+)
+
+func TestParseEditedObject(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     string
+		cancelled bool
+		wantErr   bool
+	}{
+		{
+			name:  "valid object after header",
+			input: editorHeader + "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: settings\n",
+		},
+		{
+			name:      "header only",
+			input:     editorHeader,
+			cancelled: true,
+		},
+		{
+			name:      "empty file",
+			input:     "\n\n",
+			cancelled: true,
+		},
+		{
+			name:    "invalid yaml",
+			input:   editorHeader + "apiVersion: v1\nkind: [ConfigMap\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			obj, err := parseEditedObject([]byte(tc.input))
+			switch {
+			case tc.cancelled:
+				assert.ErrorIs(t, err, errEditCancelled)
+			case tc.wantErr:
+				assert.Error(t, err)
+				assert.NotErrorIs(t, err, errEditCancelled)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, "settings", obj.GetName())
+			}
+		})
+	}
+}
+
+func TestStripHeaderKeepsCommentsInValues(t *testing.T) {
+	input := editorHeader + problemComment("invalid YAML") + `data:
+  run.sh: |
+    # keep me
+    echo hi
+`
+	assert.Equal(t, "data:\n  run.sh: |\n    # keep me\n    echo hi\n", string(stripHeader([]byte(input))))
+}
+
+func TestCheckIdentityUnchanged(t *testing.T) {
+	newObject := func(kind, name, namespace string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion("v1")
+		obj.SetKind(kind)
+		obj.SetName(name)
+		obj.SetNamespace(namespace)
+		return obj
+	}
+	original := newObject("Pod", "web", "default")
+
+	assert.NoError(t, checkIdentityUnchanged(original, newObject("Pod", "web", "default")))
+	assert.Error(t, checkIdentityUnchanged(original, newObject("Service", "web", "default")))
+	assert.Error(t, checkIdentityUnchanged(original, newObject("Pod", "api", "default")))
+	assert.Error(t, checkIdentityUnchanged(original, newObject("Pod", "web", "prod")))
+}
+
+func TestEditorCommand(t *testing.T) {
+	t.Setenv("KUBE_EDITOR", "")
+	t.Setenv("EDITOR", "")
+	assert.Equal(t, []string{"vi"}, editorCommand())
+
+	t.Setenv("EDITOR", "code --wait")
+	assert.Equal(t, []string{"code", "--wait"}, editorCommand())
+
+	t.Setenv("KUBE_EDITOR", "nano")
+	assert.Equal(t, []string{"nano"}, editorCommand())
+}
+
+func TestResourceEditExecUsesItsOwnTerminal(t *testing.T) {
+	var updates int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPut {
+			updates++
+		}
+		w.Write([]byte(`{"apiVersion":"v1","kind":"Pod","metadata":{"name":"web-0","namespace":"shop"}}`))
+	}))
+	t.Cleanup(server.Close)
+	config := &rest.Config{Host: server.URL}
+	client := &k8s.Client{Config: config}
+
+	// An editor that saves the file unchanged leaves nothing to update
+	t.Setenv("KUBE_EDITOR", "true")
+	edit := newResourceEditExec(client, k8s.PodsResource, "shop", "web-0")
+	out := new(bytes.Buffer)
+	edit.SetStdin(strings.NewReader(""))
+	edit.SetStdout(out)
+	edit.SetStderr(out)
+	require.NoError(t, edit.Run())
+	assert.Contains(t, out.String(), "Edit cancelled, no changes made")
+	assert.Zero(t, updates)
+}
+
+func TestConfirmReopen(t *testing.T) {
+	for answer, want := range map[string]bool{"\n": true, "y\n": true, "n\n": false, "No\n": false} {
+		out := new(bytes.Buffer)
+		assert.Equal(t, want, confirmReopen(bufio.NewReader(strings.NewReader(answer)), out, "invalid YAML"), "answer %q", answer)
+		assert.Contains(t, out.String(), "invalid YAML")
+		assert.Contains(t, out.String(), "Reopen the editor to fix it?")
+	}
+}
@@ -0,0 +1,94 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/pterm/pterm"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// printResourceUsage prints a table comparing what each container of a pod
+// uses with its requests and limits
+func printResourceUsage(pod PodInfo, client *k8s.Client) error {
+	var usage []k8s.ContainerUsage
+	err := RunWithSpinner(context.Background(), "Fetching resource usage...", func(ctx context.Context) error {
+		p, err := client.Clientset.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		usage, err = client.GetPodUsage(ctx, p)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	pterm.DefaultTable.WithHasHeader().WithData(resourceUsageTable(usage)).Render()
+	return nil
+}
+
+// resourceUsageTable lays out the usage of each container as table rows,
+// after a header row
+func resourceUsageTable(usage []k8s.ContainerUsage) [][]string {
+	rows := [][]string{{"Container", "CPU", "Memory", "Notes"}}
+	for _, container := range usage {
+		rows = append(rows, []string{
+			container.Name,
+			formatResourceUsage(container.CPU, formatCPU),
+			formatResourceUsage(container.Memory, formatMemory),
+			usageNotes(container),
+		})
+	}
+	return rows
+}
+
+// formatResourceUsage formats usage against the request and limit, e.g.
+// "120m / 250m request (48%) / 500m limit (24%)"
+func formatResourceUsage(u k8s.ResourceUsage, format func(resource.Quantity) string) string {
+	parts := []string{format(u.Usage)}
+	if percent, ok := u.PercentOfRequest(); ok {
+		parts = append(parts, fmt.Sprintf("%s request (%d%%)", format(*u.Request), percent))
+	} else {
+		parts = append(parts, "no request")
+	}
+	if percent, ok := u.PercentOfLimit(); ok {
+		parts = append(parts, fmt.Sprintf("%s limit (%d%%)", format(*u.Limit), percent))
+	}
+	return strings.Join(parts, " / ")
+}
+
+// usageNotes flags containers without requests and those using more than
+// they request
+func usageNotes(container k8s.ContainerUsage) string {
+	var notes []string
+	if container.NoRequests() {
+		notes = append(notes, "no requests set")
+	}
+	if container.CPU.OverRequest() {
+		notes = append(notes, "CPU above request")
+	}
+	if container.Memory.OverRequest() {
+		notes = append(notes, "memory above request")
+	}
+	if len(notes) == 0 {
+		return "✅"
+	}
+	return "⚠️  " + strings.Join(notes, ", ")
+}
+
+func formatCPU(q resource.Quantity) string {
+	return fmt.Sprintf("%dm", q.MilliValue())
+}
+
+// formatMemory shows memory in Mi, or in Ki below 1Mi so small values
+// don't read as 0Mi
+func formatMemory(q resource.Quantity) string {
+	if q.Value() < 1024*1024 {
+		return fmt.Sprintf("%dKi", (q.Value()+1023)/1024)
+	}
+	return fmt.Sprintf("%dMi", q.Value()/(1024*1024))
+}
@@ -0,0 +1,49 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/karthickk/k8s-manager/pkg/k8s"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestResourceUsageTable(t *testing.T) {
+	pod := &corev1.Pod{Spec: corev1.PodSpec{Containers: []corev1.Container{
+		{
+			Name: "app",
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("250m"), corev1.ResourceMemory: resource.MustParse("256Mi")},
+				Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m"), corev1.ResourceMemory: resource.MustParse("512Mi")},
+			},
+		},
+		{
+			Name: "worker",
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+			},
+		},
+		{Name: "sidecar"},
+	}}}
+	usage := k8s.PodUsage(pod, map[string]corev1.ResourceList{
+		"app":     {corev1.ResourceCPU: resource.MustParse("120m"), corev1.ResourceMemory: resource.MustParse("128Mi")},
+		"worker":  {corev1.ResourceCPU: resource.MustParse("150m"), corev1.ResourceMemory: resource.MustParse("64Mi")},
+		"sidecar": {corev1.ResourceCPU: resource.MustParse("5m"), corev1.ResourceMemory: resource.MustParse("20Mi")},
+	})
+
+	assert.Equal(t, [][]string{
+		{"Container", "CPU", "Memory", "Notes"},
+		{"app", "120m / 250m request (48%) / 500m limit (24%)", "128Mi / 256Mi request (50%) / 512Mi limit (25%)", "✅"},
+		{"worker", "150m / 100m request (150%)", "64Mi / no request", "⚠️  CPU above request"},
+		{"sidecar", "5m / no request", "20Mi / no request", "⚠️  no requests set"},
+	}, resourceUsageTable(usage))
+}
+
+func TestFormatMemory(t *testing.T) {
+	assert.Equal(t, "0Ki", formatMemory(resource.MustParse("0")))
+	assert.Equal(t, "1Ki", formatMemory(resource.MustParse("100")))
+	assert.Equal(t, "512Ki", formatMemory(resource.MustParse("512Ki")))
+	assert.Equal(t, "1Mi", formatMemory(resource.MustParse("1Mi")))
+	assert.Equal(t, "256Mi", formatMemory(resource.MustParse("256Mi")))
+}
@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/pterm/pterm"
+)
+
+// spinnerTimeout bounds how long an action run by RunWithSpinner may take
+const spinnerTimeout = 30 * time.Second
+
+// RunWithSpinner runs fn while a spinner titled title is shown. The context
+// passed to fn is cancelled when the timeout expires, when ctx is done or when
+// the user presses Ctrl+C. RunWithSpinner returns as soon as that happens,
+// even if fn does not watch its context, and the spinner is always stopped.
+func RunWithSpinner(ctx context.Context, title string, fn func(ctx context.Context) error) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+
+	ctx, cancel := context.WithTimeout(ctx, spinnerTimeout)
+	defer cancel()
+
+	spinner, _ := pterm.DefaultSpinner.WithRemoveWhenDone(true).Start(title)
+	defer spinner.Stop()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- fn(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		action := strings.TrimSuffix(title, "...")
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("%s timed out: %w", action, ctx.Err())
+		}
+		return fmt.Errorf("%s cancelled: %w", action, ctx.Err())
+	}
+}
+
+// waitForEnter pauses until the user has read the output of an action
+func waitForEnter() {
+	fmt.Println("\nPress Enter to continue...")
+	fmt.Scanln()
+}
@@ -0,0 +1,54 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunWithSpinner(t *testing.T) {
+	t.Run("returns the action result", func(t *testing.T) {
+		errBoom := errors.New("boom")
+		err := RunWithSpinner(context.Background(), "Working...", func(ctx context.Context) error {
+			return errBoom
+		})
+		assert.ErrorIs(t, err, errBoom)
+	})
+
+	t.Run("stops waiting when the context expires", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		release := make(chan struct{})
+		defer close(release)
+
+		start := time.Now()
+		err := RunWithSpinner(ctx, "Loading pod details...", func(ctx context.Context) error {
+			// Ignores its context to simulate a hung action
+			<-release
+			return nil
+		})
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Contains(t, err.Error(), "Loading pod details timed out")
+		assert.Less(t, time.Since(start), 5*time.Second)
+	})
+
+	t.Run("cancels the action context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancelled := make(chan struct{})
+
+		err := RunWithSpinner(ctx, "Fetching...", func(ctx context.Context) error {
+			cancel()
+			<-ctx.Done()
+			close(cancelled)
+			return ctx.Err()
+		})
+
+		assert.ErrorIs(t, err, context.Canceled)
+		<-cancelled
+	})
+}
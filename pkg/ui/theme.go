@@ -0,0 +1,161 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// Theme names the colors the interface is drawn with by the role they play,
+// so the whole UI can be recolored in one place
+type Theme struct {
+	Name string
+	// Title colors titles, headers, spinners and informational messages
+	Title lipgloss.TerminalColor
+	// Selected colors the item under the cursor
+	Selected lipgloss.TerminalColor
+	Success  lipgloss.TerminalColor
+	// Warning also colors banners that need attention without being errors
+	Warning lipgloss.TerminalColor
+	// Error also colors the production context badge
+	Error lipgloss.TerminalColor
+	// Muted colors help text, descriptions, dividers and other secondary text
+	Muted lipgloss.TerminalColor
+	// Text colors ordinary list items
+	Text   lipgloss.TerminalColor
+	Border lipgloss.TerminalColor
+	// Background is drawn behind titles and the selected item
+	Background lipgloss.TerminalColor
+	// Inverse colors text drawn on a colored background, such as badges
+	Inverse lipgloss.TerminalColor
+}
+
+// Built-in themes
+var (
+	DefaultTheme = Theme{
+		Name:       "default",
+		Title:      lipgloss.Color("39"),
+		Selected:   lipgloss.Color("170"),
+		Success:    lipgloss.Color("42"),
+		Warning:    lipgloss.Color("214"),
+		Error:      lipgloss.Color("196"),
+		Muted:      lipgloss.Color("241"),
+		Text:       lipgloss.Color("252"),
+		Border:     lipgloss.Color("62"),
+		Background: lipgloss.Color("235"),
+		Inverse:    lipgloss.Color("231"),
+	}
+
+	// HighContrastTheme uses the bright base colors, which every terminal
+	// palette keeps readable on a dark background
+	HighContrastTheme = Theme{
+		Name:       "high-contrast",
+		Title:      lipgloss.Color("15"),
+		Selected:   lipgloss.Color("11"),
+		Success:    lipgloss.Color("10"),
+		Warning:    lipgloss.Color("11"),
+		Error:      lipgloss.Color("9"),
+		Muted:      lipgloss.Color("7"),
+		Text:       lipgloss.Color("15"),
+		Border:     lipgloss.Color("15"),
+		Background: lipgloss.Color("0"),
+		Inverse:    lipgloss.Color("0"),
+	}
+
+	// MonochromeTheme draws no colors at all; emphasis is left to bold text
+	MonochromeTheme = Theme{
+		Name:       "monochrome",
+		Title:      lipgloss.NoColor{},
+		Selected:   lipgloss.NoColor{},
+		Success:    lipgloss.NoColor{},
+		Warning:    lipgloss.NoColor{},
+		Error:      lipgloss.NoColor{},
+		Muted:      lipgloss.NoColor{},
+		Text:       lipgloss.NoColor{},
+		Border:     lipgloss.NoColor{},
+		Background: lipgloss.NoColor{},
+		Inverse:    lipgloss.NoColor{},
+	}
+)
+
+var themes = map[string]Theme{
+	DefaultTheme.Name:      DefaultTheme,
+	HighContrastTheme.Name: HighContrastTheme,
+	MonochromeTheme.Name:   MonochromeTheme,
+}
+
+// currentTheme is the theme the styles were last built from
+var currentTheme Theme
+
+// colorProfile is the terminal color profile in use before the monochrome
+// theme turned color off, restored when another theme is chosen
+var colorProfile *termenv.Profile
+
+func init() {
+	SetTheme(DefaultTheme)
+}
+
+// ThemeNames returns the names of the built-in themes, sorted
+func ThemeNames() []string {
+	names := make([]string, 0, len(themes))
+	for name := range themes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ThemeByName returns the built-in theme with the given name; an empty name
+// is the default theme
+func ThemeByName(name string) (Theme, error) {
+	if name == "" {
+		return DefaultTheme, nil
+	}
+	theme, ok := themes[strings.ToLower(name)]
+	if !ok {
+		return Theme{}, fmt.Errorf("unknown theme %q (available: %s)", name, strings.Join(ThemeNames(), ", "))
+	}
+	return theme, nil
+}
+
+// CurrentTheme returns the theme the interface is drawn with
+func CurrentTheme() Theme {
+	return currentTheme
+}
+
+// SetTheme rebuilds every style of the interface from the theme. The
+// monochrome theme also stops lipgloss from emitting any color, so styles
+// built elsewhere are plain too.
+func SetTheme(theme Theme) {
+	currentTheme = theme
+
+	if theme.Name == MonochromeTheme.Name {
+		if colorProfile == nil {
+			profile := lipgloss.ColorProfile()
+			colorProfile = &profile
+		}
+		lipgloss.SetColorProfile(termenv.Ascii)
+	} else if colorProfile != nil {
+		lipgloss.SetColorProfile(*colorProfile)
+		colorProfile = nil
+	}
+
+	buildCommonStyles(theme)
+	buildDevToolsStyles(theme)
+	buildDevToolsStatusStyles(theme)
+	buildContextHeaderStyles(theme)
+	buildMenuStyles(theme)
+	buildEnhancedMenuStyles(theme)
+	buildPodStyles(theme)
+	buildPodActionStyles(theme)
+}
+
+// NoColorRequested reports whether the NO_COLOR environment variable asks
+// for output without color (see https://no-color.org)
+func NoColorRequested() bool {
+	return os.Getenv("NO_COLOR") != ""
+}
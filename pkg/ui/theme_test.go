@@ -0,0 +1,60 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThemeByName(t *testing.T) {
+	theme, err := ThemeByName("")
+	require.NoError(t, err)
+	assert.Equal(t, "default", theme.Name)
+
+	theme, err = ThemeByName("High-Contrast")
+	require.NoError(t, err)
+	assert.Equal(t, "high-contrast", theme.Name)
+
+	_, err = ThemeByName("solarized")
+	assert.ErrorContains(t, err, "available: default, high-contrast, monochrome")
+}
+
+func TestSetThemeRebuildsStyles(t *testing.T) {
+	defer SetTheme(DefaultTheme)
+
+	SetTheme(HighContrastTheme)
+	assert.Equal(t, "high-contrast", CurrentTheme().Name)
+	assert.Equal(t, HighContrastTheme.Error, devToolsErrorStyle.GetForeground())
+	assert.Equal(t, HighContrastTheme.Selected, devToolsSelectedStyle.GetForeground())
+
+	SetTheme(DefaultTheme)
+	assert.Equal(t, DefaultTheme.Error, devToolsErrorStyle.GetForeground())
+}
+
+func TestThemeRolesShareColors(t *testing.T) {
+	defer SetTheme(DefaultTheme)
+
+	// Styles that play the same role are drawn alike, whatever the theme
+	SetTheme(HighContrastTheme)
+	assert.Equal(t, HighContrastTheme.Title, TitleStyle.GetForeground())
+	assert.Equal(t, HighContrastTheme.Title, devToolsTitleStyle.GetForeground())
+	assert.Equal(t, HighContrastTheme.Selected, SelectedItemStyle.GetForeground())
+	assert.Equal(t, HighContrastTheme.Muted, HelpStyle.GetForeground())
+	assert.Equal(t, HighContrastTheme.Error, contextHeaderProdStyle.GetBackground())
+}
+
+func TestMonochromeThemeDisablesColor(t *testing.T) {
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	defer SetTheme(DefaultTheme)
+
+	SetTheme(MonochromeTheme)
+	assert.Equal(t, termenv.Ascii, lipgloss.ColorProfile())
+	assert.Equal(t, "boom", lipgloss.NewStyle().Foreground(lipgloss.Color("196")).Render("boom"))
+
+	// Another theme brings the color profile back
+	SetTheme(DefaultTheme)
+	assert.Equal(t, termenv.TrueColor, lipgloss.ColorProfile())
+}
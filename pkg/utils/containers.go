@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// sidecarContainers are the names of containers injected next to the
+// application by service meshes and similar tooling
+var sidecarContainers = map[string]bool{
+	"istio-proxy":      true,
+	"istio-init":       true,
+	"linkerd-proxy":    true,
+	"linkerd-init":     true,
+	"envoy":            true,
+	"envoy-sidecar":    true,
+	"consul-dataplane": true,
+	"vault-agent":      true,
+	"cloud-sql-proxy":  true,
+}
+
+// appLabels are the pod labels that name the application, in order of
+// preference
+var appLabels = []string{"app.kubernetes.io/name", "app"}
+
+// PrimaryContainer returns the container of a pod that logs and exec should
+// default to. Sidecars, meaning known mesh proxies and containers whose name
+// contains "sidecar", are skipped. Of the rest, the container named after
+// the app label wins, and otherwise the one requesting the most resources,
+// the first listed on a tie. A pod made of sidecars only gets its first
+// container.
+func PrimaryContainer(pod *corev1.Pod) string {
+	if pod == nil || len(pod.Spec.Containers) == 0 {
+		return ""
+	}
+
+	candidates := make([]corev1.Container, 0, len(pod.Spec.Containers))
+	for _, container := range pod.Spec.Containers {
+		if !IsSidecarContainer(container.Name) {
+			candidates = append(candidates, container)
+		}
+	}
+	if len(candidates) == 0 {
+		return pod.Spec.Containers[0].Name
+	}
+
+	for _, label := range appLabels {
+		app := pod.Labels[label]
+		if app == "" {
+			continue
+		}
+		for _, container := range candidates {
+			if container.Name == app {
+				return container.Name
+			}
+		}
+	}
+
+	best := candidates[0]
+	for _, container := range candidates[1:] {
+		if compareRequests(container, best) > 0 {
+			best = container
+		}
+	}
+	return best.Name
+}
+
+// IsSidecarContainer reports whether a container name belongs to a known
+// sidecar
+func IsSidecarContainer(name string) bool {
+	return sidecarContainers[name] || strings.Contains(name, "sidecar")
+}
+
+// compareRequests orders containers by the memory they request, then by CPU,
+// falling back to limits for containers without requests
+func compareRequests(a, b corev1.Container) int {
+	for _, name := range []corev1.ResourceName{corev1.ResourceMemory, corev1.ResourceCPU} {
+		qa, qb := containerResource(a, name), containerResource(b, name)
+		if c := qa.Cmp(qb); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// containerResource returns the request of a container for a resource, or
+// its limit when nothing is requested
+func containerResource(container corev1.Container, name corev1.ResourceName) resource.Quantity {
+	if q, ok := container.Resources.Requests[name]; ok {
+		return q
+	}
+	return container.Resources.Limits[name]
+}
@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// container returns a container requesting the given memory, if any
+func container(name, memory string) corev1.Container {
+	c := corev1.Container{Name: name}
+	if memory != "" {
+		c.Resources.Requests = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse(memory)}
+	}
+	return c
+}
+
+func TestPrimaryContainer(t *testing.T) {
+	testCases := []struct {
+		name       string
+		labels     map[string]string
+		containers []corev1.Container
+		expected   string
+	}{
+		{name: "no containers", expected: ""},
+		{name: "single container", containers: []corev1.Container{container("web", "")}, expected: "web"},
+		{name: "mesh proxy first", containers: []corev1.Container{container("istio-proxy", "1Gi"), container("web", "")}, expected: "web"},
+		{name: "sidecar in the name", containers: []corev1.Container{container("log-sidecar", ""), container("worker", "")}, expected: "worker"},
+		{name: "app label", labels: map[string]string{"app": "api"}, containers: []corev1.Container{container("cache", "2Gi"), container("api", "128Mi")}, expected: "api"},
+		{name: "recommended name label wins", labels: map[string]string{"app.kubernetes.io/name": "api", "app": "cache"}, containers: []corev1.Container{container("cache", ""), container("api", "")}, expected: "api"},
+		{name: "largest request", containers: []corev1.Container{container("metrics", "64Mi"), container("server", "512Mi"), container("reloader", "")}, expected: "server"},
+		{name: "tie keeps the first", containers: []corev1.Container{container("a", ""), container("b", "")}, expected: "a"},
+		{name: "only sidecars", containers: []corev1.Container{container("linkerd-proxy", ""), container("istio-proxy", "")}, expected: "linkerd-proxy"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Labels: tc.labels},
+				Spec:       corev1.PodSpec{Containers: tc.containers},
+			}
+			assert.Equal(t, tc.expected, PrimaryContainer(pod))
+		})
+	}
+
+	assert.Empty(t, PrimaryContainer(nil))
+}
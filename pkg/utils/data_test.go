@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsBinary(t *testing.T) {
+	testCases := []struct {
+		name     string
+		data     []byte
+		expected bool
+	}{
+		{name: "plain text", data: []byte("s3cr3t-password"), expected: false},
+		{name: "multi-line PEM", data: []byte("-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"), expected: false},
+		{name: "unicode text", data: []byte("pässwörd ✓"), expected: false},
+		{name: "tabs and carriage returns", data: []byte("a\tb\r\n"), expected: false},
+		{name: "empty", data: []byte{}, expected: false},
+		{name: "invalid UTF-8", data: []byte{0xfe, 0xed, 0xfe, 0xed}, expected: true},
+		{name: "NUL byte", data: []byte("key\x00value"), expected: true},
+		{name: "DER prefix", data: []byte{0x30, 0x82, 0x01, 0x0a}, expected: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, IsBinary(tc.data))
+		})
+	}
+}
+
+func TestParseEnvFile(t *testing.T) {
+	testCases := []struct {
+		name    string
+		data    string
+		want    map[string]string
+		wantErr string
+	}{
+		{
+			name: "values comments and blank lines",
+			data: "\xef\xbb\xbf# settings\nLOG_LEVEL=debug\n\n  URL=http://x?a=b\nGREETING=\"hi there\"\nEMPTY=\n",
+			want: map[string]string{
+				"LOG_LEVEL": "debug",
+				"URL":       "http://x?a=b",
+				"GREETING":  `"hi there"`,
+				"EMPTY":     "",
+			},
+		},
+		{name: "missing equals", data: "LOG_LEVEL=debug\nVERBOSE\n", wantErr: "line 2: expected KEY=VALUE"},
+		{name: "invalid key", data: "BAD KEY=1\n", wantErr: "line 1: invalid key"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseEnvFile([]byte(tc.data))
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
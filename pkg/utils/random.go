@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Character sets for RandomValue
+const (
+	CharsetAlnum  = "alnum"
+	CharsetHex    = "hex"
+	CharsetBase64 = "base64"
+)
+
+// Charsets lists the character sets RandomValue accepts
+var Charsets = []string{CharsetAlnum, CharsetHex, CharsetBase64}
+
+// maxRandomLength caps generated values well below the size limit of a secret
+const maxRandomLength = 4096
+
+const alnumChars = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+
+// RandomValue returns a value made from length bytes of crypto/rand output.
+// Hex and base64 values encode those bytes; alnum values are length letters
+// and digits, each chosen uniformly.
+func RandomValue(length int, charset string) (string, error) {
+	if length < 1 || length > maxRandomLength {
+		return "", fmt.Errorf("length must be between 1 and %d, got %d", maxRandomLength, length)
+	}
+
+	switch charset {
+	case CharsetAlnum:
+		value := make([]byte, length)
+		limit := big.NewInt(int64(len(alnumChars)))
+		for i := range value {
+			n, err := rand.Int(rand.Reader, limit)
+			if err != nil {
+				return "", err
+			}
+			value[i] = alnumChars[n.Int64()]
+		}
+		return string(value), nil
+
+	case CharsetHex, CharsetBase64:
+		data := make([]byte, length)
+		if _, err := rand.Read(data); err != nil {
+			return "", err
+		}
+		if charset == CharsetHex {
+			return hex.EncodeToString(data), nil
+		}
+		return base64.StdEncoding.EncodeToString(data), nil
+	}
+
+	return "", fmt.Errorf("unknown charset %q (expected %s)", charset, strings.Join(Charsets, ", "))
+}
+
+// ParseGenerateSpec splits a key=length value as used by --generate
+func ParseGenerateSpec(spec string) (string, int, error) {
+	key, lengthText, found := strings.Cut(spec, "=")
+	if !found {
+		return "", 0, fmt.Errorf("invalid generate format: %s (expected key=length)", spec)
+	}
+	if err := ValidateDataKey(key); err != nil {
+		return "", 0, err
+	}
+
+	length, err := strconv.Atoi(lengthText)
+	if err != nil || length < 1 || length > maxRandomLength {
+		return "", 0, fmt.Errorf("invalid length %q for key %s: must be between 1 and %d", lengthText, key, maxRandomLength)
+	}
+	return key, length, nil
+}
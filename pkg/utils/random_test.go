@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRandomValue(t *testing.T) {
+	value, err := RandomValue(32, CharsetBase64)
+	require.NoError(t, err)
+	decoded, err := base64.StdEncoding.DecodeString(value)
+	require.NoError(t, err)
+	assert.Len(t, decoded, 32)
+
+	value, err = RandomValue(16, CharsetHex)
+	require.NoError(t, err)
+	decoded, err = hex.DecodeString(value)
+	require.NoError(t, err)
+	assert.Len(t, decoded, 16)
+
+	value, err = RandomValue(24, CharsetAlnum)
+	require.NoError(t, err)
+	assert.Regexp(t, `^[A-Za-z0-9]{24}$`, value)
+
+	other, err := RandomValue(24, CharsetAlnum)
+	require.NoError(t, err)
+	assert.NotEqual(t, value, other)
+
+	_, err = RandomValue(32, "emoji")
+	assert.EqualError(t, err, `unknown charset "emoji" (expected alnum, hex, base64)`)
+
+	_, err = RandomValue(0, CharsetHex)
+	assert.Error(t, err)
+}
+
+func TestParseGenerateSpec(t *testing.T) {
+	testCases := []struct {
+		name       string
+		spec       string
+		wantKey    string
+		wantLength int
+		wantErr    string
+	}{
+		{name: "valid", spec: "password=32", wantKey: "password", wantLength: 32},
+		{name: "missing length", spec: "password", wantErr: "expected key=length"},
+		{name: "not a number", spec: "password=long", wantErr: `invalid length "long" for key password`},
+		{name: "zero", spec: "password=0", wantErr: `invalid length "0"`},
+		{name: "too long", spec: "password=5000", wantErr: `invalid length "5000"`},
+		{name: "invalid key", spec: "db password=32", wantErr: `invalid key "db password"`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			key, length, err := ParseGenerateSpec(tc.spec)
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantKey, key)
+			assert.Equal(t, tc.wantLength, length)
+		})
+	}
+}
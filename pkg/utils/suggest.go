@@ -0,0 +1,60 @@
+package utils
+
+import "strings"
+
+// SuggestClosest returns the candidate most likely meant by target, or ""
+// when none is close. A candidate that starts with target wins, the shortest
+// first, so the start of a generated pod name finds the pod; otherwise the
+// candidate with the smallest edit distance is chosen if it is within a
+// third of the length of target, and at least 2 edits.
+func SuggestClosest(target string, candidates []string) string {
+	if target == "" {
+		return ""
+	}
+
+	best := ""
+	for _, candidate := range candidates {
+		if candidate != target && strings.HasPrefix(candidate, target) &&
+			(best == "" || len(candidate) < len(best)) {
+			best = candidate
+		}
+	}
+	if best != "" {
+		return best
+	}
+
+	bestDistance := max(2, len(target)/3) + 1
+	for _, candidate := range candidates {
+		if candidate == target {
+			continue
+		}
+		if distance := levenshtein(target, candidate); distance < bestDistance {
+			best, bestDistance = candidate, distance
+		}
+	}
+	return best
+}
+
+// levenshtein counts the single-character insertions, deletions and
+// substitutions that turn a into b
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(rb)]
+}
@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuggestClosest(t *testing.T) {
+	pods := []string{"web-7d9f8c6b5-x2k4p", "web-7d9f8c6b5-q8m1z", "worker-5c4b-abcde", "redis-0"}
+
+	testCases := []struct {
+		name       string
+		target     string
+		candidates []string
+		want       string
+	}{
+		{name: "typo", target: "redsi-0", candidates: pods, want: "redis-0"},
+		{name: "stale generated suffix", target: "web-7d9f8c6b5-x2k4q", candidates: pods, want: "web-7d9f8c6b5-x2k4p"},
+		{name: "prefix", target: "worker", candidates: pods, want: "worker-5c4b-abcde"},
+		{name: "nothing close", target: "postgres", candidates: pods, want: ""},
+		{name: "no candidates", target: "web", want: ""},
+		{name: "exact match is not a suggestion", target: "redis-0", candidates: []string{"redis-0"}, want: ""},
+		{name: "short names allow two edits", target: "db", candidates: []string{"dbx", "api"}, want: "dbx"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, SuggestClosest(tc.target, tc.candidates))
+		})
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	assert.Equal(t, 0, levenshtein("pod", "pod"))
+	assert.Equal(t, 3, levenshtein("kitten", "sitting"))
+	assert.Equal(t, 3, levenshtein("", "abc"))
+}
@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// TableWriter writes tab-separated rows as aligned columns. Every command
+// prints its tables through one so they share the same spacing.
+type TableWriter struct {
+	*tabwriter.Writer
+	noHeaders bool
+}
+
+// NewTableWriter returns a TableWriter that writes to out once flushed. With
+// noHeaders set, header rows are left out so the output can be piped into
+// other tools.
+func NewTableWriter(out io.Writer, noHeaders bool) *TableWriter {
+	return &TableWriter{
+		Writer:    tabwriter.NewWriter(out, 0, 0, 3, ' ', 0),
+		noHeaders: noHeaders,
+	}
+}
+
+// Header writes the header row of the table
+func (t *TableWriter) Header(columns ...string) {
+	if t.noHeaders {
+		return
+	}
+	fmt.Fprintln(t.Writer, strings.Join(columns, "\t"))
+}
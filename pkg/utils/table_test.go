@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTableWriter(t *testing.T) {
+	testCases := []struct {
+		name      string
+		noHeaders bool
+		want      string
+	}{
+		{
+			name: "with headers",
+			want: "NAME       STATUS\n" +
+				"api-7d9f   Running\n" +
+				"web        Pending\n",
+		},
+		{
+			name:      "without headers",
+			noHeaders: true,
+			want: "api-7d9f   Running\n" +
+				"web        Pending\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var out bytes.Buffer
+			w := NewTableWriter(&out, tc.noHeaders)
+			w.Header("NAME", "STATUS")
+			fmt.Fprintln(w, "api-7d9f\tRunning")
+			fmt.Fprintln(w, "web\tPending")
+			assert.NoError(t, w.Flush())
+
+			assert.Equal(t, tc.want, out.String())
+		})
+	}
+}
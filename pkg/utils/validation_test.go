@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateDataKey(t *testing.T) {
+	testCases := []struct {
+		name    string
+		key     string
+		wantErr string
+	}{
+		{name: "simple key", key: "password"},
+		{name: "dotted key", key: "config.yaml"},
+		{name: "env style key", key: "DB_PASSWORD"},
+		{name: "dashed key", key: "tls-cert"},
+		{name: "empty key", key: "", wantErr: "key cannot be empty"},
+		{name: "space", key: "my key", wantErr: `invalid key "my key"`},
+		{name: "slash", key: "path/to", wantErr: `invalid key "path/to"`},
+		{name: "colon", key: "a:b", wantErr: `invalid key "a:b"`},
+		{name: "dot only", key: ".", wantErr: `invalid key "."`},
+		{name: "too long", key: strings.Repeat("a", 254), wantErr: "invalid key"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateDataKey(tc.key)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateResourceName(t *testing.T) {
+	testCases := []struct {
+		name    string
+		value   string
+		wantErr string
+	}{
+		{name: "simple name", value: "my-secret"},
+		{name: "dotted name", value: "app.config"},
+		{name: "digits", value: "cert-2024"},
+		{name: "empty name", value: "", wantErr: "name cannot be empty"},
+		{name: "uppercase", value: "MySecret", wantErr: `invalid name "MySecret"`},
+		{name: "underscore", value: "my_secret", wantErr: `invalid name "my_secret"`},
+		{name: "leading dash", value: "-secret", wantErr: `invalid name "-secret"`},
+		{name: "too long", value: strings.Repeat("a", 254), wantErr: "invalid name"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateResourceName(tc.value)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.wantErr)
+			}
+		})
+	}
+}